@@ -0,0 +1,18 @@
+package commands
+
+import "github.com/google/uuid"
+
+const (
+	PowerRequestKey = "server.power.request"
+)
+
+const (
+	PowerActionStart  = "START"
+	PowerActionStop   = "STOP"
+	PowerActionReboot = "REBOOT"
+)
+
+type PowerServerCommand struct {
+	ServerID uuid.UUID `json:"serverId"`
+	Action   string    `json:"action"`
+}
@@ -3,10 +3,33 @@ package commands
 import "github.com/google/uuid"
 
 const (
-	ProvisionRequestKey = "server.provision.request"
+	ProvisionRequestKey   = "server.provision.request"
+	DeprovisionRequestKey = "server.deprovision.request"
 )
 
 type ProvisionServerCommand struct {
 	ServerID uuid.UUID `json:"serverId"`
 	Hostname string    `json:"hostname"`
+
+	// SnapshotID is set when the server is created from a snapshot.
+	SnapshotID *uuid.UUID `json:"snapshotId,omitempty"`
+
+	// SSHKeys are OpenSSH public keys to add to authorized_keys of the
+	// default user.
+	SSHKeys []string `json:"sshKeys,omitempty"`
+
+	// IPCount is the number of addresses the server gets in each of
+	// AddressFamilies, which holds the values of the provisioning events
+	// (IPV4, IPV6); the first address of a family is its primary one. Older
+	// publishers leave both empty, which means one address per family.
+	IPCount         int      `json:"ipCount,omitempty"`
+	AddressFamilies []string `json:"addressFamilies,omitempty"`
+}
+
+type DeprovisionServerCommand struct {
+	ServerID    uuid.UUID `json:"serverId"`
+	IPv4Address *string   `json:"ipv4Address,omitempty"`
+
+	// Addresses are all IPv4 and IPv6 addresses to release.
+	Addresses []string `json:"addresses,omitempty"`
 }
@@ -0,0 +1,23 @@
+package commands
+
+import "github.com/google/uuid"
+
+const (
+	SnapshotCreateRequestKey  = "snapshot.create.request"
+	SnapshotRestoreRequestKey = "snapshot.restore.request"
+	SnapshotDeleteRequestKey  = "snapshot.delete.request"
+)
+
+type CreateSnapshotCommand struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	ServerID   uuid.UUID `json:"serverId"`
+}
+
+type RestoreSnapshotCommand struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	ServerID   uuid.UUID `json:"serverId"`
+}
+
+type DeleteSnapshotCommand struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+}
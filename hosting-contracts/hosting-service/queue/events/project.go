@@ -0,0 +1,21 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProjectInvitationCreated = "project.invitation.created"
+)
+
+type ProjectInvitationCreatedEvent struct {
+	InvitationID uuid.UUID `json:"invitationId"`
+	ProjectID    uuid.UUID `json:"projectId"`
+	ProjectName  string    `json:"projectName"`
+	InviteeID    uuid.UUID `json:"inviteeId"`
+	InvitedBy    uuid.UUID `json:"invitedBy"`
+	Role         string    `json:"role"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
@@ -9,6 +9,7 @@ const (
 type ServerStatusChangedEvent struct {
 	OwnerID     uuid.UUID `json:"ownerId"`
 	ServerID    uuid.UUID `json:"serverId"`
+	PlanID      uuid.UUID `json:"planId"`
 	Status      string    `json:"status"`
 	IPv4Address *string   `json:"ip,omitempty"`
 }
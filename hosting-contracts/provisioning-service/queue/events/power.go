@@ -0,0 +1,26 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PowerSucceededKey     = "server.power.succeeded"
+	PowerFailedKey        = "server.power.failed"
+	PowerResultKeyPattern = "server.power.*"
+)
+
+type ServerPowerSucceededEvent struct {
+	ServerID    uuid.UUID `json:"serverId"`
+	Action      string    `json:"action"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+type ServerPowerFailedEvent struct {
+	ServerID uuid.UUID `json:"serverId"`
+	Action   string    `json:"action"`
+	Reason   string    `json:"reason"`
+	FailedAt time.Time `json:"failedAt"`
+}
@@ -10,11 +10,36 @@ const (
 	ProvisionSucceededKey     = "server.provision.succeeded"
 	ProvisionFailedKey        = "server.provision.failed"
 	ProvisionResultKeyPattern = "server.provision.*"
+
+	DeprovisionSucceededKey     = "server.deprovision.succeeded"
+	DeprovisionResultKeyPattern = "server.deprovision.*"
+)
+
+const (
+	AddressFamilyIPv4 = "IPV4"
+	AddressFamilyIPv6 = "IPV6"
 )
 
+// IPAddress is an address assigned to a provisioned server. Each family has
+// one primary address.
+type IPAddress struct {
+	Address    string  `json:"address"`
+	Family     string  `json:"family"`
+	Primary    bool    `json:"primary"`
+	ReverseDNS *string `json:"reverseDns,omitempty"`
+}
+
 type ServerProvisionedEvent struct {
-	ServerID      uuid.UUID `json:"serverId"`
-	IPv4Address   string    `json:"ipv4Address"`
+	ServerID uuid.UUID `json:"serverId"`
+
+	// IPv4Address is the primary IPv4 address, empty for a server with
+	// IPv6 addresses only. Consumers should prefer Addresses, which older
+	// publishers leave empty.
+	IPv4Address string `json:"ipv4Address,omitempty"`
+
+	// Addresses are all addresses assigned to the server.
+	Addresses []IPAddress `json:"addresses,omitempty"`
+
 	ProvisionedAt time.Time `json:"provisionedAt"`
 }
 
@@ -23,3 +48,8 @@ type ServerProvisionFailedEvent struct {
 	Reason   string    `json:"reason"`
 	FailedAt time.Time `json:"failedAt"`
 }
+
+type ServerDeprovisionedEvent struct {
+	ServerID        uuid.UUID `json:"serverId"`
+	DeprovisionedAt time.Time `json:"deprovisionedAt"`
+}
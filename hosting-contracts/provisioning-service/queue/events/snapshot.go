@@ -0,0 +1,48 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SnapshotCreatedKey       = "snapshot.create.succeeded"
+	SnapshotCreateFailedKey  = "snapshot.create.failed"
+	SnapshotRestoredKey      = "snapshot.restore.succeeded"
+	SnapshotRestoreFailedKey = "snapshot.restore.failed"
+	SnapshotDeletedKey       = "snapshot.delete.succeeded"
+
+	SnapshotCreateResultKeyPattern  = "snapshot.create.*"
+	SnapshotRestoreResultKeyPattern = "snapshot.restore.*"
+	SnapshotDeleteResultKeyPattern  = "snapshot.delete.*"
+)
+
+type SnapshotCreatedEvent struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type SnapshotCreateFailedEvent struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	Reason     string    `json:"reason"`
+	FailedAt   time.Time `json:"failedAt"`
+}
+
+type SnapshotRestoredEvent struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	ServerID   uuid.UUID `json:"serverId"`
+	RestoredAt time.Time `json:"restoredAt"`
+}
+
+type SnapshotRestoreFailedEvent struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	ServerID   uuid.UUID `json:"serverId"`
+	Reason     string    `json:"reason"`
+	FailedAt   time.Time `json:"failedAt"`
+}
+
+type SnapshotDeletedEvent struct {
+	SnapshotID uuid.UUID `json:"snapshotId"`
+	DeletedAt  time.Time `json:"deletedAt"`
+}
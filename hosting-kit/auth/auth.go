@@ -12,11 +12,52 @@ var (
 	ErrForbidden    = errors.New("forbidden")
 )
 
+// Scope limits what a request authenticated with an API token may do. Every
+// scope includes the ones below it: admin, servers, read.
+type Scope string
+
+const (
+	// ScopeRead allows reading only.
+	ScopeRead Scope = "read"
+
+	// ScopeServers allows everything a user may do except managing API
+	// tokens.
+	ScopeServers Scope = "servers"
+
+	// ScopeAdmin additionally allows the admin endpoints. Only
+	// administrators get tokens with it.
+	ScopeAdmin Scope = "admin"
+)
+
+var scopeRanks = map[Scope]int{
+	ScopeRead:    1,
+	ScopeServers: 2,
+	ScopeAdmin:   3,
+}
+
+// Valid reports whether s is a known scope.
+func (s Scope) Valid() bool {
+	_, ok := scopeRanks[s]
+	return ok
+}
+
 type Claims struct {
 	UserID  uuid.UUID
 	Email   string
 	Name    string
 	IsAdmin bool
+
+	// Scope is set when the request carries an API token. Sessions have no
+	// scope and may do everything the user may.
+	Scope Scope
+}
+
+// Allows reports whether the claims grant the scope.
+func (c Claims) Allows(s Scope) bool {
+	if c.Scope == "" {
+		return true
+	}
+	return scopeRanks[c.Scope] >= scopeRanks[s]
 }
 
 type Client interface {
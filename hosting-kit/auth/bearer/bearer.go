@@ -0,0 +1,36 @@
+package bearer
+
+import (
+	"context"
+	"hosting-kit/auth"
+	"hosting-kit/otel"
+	"strings"
+)
+
+const prefix = "Bearer "
+
+type client struct {
+	tokens   auth.Client
+	fallback auth.Client
+}
+
+// New returns a client that checks "Bearer <token>" credentials with tokens
+// and hands everything else, such as session cookies, to fallback.
+func New(tokens auth.Client, fallback auth.Client) auth.Client {
+	return &client{
+		tokens:   tokens,
+		fallback: fallback,
+	}
+}
+
+func (c *client) Authenticate(ctx context.Context, token string) (auth.Claims, error) {
+	secret, ok := strings.CutPrefix(token, prefix)
+	if !ok {
+		return c.fallback.Authenticate(ctx, token)
+	}
+
+	ctx, span := otel.AddSpan(ctx, "auth.bearer.authenticate")
+	defer span.End()
+
+	return c.tokens.Authenticate(ctx, strings.TrimSpace(secret))
+}
@@ -0,0 +1,51 @@
+package kratos
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/otel"
+
+	"github.com/google/uuid"
+	ory "github.com/ory/kratos-client-go"
+)
+
+// Identities reads identities through the Kratos admin API.
+type Identities struct {
+	kratos *ory.APIClient
+}
+
+func NewIdentities(kratosAdminURL string) *Identities {
+	config := ory.NewConfiguration()
+	config.Servers = []ory.ServerConfiguration{
+		{URL: kratosAdminURL},
+	}
+
+	return &Identities{
+		kratos: ory.NewAPIClient(config),
+	}
+}
+
+// IsAdmin reports whether the identity is currently an administrator.
+func (i *Identities) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ctx, span := otel.AddSpan(ctx, "auth.kratos.isadmin")
+	defer span.End()
+
+	identity, _, err := i.kratos.IdentityAPI.
+		GetIdentity(ctx, userID.String()).
+		Execute()
+	if err != nil {
+		return false, fmt.Errorf("getidentity: %w", err)
+	}
+
+	return isAdmin(identity.MetadataPublic), nil
+}
+
+func isAdmin(metadata interface{}) bool {
+	meta, ok := metadata.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	val, _ := meta["is_admin"].(bool)
+	return val
+}
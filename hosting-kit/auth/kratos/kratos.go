@@ -50,20 +50,11 @@ func (c *client) Authenticate(ctx context.Context, token string) (auth.Claims, e
 	email, _ := traits["email"].(string)
 	name, _ := traits["name"].(string)
 
-	isAdmin := false
-	if session.Identity.MetadataPublic != nil {
-		if meta, ok := session.Identity.MetadataPublic.(map[string]interface{}); ok {
-			if val, ok := meta["is_admin"].(bool); ok {
-				isAdmin = val
-			}
-		}
-	}
-
 	claims := auth.Claims{
 		UserID:  uuid.MustParse(session.Identity.Id),
 		Email:   email,
 		Name:    name,
-		IsAdmin: isAdmin,
+		IsAdmin: isAdmin(session.Identity.MetadataPublic),
 	}
 
 	return claims, nil
@@ -9,9 +9,7 @@ func Authenticate(authClient auth.Client) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-			cookie := r.Header.Get("Cookie")
-
-			claims, err := authClient.Authenticate(r.Context(), cookie)
+			claims, err := authClient.Authenticate(r.Context(), credentials(r))
 			if err != nil {
 				http.Error(w, auth.ErrUnauthorized.Error(), http.StatusUnauthorized)
 				return
@@ -26,8 +24,7 @@ func Authenticate(authClient auth.Client) func(http.Handler) http.Handler {
 func AuthenticateOptional(authClient auth.Client) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			cookie := r.Header.Get("Cookie")
-			claims, err := authClient.Authenticate(r.Context(), cookie)
+			claims, err := authClient.Authenticate(r.Context(), credentials(r))
 
 			ctx := r.Context()
 			if err == nil {
@@ -38,3 +35,12 @@ func AuthenticateOptional(authClient auth.Client) func(http.Handler) http.Handle
 		})
 	}
 }
+
+// credentials passes the Authorization header to the auth client when the
+// request has one, and the session cookie otherwise.
+func credentials(r *http.Request) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		return authorization
+	}
+	return r.Header.Get("Cookie")
+}
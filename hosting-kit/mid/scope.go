@@ -0,0 +1,52 @@
+package mid
+
+import (
+	"hosting-kit/auth"
+	"net/http"
+)
+
+// LimitScope rejects requests whose API token does not cover them. Reads
+// need auth.ScopeRead and every other method auth.ScopeServers.
+func LimitScope() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := auth.GetClaims(r.Context())
+			if err != nil {
+				http.Error(w, auth.ErrUnauthorized.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			scope := auth.ScopeServers
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				scope = auth.ScopeRead
+			}
+
+			if !claims.Allows(scope) {
+				http.Error(w, auth.ErrForbidden.Error(), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireSession rejects requests authenticated with an API token.
+func RequireSession() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := auth.GetClaims(r.Context())
+			if err != nil {
+				http.Error(w, auth.ErrUnauthorized.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			if claims.Scope != "" {
+				http.Error(w, auth.ErrForbidden.Error(), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package page
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Key is the position of a row in a keyset-ordered listing: the sort field,
+// the value of its column as formatted by the store, and the row id that
+// breaks ties.
+type Key struct {
+	Field string `json:"f,omitempty"`
+	Value string `json:"v,omitempty"`
+	ID    string `json:"id"`
+}
+
+// EncodeCursor turns a key into the opaque string handed out to clients.
+func EncodeCursor(k Key) string {
+	data, _ := json.Marshal(k)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a string produced by EncodeCursor.
+func DecodeCursor(s string) (Key, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Key{}, ErrInvalidCursor
+	}
+
+	var k Key
+	if err := json.Unmarshal(data, &k); err != nil || k.ID == "" {
+		return Key{}, ErrInvalidCursor
+	}
+
+	return k, nil
+}
+
+// Cursor requests a page of a keyset-ordered listing: the rows after or
+// before a key, or the first rows when neither is set.
+type Cursor struct {
+	size   int
+	after  *Key
+	before *Key
+}
+
+// ParseCursor validates the cursors and clamps the size the same way Parse
+// does. At most one of after and before can be set.
+func ParseCursor(size int, after string, before string) (Cursor, error) {
+	switch {
+	case size > 100:
+		size = 100
+	case size <= 0:
+		size = 10
+	}
+
+	c := Cursor{size: size}
+
+	if after != "" && before != "" {
+		return Cursor{}, fmt.Errorf("%w: after and before cannot be combined", ErrInvalidCursor)
+	}
+
+	if after != "" {
+		k, err := DecodeCursor(after)
+		if err != nil {
+			return Cursor{}, err
+		}
+		c.after = &k
+	}
+
+	if before != "" {
+		k, err := DecodeCursor(before)
+		if err != nil {
+			return Cursor{}, err
+		}
+		c.before = &k
+	}
+
+	return c, nil
+}
+
+func (c Cursor) Size() int { return c.size }
+
+// Limit is the number of rows a store fetches: one more than the size, so
+// Collect can tell whether another page follows.
+func (c Cursor) Limit() int { return c.size + 1 }
+
+// Key returns the key the page starts from, if any.
+func (c Cursor) Key() (Key, bool) {
+	switch {
+	case c.after != nil:
+		return *c.after, true
+	case c.before != nil:
+		return *c.before, true
+	}
+	return Key{}, false
+}
+
+// Backward reports whether the page ends at its key, so the store has to
+// read in reverse order.
+func (c Cursor) Backward() bool { return c.before != nil }
+
+// Edge is a row of a cursor page together with its own cursor.
+type Edge[T any] struct {
+	Cursor string
+	Node   T
+}
+
+type CursorDocument struct {
+	PageSize    int
+	StartCursor string
+	EndCursor   string
+	HasNext     bool
+	HasPrev     bool
+}
+
+// Collect turns the rows a store fetched for c into edges in listing order
+// and describes the page. Backward rows are expected in reverse order.
+func Collect[T any](c Cursor, rows []T, key func(T) Key) ([]Edge[T], CursorDocument) {
+	more := len(rows) > c.size
+	if more {
+		rows = rows[:c.size]
+	}
+
+	if c.Backward() {
+		rows = slices.Clone(rows)
+		slices.Reverse(rows)
+	}
+
+	edges := make([]Edge[T], len(rows))
+	for i, row := range rows {
+		edges[i] = Edge[T]{Cursor: EncodeCursor(key(row)), Node: row}
+	}
+
+	doc := CursorDocument{PageSize: c.size}
+
+	if c.Backward() {
+		doc.HasPrev = more
+		doc.HasNext = true
+	} else {
+		doc.HasNext = more
+		doc.HasPrev = c.after != nil
+	}
+
+	if len(edges) > 0 {
+		doc.StartCursor = edges[0].Cursor
+		doc.EndCursor = edges[len(edges)-1].Cursor
+	}
+
+	return edges, doc
+}
@@ -0,0 +1,243 @@
+package page_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"hosting-kit/page"
+	"strconv"
+	"testing"
+)
+
+func Test_CursorRoundTrip(t *testing.T) {
+	type testCase struct {
+		name string
+		key  page.Key
+	}
+
+	table := []testCase{
+		{name: "id_only", key: page.Key{ID: "b7c1d6a4-5f3e-4c1a-9a1e-0d6c2f7e8a90"}},
+		{name: "field_value", key: page.Key{Field: "name", Value: "web-01", ID: "1"}},
+		{name: "timestamp", key: page.Key{Field: "created_at", Value: "2026-03-01T10:00:00.123456Z", ID: "2"}},
+		{name: "special_characters", key: page.Key{Field: "name", Value: `a"b\c/d ünï 😀`, ID: "3"}},
+		{name: "empty_value", key: page.Key{Field: "name", ID: "4"}},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			s := page.EncodeCursor(tt.key)
+
+			got, err := page.DecodeCursor(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.key {
+				t.Errorf("got %+v, want %+v", got, tt.key)
+			}
+		})
+	}
+}
+
+func Test_DecodeCursor(t *testing.T) {
+	valid := page.EncodeCursor(page.Key{Field: "name", Value: "web-01", ID: "1"})
+
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	type testCase struct {
+		name   string
+		cursor string
+	}
+
+	table := []testCase{
+		{name: "empty", cursor: ""},
+		{name: "not_base64", cursor: "not a cursor!"},
+		{name: "padded_base64", cursor: base64.URLEncoding.EncodeToString([]byte(`{"id":"1"}`)) + "="},
+		{name: "truncated", cursor: valid[:len(valid)-4]},
+		{name: "tampered_character", cursor: "A" + valid[1:]},
+		{name: "not_json", cursor: encode("name=web-01")},
+		{name: "json_array", cursor: encode(`["name","web-01","1"]`)},
+		{name: "missing_id", cursor: encode(`{"f":"name","v":"web-01"}`)},
+		{name: "empty_id", cursor: encode(`{"f":"name","v":"web-01","id":""}`)},
+		{name: "wrong_type", cursor: encode(`{"id":1}`)},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := page.DecodeCursor(tt.cursor)
+			if !errors.Is(err, page.ErrInvalidCursor) {
+				t.Errorf("got error %v, want %v", err, page.ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func Test_ParseCursor(t *testing.T) {
+	key := page.Key{Field: "name", Value: "web-01", ID: "1"}
+	valid := page.EncodeCursor(key)
+
+	type testCase struct {
+		name   string
+		size   int
+		after  string
+		before string
+
+		wantErr      error
+		wantSize     int
+		wantKey      bool
+		wantBackward bool
+	}
+
+	table := []testCase{
+		{name: "first_page", size: 20, wantSize: 20},
+		{name: "default_size", size: 0, wantSize: 10},
+		{name: "negative_size", size: -5, wantSize: 10},
+		{name: "max_size", size: 100, wantSize: 100},
+		{name: "clamped_size", size: 500, wantSize: 100},
+		{name: "after", size: 10, after: valid, wantSize: 10, wantKey: true},
+		{name: "before", size: 10, before: valid, wantSize: 10, wantKey: true, wantBackward: true},
+		{name: "fail_after_and_before", size: 10, after: valid, before: valid, wantErr: page.ErrInvalidCursor},
+		{name: "fail_bad_after", size: 10, after: "???", wantErr: page.ErrInvalidCursor},
+		{name: "fail_bad_before", size: 10, before: "???", wantErr: page.ErrInvalidCursor},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := page.ParseCursor(tt.size, tt.after, tt.before)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if c.Size() != tt.wantSize {
+				t.Errorf("size: got %d, want %d", c.Size(), tt.wantSize)
+			}
+			if c.Limit() != tt.wantSize+1 {
+				t.Errorf("limit: got %d, want %d", c.Limit(), tt.wantSize+1)
+			}
+
+			got, ok := c.Key()
+			if ok != tt.wantKey {
+				t.Fatalf("key set: got %v, want %v", ok, tt.wantKey)
+			}
+			if ok && got != key {
+				t.Errorf("key: got %+v, want %+v", got, key)
+			}
+			if c.Backward() != tt.wantBackward {
+				t.Errorf("backward: got %v, want %v", c.Backward(), tt.wantBackward)
+			}
+		})
+	}
+}
+
+func Test_Collect(t *testing.T) {
+	key := func(n int) page.Key {
+		return page.Key{Field: "n", Value: strconv.Itoa(n), ID: strconv.Itoa(n)}
+	}
+	at := page.EncodeCursor(key(0))
+
+	// rows returns the rows a store would fetch: ascending from 1 for
+	// forward pages, descending from n for backward pages.
+	rows := func(n int, backward bool) []int {
+		r := make([]int, n)
+		for i := range r {
+			r[i] = i + 1
+			if backward {
+				r[i] = n - i
+			}
+		}
+		return r
+	}
+
+	type testCase struct {
+		name   string
+		after  string
+		before string
+		fetch  int
+
+		wantNodes []int
+		wantNext  bool
+		wantPrev  bool
+	}
+
+	table := []testCase{
+		{name: "first_page_empty", fetch: 0, wantNodes: []int{}},
+		{name: "first_page_short", fetch: 2, wantNodes: []int{1, 2}},
+		{name: "first_page_exact", fetch: 3, wantNodes: []int{1, 2, 3}},
+		{name: "first_page_more", fetch: 4, wantNodes: []int{1, 2, 3}, wantNext: true},
+		{name: "after_last_page", after: at, fetch: 3, wantNodes: []int{1, 2, 3}, wantPrev: true},
+		{name: "after_more", after: at, fetch: 4, wantNodes: []int{1, 2, 3}, wantNext: true, wantPrev: true},
+		{name: "after_past_end", after: at, fetch: 0, wantNodes: []int{}, wantPrev: true},
+		{name: "before_first_page", before: at, fetch: 3, wantNodes: []int{1, 2, 3}, wantNext: true},
+		{name: "before_more", before: at, fetch: 4, wantNodes: []int{2, 3, 4}, wantNext: true, wantPrev: true},
+		{name: "before_start", before: at, fetch: 0, wantNodes: []int{}, wantNext: true},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := page.ParseCursor(3, tt.after, tt.before)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			fetched := rows(tt.fetch, c.Backward())
+			edges, doc := page.Collect(c, fetched, key)
+
+			if len(edges) != len(tt.wantNodes) {
+				t.Fatalf("edges: got %d, want %d", len(edges), len(tt.wantNodes))
+			}
+			for i, e := range edges {
+				if e.Node != tt.wantNodes[i] {
+					t.Errorf("edge %d: got node %d, want %d", i, e.Node, tt.wantNodes[i])
+				}
+				got, err := page.DecodeCursor(e.Cursor)
+				if err != nil || got != key(e.Node) {
+					t.Errorf("edge %d: cursor decodes to %+v (%v), want %+v", i, got, err, key(e.Node))
+				}
+			}
+
+			if doc.PageSize != 3 {
+				t.Errorf("page size: got %d, want 3", doc.PageSize)
+			}
+			if doc.HasNext != tt.wantNext {
+				t.Errorf("has next: got %v, want %v", doc.HasNext, tt.wantNext)
+			}
+			if doc.HasPrev != tt.wantPrev {
+				t.Errorf("has prev: got %v, want %v", doc.HasPrev, tt.wantPrev)
+			}
+
+			if len(edges) == 0 {
+				if doc.StartCursor != "" || doc.EndCursor != "" {
+					t.Errorf("cursors of an empty page: got %q and %q, want none", doc.StartCursor, doc.EndCursor)
+				}
+				return
+			}
+			if doc.StartCursor != edges[0].Cursor {
+				t.Errorf("start cursor: got %q, want %q", doc.StartCursor, edges[0].Cursor)
+			}
+			if doc.EndCursor != edges[len(edges)-1].Cursor {
+				t.Errorf("end cursor: got %q, want %q", doc.EndCursor, edges[len(edges)-1].Cursor)
+			}
+		})
+	}
+}
+
+func Test_CollectKeepsRows(t *testing.T) {
+	c, err := page.ParseCursor(2, "", page.EncodeCursor(page.Key{ID: "0"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fetched := []int{3, 2, 1}
+	page.Collect(c, fetched, func(n int) page.Key { return page.Key{ID: strconv.Itoa(n)} })
+
+	if fetched[0] != 3 || fetched[1] != 2 || fetched[2] != 1 {
+		t.Errorf("rows were reordered in place: %v", fetched)
+	}
+}
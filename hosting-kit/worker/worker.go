@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type JobFunc func(ctx context.Context) error
+
+type ErrorHandlerFunc func(ctx context.Context, err error, name string)
+
+type Manager struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	jobTimeout time.Duration
+	tracer     trace.Tracer
+}
+
+func NewManager(jobTimeout time.Duration, tracer trace.Tracer) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Manager{
+		ctx:        ctx,
+		cancel:     cancel,
+		jobTimeout: jobTimeout,
+		tracer:     tracer,
+	}
+}
+
+// Every runs job in the background each interval until the manager is stopped.
+func (m *Manager) Every(name string, interval time.Duration, job JobFunc) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+				m.run(name, job)
+			}
+		}
+	}()
+}
+
+func (m *Manager) run(name string, job JobFunc) {
+	ctx, span := m.tracer.Start(m.ctx, "worker.run", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	ctx = otel.InjectTracing(ctx, m.tracer)
+
+	span.SetAttributes(attribute.String("worker.job", name))
+
+	ctx, cancel := context.WithTimeout(ctx, m.jobTimeout)
+	defer cancel()
+
+	if err := job(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetStatus(codes.Ok, "")
+}
+
+func (m *Manager) Stop(ctx context.Context) error {
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func LogErrors(handler ErrorHandlerFunc, name string, next JobFunc) JobFunc {
+	return func(ctx context.Context) error {
+		err := next(ctx)
+		if err != nil && handler != nil {
+			handler(ctx, err, name)
+		}
+		return err
+	}
+}
@@ -0,0 +1,47 @@
+package projectgrp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hosting-contracts/hosting-service/queue/events"
+	"hosting-kit/logger"
+	"hosting-kit/messaging"
+	"hosting-notification-service/internal/notification"
+)
+
+type handlers struct {
+	log      *logger.Logger
+	notifier *notification.Notifier
+}
+
+func new(log *logger.Logger, notifier *notification.Notifier) *handlers {
+	return &handlers{notifier: notifier, log: log}
+}
+
+// HandleInvitationCreated tells the invited user about the invitation, so
+// the client can offer to accept or decline it.
+func (h *handlers) HandleInvitationCreated(ctx context.Context, body []byte, routingKey string) error {
+	var invitationEvent events.ProjectInvitationCreatedEvent
+	if err := json.Unmarshal(body, &invitationEvent); err != nil {
+		return fmt.Errorf("%w: unmarshal failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "handling project invitation",
+		"invitation_id", invitationEvent.InvitationID,
+		"project_id", invitationEvent.ProjectID,
+		"invitee_id", invitationEvent.InviteeID,
+		"routing_key", routingKey,
+	)
+
+	event := notification.Event{
+		Type:    routingKey,
+		Payload: json.RawMessage(body),
+	}
+
+	if err := h.notifier.Notify(ctx, invitationEvent.InviteeID, event); err != nil {
+		return err
+	}
+
+	return nil
+}
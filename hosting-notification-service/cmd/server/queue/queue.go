@@ -3,14 +3,16 @@ package queue
 import (
 	"hosting-kit/logger"
 	"hosting-kit/messaging"
+	"hosting-notification-service/cmd/server/queue/handlers/projectgrp"
 	"hosting-notification-service/cmd/server/queue/handlers/servergrp"
 	"hosting-notification-service/internal/notification"
 )
 
 type Config struct {
-	QueueName string
-	NotiBus   *notification.Notifier
-	Log       *logger.Logger
+	QueueName        string
+	ProjectQueueName string
+	NotiBus          *notification.Notifier
+	Log              *logger.Logger
 }
 
 func RegisterAll(manager *messaging.MessageManager, cfg Config) error {
@@ -26,5 +28,17 @@ func RegisterAll(manager *messaging.MessageManager, cfg Config) error {
 		return err
 	}
 
+	err = projectgrp.Register(
+		manager,
+		projectgrp.Config{
+			NotiBus:   cfg.NotiBus,
+			QueueName: cfg.ProjectQueueName,
+			Log:       cfg.Log,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
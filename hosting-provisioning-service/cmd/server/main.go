@@ -47,6 +47,7 @@ func run(ctx context.Context, log *logger.Logger) error {
 		App struct {
 			ProvisioningTime time.Duration `conf:"default:10s"`
 			ShutdownTimeout  time.Duration `conf:"default:20s"`
+			ReverseDNSZone   string        `conf:"default:servers.hosting.internal"`
 		}
 		Web struct {
 			DebugHost string `conf:"default:0.0.0.0:7010"`
@@ -126,7 +127,7 @@ func run(ctx context.Context, log *logger.Logger) error {
 
 	provisioningOtelExt := provisioningotel.NewExtension()
 	provisioningPublisher := provisionmsg.NewNotifier(rqManager)
-	provisioningBus := provisioning.NewBusiness(cfg.App.ProvisioningTime, provisioningPublisher, provisioningOtelExt)
+	provisioningBus := provisioning.NewBusiness(cfg.App.ProvisioningTime, cfg.App.ReverseDNSZone, provisioningPublisher, provisioningOtelExt)
 
 	// -------------------------------------------------------------------------
 	// Start API Service
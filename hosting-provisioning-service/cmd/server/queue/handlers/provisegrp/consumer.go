@@ -21,21 +21,30 @@ func Register(manager *messaging.MessageManager, cfg Config) error {
 
 	wrappedHandler := messaging.LogErrors(func(ctx context.Context, err error, key string) {
 		cfg.Log.Error(ctx, "message processing failed", "error", err, "routing_key", key)
-	}, handlers.handleProvisionServer)
+	}, handlers.handleCommand)
 
-	err := manager.Subscribe(
-		cfg.QueueName,
+	for _, key := range []string{
 		commands.ProvisionRequestKey,
-		topology.CommandsExchange,
-		wrappedHandler,
-		&messaging.DLQConfig{
-			ExchangeName: topology.DLXExchange,
-			RoutingKey:   topology.GetDLQKey(cfg.QueueName),
-		},
-	)
+		commands.DeprovisionRequestKey,
+		commands.PowerRequestKey,
+		commands.SnapshotCreateRequestKey,
+		commands.SnapshotRestoreRequestKey,
+		commands.SnapshotDeleteRequestKey,
+	} {
+		err := manager.Subscribe(
+			cfg.QueueName,
+			key,
+			topology.CommandsExchange,
+			wrappedHandler,
+			&messaging.DLQConfig{
+				ExchangeName: topology.DLXExchange,
+				RoutingKey:   topology.GetDLQKey(cfg.QueueName),
+			},
+		)
 
-	if err != nil {
-		return fmt.Errorf("provisegrp: subscribe provision failed: %w", err)
+		if err != nil {
+			return fmt.Errorf("provisegrp: subscribe %s failed: %w", key, err)
+		}
 	}
 
 	return nil
@@ -3,8 +3,10 @@ package provisegrp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hosting-contracts/hosting-service/queue/commands"
+	"hosting-contracts/provisioning-service/queue/events"
 	"hosting-kit/logger"
 	"hosting-kit/messaging"
 	"hosting-provisioning-service/internal/provisioning"
@@ -22,11 +24,26 @@ func new(log *logger.Logger, provBus provisioning.ExtBusiness) *handlers {
 	}
 }
 
-func (h *handlers) handleProvisionServer(ctx context.Context, body []byte, routingKey string) error {
-	if routingKey != commands.ProvisionRequestKey {
+func (h *handlers) handleCommand(ctx context.Context, body []byte, routingKey string) error {
+	switch routingKey {
+	case commands.ProvisionRequestKey:
+		return h.handleProvisionServer(ctx, body)
+	case commands.DeprovisionRequestKey:
+		return h.handleDeprovisionServer(ctx, body)
+	case commands.PowerRequestKey:
+		return h.handlePowerServer(ctx, body)
+	case commands.SnapshotCreateRequestKey:
+		return h.handleCreateSnapshot(ctx, body)
+	case commands.SnapshotRestoreRequestKey:
+		return h.handleRestoreSnapshot(ctx, body)
+	case commands.SnapshotDeleteRequestKey:
+		return h.handleDeleteSnapshot(ctx, body)
+	default:
 		return fmt.Errorf("%w: unknown routing key: %s", messaging.ErrPermanentFailure, routingKey)
 	}
+}
 
+func (h *handlers) handleProvisionServer(ctx context.Context, body []byte) error {
 	var cmd commands.ProvisionServerCommand
 	if err := json.Unmarshal(body, &cmd); err != nil {
 		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
@@ -35,7 +52,122 @@ func (h *handlers) handleProvisionServer(ctx context.Context, body []byte, routi
 	h.log.Info(ctx, "received provisioning request",
 		"hostname", cmd.Hostname,
 		"server_id", cmd.ServerID,
+		"snapshot_id", cmd.SnapshotID,
+		"ssh_keys", len(cmd.SSHKeys),
+		"ip_count", cmd.IPCount,
+		"address_families", cmd.AddressFamilies,
+	)
+
+	req := provisioning.Request{
+		ServerID:   cmd.ServerID,
+		Hostname:   cmd.Hostname,
+		SnapshotID: cmd.SnapshotID,
+		SSHKeys:    cmd.SSHKeys,
+	}
+
+	if err := requestAddresses(&req, cmd.IPCount, cmd.AddressFamilies); err != nil {
+		return fmt.Errorf("%w: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	return h.provBus.ProvisionServer(ctx, req)
+}
+
+// requestAddresses fills in the address counts of a request. Commands of
+// older publishers carry neither count nor families and get one address in
+// each family.
+func requestAddresses(req *provisioning.Request, count int, families []string) error {
+	if count == 0 {
+		count = 1
+	}
+	if len(families) == 0 {
+		families = []string{events.AddressFamilyIPv4, events.AddressFamilyIPv6}
+	}
+
+	for _, family := range families {
+		switch family {
+		case events.AddressFamilyIPv4:
+			req.IPv4Count = count
+		case events.AddressFamilyIPv6:
+			req.IPv6Count = count
+		default:
+			return fmt.Errorf("unknown address family: %s", family)
+		}
+	}
+
+	return nil
+}
+
+func (h *handlers) handleDeprovisionServer(ctx context.Context, body []byte) error {
+	var cmd commands.DeprovisionServerCommand
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "received deprovisioning request",
+		"server_id", cmd.ServerID,
+	)
+
+	return h.provBus.ReleaseServer(ctx, cmd.ServerID, cmd.IPv4Address)
+}
+
+func (h *handlers) handlePowerServer(ctx context.Context, body []byte) error {
+	var cmd commands.PowerServerCommand
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "received power request",
+		"action", cmd.Action,
+		"server_id", cmd.ServerID,
+	)
+
+	if err := h.provBus.ExecutePower(ctx, cmd.ServerID, provisioning.PowerAction(cmd.Action)); err != nil {
+		if errors.Is(err, provisioning.ErrUnknownAction) {
+			return fmt.Errorf("%w: %v", messaging.ErrPermanentFailure, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (h *handlers) handleCreateSnapshot(ctx context.Context, body []byte) error {
+	var cmd commands.CreateSnapshotCommand
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "received snapshot request",
+		"snapshot_id", cmd.SnapshotID,
+		"server_id", cmd.ServerID,
+	)
+
+	return h.provBus.CreateSnapshot(ctx, cmd.SnapshotID, cmd.ServerID)
+}
+
+func (h *handlers) handleRestoreSnapshot(ctx context.Context, body []byte) error {
+	var cmd commands.RestoreSnapshotCommand
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "received snapshot restore request",
+		"snapshot_id", cmd.SnapshotID,
+		"server_id", cmd.ServerID,
+	)
+
+	return h.provBus.RestoreSnapshot(ctx, cmd.SnapshotID, cmd.ServerID)
+}
+
+func (h *handlers) handleDeleteSnapshot(ctx context.Context, body []byte) error {
+	var cmd commands.DeleteSnapshotCommand
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal command: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "received snapshot deletion request",
+		"snapshot_id", cmd.SnapshotID,
 	)
 
-	return h.provBus.GenerateIP(ctx, cmd.ServerID)
+	return h.provBus.DeleteSnapshot(ctx, cmd.SnapshotID)
 }
@@ -20,9 +20,44 @@ func NewExtension() provisioning.Extension {
 	}
 }
 
-func (e *Extension) GenerateIP(ctx context.Context, serverID uuid.UUID) error {
-	ctx, span := otel.AddSpan(ctx, "provisioning.generateip")
+func (e *Extension) ProvisionServer(ctx context.Context, req provisioning.Request) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.provisionserver")
 	defer span.End()
 
-	return e.bus.GenerateIP(ctx, serverID)
+	return e.bus.ProvisionServer(ctx, req)
+}
+
+func (e *Extension) ExecutePower(ctx context.Context, serverID uuid.UUID, action provisioning.PowerAction) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.executepower")
+	defer span.End()
+
+	return e.bus.ExecutePower(ctx, serverID, action)
+}
+
+func (e *Extension) ReleaseServer(ctx context.Context, serverID uuid.UUID, ip *string) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.releaseserver")
+	defer span.End()
+
+	return e.bus.ReleaseServer(ctx, serverID, ip)
+}
+
+func (e *Extension) CreateSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.createsnapshot")
+	defer span.End()
+
+	return e.bus.CreateSnapshot(ctx, snapshotID, serverID)
+}
+
+func (e *Extension) RestoreSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.restoresnapshot")
+	defer span.End()
+
+	return e.bus.RestoreSnapshot(ctx, snapshotID, serverID)
+}
+
+func (e *Extension) DeleteSnapshot(ctx context.Context, snapshotID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "provisioning.deletesnapshot")
+	defer span.End()
+
+	return e.bus.DeleteSnapshot(ctx, snapshotID)
 }
@@ -5,33 +5,63 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-var ErrIpGenerationFailed = errors.New("generation failed")
+var (
+	ErrIpGenerationFailed = errors.New("generation failed")
+	ErrUnknownAction      = errors.New("unknown power action")
+)
+
+// MaxAddressesPerFamily caps the addresses of one family a server can get.
+const MaxAddressesPerFamily = 16
+
+type PowerAction string
+
+const (
+	PowerStart  PowerAction = "START"
+	PowerStop   PowerAction = "STOP"
+	PowerReboot PowerAction = "REBOOT"
+)
 
 type Extension func(ExtBusiness) ExtBusiness
 
 type Notifier interface {
 	NotifySuccess(ctx context.Context, serverID uuid.UUID, res Result) error
 	NotifyFailure(ctx context.Context, serverID uuid.UUID, reason string, failedAt time.Time) error
+	NotifyDeprovisioned(ctx context.Context, serverID uuid.UUID, deprovisionedAt time.Time) error
+	NotifyPowerSuccess(ctx context.Context, serverID uuid.UUID, action PowerAction, completedAt time.Time) error
+	NotifyPowerFailure(ctx context.Context, serverID uuid.UUID, action PowerAction, reason string, failedAt time.Time) error
+	NotifySnapshotCreated(ctx context.Context, snapshotID uuid.UUID, createdAt time.Time) error
+	NotifySnapshotFailed(ctx context.Context, snapshotID uuid.UUID, reason string, failedAt time.Time) error
+	NotifySnapshotRestored(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID, restoredAt time.Time) error
+	NotifySnapshotRestoreFailed(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID, reason string, failedAt time.Time) error
+	NotifySnapshotDeleted(ctx context.Context, snapshotID uuid.UUID, deletedAt time.Time) error
 }
 
 type Business struct {
 	provisioningTime time.Duration
+	reverseDNSZone   string
 	notifier         Notifier
 	extensions       []Extension
 }
 
 type ExtBusiness interface {
-	GenerateIP(ctx context.Context, serverID uuid.UUID) error
+	ProvisionServer(ctx context.Context, req Request) error
+	ExecutePower(ctx context.Context, serverID uuid.UUID, action PowerAction) error
+	ReleaseServer(ctx context.Context, serverID uuid.UUID, ip *string) error
+	CreateSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error
+	RestoreSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error
+	DeleteSnapshot(ctx context.Context, snapshotID uuid.UUID) error
 }
 
-func NewBusiness(provisioningTime time.Duration, notifier Notifier, extensions ...Extension) ExtBusiness {
+func NewBusiness(provisioningTime time.Duration, reverseDNSZone string, notifier Notifier, extensions ...Extension) ExtBusiness {
 	b := &Business{
 		provisioningTime: provisioningTime,
+		reverseDNSZone:   reverseDNSZone,
 		notifier:         notifier,
 		extensions:       extensions,
 	}
@@ -48,32 +78,266 @@ func NewBusiness(provisioningTime time.Duration, notifier Notifier, extensions .
 	return extBus
 }
 
+// Request describes the server a provisioning command asks for.
+type Request struct {
+	ServerID uuid.UUID
+	Hostname string
+
+	// SnapshotID is the image written to the disk of the server once it is
+	// allocated, nil for an empty disk.
+	SnapshotID *uuid.UUID
+
+	// SSHKeys are OpenSSH public keys installed for the default user.
+	SSHKeys []string
+
+	// IPv4Count and IPv6Count are the addresses the server gets in each
+	// family. The first address of a family is its primary one.
+	IPv4Count int
+	IPv6Count int
+}
+
+// Address is an IP address assigned to a provisioned server.
+type Address struct {
+	IP         string
+	IPv6       bool
+	Primary    bool
+	ReverseDNS string
+}
+
 type Result struct {
-	IP            string
+	Addresses     []Address
 	ProvisionedAt time.Time
 }
 
-func (ps *Business) GenerateIP(ctx context.Context, serverID uuid.UUID) error {
-
+// ProvisionServer allocates the server and its addresses, restores the
+// snapshot of a server created from one and installs the SSH keys. A failed
+// step is reported with NotifyFailure.
+func (ps *Business) ProvisionServer(ctx context.Context, req Request) error {
 	select {
 	case <-time.After(ps.provisioningTime):
 	case <-ctx.Done():
-		return fmt.Errorf("generateip: provisioning cancelled for server %s", serverID)
+		return fmt.Errorf("provisionserver: provisioning cancelled for server %s", req.ServerID)
 	}
 
 	if rand.Intn(10) < 2 {
-		if err := ps.notifier.NotifyFailure(ctx, serverID, "IP generation failed", time.Now().UTC()); err != nil {
-			return fmt.Errorf("generateip: %w", err)
+		return ps.fail(ctx, req.ServerID, errors.New("IP generation failed"))
+	}
+
+	addrs, err := ps.allocateAddresses(req)
+	if err != nil {
+		return ps.fail(ctx, req.ServerID, err)
+	}
+
+	if req.SnapshotID != nil {
+		if err := ps.restoreImage(ctx, *req.SnapshotID); err != nil {
+			return ps.fail(ctx, req.ServerID, err)
+		}
+	}
+
+	if len(req.SSHKeys) > 0 {
+		if err := ps.installKeys(ctx, req.SSHKeys); err != nil {
+			return ps.fail(ctx, req.ServerID, err)
 		}
-		return nil
 	}
 
-	ip := fmt.Sprintf("10.0.0.%d", rand.Intn(255))
-	if err := ps.notifier.NotifySuccess(ctx, serverID, Result{
-		IP:            ip,
+	if err := ps.notifier.NotifySuccess(ctx, req.ServerID, Result{
+		Addresses:     addrs,
 		ProvisionedAt: time.Now().UTC(),
 	}); err != nil {
-		return fmt.Errorf("generateip: %w", err)
+		return fmt.Errorf("provisionserver: %w", err)
+	}
+	return nil
+}
+
+// allocateAddresses picks the addresses of a server from a random /24 and
+// the matching IPv6 /64, and names each of them in the reverse DNS zone.
+func (ps *Business) allocateAddresses(req Request) ([]Address, error) {
+	if req.IPv4Count < 0 || req.IPv6Count < 0 || req.IPv4Count+req.IPv6Count == 0 {
+		return nil, errors.New("no addresses requested")
+	}
+	if req.IPv4Count > MaxAddressesPerFamily || req.IPv6Count > MaxAddressesPerFamily {
+		return nil, fmt.Errorf("at most %d addresses per family can be assigned", MaxAddressesPerFamily)
+	}
+
+	block := rand.Intn(1 << 16)
+	addrs := make([]Address, 0, req.IPv4Count+req.IPv6Count)
+
+	for i := range req.IPv4Count {
+		ip := fmt.Sprintf("10.%d.%d.%d", block>>8, block&0xff, i+10)
+		addrs = append(addrs, Address{
+			IP:         ip,
+			Primary:    i == 0,
+			ReverseDNS: ps.reverseName(ip),
+		})
+	}
+
+	for i := range req.IPv6Count {
+		ip := fmt.Sprintf("fd00:%x:%x::%x", block>>8, block&0xff, i+10)
+		addrs = append(addrs, Address{
+			IP:         ip,
+			IPv6:       true,
+			Primary:    i == 0,
+			ReverseDNS: ps.reverseName(ip),
+		})
+	}
+
+	return addrs, nil
+}
+
+// reverseName is the PTR name of an address, e.g. 10-1-2-10.<zone>.
+func (ps *Business) reverseName(ip string) string {
+	label := strings.NewReplacer(".", "-", ":", "-").Replace(ip)
+	return label + "." + ps.reverseDNSZone
+}
+
+// restoreImage writes a snapshot to the disk of a freshly allocated server.
+func (ps *Business) restoreImage(ctx context.Context, snapshotID uuid.UUID) error {
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("restore of snapshot %s cancelled", snapshotID)
+	}
+
+	if rand.Intn(10) < 1 {
+		return fmt.Errorf("restore of snapshot %s failed", snapshotID)
+	}
+
+	return nil
+}
+
+// installKeys writes the keys to the authorized_keys file of the default
+// user. Each key must be a single line, or it would add keys nobody asked for.
+func (ps *Business) installKeys(ctx context.Context, keys []string) error {
+	var authorized strings.Builder
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || strings.ContainsAny(key, "\r\n") {
+			return errors.New("invalid SSH public key")
+		}
+		authorized.WriteString(key)
+		authorized.WriteByte('\n')
+	}
+
+	select {
+	case <-time.After(ps.provisioningTime / 10):
+	case <-ctx.Done():
+		return errors.New("installing SSH keys cancelled")
+	}
+
+	if rand.Intn(100) < 1 {
+		return fmt.Errorf("writing %d bytes of authorized_keys failed", authorized.Len())
+	}
+
+	return nil
+}
+
+// fail reports a failed step of ProvisionServer. A step cut short by the
+// context is returned instead, so the command is redelivered.
+func (ps *Business) fail(ctx context.Context, serverID uuid.UUID, cause error) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("provisionserver: %w", cause)
+	}
+
+	if err := ps.notifier.NotifyFailure(ctx, serverID, cause.Error(), time.Now().UTC()); err != nil {
+		return fmt.Errorf("provisionserver: %w", err)
+	}
+	return nil
+}
+
+func (ps *Business) ExecutePower(ctx context.Context, serverID uuid.UUID, action PowerAction) error {
+	switch action {
+	case PowerStart, PowerStop, PowerReboot:
+	default:
+		return fmt.Errorf("executepower: %w: %s", ErrUnknownAction, action)
+	}
+
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("executepower: %s cancelled for server %s", action, serverID)
+	}
+
+	if rand.Intn(10) < 1 {
+		if err := ps.notifier.NotifyPowerFailure(ctx, serverID, action, "hypervisor did not respond", time.Now().UTC()); err != nil {
+			return fmt.Errorf("executepower: %w", err)
+		}
+		return nil
+	}
+
+	if err := ps.notifier.NotifyPowerSuccess(ctx, serverID, action, time.Now().UTC()); err != nil {
+		return fmt.Errorf("executepower: %w", err)
+	}
+	return nil
+}
+
+// ReleaseServer tears the server down and frees its IP address. Errors are
+// returned so the command is redelivered until the release succeeds.
+func (ps *Business) ReleaseServer(ctx context.Context, serverID uuid.UUID, ip *string) error {
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("releaseserver: deprovisioning cancelled for server %s", serverID)
+	}
+
+	if err := ps.notifier.NotifyDeprovisioned(ctx, serverID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("releaseserver: %w", err)
+	}
+	return nil
+}
+
+// CreateSnapshot copies the disk of a stopped server into a snapshot image.
+func (ps *Business) CreateSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error {
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("createsnapshot: snapshot %s of server %s cancelled", snapshotID, serverID)
+	}
+
+	if rand.Intn(10) < 1 {
+		if err := ps.notifier.NotifySnapshotFailed(ctx, snapshotID, "disk copy failed", time.Now().UTC()); err != nil {
+			return fmt.Errorf("createsnapshot: %w", err)
+		}
+		return nil
+	}
+
+	if err := ps.notifier.NotifySnapshotCreated(ctx, snapshotID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("createsnapshot: %w", err)
+	}
+	return nil
+}
+
+// RestoreSnapshot writes a snapshot image back to the disk of its server.
+func (ps *Business) RestoreSnapshot(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID) error {
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("restoresnapshot: restore of snapshot %s cancelled", snapshotID)
+	}
+
+	if rand.Intn(10) < 1 {
+		if err := ps.notifier.NotifySnapshotRestoreFailed(ctx, snapshotID, serverID, "disk write failed", time.Now().UTC()); err != nil {
+			return fmt.Errorf("restoresnapshot: %w", err)
+		}
+		return nil
+	}
+
+	if err := ps.notifier.NotifySnapshotRestored(ctx, snapshotID, serverID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("restoresnapshot: %w", err)
+	}
+	return nil
+}
+
+// DeleteSnapshot removes a snapshot image. Like ReleaseServer it never
+// reports a failure, the command is redelivered until it succeeds.
+func (ps *Business) DeleteSnapshot(ctx context.Context, snapshotID uuid.UUID) error {
+	select {
+	case <-time.After(ps.provisioningTime):
+	case <-ctx.Done():
+		return fmt.Errorf("deletesnapshot: deletion of snapshot %s cancelled", snapshotID)
+	}
+
+	if err := ps.notifier.NotifySnapshotDeleted(ctx, snapshotID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("deletesnapshot: %w", err)
 	}
 	return nil
 }
@@ -26,10 +26,28 @@ func NewNotifier(mgr *messaging.MessageManager) *Notifier {
 func (s *Notifier) NotifySuccess(ctx context.Context, serverID uuid.UUID, res provisioning.Result) error {
 	successEvent := events.ServerProvisionedEvent{
 		ServerID:      serverID,
-		IPv4Address:   res.IP,
+		Addresses:     make([]events.IPAddress, len(res.Addresses)),
 		ProvisionedAt: res.ProvisionedAt,
 	}
 
+	for i, addr := range res.Addresses {
+		family := events.AddressFamilyIPv4
+		if addr.IPv6 {
+			family = events.AddressFamilyIPv6
+		} else if addr.Primary {
+			successEvent.IPv4Address = addr.IP
+		}
+
+		successEvent.Addresses[i] = events.IPAddress{
+			Address: addr.IP,
+			Family:  family,
+			Primary: addr.Primary,
+		}
+		if addr.ReverseDNS != "" {
+			successEvent.Addresses[i].ReverseDNS = &addr.ReverseDNS
+		}
+	}
+
 	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.ProvisionSucceededKey, successEvent); err != nil {
 		return fmt.Errorf("msg: failed to publish ServerProvisionedEvent: %w", err)
 	}
@@ -48,3 +66,106 @@ func (s *Notifier) NotifyFailure(ctx context.Context, serverID uuid.UUID, reason
 	}
 	return nil
 }
+
+func (s *Notifier) NotifyDeprovisioned(ctx context.Context, serverID uuid.UUID, deprovisionedAt time.Time) error {
+	event := events.ServerDeprovisionedEvent{
+		ServerID:        serverID,
+		DeprovisionedAt: deprovisionedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.DeprovisionSucceededKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish ServerDeprovisionedEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifyPowerSuccess(ctx context.Context, serverID uuid.UUID, action provisioning.PowerAction, completedAt time.Time) error {
+	successEvent := events.ServerPowerSucceededEvent{
+		ServerID:    serverID,
+		Action:      string(action),
+		CompletedAt: completedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.PowerSucceededKey, successEvent); err != nil {
+		return fmt.Errorf("msg: failed to publish ServerPowerSucceededEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifyPowerFailure(ctx context.Context, serverID uuid.UUID, action provisioning.PowerAction, reason string, failedAt time.Time) error {
+	failedEvent := events.ServerPowerFailedEvent{
+		ServerID: serverID,
+		Action:   string(action),
+		Reason:   reason,
+		FailedAt: failedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.PowerFailedKey, failedEvent); err != nil {
+		return fmt.Errorf("msg: failed to publish ServerPowerFailedEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifySnapshotCreated(ctx context.Context, snapshotID uuid.UUID, createdAt time.Time) error {
+	event := events.SnapshotCreatedEvent{
+		SnapshotID: snapshotID,
+		CreatedAt:  createdAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.SnapshotCreatedKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish SnapshotCreatedEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifySnapshotFailed(ctx context.Context, snapshotID uuid.UUID, reason string, failedAt time.Time) error {
+	event := events.SnapshotCreateFailedEvent{
+		SnapshotID: snapshotID,
+		Reason:     reason,
+		FailedAt:   failedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.SnapshotCreateFailedKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish SnapshotCreateFailedEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifySnapshotRestored(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID, restoredAt time.Time) error {
+	event := events.SnapshotRestoredEvent{
+		SnapshotID: snapshotID,
+		ServerID:   serverID,
+		RestoredAt: restoredAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.SnapshotRestoredKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish SnapshotRestoredEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifySnapshotRestoreFailed(ctx context.Context, snapshotID uuid.UUID, serverID uuid.UUID, reason string, failedAt time.Time) error {
+	event := events.SnapshotRestoreFailedEvent{
+		SnapshotID: snapshotID,
+		ServerID:   serverID,
+		Reason:     reason,
+		FailedAt:   failedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.SnapshotRestoreFailedKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish SnapshotRestoreFailedEvent: %w", err)
+	}
+	return nil
+}
+
+func (s *Notifier) NotifySnapshotDeleted(ctx context.Context, snapshotID uuid.UUID, deletedAt time.Time) error {
+	event := events.SnapshotDeletedEvent{
+		SnapshotID: snapshotID,
+		DeletedAt:  deletedAt,
+	}
+
+	if err := s.mgr.Publish(ctx, topology.EventsExchange, events.SnapshotDeletedKey, event); err != nil {
+		return fmt.Errorf("msg: failed to publish SnapshotDeletedEvent: %w", err)
+	}
+	return nil
+}
@@ -21,6 +21,10 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Resources_ConsumeResource_FullMethodName = "/gen.Resources/ConsumeResource"
 	Resources_ReturnResource_FullMethodName  = "/gen.Resources/ReturnResource"
+	Resources_ResizeResource_FullMethodName  = "/gen.Resources/ResizeResource"
+	Resources_ReserveResource_FullMethodName = "/gen.Resources/ReserveResource"
+	Resources_ListPools_FullMethodName       = "/gen.Resources/ListPools"
+	Resources_RepairPool_FullMethodName      = "/gen.Resources/RepairPool"
 )
 
 // ResourcesClient is the client API for Resources service.
@@ -29,6 +33,10 @@ const (
 type ResourcesClient interface {
 	ConsumeResource(ctx context.Context, in *ConsumeRequest, opts ...grpc.CallOption) (*ConsumeReply, error)
 	ReturnResource(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnReply, error)
+	ResizeResource(ctx context.Context, in *ResizeRequest, opts ...grpc.CallOption) (*ResizeReply, error)
+	ReserveResource(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*ReserveReply, error)
+	ListPools(ctx context.Context, in *ListPoolsRequest, opts ...grpc.CallOption) (*ListPoolsReply, error)
+	RepairPool(ctx context.Context, in *RepairPoolRequest, opts ...grpc.CallOption) (*RepairPoolReply, error)
 }
 
 type resourcesClient struct {
@@ -59,12 +67,56 @@ func (c *resourcesClient) ReturnResource(ctx context.Context, in *ReturnRequest,
 	return out, nil
 }
 
+func (c *resourcesClient) ResizeResource(ctx context.Context, in *ResizeRequest, opts ...grpc.CallOption) (*ResizeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeReply)
+	err := c.cc.Invoke(ctx, Resources_ResizeResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesClient) ReserveResource(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*ReserveReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveReply)
+	err := c.cc.Invoke(ctx, Resources_ReserveResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesClient) ListPools(ctx context.Context, in *ListPoolsRequest, opts ...grpc.CallOption) (*ListPoolsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoolsReply)
+	err := c.cc.Invoke(ctx, Resources_ListPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesClient) RepairPool(ctx context.Context, in *RepairPoolRequest, opts ...grpc.CallOption) (*RepairPoolReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepairPoolReply)
+	err := c.cc.Invoke(ctx, Resources_RepairPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourcesServer is the server API for Resources service.
 // All implementations must embed UnimplementedResourcesServer
 // for forward compatibility.
 type ResourcesServer interface {
 	ConsumeResource(context.Context, *ConsumeRequest) (*ConsumeReply, error)
 	ReturnResource(context.Context, *ReturnRequest) (*ReturnReply, error)
+	ResizeResource(context.Context, *ResizeRequest) (*ResizeReply, error)
+	ReserveResource(context.Context, *ReserveRequest) (*ReserveReply, error)
+	ListPools(context.Context, *ListPoolsRequest) (*ListPoolsReply, error)
+	RepairPool(context.Context, *RepairPoolRequest) (*RepairPoolReply, error)
 	mustEmbedUnimplementedResourcesServer()
 }
 
@@ -81,6 +133,18 @@ func (UnimplementedResourcesServer) ConsumeResource(context.Context, *ConsumeReq
 func (UnimplementedResourcesServer) ReturnResource(context.Context, *ReturnRequest) (*ReturnReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnResource not implemented")
 }
+func (UnimplementedResourcesServer) ResizeResource(context.Context, *ResizeRequest) (*ResizeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeResource not implemented")
+}
+func (UnimplementedResourcesServer) ReserveResource(context.Context, *ReserveRequest) (*ReserveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveResource not implemented")
+}
+func (UnimplementedResourcesServer) ListPools(context.Context, *ListPoolsRequest) (*ListPoolsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
+}
+func (UnimplementedResourcesServer) RepairPool(context.Context, *RepairPoolRequest) (*RepairPoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairPool not implemented")
+}
 func (UnimplementedResourcesServer) mustEmbedUnimplementedResourcesServer() {}
 func (UnimplementedResourcesServer) testEmbeddedByValue()                   {}
 
@@ -138,6 +202,78 @@ func _Resources_ReturnResource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Resources_ResizeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServer).ResizeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Resources_ResizeResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServer).ResizeResource(ctx, req.(*ResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Resources_ReserveResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServer).ReserveResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Resources_ReserveResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServer).ReserveResource(ctx, req.(*ReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Resources_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Resources_ListPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServer).ListPools(ctx, req.(*ListPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Resources_RepairPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServer).RepairPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Resources_RepairPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServer).RepairPool(ctx, req.(*RepairPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Resources_ServiceDesc is the grpc.ServiceDesc for Resources service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +289,22 @@ var Resources_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnResource",
 			Handler:    _Resources_ReturnResource_Handler,
 		},
+		{
+			MethodName: "ResizeResource",
+			Handler:    _Resources_ResizeResource_Handler,
+		},
+		{
+			MethodName: "ReserveResource",
+			Handler:    _Resources_ReserveResource_Handler,
+		},
+		{
+			MethodName: "ListPools",
+			Handler:    _Resources_ListPools_Handler,
+		},
+		{
+			MethodName: "RepairPool",
+			Handler:    _Resources_RepairPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "resources.proto",
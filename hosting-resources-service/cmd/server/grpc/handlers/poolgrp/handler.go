@@ -23,33 +23,58 @@ func New(poolBus pool.ExtBusiness) *Handlers {
 }
 
 func (h *Handlers) ConsumeResource(ctx context.Context, req *gen.ConsumeRequest) (*gen.ConsumeReply, error) {
-	poolID, err := h.poolBus.ConsumeResource(ctx, pool.Resource{
+	reservationID, err := parseReservationID(req.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+
+	placement := pool.Placement{
+		Region: req.GetPlacement().GetRegion(),
+	}
+
+	p, err := h.poolBus.ConsumeResource(ctx, reservationID, pool.Resource{
 		CPUCores: int(req.Resource.CpuCores),
 		RAMMB:    int(req.Resource.RamMb),
 		DiskGB:   int(req.Resource.DiskGb),
 		IPCount:  int(req.Resource.IpCount),
-	})
+	}, placement)
 
 	if err != nil {
 		if errors.Is(err, pool.ErrValidation) {
 			return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
 		}
+		if errors.Is(err, pool.ErrRegionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "region not found: %v", err)
+		}
 		if errors.Is(err, pool.ErrNotEnoughResources) {
 			return nil, status.Errorf(codes.FailedPrecondition, "not enough resources: %v", err)
 		}
+		if errors.Is(err, pool.ErrReservationReturned) {
+			return nil, status.Errorf(codes.Aborted, "reservation returned: %v", err)
+		}
 
 		return nil, status.Errorf(codes.Internal, "consume resource: %v", err)
 	}
 
 	return &gen.ConsumeReply{
-		PoolId: poolID.String(),
+		PoolId: p.ID.String(),
+		Region: p.Region,
 	}, nil
 }
 
 func (h *Handlers) ReturnResource(ctx context.Context, req *gen.ReturnRequest) (*gen.ReturnReply, error) {
-	poolID, err := uuid.Parse(req.PoolId)
+	reservationID, err := parseReservationID(req.GetReservationId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid pool ID: %v", err)
+		return nil, err
+	}
+
+	// The pool of a consumed reservation is known, so it may be left out.
+	var poolID uuid.UUID
+	if req.PoolId != "" || reservationID == uuid.Nil {
+		poolID, err = uuid.Parse(req.PoolId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pool ID: %v", err)
+		}
 	}
 
 	resource := pool.Resource{
@@ -59,7 +84,7 @@ func (h *Handlers) ReturnResource(ctx context.Context, req *gen.ReturnRequest) (
 		IPCount:  int(req.Resource.IpCount),
 	}
 
-	err = h.poolBus.ReturnResource(ctx, resource, poolID)
+	err = h.poolBus.ReturnResource(ctx, reservationID, resource, poolID)
 
 	if err != nil {
 		if errors.Is(err, pool.ErrValidation) {
@@ -74,3 +99,186 @@ func (h *Handlers) ReturnResource(ctx context.Context, req *gen.ReturnRequest) (
 
 	return &gen.ReturnReply{}, nil
 }
+
+func (h *Handlers) ResizeResource(ctx context.Context, req *gen.ResizeRequest) (*gen.ResizeReply, error) {
+	reservationID, err := parseReservationID(req.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+
+	poolID, err := uuid.Parse(req.PoolId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool ID: %v", err)
+	}
+
+	current := pool.Resource{
+		CPUCores: int(req.Current.CpuCores),
+		RAMMB:    int(req.Current.RamMb),
+		DiskGB:   int(req.Current.DiskGb),
+		IPCount:  int(req.Current.IpCount),
+	}
+
+	target := pool.Resource{
+		CPUCores: int(req.Target.CpuCores),
+		RAMMB:    int(req.Target.RamMb),
+		DiskGB:   int(req.Target.DiskGb),
+		IPCount:  int(req.Target.IpCount),
+	}
+
+	newPoolID, err := h.poolBus.ResizeResource(ctx, reservationID, current, target, poolID)
+
+	if err != nil {
+		if errors.Is(err, pool.ErrValidation) {
+			return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+		}
+		if errors.Is(err, pool.ErrPoolNotFound) {
+			return nil, status.Errorf(codes.NotFound, "pool not found: %v", err)
+		}
+		if errors.Is(err, pool.ErrNotEnoughResources) {
+			return nil, status.Errorf(codes.FailedPrecondition, "not enough resources: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "resize resource: %v", err)
+	}
+
+	return &gen.ResizeReply{
+		PoolId: newPoolID.String(),
+	}, nil
+}
+
+func (h *Handlers) ReserveResource(ctx context.Context, req *gen.ReserveRequest) (*gen.ReserveReply, error) {
+	poolID, err := uuid.Parse(req.PoolId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool ID: %v", err)
+	}
+
+	resource := pool.Resource{
+		CPUCores: int(req.Resource.CpuCores),
+		RAMMB:    int(req.Resource.RamMb),
+		DiskGB:   int(req.Resource.DiskGb),
+		IPCount:  int(req.Resource.IpCount),
+	}
+
+	reservationID, err := parseReservationID(req.GetReservationId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.poolBus.ReserveResource(ctx, reservationID, resource, poolID)
+
+	if err != nil {
+		if errors.Is(err, pool.ErrValidation) {
+			return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+		}
+		if errors.Is(err, pool.ErrPoolNotFound) {
+			return nil, status.Errorf(codes.NotFound, "pool not found: %v", err)
+		}
+		if errors.Is(err, pool.ErrNotEnoughResources) {
+			return nil, status.Errorf(codes.FailedPrecondition, "not enough resources: %v", err)
+		}
+		if errors.Is(err, pool.ErrReservationReturned) {
+			return nil, status.Errorf(codes.Aborted, "reservation returned: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "reserve resource: %v", err)
+	}
+
+	return &gen.ReserveReply{}, nil
+}
+
+func (h *Handlers) ListPools(ctx context.Context, req *gen.ListPoolsRequest) (*gen.ListPoolsReply, error) {
+	pools, err := h.poolBus.ListPools(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list pools: %v", err)
+	}
+
+	reply := &gen.ListPoolsReply{
+		Pools: make([]*gen.PoolState, len(pools)),
+	}
+	for i, p := range pools {
+		reply.Pools[i] = toPoolState(p)
+	}
+
+	return reply, nil
+}
+
+func (h *Handlers) RepairPool(ctx context.Context, req *gen.RepairPoolRequest) (*gen.RepairPoolReply, error) {
+	poolID, err := uuid.Parse(req.PoolId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pool ID: %v", err)
+	}
+
+	if req.Observed == nil || req.Allocated == nil {
+		return nil, status.Error(codes.InvalidArgument, "observed and allocated are required")
+	}
+
+	p, err := h.poolBus.RepairPool(ctx, poolID, toResource(req.Observed), toResource(req.Allocated))
+	if err != nil {
+		if errors.Is(err, pool.ErrValidation) {
+			return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
+		}
+		if errors.Is(err, pool.ErrPoolNotFound) {
+			return nil, status.Errorf(codes.NotFound, "pool not found: %v", err)
+		}
+		if errors.Is(err, pool.ErrCountersChanged) {
+			return nil, status.Errorf(codes.Aborted, "pool changed: %v", err)
+		}
+		if errors.Is(err, pool.ErrNotEnoughResources) {
+			return nil, status.Errorf(codes.FailedPrecondition, "not enough resources: %v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "repair pool: %v", err)
+	}
+
+	return &gen.RepairPoolReply{
+		Pool: toPoolState(p),
+	}, nil
+}
+
+func toResource(r *gen.Resource) pool.Resource {
+	return pool.Resource{
+		CPUCores: int(r.CpuCores),
+		RAMMB:    int(r.RamMb),
+		DiskGB:   int(r.DiskGb),
+		IPCount:  int(r.IpCount),
+	}
+}
+
+func toGenResource(r pool.Resource) *gen.Resource {
+	return &gen.Resource{
+		CpuCores: int32(r.CPUCores),
+		RamMb:    int32(r.RAMMB),
+		DiskGb:   int32(r.DiskGB),
+		IpCount:  int32(r.IPCount),
+	}
+}
+
+func toPoolState(p pool.Pool) *gen.PoolState {
+	state := &gen.PoolState{
+		PoolId:    p.ID.String(),
+		Name:      p.Name,
+		Region:    p.Region,
+		Available: toGenResource(p.Resources),
+	}
+
+	if p.Capacity != nil {
+		state.Capacity = toGenResource(*p.Capacity)
+	}
+
+	return state
+}
+
+// parseReservationID reads an optional reservation id. Requests without one
+// are applied every time they are received.
+func parseReservationID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+
+	reservationID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid reservation ID: %v", err)
+	}
+
+	return reservationID, nil
+}
@@ -128,7 +128,8 @@ func run(ctx context.Context, log *logger.Logger) error {
 
 	poolOtelExt := poolotel.NewExtension()
 	poolStore := pooldb.NewStore(db)
-	poolBus := pool.NewBusiness(poolStore, poolOtelExt)
+	transactor := database.NewTransactor(db)
+	poolBus := pool.NewBusiness(poolStore, transactor, poolOtelExt)
 
 	// -------------------------------------------------------------------------
 	// Initialize authentication support
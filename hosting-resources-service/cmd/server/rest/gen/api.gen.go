@@ -15,6 +15,10 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	CookieAuthScopes = "cookieAuth.Scopes"
+)
+
 // CreatePoolRequest defines model for CreatePoolRequest.
 type CreatePoolRequest struct {
 	CpuCores int    `json:"cpuCores"`
@@ -22,6 +26,23 @@ type CreatePoolRequest struct {
 	IpCount  int    `json:"ipCount"`
 	Name     string `json:"name"`
 	RamMb    int    `json:"ramMb"`
+
+	// Region Регион пула из строчных латинских букв, цифр и дефисов, например eu-central
+	Region string `json:"region"`
+}
+
+// CursorMetadata Информация о курсорной пагинации
+type CursorMetadata struct {
+	// EndCursor Курсор последнего элемента страницы
+	EndCursor       *string `json:"endCursor,omitempty"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+
+	// Size Размер страницы
+	Size int `json:"size"`
+
+	// StartCursor Курсор первого элемента страницы
+	StartCursor *string `json:"startCursor,omitempty"`
 }
 
 // Link defines model for Link.
@@ -62,7 +83,10 @@ type Pool struct {
 	UnderscoreLinks Links              `json:"_links"`
 	Id              openapi_types.UUID `json:"id"`
 	Name            string             `json:"name"`
-	Resources       Resource           `json:"resources"`
+
+	// Region Регион, в котором размещены серверы пула, например eu-central
+	Region    string   `json:"region"`
+	Resources Resource `json:"resources"`
 }
 
 // PoolCollectionResponse defines model for PoolCollectionResponse.
@@ -74,8 +98,11 @@ type PoolCollectionResponse struct {
 	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
 	UnderscoreLinks Links `json:"_links"`
 
+	// Cursor Информация о курсорной пагинации
+	Cursor *CursorMetadata `json:"cursor,omitempty"`
+
 	// Page Информация о пагинации
-	Page PageMetadata `json:"page"`
+	Page *PageMetadata `json:"page,omitempty"`
 }
 
 // Resource defines model for Resource.
@@ -97,6 +124,15 @@ type StatusResponse struct {
 	Message string `json:"message"`
 }
 
+// After defines model for After.
+type After = string
+
+// Before defines model for Before.
+type Before = string
+
+// Limit defines model for Limit.
+type Limit = int
+
 // Page defines model for Page.
 type Page = int
 
@@ -116,6 +152,15 @@ type ListPoolsParams struct {
 
 	// PageSize Количество элементов на странице.
 	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Limit Размер страницы в режиме курсоров. Включает курсорную пагинацию вместо номеров страниц.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Непрозрачный курсор: вернуть элементы после него
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Непрозрачный курсор: вернуть элементы перед ним
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
 }
 
 // CreatePoolJSONRequestBody defines body for CreatePool for application/json ContentType.
@@ -196,6 +241,12 @@ func (siw *ServerInterfaceWrapper) ListPools(w http.ResponseWriter, r *http.Requ
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListPoolsParams
 
@@ -215,6 +266,30 @@ func (siw *ServerInterfaceWrapper) ListPools(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListPools(w, r, params)
 	}))
@@ -229,6 +304,12 @@ func (siw *ServerInterfaceWrapper) ListPools(w http.ResponseWriter, r *http.Requ
 // CreatePool operation middleware
 func (siw *ServerInterfaceWrapper) CreatePool(w http.ResponseWriter, r *http.Request) {
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreatePool(w, r)
 	}))
@@ -254,6 +335,12 @@ func (siw *ServerInterfaceWrapper) AddResources(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AddResources(w, r, poolId)
 	}))
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"hosting-kit/page"
 	"hosting-resources-service/cmd/server/rest/gen"
+	"hosting-resources-service/cmd/server/rest/pagination"
 	"hosting-resources-service/internal/pool"
 )
 
@@ -21,6 +22,27 @@ func New(poolBus pool.ExtBusiness, prefix string) *PoolHandlers {
 }
 
 func (p *PoolHandlers) ListPools(ctx context.Context, request gen.ListPoolsRequestObject) (gen.ListPoolsResponseObject, error) {
+	cur, ok, err := pagination.ParseCursor(request.Params.Limit, request.Params.After, request.Params.Before)
+	if err != nil {
+		return gen.ListPools400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	if ok {
+		edges, doc, err := p.poolBus.SearchByCursor(ctx, cur)
+		if err != nil {
+			if errors.Is(err, page.ErrInvalidCursor) {
+				return gen.ListPools400JSONResponse{
+					BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+				}, nil
+			}
+			return nil, err
+		}
+
+		return gen.ListPools200ApplicationHalPlusJSONResponse(toPoolCursorResponse(edges, cur, doc, p.prefix)), nil
+	}
+
 	pageNum := 1
 	pageSize := 10
 
@@ -45,6 +67,7 @@ func (p *PoolHandlers) ListPools(ctx context.Context, request gen.ListPoolsReque
 func (p *PoolHandlers) CreatePool(ctx context.Context, request gen.CreatePoolRequestObject) (gen.CreatePoolResponseObject, error) {
 	newPool, err := p.poolBus.CreatePool(ctx, pool.NewPool{
 		Name:     request.Body.Name,
+		Region:   request.Body.Region,
 		CPUCores: request.Body.CpuCores,
 		RAMMB:    request.Body.RamMb,
 		DiskGB:   request.Body.DiskGb,
@@ -24,6 +24,7 @@ func toPool(p pool.Pool, prefix string) gen.Pool {
 		UnderscoreLinks: links,
 		Id:              p.ID,
 		Name:            p.Name,
+		Region:          p.Region,
 		Resources:       resources,
 	}
 }
@@ -34,13 +35,34 @@ func toPoolCollectionResponse(pools []pool.Pool, pg page.Page, total int, prefix
 		items[i] = toPool(p, prefix)
 	}
 
+	md := pagination.ToMetaData(pg, total)
+
 	return gen.PoolCollectionResponse{
 		UnderscoreEmbedded: struct {
 			Pools []gen.Pool `json:"pools"`
 		}{
 			Pools: items,
 		},
-		Page:            pagination.ToMetaData(pg, total),
+		Page:            &md,
 		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/pools", prefix), pg, total),
 	}
 }
+
+func toPoolCursorResponse(edges []page.Edge[pool.Pool], cur page.Cursor, doc page.CursorDocument, prefix string) gen.PoolCollectionResponse {
+	items := make([]gen.Pool, len(edges))
+	for i, e := range edges {
+		items[i] = toPool(e.Node, prefix)
+	}
+
+	md := pagination.ToCursorMetaData(doc)
+
+	return gen.PoolCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Pools []gen.Pool `json:"pools"`
+		}{
+			Pools: items,
+		},
+		Cursor:          &md,
+		UnderscoreLinks: pagination.ToCursorLinks(fmt.Sprintf("%s/pools", prefix), nil, cur, doc),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"hosting-kit/page"
 	"hosting-resources-service/cmd/server/rest/gen"
+	"net/url"
 )
 
 func ToMetaData(pg page.Page, total int) gen.PageMetadata {
@@ -40,3 +41,87 @@ func ToLinks(baseURL string, pg page.Page, total int) gen.Links {
 
 	return links
 }
+
+func ToCursorMetaData(doc page.CursorDocument) gen.CursorMetadata {
+	md := gen.CursorMetadata{
+		Size:            doc.PageSize,
+		HasNextPage:     doc.HasNext,
+		HasPreviousPage: doc.HasPrev,
+	}
+
+	if doc.StartCursor != "" {
+		md.StartCursor = &doc.StartCursor
+	}
+	if doc.EndCursor != "" {
+		md.EndCursor = &doc.EndCursor
+	}
+
+	return md
+}
+
+// ToCursorLinks builds the links of a cursor page. Like ToQueryLinks it keeps
+// the given query parameters on every link.
+func ToCursorLinks(baseURL string, query url.Values, cur page.Cursor, doc page.CursorDocument) gen.Links {
+	links := make(gen.Links)
+
+	makeHref := func(param string, cursor string) string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("limit", fmt.Sprint(doc.PageSize))
+		if param != "" {
+			q.Set(param, cursor)
+		}
+		return baseURL + "?" + q.Encode()
+	}
+
+	self := makeHref("", "")
+	if key, ok := cur.Key(); ok {
+		param := "after"
+		if cur.Backward() {
+			param = "before"
+		}
+		self = makeHref(param, page.EncodeCursor(key))
+	}
+
+	links["self"] = gen.Link{Href: self}
+	links["first"] = gen.Link{Href: makeHref("", "")}
+
+	if doc.HasNext && doc.EndCursor != "" {
+		links["next"] = gen.Link{Href: makeHref("after", doc.EndCursor)}
+	}
+	if doc.HasPrev && doc.StartCursor != "" {
+		links["prev"] = gen.Link{Href: makeHref("before", doc.StartCursor)}
+	}
+
+	return links
+}
+
+// ParseCursor reads the cursor query parameters. The second result is false
+// when none of them is set and the listing is paged by number.
+func ParseCursor(limit *int, after *string, before *string) (page.Cursor, bool, error) {
+	if limit == nil && after == nil && before == nil {
+		return page.Cursor{}, false, nil
+	}
+
+	var size int
+	if limit != nil {
+		size = *limit
+	}
+
+	var a, b string
+	if after != nil {
+		a = *after
+	}
+	if before != nil {
+		b = *before
+	}
+
+	cur, err := page.ParseCursor(size, a, b)
+	if err != nil {
+		return page.Cursor{}, true, err
+	}
+
+	return cur, true, nil
+}
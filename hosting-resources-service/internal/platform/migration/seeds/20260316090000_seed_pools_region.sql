@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+UPDATE pools SET region = 'eu-central' WHERE id = '11111111-1111-1111-1111-111111111111';
+
+UPDATE pools SET region = 'eu-west' WHERE id = '22222222-2222-2222-2222-222222222222';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE pools SET region = 'default' WHERE id IN (
+    '11111111-1111-1111-1111-111111111111',
+    '22222222-2222-2222-2222-222222222222'
+);
+-- +goose StatementEnd
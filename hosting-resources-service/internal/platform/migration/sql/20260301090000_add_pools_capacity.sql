@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pools
+    ADD COLUMN capacity_cpu_cores INT,
+    ADD COLUMN capacity_ram_mb INT,
+    ADD COLUMN capacity_disk_gb INT,
+    ADD COLUMN capacity_ip_count INT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pools
+    DROP COLUMN IF EXISTS capacity_ip_count,
+    DROP COLUMN IF EXISTS capacity_disk_gb,
+    DROP COLUMN IF EXISTS capacity_ram_mb,
+    DROP COLUMN IF EXISTS capacity_cpu_cores;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pools
+    ADD COLUMN region TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE pools
+    ALTER COLUMN region DROP DEFAULT;
+
+CREATE INDEX idx_pools_region_updated_at ON pools(region, updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pools_region_updated_at;
+
+ALTER TABLE pools
+    DROP COLUMN IF EXISTS region;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reservations (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    pool_id UUID REFERENCES pools(id),
+    cpu_cores INT NOT NULL,
+    ram_mb INT NOT NULL,
+    disk_gb INT NOT NULL,
+    ip_count INT NOT NULL,
+    returned_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reservations;
+-- +goose StatementEnd
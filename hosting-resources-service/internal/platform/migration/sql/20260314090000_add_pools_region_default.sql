@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pools inserted without a region, such as those of the initial seed on a
+-- fresh database, land in the default region until a region is assigned.
+ALTER TABLE pools
+    ALTER COLUMN region SET DEFAULT 'default';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pools
+    ALTER COLUMN region DROP DEFAULT;
+-- +goose StatementEnd
@@ -28,11 +28,18 @@ func (e *Extension) AddResources(ctx context.Context, r pool.Resource, poolID uu
 	return e.bus.AddResources(ctx, r, poolID)
 }
 
-func (e *Extension) ConsumeResource(ctx context.Context, r pool.Resource) (uuid.UUID, error) {
+func (e *Extension) ConsumeResource(ctx context.Context, reservationID uuid.UUID, r pool.Resource, placement pool.Placement) (pool.Pool, error) {
 	ctx, span := otel.AddSpan(ctx, "pool.consumeresource")
 	defer span.End()
 
-	return e.bus.ConsumeResource(ctx, r)
+	return e.bus.ConsumeResource(ctx, reservationID, r, placement)
+}
+
+func (e *Extension) ReserveResource(ctx context.Context, reservationID uuid.UUID, r pool.Resource, poolID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "pool.reserveresource")
+	defer span.End()
+
+	return e.bus.ReserveResource(ctx, reservationID, r, poolID)
 }
 
 func (e *Extension) CreatePool(ctx context.Context, p pool.NewPool) (pool.Pool, error) {
@@ -42,11 +49,18 @@ func (e *Extension) CreatePool(ctx context.Context, p pool.NewPool) (pool.Pool,
 	return e.bus.CreatePool(ctx, p)
 }
 
-func (e *Extension) ReturnResource(ctx context.Context, r pool.Resource, poolID uuid.UUID) error {
+func (e *Extension) ReturnResource(ctx context.Context, reservationID uuid.UUID, r pool.Resource, poolID uuid.UUID) error {
 	ctx, span := otel.AddSpan(ctx, "pool.returnresource")
 	defer span.End()
 
-	return e.bus.ReturnResource(ctx, r, poolID)
+	return e.bus.ReturnResource(ctx, reservationID, r, poolID)
+}
+
+func (e *Extension) ResizeResource(ctx context.Context, reservationID uuid.UUID, current pool.Resource, target pool.Resource, poolID uuid.UUID) (uuid.UUID, error) {
+	ctx, span := otel.AddSpan(ctx, "pool.resizeresource")
+	defer span.End()
+
+	return e.bus.ResizeResource(ctx, reservationID, current, target, poolID)
 }
 
 func (e *Extension) Search(ctx context.Context, pg page.Page) ([]pool.Pool, int, error) {
@@ -55,3 +69,24 @@ func (e *Extension) Search(ctx context.Context, pg page.Page) ([]pool.Pool, int,
 
 	return e.bus.Search(ctx, pg)
 }
+
+func (e *Extension) SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[pool.Pool], page.CursorDocument, error) {
+	ctx, span := otel.AddSpan(ctx, "pool.searchbycursor")
+	defer span.End()
+
+	return e.bus.SearchByCursor(ctx, cur)
+}
+
+func (e *Extension) ListPools(ctx context.Context) ([]pool.Pool, error) {
+	ctx, span := otel.AddSpan(ctx, "pool.listpools")
+	defer span.End()
+
+	return e.bus.ListPools(ctx)
+}
+
+func (e *Extension) RepairPool(ctx context.Context, poolID uuid.UUID, observed pool.Resource, allocated pool.Resource) (pool.Pool, error) {
+	ctx, span := otel.AddSpan(ctx, "pool.repairpool")
+	defer span.End()
+
+	return e.bus.RepairPool(ctx, poolID, observed, allocated)
+}
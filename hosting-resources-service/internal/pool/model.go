@@ -1,11 +1,23 @@
 package pool
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxRegionLength limits the name of a region.
+const MaxRegionLength = 50
 
 type Pool struct {
-	ID        uuid.UUID
-	Name      string
+	ID   uuid.UUID
+	Name string
+	// Region is where the servers of the pool run, e.g. eu-central.
+	Region    string
 	Resources Resource
+	// Capacity is what the pool was stocked with. It is nil for pools created
+	// before capacity was tracked, until a repair sets it.
+	Capacity *Resource
 }
 
 type Resource struct {
@@ -17,8 +29,36 @@ type Resource struct {
 
 type NewPool struct {
 	Name     string
+	Region   string
 	CPUCores int
 	RAMMB    int
 	DiskGB   int
 	IPCount  int
 }
+
+// Placement constrains the pool resources are consumed from. An empty
+// region allows any pool.
+type Placement struct {
+	Region string
+}
+
+type ReservationKind string
+
+const (
+	ReservationConsume ReservationKind = "CONSUME"
+	ReservationReturn  ReservationKind = "RETURN"
+	ReservationResize  ReservationKind = "RESIZE"
+)
+
+// Reservation records a request made with a reservation id, so that a replay
+// of the request is not applied twice. PoolID is the pool the resources were
+// taken from or given back to; it is nil for a return of a reservation that
+// was never consumed.
+type Reservation struct {
+	ID         uuid.UUID
+	Kind       ReservationKind
+	PoolID     *uuid.UUID
+	Resources  Resource
+	ReturnedAt *time.Time
+	CreatedAt  time.Time
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"hosting-kit/page"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,33 +16,67 @@ var (
 	ErrValidation         = errors.New("validation error")
 	ErrNotEnoughResources = errors.New("not enough resources available")
 	ErrPoolNotFound       = errors.New("pool not found")
+	ErrCountersChanged    = errors.New("pool counters changed")
+	ErrRegionNotFound     = errors.New("region not found")
+
+	ErrReservationExists   = errors.New("reservation already exists")
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationReturned = errors.New("reservation was already returned")
 )
 
+var regionPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 type Extension func(ExtBusiness) ExtBusiness
 
 type Storer interface {
 	AppendResource(ctx context.Context, r Resource, poolID uuid.UUID) (Pool, error)
-	SubtractResource(ctx context.Context, r Resource) (uuid.UUID, error)
+	GrowResource(ctx context.Context, r Resource, poolID uuid.UUID) (Pool, error)
+	RepairResource(ctx context.Context, poolID uuid.UUID, observed Resource, allocated Resource) (Pool, error)
+	SubtractResource(ctx context.Context, r Resource, placement Placement) (Pool, error)
+	ChangeResource(ctx context.Context, delta Resource, poolID uuid.UUID) error
+	MoveResource(ctx context.Context, current Resource, poolID uuid.UUID, target Resource) (uuid.UUID, error)
 	CreatePool(ctx context.Context, p Pool) error
 	FindAll(ctx context.Context, pg page.Page) ([]Pool, int, error)
+	FindAllByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Pool], page.CursorDocument, error)
+	FindAllPools(ctx context.Context) ([]Pool, error)
+	FindByID(ctx context.Context, poolID uuid.UUID) (Pool, error)
+
+	// CreateReservation records a reservation and returns
+	// ErrReservationExists if its id is already known.
+	CreateReservation(ctx context.Context, r Reservation) error
+	// FindReservation reads a reservation and locks it until the end of the
+	// transaction.
+	FindReservation(ctx context.Context, reservationID uuid.UUID) (Reservation, error)
+	UpdateReservation(ctx context.Context, r Reservation) error
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type ExtBusiness interface {
 	CreatePool(ctx context.Context, p NewPool) (Pool, error)
-	ConsumeResource(ctx context.Context, r Resource) (uuid.UUID, error)
-	ReturnResource(ctx context.Context, r Resource, poolID uuid.UUID) error
+	ConsumeResource(ctx context.Context, reservationID uuid.UUID, r Resource, placement Placement) (Pool, error)
+	ReturnResource(ctx context.Context, reservationID uuid.UUID, r Resource, poolID uuid.UUID) error
+	ResizeResource(ctx context.Context, reservationID uuid.UUID, current Resource, target Resource, poolID uuid.UUID) (uuid.UUID, error)
+	ReserveResource(ctx context.Context, reservationID uuid.UUID, r Resource, poolID uuid.UUID) error
 	AddResources(ctx context.Context, r Resource, poolID uuid.UUID) (Pool, error)
 	Search(ctx context.Context, pg page.Page) ([]Pool, int, error)
+	SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Pool], page.CursorDocument, error)
+	ListPools(ctx context.Context) ([]Pool, error)
+	RepairPool(ctx context.Context, poolID uuid.UUID, observed Resource, allocated Resource) (Pool, error)
 }
 
 type Business struct {
 	storer     Storer
+	tx         Transactor
 	extensions []Extension
 }
 
-func NewBusiness(storer Storer, extensions ...Extension) ExtBusiness {
+func NewBusiness(storer Storer, tx Transactor, extensions ...Extension) ExtBusiness {
 	b := &Business{
 		storer:     storer,
+		tx:         tx,
 		extensions: extensions,
 	}
 
@@ -62,6 +98,10 @@ func (b *Business) CreatePool(ctx context.Context, p NewPool) (Pool, error) {
 		return Pool{}, fmt.Errorf("%w: pool name cannot be empty", ErrValidation)
 	}
 
+	if err := validateRegion(p.Region); err != nil {
+		return Pool{}, err
+	}
+
 	resource := Resource{
 		CPUCores: p.CPUCores,
 		RAMMB:    p.RAMMB,
@@ -73,10 +113,14 @@ func (b *Business) CreatePool(ctx context.Context, p NewPool) (Pool, error) {
 		return Pool{}, err
 	}
 
+	capacity := resource
+
 	pool := Pool{
 		ID:        uuid.New(),
 		Name:      p.Name,
+		Region:    p.Region,
 		Resources: resource,
+		Capacity:  &capacity,
 	}
 
 	if err := b.storer.CreatePool(ctx, pool); err != nil {
@@ -86,38 +130,323 @@ func (b *Business) CreatePool(ctx context.Context, p NewPool) (Pool, error) {
 	return pool, nil
 }
 
-func (b *Business) ConsumeResource(ctx context.Context, r Resource) (uuid.UUID, error) {
+// ConsumeResource takes r from the least recently used pool that fits it
+// and the placement. A region without any pool is reported as
+// ErrRegionNotFound, a region whose pools are full as ErrNotEnoughResources.
+//
+// With a reservation id the request is applied once: a replay returns the
+// pool of the first request, and a reservation that was returned already
+// fails with ErrReservationReturned.
+func (b *Business) ConsumeResource(ctx context.Context, reservationID uuid.UUID, r Resource, placement Placement) (Pool, error) {
 	if err := validateResource(r); err != nil {
-		return uuid.UUID{}, err
+		return Pool{}, err
 	}
 
-	poolID, err := b.storer.SubtractResource(ctx, r)
+	if placement.Region != "" {
+		if err := validateRegion(placement.Region); err != nil {
+			return Pool{}, err
+		}
+	}
+
+	if reservationID == uuid.Nil {
+		pool, err := b.storer.SubtractResource(ctx, r, placement)
+		if err != nil {
+			return Pool{}, fmt.Errorf("consume resourses: %w", err)
+		}
+
+		return pool, nil
+	}
+
+	var pool Pool
+
+	err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		res := Reservation{
+			ID:        reservationID,
+			Kind:      ReservationConsume,
+			Resources: r,
+			CreatedAt: time.Now().UTC(),
+		}
+
+		err := b.storer.CreateReservation(ctx, res)
+		if errors.Is(err, ErrReservationExists) {
+			pool, err = b.replayConsume(ctx, reservationID)
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("createreservation: %w", err)
+		}
+
+		pool, err = b.storer.SubtractResource(ctx, r, placement)
+		if err != nil {
+			return err
+		}
+
+		res.PoolID = &pool.ID
+		if err := b.storer.UpdateReservation(ctx, res); err != nil {
+			return fmt.Errorf("updatereservation: %w", err)
+		}
+
+		return nil
+	})
 
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("consume resourses: %w", err)
+		return Pool{}, fmt.Errorf("consume resourses: %w", err)
 	}
 
-	return poolID, nil
+	return pool, nil
 }
 
-func (b *Business) ReturnResource(ctx context.Context, r Resource, poolID uuid.UUID) error {
+func (b *Business) replayConsume(ctx context.Context, reservationID uuid.UUID) (Pool, error) {
+	res, err := b.storer.FindReservation(ctx, reservationID)
+	if err != nil {
+		return Pool{}, fmt.Errorf("findreservation: %w", err)
+	}
+
+	if res.Kind != ReservationConsume {
+		return Pool{}, fmt.Errorf("%w: reservation id is used by a %s request", ErrValidation, res.Kind)
+	}
+
+	if res.ReturnedAt != nil {
+		return Pool{}, ErrReservationReturned
+	}
+
+	pool, err := b.storer.FindByID(ctx, *res.PoolID)
+	if err != nil {
+		return Pool{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	return pool, nil
+}
+
+// ReturnResource gives r back to poolID. With the id of a consumed
+// reservation it gives back what that reservation took, from the pool it was
+// taken from. A reservation id that was never consumed is recorded as
+// returned, so a consume that arrives late is refused; poolID may be
+// uuid.Nil then. Replays are ignored.
+func (b *Business) ReturnResource(ctx context.Context, reservationID uuid.UUID, r Resource, poolID uuid.UUID) error {
 	if err := validateResource(r); err != nil {
 		return err
 	}
 
-	if _, err := b.storer.AppendResource(ctx, r, poolID); err != nil {
+	if reservationID == uuid.Nil {
+		if poolID == uuid.Nil {
+			return fmt.Errorf("%w: pool id is required without a reservation id", ErrValidation)
+		}
+
+		if _, err := b.storer.AppendResource(ctx, r, poolID); err != nil {
+			return fmt.Errorf("return resources: %w", err)
+		}
+
+		return nil
+	}
+
+	err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		res := Reservation{
+			ID:         reservationID,
+			Kind:       ReservationReturn,
+			Resources:  r,
+			ReturnedAt: &now,
+			CreatedAt:  now,
+		}
+		if poolID != uuid.Nil {
+			res.PoolID = &poolID
+		}
+
+		err := b.storer.CreateReservation(ctx, res)
+		if err == nil {
+			if res.PoolID == nil {
+				return nil
+			}
+
+			if _, err := b.storer.AppendResource(ctx, r, poolID); err != nil {
+				return fmt.Errorf("appendresource: %w", err)
+			}
+
+			return nil
+		}
+
+		if !errors.Is(err, ErrReservationExists) {
+			return fmt.Errorf("createreservation: %w", err)
+		}
+
+		existing, err := b.storer.FindReservation(ctx, reservationID)
+		if err != nil {
+			return fmt.Errorf("findreservation: %w", err)
+		}
+
+		if existing.ReturnedAt != nil {
+			return nil
+		}
+
+		if existing.Kind != ReservationConsume {
+			return fmt.Errorf("%w: reservation id is used by a %s request", ErrValidation, existing.Kind)
+		}
+
+		if _, err := b.storer.AppendResource(ctx, existing.Resources, *existing.PoolID); err != nil {
+			return fmt.Errorf("appendresource: %w", err)
+		}
+
+		existing.ReturnedAt = &now
+		if err := b.storer.UpdateReservation(ctx, existing); err != nil {
+			return fmt.Errorf("updatereservation: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
 		return fmt.Errorf("return resources: %w", err)
 	}
 
 	return nil
 }
 
+// ResizeResource changes a reservation from current to target. The difference
+// is taken from the same pool when it fits, otherwise the whole target is
+// reserved in another pool of the same region and current is released. It
+// returns the pool that holds the reservation afterwards. With a reservation
+// id the request is applied once and a replay returns the same pool.
+func (b *Business) ResizeResource(ctx context.Context, reservationID uuid.UUID, current Resource, target Resource, poolID uuid.UUID) (uuid.UUID, error) {
+	if err := validateResource(current); err != nil {
+		return uuid.Nil, err
+	}
+	if err := validateResource(target); err != nil {
+		return uuid.Nil, err
+	}
+
+	if reservationID == uuid.Nil {
+		return b.resize(ctx, current, target, poolID)
+	}
+
+	var newPoolID uuid.UUID
+
+	err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		res := Reservation{
+			ID:        reservationID,
+			Kind:      ReservationResize,
+			PoolID:    &poolID,
+			Resources: target,
+			CreatedAt: time.Now().UTC(),
+		}
+
+		err := b.storer.CreateReservation(ctx, res)
+		if errors.Is(err, ErrReservationExists) {
+			existing, err := b.storer.FindReservation(ctx, reservationID)
+			if err != nil {
+				return fmt.Errorf("findreservation: %w", err)
+			}
+
+			if existing.Kind != ReservationResize {
+				return fmt.Errorf("%w: reservation id is used by a %s request", ErrValidation, existing.Kind)
+			}
+
+			newPoolID = *existing.PoolID
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("createreservation: %w", err)
+		}
+
+		newPoolID, err = b.resize(ctx, current, target, poolID)
+		if err != nil {
+			return err
+		}
+
+		res.PoolID = &newPoolID
+		if err := b.storer.UpdateReservation(ctx, res); err != nil {
+			return fmt.Errorf("updatereservation: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return newPoolID, nil
+}
+
+func (b *Business) resize(ctx context.Context, current Resource, target Resource, poolID uuid.UUID) (uuid.UUID, error) {
+	delta := Resource{
+		CPUCores: target.CPUCores - current.CPUCores,
+		RAMMB:    target.RAMMB - current.RAMMB,
+		DiskGB:   target.DiskGB - current.DiskGB,
+		IPCount:  target.IPCount - current.IPCount,
+	}
+
+	err := b.storer.ChangeResource(ctx, delta, poolID)
+	if err == nil {
+		return poolID, nil
+	}
+
+	if !errors.Is(err, ErrNotEnoughResources) {
+		return uuid.Nil, fmt.Errorf("resize resources: %w", err)
+	}
+
+	newPoolID, err := b.storer.MoveResource(ctx, current, poolID, target)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("resize resources: %w", err)
+	}
+
+	return newPoolID, nil
+}
+
+// ReserveResource takes r from the given pool. Unlike ConsumeResource it does
+// not fall back to another pool, so a snapshot stays next to its server.
+//
+// With a reservation id it is recorded like a consumed reservation: a replay
+// is ignored, a reservation that was returned already fails with
+// ErrReservationReturned, and ReturnResource gives it back under the same id.
+func (b *Business) ReserveResource(ctx context.Context, reservationID uuid.UUID, r Resource, poolID uuid.UUID) error {
+	if err := validateResource(r); err != nil {
+		return err
+	}
+
+	if reservationID == uuid.Nil {
+		if err := b.storer.ChangeResource(ctx, r, poolID); err != nil {
+			return fmt.Errorf("reserve resources: %w", err)
+		}
+
+		return nil
+	}
+
+	err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		res := Reservation{
+			ID:        reservationID,
+			Kind:      ReservationConsume,
+			PoolID:    &poolID,
+			Resources: r,
+			CreatedAt: time.Now().UTC(),
+		}
+
+		err := b.storer.CreateReservation(ctx, res)
+		if errors.Is(err, ErrReservationExists) {
+			_, err = b.replayConsume(ctx, reservationID)
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("createreservation: %w", err)
+		}
+
+		return b.storer.ChangeResource(ctx, r, poolID)
+	})
+
+	if err != nil {
+		return fmt.Errorf("reserve resources: %w", err)
+	}
+
+	return nil
+}
+
 func (b *Business) AddResources(ctx context.Context, r Resource, poolID uuid.UUID) (Pool, error) {
 	if err := validateResource(r); err != nil {
 		return Pool{}, err
 	}
 
-	pool, err := b.storer.AppendResource(ctx, r, poolID)
+	pool, err := b.storer.GrowResource(ctx, r, poolID)
 
 	if err != nil {
 		return Pool{}, fmt.Errorf("add resources: %w", err)
@@ -136,6 +465,45 @@ func (b *Business) Search(ctx context.Context, pg page.Page) ([]Pool, int, error
 	return pools, count, nil
 }
 
+func (b *Business) SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Pool], page.CursorDocument, error) {
+	pools, doc, err := b.storer.FindAllByCursor(ctx, cur)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("searchbycursor: %w", err)
+	}
+
+	return pools, doc, nil
+}
+
+// ListPools returns every pool with its counters and capacity, for
+// reconciliation by the hosting service.
+func (b *Business) ListPools(ctx context.Context) ([]Pool, error) {
+	pools, err := b.storer.FindAllPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listpools: %w", err)
+	}
+
+	return pools, nil
+}
+
+// RepairPool sets the available counters of the pool to its capacity minus
+// allocated. observed are the counters the caller based allocated on; if the
+// pool changed since, ErrCountersChanged is returned and nothing is written.
+func (b *Business) RepairPool(ctx context.Context, poolID uuid.UUID, observed Resource, allocated Resource) (Pool, error) {
+	if err := validateResource(observed); err != nil {
+		return Pool{}, err
+	}
+	if err := validateResource(allocated); err != nil {
+		return Pool{}, err
+	}
+
+	pool, err := b.storer.RepairResource(ctx, poolID, observed, allocated)
+	if err != nil {
+		return Pool{}, fmt.Errorf("repairpool: %w", err)
+	}
+
+	return pool, nil
+}
+
 func validateResource(r Resource) error {
 	if r.CPUCores < 0 {
 		return fmt.Errorf("%w: CPU cores cannot be negative", ErrValidation)
@@ -152,3 +520,17 @@ func validateResource(r Resource) error {
 
 	return nil
 }
+
+func validateRegion(region string) error {
+	if region == "" {
+		return fmt.Errorf("%w: region cannot be empty", ErrValidation)
+	}
+	if len(region) > MaxRegionLength {
+		return fmt.Errorf("%w: region cannot be longer than %d characters", ErrValidation, MaxRegionLength)
+	}
+	if !regionPattern.MatchString(region) {
+		return fmt.Errorf("%w: region may only contain lowercase letters, digits and dashes", ErrValidation)
+	}
+
+	return nil
+}
@@ -8,25 +8,40 @@ import (
 )
 
 type poolDB struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	CPUCores  int       `db:"cpu_cores"`
-	RAMMB     int       `db:"ram_mb"`
-	DiskGB    int       `db:"disk_gb"`
-	IPCount   int       `db:"ip_count"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID               uuid.UUID `db:"id"`
+	Name             string    `db:"name"`
+	Region           string    `db:"region"`
+	CPUCores         int       `db:"cpu_cores"`
+	RAMMB            int       `db:"ram_mb"`
+	DiskGB           int       `db:"disk_gb"`
+	IPCount          int       `db:"ip_count"`
+	CapacityCPUCores *int      `db:"capacity_cpu_cores"`
+	CapacityRAMMB    *int      `db:"capacity_ram_mb"`
+	CapacityDiskGB   *int      `db:"capacity_disk_gb"`
+	CapacityIPCount  *int      `db:"capacity_ip_count"`
+	UpdatedAt        time.Time `db:"updated_at"`
 }
 
 func toDBPool(p pool.Pool) poolDB {
-	return poolDB{
+	db := poolDB{
 		ID:        p.ID,
 		Name:      p.Name,
+		Region:    p.Region,
 		CPUCores:  p.Resources.CPUCores,
 		RAMMB:     p.Resources.RAMMB,
 		DiskGB:    p.Resources.DiskGB,
 		IPCount:   p.Resources.IPCount,
 		UpdatedAt: time.Now().UTC(),
 	}
+
+	if p.Capacity != nil {
+		db.CapacityCPUCores = &p.Capacity.CPUCores
+		db.CapacityRAMMB = &p.Capacity.RAMMB
+		db.CapacityDiskGB = &p.Capacity.DiskGB
+		db.CapacityIPCount = &p.Capacity.IPCount
+	}
+
+	return db
 }
 
 func toBusPool(db poolDB) pool.Pool {
@@ -37,11 +52,23 @@ func toBusPool(db poolDB) pool.Pool {
 		IPCount:  db.IPCount,
 	}
 
-	return pool.Pool{
+	p := pool.Pool{
 		ID:        db.ID,
 		Name:      db.Name,
+		Region:    db.Region,
 		Resources: res,
 	}
+
+	if db.CapacityCPUCores != nil && db.CapacityRAMMB != nil && db.CapacityDiskGB != nil && db.CapacityIPCount != nil {
+		p.Capacity = &pool.Resource{
+			CPUCores: *db.CapacityCPUCores,
+			RAMMB:    *db.CapacityRAMMB,
+			DiskGB:   *db.CapacityDiskGB,
+			IPCount:  *db.CapacityIPCount,
+		}
+	}
+
+	return p
 }
 
 func toBusPools(dbs []poolDB) []pool.Pool {
@@ -51,3 +78,45 @@ func toBusPools(dbs []poolDB) []pool.Pool {
 	}
 	return pools
 }
+
+type reservationDB struct {
+	ID         uuid.UUID  `db:"id"`
+	Kind       string     `db:"kind"`
+	PoolID     *uuid.UUID `db:"pool_id"`
+	CPUCores   int        `db:"cpu_cores"`
+	RAMMB      int        `db:"ram_mb"`
+	DiskGB     int        `db:"disk_gb"`
+	IPCount    int        `db:"ip_count"`
+	ReturnedAt *time.Time `db:"returned_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+func toDBReservation(r pool.Reservation) reservationDB {
+	return reservationDB{
+		ID:         r.ID,
+		Kind:       string(r.Kind),
+		PoolID:     r.PoolID,
+		CPUCores:   r.Resources.CPUCores,
+		RAMMB:      r.Resources.RAMMB,
+		DiskGB:     r.Resources.DiskGB,
+		IPCount:    r.Resources.IPCount,
+		ReturnedAt: r.ReturnedAt,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func toBusReservation(db reservationDB) pool.Reservation {
+	return pool.Reservation{
+		ID:     db.ID,
+		Kind:   pool.ReservationKind(db.Kind),
+		PoolID: db.PoolID,
+		Resources: pool.Resource{
+			CPUCores: db.CPUCores,
+			RAMMB:    db.RAMMB,
+			DiskGB:   db.DiskGB,
+			IPCount:  db.IPCount,
+		},
+		ReturnedAt: db.ReturnedAt,
+		CreatedAt:  db.CreatedAt,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hosting-kit/database"
 	"hosting-kit/page"
 	"hosting-resources-service/internal/pool"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const poolColumns = `id, name, region, cpu_cores, ram_mb, disk_gb, ip_count,
+	capacity_cpu_cores, capacity_ram_mb, capacity_disk_gb, capacity_ip_count, updated_at`
+
 type Store struct {
 	db *pgxpool.Pool
 }
@@ -31,7 +35,7 @@ func (s *Store) AppendResource(ctx context.Context, r pool.Resource, poolID uuid
 		updated_at = NOW()
 	WHERE
 		id = @id
-	RETURNING id, name, cpu_cores, ram_mb, disk_gb, ip_count, updated_at`
+	RETURNING ` + poolColumns
 
 	args := pgx.NamedArgs{
 		"id":   poolID,
@@ -41,28 +45,115 @@ func (s *Store) AppendResource(ctx context.Context, r pool.Resource, poolID uuid
 		"ip":   r.IPCount,
 	}
 
-	var dbPool poolDB
-	err := s.db.QueryRow(ctx, q, args).Scan(
-		&dbPool.ID,
-		&dbPool.Name,
-		&dbPool.CPUCores,
-		&dbPool.RAMMB,
-		&dbPool.DiskGB,
-		&dbPool.IPCount,
-		&dbPool.UpdatedAt,
-	)
-
+	p, err := s.queryPool(ctx, q, args)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return pool.Pool{}, pool.ErrPoolNotFound
-		}
 		return pool.Pool{}, fmt.Errorf("db: append query: %w", err)
 	}
 
-	return toBusPool(dbPool), nil
+	return p, nil
 }
 
-func (s *Store) SubtractResource(ctx context.Context, r pool.Resource) (uuid.UUID, error) {
+// GrowResource adds r to the pool like AppendResource and raises its
+// capacity by the same amount. An unknown capacity stays unknown.
+func (s *Store) GrowResource(ctx context.Context, r pool.Resource, poolID uuid.UUID) (pool.Pool, error) {
+	const q = `
+	UPDATE pools
+	SET
+		cpu_cores          = cpu_cores + @cpu,
+		ram_mb             = ram_mb    + @ram,
+		disk_gb            = disk_gb   + @disk,
+		ip_count           = ip_count  + @ip,
+		capacity_cpu_cores = capacity_cpu_cores + @cpu,
+		capacity_ram_mb    = capacity_ram_mb    + @ram,
+		capacity_disk_gb   = capacity_disk_gb   + @disk,
+		capacity_ip_count  = capacity_ip_count  + @ip,
+		updated_at         = NOW()
+	WHERE
+		id = @id
+	RETURNING ` + poolColumns
+
+	args := pgx.NamedArgs{
+		"id":   poolID,
+		"cpu":  r.CPUCores,
+		"ram":  r.RAMMB,
+		"disk": r.DiskGB,
+		"ip":   r.IPCount,
+	}
+
+	p, err := s.queryPool(ctx, q, args)
+	if err != nil {
+		return pool.Pool{}, fmt.Errorf("db: grow query: %w", err)
+	}
+
+	return p, nil
+}
+
+// RepairResource sets the available counters of the pool to its capacity
+// minus allocated, but only while they still equal observed. A pool without
+// a known capacity adopts observed plus allocated as its capacity.
+func (s *Store) RepairResource(ctx context.Context, poolID uuid.UUID, observed pool.Resource, allocated pool.Resource) (pool.Pool, error) {
+	const q = `
+	UPDATE pools
+	SET
+		cpu_cores          = COALESCE(capacity_cpu_cores, cpu_cores + @cpu) - @cpu,
+		ram_mb             = COALESCE(capacity_ram_mb,    ram_mb    + @ram) - @ram,
+		disk_gb            = COALESCE(capacity_disk_gb,   disk_gb   + @disk) - @disk,
+		ip_count           = COALESCE(capacity_ip_count,  ip_count  + @ip) - @ip,
+		capacity_cpu_cores = COALESCE(capacity_cpu_cores, cpu_cores + @cpu),
+		capacity_ram_mb    = COALESCE(capacity_ram_mb,    ram_mb    + @ram),
+		capacity_disk_gb   = COALESCE(capacity_disk_gb,   disk_gb   + @disk),
+		capacity_ip_count  = COALESCE(capacity_ip_count,  ip_count  + @ip),
+		updated_at         = NOW()
+	WHERE
+		id = @id AND
+		cpu_cores = @obs_cpu AND
+		ram_mb    = @obs_ram AND
+		disk_gb   = @obs_disk AND
+		ip_count  = @obs_ip AND
+		COALESCE(capacity_cpu_cores, @cpu)  >= @cpu AND
+		COALESCE(capacity_ram_mb,    @ram)  >= @ram AND
+		COALESCE(capacity_disk_gb,   @disk) >= @disk AND
+		COALESCE(capacity_ip_count,  @ip)   >= @ip
+	RETURNING ` + poolColumns
+
+	args := pgx.NamedArgs{
+		"id":       poolID,
+		"cpu":      allocated.CPUCores,
+		"ram":      allocated.RAMMB,
+		"disk":     allocated.DiskGB,
+		"ip":       allocated.IPCount,
+		"obs_cpu":  observed.CPUCores,
+		"obs_ram":  observed.RAMMB,
+		"obs_disk": observed.DiskGB,
+		"obs_ip":   observed.IPCount,
+	}
+
+	p, err := s.queryPool(ctx, q, args)
+	if err == nil {
+		return p, nil
+	}
+
+	if !errors.Is(err, pool.ErrPoolNotFound) {
+		return pool.Pool{}, fmt.Errorf("db: repair query: %w", err)
+	}
+
+	const qCurrent = `SELECT ` + poolColumns + ` FROM pools WHERE id = @id`
+
+	current, err := s.queryPool(ctx, qCurrent, pgx.NamedArgs{"id": poolID})
+	if err != nil {
+		return pool.Pool{}, fmt.Errorf("db: repair current: %w", err)
+	}
+
+	if current.Resources != observed {
+		return pool.Pool{}, pool.ErrCountersChanged
+	}
+
+	return pool.Pool{}, pool.ErrNotEnoughResources
+}
+
+// SubtractResource takes r from the least recently updated pool that fits
+// it and the placement.
+func (s *Store) SubtractResource(ctx context.Context, r pool.Resource, placement pool.Placement) (pool.Pool, error) {
 	const q = `
 	UPDATE pools
 	SET
@@ -75,6 +166,7 @@ func (s *Store) SubtractResource(ctx context.Context, r pool.Resource) (uuid.UUI
 		SELECT id
 		FROM pools
 		WHERE 
+			(@region = '' OR region = @region) AND
 			cpu_cores >= @cpu AND
 			ram_mb    >= @ram AND
 			disk_gb   >= @disk AND
@@ -83,34 +175,165 @@ func (s *Store) SubtractResource(ctx context.Context, r pool.Resource) (uuid.UUI
 		LIMIT 1
 		FOR UPDATE SKIP LOCKED
 	)
-	RETURNING id`
+	RETURNING ` + poolColumns
 
 	args := pgx.NamedArgs{
-		"cpu":  r.CPUCores,
-		"ram":  r.RAMMB,
-		"disk": r.DiskGB,
-		"ip":   r.IPCount,
+		"region": placement.Region,
+		"cpu":    r.CPUCores,
+		"ram":    r.RAMMB,
+		"disk":   r.DiskGB,
+		"ip":     r.IPCount,
+	}
+
+	p, err := s.queryPool(ctx, q, args)
+	if err == nil {
+		return p, nil
+	}
+
+	if !errors.Is(err, pool.ErrPoolNotFound) {
+		return pool.Pool{}, fmt.Errorf("db: subtract query: %w", err)
+	}
+
+	if placement.Region == "" {
+		return pool.Pool{}, pool.ErrNotEnoughResources
 	}
 
-	var poolID uuid.UUID
-	err := s.db.QueryRow(ctx, q, args).Scan(&poolID)
+	const qExists = `SELECT EXISTS (SELECT 1 FROM pools WHERE region = @region)`
+
+	var exists bool
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qExists, pgx.NamedArgs{"region": placement.Region}).Scan(&exists); err != nil {
+		return pool.Pool{}, fmt.Errorf("db: subtract region exists: %w", err)
+	}
+
+	if !exists {
+		return pool.Pool{}, pool.ErrRegionNotFound
+	}
+
+	return pool.Pool{}, pool.ErrNotEnoughResources
+}
+
+func (s *Store) ChangeResource(ctx context.Context, delta pool.Resource, poolID uuid.UUID) error {
+	const q = `
+	UPDATE pools
+	SET
+		cpu_cores  = cpu_cores - @cpu,
+		ram_mb     = ram_mb    - @ram,
+		disk_gb    = disk_gb   - @disk,
+		ip_count   = ip_count  - @ip,
+		updated_at = NOW()
+	WHERE
+		id = @id AND
+		cpu_cores >= @cpu AND
+		ram_mb    >= @ram AND
+		disk_gb   >= @disk AND
+		ip_count  >= @ip`
+
+	args := pgx.NamedArgs{
+		"id":   poolID,
+		"cpu":  delta.CPUCores,
+		"ram":  delta.RAMMB,
+		"disk": delta.DiskGB,
+		"ip":   delta.IPCount,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: change exec: %w", err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	const qExists = `SELECT EXISTS (SELECT 1 FROM pools WHERE id = @id)`
+
+	var exists bool
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qExists, pgx.NamedArgs{"id": poolID}).Scan(&exists); err != nil {
+		return fmt.Errorf("db: change exists: %w", err)
+	}
+
+	if !exists {
+		return pool.ErrPoolNotFound
+	}
+
+	return pool.ErrNotEnoughResources
+}
+
+// MoveResource reserves target in another pool of the same region and
+// releases current in poolID within a single statement, so capacity is never
+// lost in between.
+func (s *Store) MoveResource(ctx context.Context, current pool.Resource, poolID uuid.UUID, target pool.Resource) (uuid.UUID, error) {
+	const q = `
+	WITH reserved AS (
+		UPDATE pools
+		SET
+			cpu_cores  = cpu_cores - @cpu,
+			ram_mb     = ram_mb    - @ram,
+			disk_gb    = disk_gb   - @disk,
+			ip_count   = ip_count  - @ip,
+			updated_at = NOW()
+		WHERE id = (
+			SELECT id
+			FROM pools
+			WHERE
+				id <> @pool_id AND
+				region = (SELECT region FROM pools WHERE id = @pool_id) AND
+				cpu_cores >= @cpu AND
+				ram_mb    >= @ram AND
+				disk_gb   >= @disk AND
+				ip_count  >= @ip
+			ORDER BY updated_at ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	), released AS (
+		UPDATE pools
+		SET
+			cpu_cores  = cpu_cores + @cur_cpu,
+			ram_mb     = ram_mb    + @cur_ram,
+			disk_gb    = disk_gb   + @cur_disk,
+			ip_count   = ip_count  + @cur_ip,
+			updated_at = NOW()
+		WHERE
+			id = @pool_id AND
+			EXISTS (SELECT 1 FROM reserved)
+	)
+	SELECT id FROM reserved`
+
+	args := pgx.NamedArgs{
+		"pool_id":  poolID,
+		"cpu":      target.CPUCores,
+		"ram":      target.RAMMB,
+		"disk":     target.DiskGB,
+		"ip":       target.IPCount,
+		"cur_cpu":  current.CPUCores,
+		"cur_ram":  current.RAMMB,
+		"cur_disk": current.DiskGB,
+		"cur_ip":   current.IPCount,
+	}
+
+	var newPoolID uuid.UUID
+	err := database.Conn(ctx, s.db).QueryRow(ctx, q, args).Scan(&newPoolID)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return uuid.Nil, pool.ErrNotEnoughResources
 		}
-		return uuid.Nil, fmt.Errorf("db: subtract exec: %w", err)
+		return uuid.Nil, fmt.Errorf("db: move exec: %w", err)
 	}
 
-	return poolID, nil
+	return newPoolID, nil
 }
 
 func (s *Store) CreatePool(ctx context.Context, p pool.Pool) error {
 	const q = `
 	INSERT INTO pools
-		(id, name, cpu_cores, ram_mb, disk_gb, ip_count, updated_at)
+		(id, name, region, cpu_cores, ram_mb, disk_gb, ip_count,
+		capacity_cpu_cores, capacity_ram_mb, capacity_disk_gb, capacity_ip_count, updated_at)
 	VALUES
-		(@id, @name, @cpu_cores, @ram_mb, @disk_gb, @ip_count, @updated_at)
+		(@id, @name, @region, @cpu_cores, @ram_mb, @disk_gb, @ip_count,
+		@capacity_cpu_cores, @capacity_ram_mb, @capacity_disk_gb, @capacity_ip_count, @updated_at)
 	`
 
 	dbPool := toDBPool(p)
@@ -118,14 +341,20 @@ func (s *Store) CreatePool(ctx context.Context, p pool.Pool) error {
 	args := pgx.NamedArgs{
 		"id":         dbPool.ID,
 		"name":       dbPool.Name,
+		"region":     dbPool.Region,
 		"cpu_cores":  dbPool.CPUCores,
 		"disk_gb":    dbPool.DiskGB,
 		"ram_mb":     dbPool.RAMMB,
 		"ip_count":   dbPool.IPCount,
 		"updated_at": dbPool.UpdatedAt,
+
+		"capacity_cpu_cores": dbPool.CapacityCPUCores,
+		"capacity_ram_mb":    dbPool.CapacityRAMMB,
+		"capacity_disk_gb":   dbPool.CapacityDiskGB,
+		"capacity_ip_count":  dbPool.CapacityIPCount,
 	}
 
-	_, err := s.db.Exec(ctx, q, args)
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
 	if err != nil {
 		return fmt.Errorf("db: %w", err)
 	}
@@ -137,7 +366,7 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]pool.Pool, int, er
 	const qCount = `SELECT count(*) FROM pools`
 
 	var total int
-	if err := s.db.QueryRow(ctx, qCount).Scan(&total); err != nil {
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("db: %w", err)
 	}
 
@@ -147,7 +376,7 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]pool.Pool, int, er
 
 	const qSelect = `
 	SELECT 
-		id, name, cpu_cores, ram_mb, disk_gb, ip_count, updated_at
+		` + poolColumns + `
 	FROM 
 		pools
 	ORDER BY 
@@ -159,7 +388,7 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]pool.Pool, int, er
 		"offset": pg.Offset(),
 	}
 
-	rows, err := s.db.Query(ctx, qSelect, args)
+	rows, err := database.Conn(ctx, s.db).Query(ctx, qSelect, args)
 	if err != nil {
 		return nil, 0, fmt.Errorf("db: %w", err)
 	}
@@ -171,3 +400,191 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]pool.Pool, int, er
 
 	return toBusPools(dbPools), total, nil
 }
+
+// FindAllByCursor reads pools in id order, starting from the cursor key.
+func (s *Store) FindAllByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[pool.Pool], page.CursorDocument, error) {
+	args := pgx.NamedArgs{"limit": cur.Limit()}
+
+	var where string
+	order := "id ASC"
+
+	if key, ok := cur.Key(); ok {
+		id, err := uuid.Parse(key.ID)
+		if err != nil || key.Field != "" {
+			return nil, page.CursorDocument{}, page.ErrInvalidCursor
+		}
+		args["cursor_id"] = id
+
+		where = "WHERE id > @cursor_id"
+		if cur.Backward() {
+			where = "WHERE id < @cursor_id"
+			order = "id DESC"
+		}
+	}
+
+	q := `
+	SELECT 
+		` + poolColumns + `
+	FROM 
+		pools
+	` + where + `
+	ORDER BY 
+		` + order + `
+	LIMIT @limit`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbPools, err := pgx.CollectRows(rows, pgx.RowToStructByName[poolDB])
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	edges, doc := page.Collect(cur, toBusPools(dbPools), func(p pool.Pool) page.Key {
+		return page.Key{ID: p.ID.String()}
+	})
+
+	return edges, doc, nil
+}
+
+// FindAllPools reads every pool in id order. The number of pools is small, so
+// it is not paged.
+func (s *Store) FindAllPools(ctx context.Context) ([]pool.Pool, error) {
+	const q = `
+	SELECT 
+		` + poolColumns + `
+	FROM 
+		pools
+	ORDER BY 
+		id ASC`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbPools, err := pgx.CollectRows(rows, pgx.RowToStructByName[poolDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusPools(dbPools), nil
+}
+
+// FindByID reads a single pool.
+func (s *Store) FindByID(ctx context.Context, poolID uuid.UUID) (pool.Pool, error) {
+	const q = `SELECT ` + poolColumns + ` FROM pools WHERE id = @id`
+
+	p, err := s.queryPool(ctx, q, pgx.NamedArgs{"id": poolID})
+	if err != nil {
+		if errors.Is(err, pool.ErrPoolNotFound) {
+			return pool.Pool{}, err
+		}
+		return pool.Pool{}, fmt.Errorf("db: %w", err)
+	}
+
+	return p, nil
+}
+
+func (s *Store) CreateReservation(ctx context.Context, r pool.Reservation) error {
+	const q = `
+	INSERT INTO reservations
+		(id, kind, pool_id, cpu_cores, ram_mb, disk_gb, ip_count, returned_at, created_at)
+	VALUES
+		(@id, @kind, @pool_id, @cpu_cores, @ram_mb, @disk_gb, @ip_count, @returned_at, @created_at)
+	ON CONFLICT (id) DO NOTHING`
+
+	dbRes := toDBReservation(r)
+
+	args := pgx.NamedArgs{
+		"id":          dbRes.ID,
+		"kind":        dbRes.Kind,
+		"pool_id":     dbRes.PoolID,
+		"cpu_cores":   dbRes.CPUCores,
+		"ram_mb":      dbRes.RAMMB,
+		"disk_gb":     dbRes.DiskGB,
+		"ip_count":    dbRes.IPCount,
+		"returned_at": dbRes.ReturnedAt,
+		"created_at":  dbRes.CreatedAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pool.ErrReservationExists
+	}
+
+	return nil
+}
+
+func (s *Store) FindReservation(ctx context.Context, reservationID uuid.UUID) (pool.Reservation, error) {
+	const q = `
+	SELECT
+		id, kind, pool_id, cpu_cores, ram_mb, disk_gb, ip_count, returned_at, created_at
+	FROM
+		reservations
+	WHERE
+		id = @id
+	FOR UPDATE`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"id": reservationID})
+	if err != nil {
+		return pool.Reservation{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbRes, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[reservationDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pool.Reservation{}, pool.ErrReservationNotFound
+		}
+		return pool.Reservation{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusReservation(dbRes), nil
+}
+
+func (s *Store) UpdateReservation(ctx context.Context, r pool.Reservation) error {
+	const q = `
+	UPDATE reservations
+	SET
+		pool_id = @pool_id,
+		returned_at = @returned_at
+	WHERE
+		id = @id`
+
+	dbRes := toDBReservation(r)
+
+	args := pgx.NamedArgs{
+		"id":          dbRes.ID,
+		"pool_id":     dbRes.PoolID,
+		"returned_at": dbRes.ReturnedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) queryPool(ctx context.Context, q string, args pgx.NamedArgs) (pool.Pool, error) {
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return pool.Pool{}, err
+	}
+
+	dbPool, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[poolDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pool.Pool{}, pool.ErrPoolNotFound
+		}
+		return pool.Pool{}, err
+	}
+
+	return toBusPool(dbPool), nil
+}
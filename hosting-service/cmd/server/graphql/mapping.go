@@ -1,32 +1,486 @@
 package graphql
 
 import (
+	"errors"
 	"hosting-kit/page"
+	"hosting-service/internal/billing"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func toPlan(p plan.Plan) *Plan {
 	return &Plan{
-		ID:       p.ID.String(),
-		Name:     p.Name,
-		CPUCores: p.CPUCores,
-		RAMMb:    p.RAMMB,
-		DiskGb:   p.DiskGB,
+		ID:                   p.ID.String(),
+		Name:                 p.Name,
+		CPUCores:             p.CPUCores,
+		RAMMb:                p.RAMMB,
+		DiskGb:               p.DiskGB,
+		RunningHourlyPrice:   int(p.RunningHourlyPrice),
+		AllocatedHourlyPrice: int(p.AllocatedHourlyPrice),
 	}
 }
 
 func toServer(s server.Server) *Server {
+	var purgeAt *string
+	if s.PurgeAt != nil {
+		v := s.PurgeAt.String()
+		purgeAt = &v
+	}
+
+	var snapshotID *string
+	if s.SnapshotID != nil {
+		v := s.SnapshotID.String()
+		snapshotID = &v
+	}
+
 	return &Server{
-		ID:          s.ID.String(),
-		Name:        s.Name,
-		Status:      ServerStatus(s.Status),
-		PlanID:      s.PlanID.String(),
-		IPv4Address: s.IPv4Address,
-		CreatedAt:   s.CreatedAt.String(),
+		ID:                s.ID.String(),
+		OwnerID:           s.OwnerID.String(),
+		ProjectID:         s.ProjectID.String(),
+		PoolID:            s.PoolID.String(),
+		Region:            s.Region,
+		Name:              s.Name,
+		Status:            ServerStatus(s.Status),
+		PlanID:            s.PlanID.String(),
+		IPv4Address:       s.IPv4Address,
+		Addresses:         toAddresses(s.Addresses),
+		CreatedAt:         s.CreatedAt.String(),
+		ProvisionAttempts: s.ProvisionAttempts,
+		Version:           s.Version,
+		FailureReason:     s.FailureReason,
+		PurgeAt:           purgeAt,
+		SnapshotID:        snapshotID,
+	}
+}
+
+func toAddresses(addrs []server.Address) []*ServerAddress {
+	out := make([]*ServerAddress, len(addrs))
+	for i, a := range addrs {
+		out[i] = &ServerAddress{
+			Address:    a.Address,
+			Family:     AddressFamily(a.Family),
+			Primary:    a.Primary,
+			ReverseDNS: a.ReverseDNS,
+		}
+	}
+	return out
+}
+
+func toSnapshot(s snapshot.Snapshot) *Snapshot {
+	return &Snapshot{
+		ID:            s.ID.String(),
+		ServerID:      s.ServerID.String(),
+		Name:          s.Name,
+		SizeGb:        s.SizeGB,
+		Status:        SnapshotStatus(s.Status),
+		FailureReason: s.FailureReason,
+		CreatedAt:     s.CreatedAt.String(),
+	}
+}
+
+func toSnapshotCollection(snaps []snapshot.Snapshot, p page.Page, count int) *SnapshotCollection {
+	items := make([]*Snapshot, len(snaps))
+	for i, s := range snaps {
+		items[i] = toSnapshot(s)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &SnapshotCollection{
+		Snapshots: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func toSSHKey(k sshkey.SSHKey) *SSHKey {
+	return &SSHKey{
+		ID:          k.ID.String(),
+		Name:        k.Name,
+		PublicKey:   k.PublicKey,
+		Fingerprint: k.Fingerprint,
+		CreatedAt:   k.CreatedAt.String(),
+	}
+}
+
+func toSSHKeyCollection(keys []sshkey.SSHKey, p page.Page, count int) *SSHKeyCollection {
+	items := make([]*SSHKey, len(keys))
+	for i, k := range keys {
+		items[i] = toSSHKey(k)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &SSHKeyCollection{
+		Keys: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func optionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	v := t.String()
+	return &v
+}
+
+func toSchedule(s schedule.Schedule) *Schedule {
+	return &Schedule{
+		ID:        s.ID.String(),
+		ServerID:  s.ServerID.String(),
+		Action:    ScheduledAction(s.Action),
+		RunAt:     optionalTime(s.RunAt),
+		Cron:      s.Cron,
+		Timezone:  s.Timezone,
+		Enabled:   s.Enabled,
+		NextRunAt: optionalTime(s.NextRunAt),
+		LastRunAt: optionalTime(s.LastRunAt),
+		LastError: s.LastError,
+		CreatedAt: s.CreatedAt.String(),
+		UpdatedAt: s.UpdatedAt.String(),
+	}
+}
+
+func toScheduleCollection(schedules []schedule.Schedule, p page.Page, count int) *ScheduleCollection {
+	items := make([]*Schedule, len(schedules))
+	for i, s := range schedules {
+		items[i] = toSchedule(s)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &ScheduleCollection{
+		Schedules: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func toRunAt(runAt *string) (*time.Time, error) {
+	if runAt == nil {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *runAt)
+	if err != nil {
+		return nil, errors.New("invalid runAt format, expected RFC 3339")
+	}
+
+	return &t, nil
+}
+
+func toBusNewSchedule(input CreateScheduleInput) (schedule.NewSchedule, error) {
+	runAt, err := toRunAt(input.RunAt)
+	if err != nil {
+		return schedule.NewSchedule{}, err
+	}
+
+	ns := schedule.NewSchedule{
+		Action: server.ActionType(input.Action),
+		RunAt:  runAt,
+		Cron:   input.Cron,
+	}
+
+	if input.Timezone != nil {
+		ns.Timezone = *input.Timezone
+	}
+
+	return ns, nil
+}
+
+func toBusUpdateSchedule(input UpdateScheduleInput) (schedule.UpdateSchedule, error) {
+	runAt, err := toRunAt(input.RunAt)
+	if err != nil {
+		return schedule.UpdateSchedule{}, err
+	}
+
+	us := schedule.UpdateSchedule{
+		RunAt:    runAt,
+		Cron:     input.Cron,
+		Timezone: input.Timezone,
+		Enabled:  input.Enabled,
+	}
+
+	if input.Action != nil {
+		action := server.ActionType(*input.Action)
+		us.Action = &action
+	}
+
+	return us, nil
+}
+
+func toLineItems(items []billing.LineItem) []*UsageLineItem {
+	res := make([]*UsageLineItem, len(items))
+	for i, item := range items {
+		res[i] = &UsageLineItem{
+			ServerID:    item.ServerID.String(),
+			PlanID:      item.PlanID.String(),
+			Meter:       UsageMeter(item.Meter),
+			Seconds:     int(item.Seconds),
+			HourlyPrice: int(item.HourlyPrice),
+			Amount:      int(item.Amount),
+		}
+	}
+	return res
+}
+
+func toUsageStatement(s billing.Statement) *UsageStatement {
+	return &UsageStatement{
+		PeriodStart: s.PeriodStart.String(),
+		PeriodEnd:   s.PeriodEnd.String(),
+		Items:       toLineItems(s.Items),
+		Total:       int(s.Total),
+		Currency:    billing.Currency,
+	}
+}
+
+func toInvoice(inv billing.Invoice) *Invoice {
+	return &Invoice{
+		ID:          inv.ID.String(),
+		PeriodStart: inv.PeriodStart.String(),
+		PeriodEnd:   inv.PeriodEnd.String(),
+		Items:       toLineItems(inv.Items),
+		Total:       int(inv.Total),
+		Currency:    inv.Currency,
+		CreatedAt:   inv.CreatedAt.String(),
+	}
+}
+
+func toInvoiceCollection(invoices []billing.Invoice, p page.Page, count int) *InvoiceCollection {
+	items := make([]*Invoice, len(invoices))
+	for i, inv := range invoices {
+		items[i] = toInvoice(inv)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &InvoiceCollection{
+		Invoices: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func toProject(p project.Project) *Project {
+	return &Project{
+		ID:        p.ID.String(),
+		Name:      p.Name,
+		Personal:  p.Personal,
+		CreatedBy: p.CreatedBy.String(),
+		CreatedAt: p.CreatedAt.String(),
+	}
+}
+
+func toProjectCollection(projects []project.Project, p page.Page, count int) *ProjectCollection {
+	items := make([]*Project, len(projects))
+	for i, pr := range projects {
+		items[i] = toProject(pr)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &ProjectCollection{
+		Projects: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func toProjectMember(m project.Member) *ProjectMember {
+	return &ProjectMember{
+		ProjectID: m.ProjectID.String(),
+		UserID:    m.UserID.String(),
+		Role:      ProjectRole(m.Role),
+		CreatedAt: m.CreatedAt.String(),
+	}
+}
+
+func toProjectMemberCollection(members []project.Member, p page.Page, count int) *ProjectMemberCollection {
+	items := make([]*ProjectMember, len(members))
+	for i, m := range members {
+		items[i] = toProjectMember(m)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &ProjectMemberCollection{
+		Members: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+func toInvitation(inv project.Invitation) *Invitation {
+	return &Invitation{
+		ID:        inv.ID.String(),
+		ProjectID: inv.ProjectID.String(),
+		InviteeID: inv.InviteeID.String(),
+		InvitedBy: inv.InvitedBy.String(),
+		Role:      ProjectRole(inv.Role),
+		Status:    InvitationStatus(inv.Status),
+		CreatedAt: inv.CreatedAt.String(),
+		ExpiresAt: inv.ExpiresAt.String(),
 	}
 }
 
+func toInvitationCollection(invs []project.Invitation, p page.Page, count int) *InvitationCollection {
+	items := make([]*Invitation, len(invs))
+	for i, inv := range invs {
+		items[i] = toInvitation(inv)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &InvitationCollection{
+		Invitations: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+// toProjectID parses the optional project of an order. Without one the
+// server goes to the personal project.
+func toProjectID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	projectID, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	return &projectID, nil
+}
+
+func toPlacement(region *string) server.Placement {
+	if region == nil {
+		return server.Placement{}
+	}
+
+	return server.Placement{Region: *region}
+}
+
+// toActionError types the error of a single server in a bulk action. Errors
+// the client cannot act on are reported as INTERNAL without details.
+func toActionError(err error) *ServerActionError {
+	code := ServerActionErrorCodeInternal
+	message := "internal server error"
+
+	switch {
+	case errors.Is(err, server.ErrServerNotFound):
+		code, message = ServerActionErrorCodeNotFound, server.ErrServerNotFound.Error()
+	case errors.Is(err, server.ErrAccessDenied):
+		code, message = ServerActionErrorCodeAccessDenied, server.ErrAccessDenied.Error()
+	case errors.Is(err, server.ErrValidation):
+		code, message = ServerActionErrorCodeInvalidStatus, err.Error()
+	case errors.Is(err, server.ErrConflict):
+		code, message = ServerActionErrorCodeConflict, err.Error()
+	case errors.Is(err, server.ErrInvalidPlan):
+		code, message = ServerActionErrorCodeInvalidPlan, server.ErrInvalidPlan.Error()
+	case errors.Is(err, server.ErrNoResources):
+		code, message = ServerActionErrorCodeNoResources, server.ErrNoResources.Error()
+	case errors.Is(err, server.ErrQuotaExceeded):
+		code, message = ServerActionErrorCodeQuotaExceeded, err.Error()
+	}
+
+	return &ServerActionError{Code: code, Message: message}
+}
+
+func toActionResults(results []server.BulkResult) []*ServerActionResult {
+	items := make([]*ServerActionResult, len(results))
+	for i, res := range results {
+		items[i] = &ServerActionResult{ServerID: res.ServerID.String(), Success: res.Err == nil}
+		if res.Err != nil {
+			items[i].Error = toActionError(res.Err)
+			continue
+		}
+		items[i].Server = toServer(res.Server)
+	}
+	return items
+}
+
+func toServerIDs(ids []string) ([]uuid.UUID, error) {
+	parsed := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		serverID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, errors.New("invalid server ID format")
+		}
+		parsed[i] = serverID
+	}
+
+	return parsed, nil
+}
+
+func toSSHKeyIDs(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	parsed := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		keyID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, errors.New("invalid ssh key ID format")
+		}
+		parsed[i] = keyID
+	}
+
+	return parsed, nil
+}
+
 func toPlanCollection(plans []plan.Plan, p page.Page, count int) *PlanCollection {
 	items := make([]*Plan, len(plans))
 	for i, p := range plans {
@@ -68,3 +522,191 @@ func toServerCollection(servers []server.Server, p page.Page, count int) *Server
 		},
 	}
 }
+
+func toServerEvent(e server.Event) *ServerEvent {
+	var oldStatus *string
+	if e.OldStatus != nil {
+		s := string(*e.OldStatus)
+		oldStatus = &s
+	}
+
+	return &ServerEvent{
+		ID:        e.ID.String(),
+		Actor:     e.Actor,
+		OldStatus: oldStatus,
+		NewStatus: string(e.NewStatus),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt.String(),
+	}
+}
+
+func toServerEventCollection(events []server.Event, p page.Page, count int) *ServerEventCollection {
+	items := make([]*ServerEvent, len(events))
+	for i, e := range events {
+		items[i] = toServerEvent(e)
+	}
+
+	doc := page.NewDocument(p, count)
+
+	return &ServerEventCollection{
+		Events: items,
+		Meta: &CollectionMeta{
+			Number:        doc.Page,
+			Size:          doc.PageSize,
+			TotalElements: doc.TotalCount,
+			TotalPages:    doc.TotalPages,
+			HasNextPage:   doc.HasNext,
+			HasPrevPage:   doc.HasPrev,
+		},
+	}
+}
+
+var orderFields = map[ServerOrderField]string{
+	ServerOrderFieldName:        server.OrderByName,
+	ServerOrderFieldStatus:      server.OrderByStatus,
+	ServerOrderFieldIPV4Address: server.OrderByIPv4Address,
+	ServerOrderFieldCreatedAt:   server.OrderByCreatedAt,
+}
+
+func toQueryFilter(f *ServerFilter) (server.QueryFilter, error) {
+	var filter server.QueryFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	if f.OwnerID != nil {
+		ownerID, err := uuid.Parse(*f.OwnerID)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid owner ID format")
+		}
+		filter.OwnerID = &ownerID
+	}
+
+	if f.PoolID != nil {
+		poolID, err := uuid.Parse(*f.PoolID)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid pool ID format")
+		}
+		filter.PoolID = &poolID
+	}
+
+	if f.ProjectID != nil {
+		projectID, err := uuid.Parse(*f.ProjectID)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid project ID format")
+		}
+		filter.ProjectID = &projectID
+	}
+
+	if f.Status != nil {
+		status := server.ServerStatus(*f.Status)
+		filter.Status = &status
+	}
+
+	if f.PlanID != nil {
+		planID, err := uuid.Parse(*f.PlanID)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid plan ID format")
+		}
+		filter.PlanID = &planID
+	}
+
+	filter.Name = f.Name
+	filter.IPAddress = f.IPAddress
+
+	if f.CreatedFrom != nil {
+		t, err := time.Parse(time.RFC3339, *f.CreatedFrom)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid createdFrom format, expected RFC 3339")
+		}
+		filter.StartCreatedAt = &t
+	}
+
+	if f.CreatedTo != nil {
+		t, err := time.Parse(time.RFC3339, *f.CreatedTo)
+		if err != nil {
+			return server.QueryFilter{}, errors.New("invalid createdTo format, expected RFC 3339")
+		}
+		filter.EndCreatedAt = &t
+	}
+
+	return filter, nil
+}
+
+func toOrderBy(o *ServerOrder) (server.OrderBy, error) {
+	if o == nil {
+		return server.DefaultOrderBy, nil
+	}
+
+	return server.NewOrderBy(orderFields[o.Field], string(o.Direction))
+}
+
+// toCursor maps the Relay arguments onto a keyset cursor. Paging back needs
+// a before cursor, since the end of the listing has no key.
+func toCursor(first *int, after *string, last *int, before *string) (page.Cursor, error) {
+	if first != nil && last != nil {
+		return page.Cursor{}, errors.New("first and last cannot be combined")
+	}
+
+	if last != nil && before == nil {
+		return page.Cursor{}, errors.New("last requires a before cursor")
+	}
+
+	var size int
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+
+	var a, b string
+	if after != nil {
+		a = *after
+	}
+	if before != nil {
+		b = *before
+	}
+
+	return page.ParseCursor(size, a, b)
+}
+
+func toPageInfo(doc page.CursorDocument) *PageInfo {
+	info := PageInfo{
+		HasNextPage:     doc.HasNext,
+		HasPreviousPage: doc.HasPrev,
+	}
+
+	if doc.StartCursor != "" {
+		info.StartCursor = &doc.StartCursor
+	}
+	if doc.EndCursor != "" {
+		info.EndCursor = &doc.EndCursor
+	}
+
+	return &info
+}
+
+func toServerConnection(edges []page.Edge[server.Server], doc page.CursorDocument) *ServerConnection {
+	items := make([]*ServerEdge, len(edges))
+	for i, e := range edges {
+		items[i] = &ServerEdge{Cursor: e.Cursor, Node: toServer(e.Node)}
+	}
+
+	return &ServerConnection{
+		Edges:    items,
+		PageInfo: toPageInfo(doc),
+	}
+}
+
+func toPlanConnection(edges []page.Edge[plan.Plan], doc page.CursorDocument) *PlanConnection {
+	items := make([]*PlanEdge, len(edges))
+	for i, e := range edges {
+		items[i] = &PlanEdge{Cursor: e.Cursor, Node: toPlan(e.Node)}
+	}
+
+	return &PlanConnection{
+		Edges:    items,
+		PageInfo: toPageInfo(doc),
+	}
+}
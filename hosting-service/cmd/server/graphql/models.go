@@ -19,10 +19,56 @@ type CollectionMeta struct {
 }
 
 type CreatePlanInput struct {
-	Name     string `json:"name"`
-	CPUCores int    `json:"cpuCores"`
-	RAMMb    int    `json:"ramMb"`
-	DiskGb   int    `json:"diskGb"`
+	Name                 string `json:"name"`
+	CPUCores             int    `json:"cpuCores"`
+	RAMMb                int    `json:"ramMb"`
+	DiskGb               int    `json:"diskGb"`
+	RunningHourlyPrice   *int   `json:"runningHourlyPrice,omitempty"`
+	AllocatedHourlyPrice *int   `json:"allocatedHourlyPrice,omitempty"`
+}
+
+// Set either runAt or cron.
+type CreateScheduleInput struct {
+	ServerID string          `json:"serverId"`
+	Action   ScheduledAction `json:"action"`
+	// RFC 3339 timestamp in the future.
+	RunAt *string `json:"runAt,omitempty"`
+	Cron  *string `json:"cron,omitempty"`
+	// Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// Charges of a closed calendar month.
+// Offers a user a role in a project until expiresAt.
+type Invitation struct {
+	ID        string           `json:"id"`
+	ProjectID string           `json:"projectId"`
+	InviteeID string           `json:"inviteeId"`
+	InvitedBy string           `json:"invitedBy"`
+	Role      ProjectRole      `json:"role"`
+	Status    InvitationStatus `json:"status"`
+	CreatedAt string           `json:"createdAt"`
+	ExpiresAt string           `json:"expiresAt"`
+}
+
+type InvitationCollection struct {
+	Invitations []*Invitation   `json:"invitations"`
+	Meta        *CollectionMeta `json:"meta"`
+}
+
+type Invoice struct {
+	ID          string           `json:"id"`
+	PeriodStart string           `json:"periodStart"`
+	PeriodEnd   string           `json:"periodEnd"`
+	Items       []*UsageLineItem `json:"items"`
+	Total       int              `json:"total"`
+	Currency    string           `json:"currency"`
+	CreatedAt   string           `json:"createdAt"`
+}
+
+type InvoiceCollection struct {
+	Invoices []*Invoice      `json:"invoices"`
+	Meta     *CollectionMeta `json:"meta"`
 }
 
 type Mutation struct {
@@ -31,6 +77,21 @@ type Mutation struct {
 type OrderServerInput struct {
 	PlanID string `json:"planId"`
 	Name   string `json:"name"`
+	// Defaults to the personal project. Ordering needs the ADMIN role in the project.
+	ProjectID *string `json:"projectId,omitempty"`
+	// Defaults to any region with enough capacity. See GET /regions in the REST API.
+	Region *string `json:"region,omitempty"`
+	// Keys from sshKeys to install on the server.
+	SSHKeyIds []string `json:"sshKeyIds,omitempty"`
+	// Replaying the mutation with the same key returns the first result.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
 }
 
 type Plan struct {
@@ -39,6 +100,10 @@ type Plan struct {
 	CPUCores int    `json:"cpuCores"`
 	RAMMb    int    `json:"ramMB"`
 	DiskGb   int    `json:"diskGB"`
+	// Price of an hour in RUNNING status, in minor currency units.
+	RunningHourlyPrice int `json:"runningHourlyPrice"`
+	// Price of an hour holding resources, in minor currency units.
+	AllocatedHourlyPrice int `json:"allocatedHourlyPrice"`
 }
 
 type PlanCollection struct {
@@ -46,17 +111,134 @@ type PlanCollection struct {
 	Meta  *CollectionMeta `json:"meta"`
 }
 
+type PlanConnection struct {
+	Edges    []*PlanEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type PlanEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Plan  `json:"node"`
+}
+
+// Groups servers that its members share.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Holds the servers ordered without a project. Cannot be deleted.
+	Personal  bool   `json:"personal"`
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type ProjectCollection struct {
+	Projects []*Project      `json:"projects"`
+	Meta     *CollectionMeta `json:"meta"`
+}
+
+type ProjectMember struct {
+	ProjectID string      `json:"projectId"`
+	UserID    string      `json:"userId"`
+	Role      ProjectRole `json:"role"`
+	CreatedAt string      `json:"createdAt"`
+}
+
+type ProjectMemberCollection struct {
+	Members []*ProjectMember `json:"members"`
+	Meta    *CollectionMeta  `json:"meta"`
+}
+
 type Query struct {
 }
 
+type SSHKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// The key in the OpenSSH authorized_keys format.
+	PublicKey string `json:"publicKey"`
+	// SHA256 fingerprint as printed by ssh-keygen -l.
+	Fingerprint string `json:"fingerprint"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+type SSHKeyCollection struct {
+	Keys []*SSHKey       `json:"keys"`
+	Meta *CollectionMeta `json:"meta"`
+}
+
+// Runs a power action on a server as its owner, once at runAt or recurring on cron.
+type Schedule struct {
+	ID       string          `json:"id"`
+	ServerID string          `json:"serverId"`
+	Action   ScheduledAction `json:"action"`
+	RunAt    *string         `json:"runAt,omitempty"`
+	// Five fields: minute, hour, day of month, month, day of week, e.g. 0 8 * * 1-5.
+	Cron *string `json:"cron,omitempty"`
+	// IANA timezone the cron expression is evaluated in.
+	Timezone string `json:"timezone"`
+	Enabled  bool   `json:"enabled"`
+	// Empty once a one-shot schedule ran or while the schedule is disabled.
+	NextRunAt *string `json:"nextRunAt,omitempty"`
+	LastRunAt *string `json:"lastRunAt,omitempty"`
+	// Why the last run did not change the server.
+	LastError *string `json:"lastError,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+}
+
+type ScheduleCollection struct {
+	Schedules []*Schedule     `json:"schedules"`
+	Meta      *CollectionMeta `json:"meta"`
+}
+
 type Server struct {
-	ID          string       `json:"id"`
-	Name        string       `json:"name"`
-	Status      ServerStatus `json:"status"`
-	PlanID      string       `json:"planId"`
-	IPv4Address *string      `json:"IPv4Address,omitempty"`
-	CreatedAt   string       `json:"createdAt"`
-	Plan        *Plan        `json:"plan,omitempty"`
+	ID string `json:"id"`
+	// The user who ordered the server.
+	OwnerID   string `json:"ownerId"`
+	ProjectID string `json:"projectId"`
+	PoolID    string `json:"poolId"`
+	// Region of the pool the server runs in, e.g. eu-central.
+	Region string       `json:"region"`
+	Name   string       `json:"name"`
+	Status ServerStatus `json:"status"`
+	PlanID string       `json:"planId"`
+	// The primary IPv4 address.
+	IPv4Address *string `json:"IPv4Address,omitempty"`
+	// IPv4 and IPv6 addresses, empty until the server is provisioned.
+	Addresses         []*ServerAddress `json:"addresses"`
+	CreatedAt         string           `json:"createdAt"`
+	ProvisionAttempts int              `json:"provisionAttempts"`
+	FailureReason     *string          `json:"failureReason,omitempty"`
+	// When a DELETED_PENDING server is deprovisioned for good.
+	PurgeAt *string `json:"purgeAt,omitempty"`
+	// The snapshot the server was created from, if any.
+	SnapshotID *string `json:"snapshotId,omitempty"`
+	// Grows with every update. Pass it back as expectedVersion to avoid lost writes.
+	Version int                    `json:"version"`
+	Plan    *Plan                  `json:"plan,omitempty"`
+	History *ServerEventCollection `json:"history"`
+}
+
+type ServerActionError struct {
+	Code    ServerActionErrorCode `json:"code"`
+	Message string                `json:"message"`
+}
+
+// Outcome of a bulk action on a single server. server is set on success, error otherwise.
+type ServerActionResult struct {
+	ServerID string             `json:"serverId"`
+	Success  bool               `json:"success"`
+	Server   *Server            `json:"server,omitempty"`
+	Error    *ServerActionError `json:"error,omitempty"`
+}
+
+type ServerAddress struct {
+	Address string        `json:"address"`
+	Family  AddressFamily `json:"family"`
+	// One address of each family is primary.
+	Primary bool `json:"primary"`
+	// PTR name of the address.
+	ReverseDNS *string `json:"reverseDns,omitempty"`
 }
 
 type ServerCollection struct {
@@ -64,23 +246,419 @@ type ServerCollection struct {
 	Meta    *CollectionMeta `json:"meta"`
 }
 
+type ServerConnection struct {
+	Edges    []*ServerEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type ServerEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Server `json:"node"`
+}
+
+type ServerEvent struct {
+	ID        string  `json:"id"`
+	Actor     string  `json:"actor"`
+	OldStatus *string `json:"oldStatus,omitempty"`
+	NewStatus string  `json:"newStatus"`
+	Reason    string  `json:"reason"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+type ServerEventCollection struct {
+	Events []*ServerEvent  `json:"events"`
+	Meta   *CollectionMeta `json:"meta"`
+}
+
+type ServerFilter struct {
+	// Only applied by the admin queries; users always see the servers of their projects.
+	OwnerID   *string       `json:"ownerId,omitempty"`
+	PoolID    *string       `json:"poolId,omitempty"`
+	ProjectID *string       `json:"projectId,omitempty"`
+	Status    *ServerStatus `json:"status,omitempty"`
+	PlanID    *string       `json:"planId,omitempty"`
+	// Case-insensitive substring of the server name.
+	Name *string `json:"name,omitempty"`
+	// Any IPv4 or IPv6 address of the server.
+	IPAddress *string `json:"ipAddress,omitempty"`
+	// RFC 3339 timestamp, inclusive.
+	CreatedFrom *string `json:"createdFrom,omitempty"`
+	// RFC 3339 timestamp, inclusive.
+	CreatedTo *string `json:"createdTo,omitempty"`
+}
+
+type ServerOrder struct {
+	Field     ServerOrderField `json:"field"`
+	Direction SortDirection    `json:"direction"`
+}
+
+type Snapshot struct {
+	ID            string         `json:"id"`
+	ServerID      string         `json:"serverId"`
+	Name          string         `json:"name"`
+	SizeGb        int            `json:"sizeGB"`
+	Status        SnapshotStatus `json:"status"`
+	FailureReason *string        `json:"failureReason,omitempty"`
+	CreatedAt     string         `json:"createdAt"`
+}
+
+type SnapshotCollection struct {
+	Snapshots []*Snapshot     `json:"snapshots"`
+	Meta      *CollectionMeta `json:"meta"`
+}
+
+// Only the given fields change. runAt makes the schedule one-shot, cron recurring.
+type UpdateScheduleInput struct {
+	Action   *ScheduledAction `json:"action,omitempty"`
+	RunAt    *string          `json:"runAt,omitempty"`
+	Cron     *string          `json:"cron,omitempty"`
+	Timezone *string          `json:"timezone,omitempty"`
+	Enabled  *bool            `json:"enabled,omitempty"`
+}
+
+type UsageLineItem struct {
+	ServerID string     `json:"serverId"`
+	PlanID   string     `json:"planId"`
+	Meter    UsageMeter `json:"meter"`
+	Seconds  int        `json:"seconds"`
+	// Price of an hour on the plan, in minor currency units.
+	HourlyPrice int `json:"hourlyPrice"`
+	// Amount in minor currency units, rounded up.
+	Amount int `json:"amount"`
+}
+
+// Charges of the current calendar month (UTC) so far, at the prices in effect when the usage started.
+type UsageStatement struct {
+	PeriodStart string           `json:"periodStart"`
+	PeriodEnd   string           `json:"periodEnd"`
+	Items       []*UsageLineItem `json:"items"`
+	Total       int              `json:"total"`
+	Currency    string           `json:"currency"`
+}
+
+type AddressFamily string
+
+const (
+	AddressFamilyIpv4 AddressFamily = "IPV4"
+	AddressFamilyIpv6 AddressFamily = "IPV6"
+)
+
+var AllAddressFamily = []AddressFamily{
+	AddressFamilyIpv4,
+	AddressFamilyIpv6,
+}
+
+func (e AddressFamily) IsValid() bool {
+	switch e {
+	case AddressFamilyIpv4, AddressFamilyIpv6:
+		return true
+	}
+	return false
+}
+
+func (e AddressFamily) String() string {
+	return string(e)
+}
+
+func (e *AddressFamily) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AddressFamily(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AddressFamily", str)
+	}
+	return nil
+}
+
+func (e AddressFamily) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AddressFamily) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AddressFamily) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AdminServerAction string
+
+const (
+	// Stop a running server or one stuck in STARTING, REBOOTING or STOPPING.
+	AdminServerActionForceStop AdminServerAction = "FORCE_STOP"
+	// Delete a server in any status but DELETING without waiting for the restore period.
+	AdminServerActionForceDelete AdminServerAction = "FORCE_DELETE"
+	// Resend the provisioning command of a server stuck in PENDING.
+	AdminServerActionResetProvision AdminServerAction = "RESET_PROVISION"
+)
+
+var AllAdminServerAction = []AdminServerAction{
+	AdminServerActionForceStop,
+	AdminServerActionForceDelete,
+	AdminServerActionResetProvision,
+}
+
+func (e AdminServerAction) IsValid() bool {
+	switch e {
+	case AdminServerActionForceStop, AdminServerActionForceDelete, AdminServerActionResetProvision:
+		return true
+	}
+	return false
+}
+
+func (e AdminServerAction) String() string {
+	return string(e)
+}
+
+func (e *AdminServerAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AdminServerAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AdminServerAction", str)
+	}
+	return nil
+}
+
+func (e AdminServerAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AdminServerAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AdminServerAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "PENDING"
+	InvitationStatusAccepted InvitationStatus = "ACCEPTED"
+	InvitationStatusDeclined InvitationStatus = "DECLINED"
+	InvitationStatusRevoked  InvitationStatus = "REVOKED"
+	InvitationStatusExpired  InvitationStatus = "EXPIRED"
+)
+
+var AllInvitationStatus = []InvitationStatus{
+	InvitationStatusPending,
+	InvitationStatusAccepted,
+	InvitationStatusDeclined,
+	InvitationStatusRevoked,
+	InvitationStatusExpired,
+}
+
+func (e InvitationStatus) IsValid() bool {
+	switch e {
+	case InvitationStatusPending, InvitationStatusAccepted, InvitationStatusDeclined, InvitationStatusRevoked, InvitationStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (e InvitationStatus) String() string {
+	return string(e)
+}
+
+func (e *InvitationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InvitationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InvitationStatus", str)
+	}
+	return nil
+}
+
+func (e InvitationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InvitationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InvitationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Every role includes what the roles below it may do.
+type ProjectRole string
+
+const (
+	// Renames, deletes and hands over the project.
+	ProjectRoleOwner ProjectRole = "OWNER"
+	// Orders, resizes and deletes servers and manages members.
+	ProjectRoleAdmin ProjectRole = "ADMIN"
+	// Starts, stops and reboots servers.
+	ProjectRoleOperator ProjectRole = "OPERATOR"
+	// Sees the project and its servers.
+	ProjectRoleViewer ProjectRole = "VIEWER"
+)
+
+var AllProjectRole = []ProjectRole{
+	ProjectRoleOwner,
+	ProjectRoleAdmin,
+	ProjectRoleOperator,
+	ProjectRoleViewer,
+}
+
+func (e ProjectRole) IsValid() bool {
+	switch e {
+	case ProjectRoleOwner, ProjectRoleAdmin, ProjectRoleOperator, ProjectRoleViewer:
+		return true
+	}
+	return false
+}
+
+func (e ProjectRole) String() string {
+	return string(e)
+}
+
+func (e *ProjectRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProjectRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProjectRole", str)
+	}
+	return nil
+}
+
+func (e ProjectRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ProjectRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ProjectRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ScheduledAction string
+
+const (
+	ScheduledActionStart  ScheduledAction = "START"
+	ScheduledActionStop   ScheduledAction = "STOP"
+	ScheduledActionReboot ScheduledAction = "REBOOT"
+)
+
+var AllScheduledAction = []ScheduledAction{
+	ScheduledActionStart,
+	ScheduledActionStop,
+	ScheduledActionReboot,
+}
+
+func (e ScheduledAction) IsValid() bool {
+	switch e {
+	case ScheduledActionStart, ScheduledActionStop, ScheduledActionReboot:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledAction) String() string {
+	return string(e)
+}
+
+func (e *ScheduledAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledAction", str)
+	}
+	return nil
+}
+
+func (e ScheduledAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ScheduledAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ScheduledAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ServerAction string
 
 const (
-	ServerActionStart  ServerAction = "START"
-	ServerActionStop   ServerAction = "STOP"
-	ServerActionDelete ServerAction = "DELETE"
+	ServerActionStart          ServerAction = "START"
+	ServerActionStop           ServerAction = "STOP"
+	ServerActionDelete         ServerAction = "DELETE"
+	ServerActionResize         ServerAction = "RESIZE"
+	ServerActionReboot         ServerAction = "REBOOT"
+	ServerActionRetryProvision ServerAction = "RETRY_PROVISION"
+	// Bring a DELETED_PENDING server back to the status it had before the delete.
+	ServerActionRestore ServerAction = "RESTORE"
 )
 
 var AllServerAction = []ServerAction{
 	ServerActionStart,
 	ServerActionStop,
 	ServerActionDelete,
+	ServerActionResize,
+	ServerActionReboot,
+	ServerActionRetryProvision,
+	ServerActionRestore,
 }
 
 func (e ServerAction) IsValid() bool {
 	switch e {
-	case ServerActionStart, ServerActionStop, ServerActionDelete:
+	case ServerActionStart, ServerActionStop, ServerActionDelete, ServerActionResize, ServerActionReboot, ServerActionRetryProvision, ServerActionRestore:
 		return true
 	}
 	return false
@@ -121,6 +699,133 @@ func (e ServerAction) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ServerActionErrorCode string
+
+const (
+	ServerActionErrorCodeNotFound     ServerActionErrorCode = "NOT_FOUND"
+	ServerActionErrorCodeAccessDenied ServerActionErrorCode = "ACCESS_DENIED"
+	// The server is not in a status the action can start from.
+	ServerActionErrorCodeInvalidStatus ServerActionErrorCode = "INVALID_STATUS"
+	ServerActionErrorCodeConflict      ServerActionErrorCode = "CONFLICT"
+	ServerActionErrorCodeInvalidPlan   ServerActionErrorCode = "INVALID_PLAN"
+	ServerActionErrorCodeNoResources   ServerActionErrorCode = "NO_RESOURCES"
+	ServerActionErrorCodeQuotaExceeded ServerActionErrorCode = "QUOTA_EXCEEDED"
+	ServerActionErrorCodeInternal      ServerActionErrorCode = "INTERNAL"
+)
+
+var AllServerActionErrorCode = []ServerActionErrorCode{
+	ServerActionErrorCodeNotFound,
+	ServerActionErrorCodeAccessDenied,
+	ServerActionErrorCodeInvalidStatus,
+	ServerActionErrorCodeConflict,
+	ServerActionErrorCodeInvalidPlan,
+	ServerActionErrorCodeNoResources,
+	ServerActionErrorCodeQuotaExceeded,
+	ServerActionErrorCodeInternal,
+}
+
+func (e ServerActionErrorCode) IsValid() bool {
+	switch e {
+	case ServerActionErrorCodeNotFound, ServerActionErrorCodeAccessDenied, ServerActionErrorCodeInvalidStatus, ServerActionErrorCodeConflict, ServerActionErrorCodeInvalidPlan, ServerActionErrorCodeNoResources, ServerActionErrorCodeQuotaExceeded, ServerActionErrorCodeInternal:
+		return true
+	}
+	return false
+}
+
+func (e ServerActionErrorCode) String() string {
+	return string(e)
+}
+
+func (e *ServerActionErrorCode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServerActionErrorCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServerActionErrorCode", str)
+	}
+	return nil
+}
+
+func (e ServerActionErrorCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ServerActionErrorCode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ServerActionErrorCode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ServerOrderField string
+
+const (
+	ServerOrderFieldName        ServerOrderField = "NAME"
+	ServerOrderFieldStatus      ServerOrderField = "STATUS"
+	ServerOrderFieldIPV4Address ServerOrderField = "IPV4_ADDRESS"
+	ServerOrderFieldCreatedAt   ServerOrderField = "CREATED_AT"
+)
+
+var AllServerOrderField = []ServerOrderField{
+	ServerOrderFieldName,
+	ServerOrderFieldStatus,
+	ServerOrderFieldIPV4Address,
+	ServerOrderFieldCreatedAt,
+}
+
+func (e ServerOrderField) IsValid() bool {
+	switch e {
+	case ServerOrderFieldName, ServerOrderFieldStatus, ServerOrderFieldIPV4Address, ServerOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e ServerOrderField) String() string {
+	return string(e)
+}
+
+func (e *ServerOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServerOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServerOrderField", str)
+	}
+	return nil
+}
+
+func (e ServerOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ServerOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ServerOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ServerStatus string
 
 const (
@@ -128,6 +833,14 @@ const (
 	ServerStatusRunning         ServerStatus = "RUNNING"
 	ServerStatusStopped         ServerStatus = "STOPPED"
 	ServerStatusProvisionFailed ServerStatus = "PROVISION_FAILED"
+	ServerStatusStarting        ServerStatus = "STARTING"
+	ServerStatusStopping        ServerStatus = "STOPPING"
+	ServerStatusRebooting       ServerStatus = "REBOOTING"
+	ServerStatusDeleting        ServerStatus = "DELETING"
+	// Deleted but still restorable until purgeAt. The resources stay reserved.
+	ServerStatusDeletedPending ServerStatus = "DELETED_PENDING"
+	// A snapshot is being written back to the disk of the server.
+	ServerStatusRestoring ServerStatus = "RESTORING"
 )
 
 var AllServerStatus = []ServerStatus{
@@ -135,11 +848,17 @@ var AllServerStatus = []ServerStatus{
 	ServerStatusRunning,
 	ServerStatusStopped,
 	ServerStatusProvisionFailed,
+	ServerStatusStarting,
+	ServerStatusStopping,
+	ServerStatusRebooting,
+	ServerStatusDeleting,
+	ServerStatusDeletedPending,
+	ServerStatusRestoring,
 }
 
 func (e ServerStatus) IsValid() bool {
 	switch e {
-	case ServerStatusPending, ServerStatusRunning, ServerStatusStopped, ServerStatusProvisionFailed:
+	case ServerStatusPending, ServerStatusRunning, ServerStatusStopped, ServerStatusProvisionFailed, ServerStatusStarting, ServerStatusStopping, ServerStatusRebooting, ServerStatusDeleting, ServerStatusDeletedPending, ServerStatusRestoring:
 		return true
 	}
 	return false
@@ -179,3 +898,176 @@ func (e ServerStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusCreating  SnapshotStatus = "CREATING"
+	SnapshotStatusAvailable SnapshotStatus = "AVAILABLE"
+	SnapshotStatusFailed    SnapshotStatus = "FAILED"
+	SnapshotStatusRestoring SnapshotStatus = "RESTORING"
+	SnapshotStatusDeleting  SnapshotStatus = "DELETING"
+)
+
+var AllSnapshotStatus = []SnapshotStatus{
+	SnapshotStatusCreating,
+	SnapshotStatusAvailable,
+	SnapshotStatusFailed,
+	SnapshotStatusRestoring,
+	SnapshotStatusDeleting,
+}
+
+func (e SnapshotStatus) IsValid() bool {
+	switch e {
+	case SnapshotStatusCreating, SnapshotStatusAvailable, SnapshotStatusFailed, SnapshotStatusRestoring, SnapshotStatusDeleting:
+		return true
+	}
+	return false
+}
+
+func (e SnapshotStatus) String() string {
+	return string(e)
+}
+
+func (e *SnapshotStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SnapshotStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SnapshotStatus", str)
+	}
+	return nil
+}
+
+func (e SnapshotStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SnapshotStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SnapshotStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UsageMeter string
+
+const (
+	// Time in RUNNING status.
+	UsageMeterRunning UsageMeter = "RUNNING"
+	// Time a provisioned server holds its resources.
+	UsageMeterAllocated UsageMeter = "ALLOCATED"
+)
+
+var AllUsageMeter = []UsageMeter{
+	UsageMeterRunning,
+	UsageMeterAllocated,
+}
+
+func (e UsageMeter) IsValid() bool {
+	switch e {
+	case UsageMeterRunning, UsageMeterAllocated:
+		return true
+	}
+	return false
+}
+
+func (e UsageMeter) String() string {
+	return string(e)
+}
+
+func (e *UsageMeter) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UsageMeter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UsageMeter", str)
+	}
+	return nil
+}
+
+func (e UsageMeter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UsageMeter) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UsageMeter) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
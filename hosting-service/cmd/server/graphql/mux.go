@@ -1,29 +1,55 @@
 package graphql
 
 import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/go-chi/chi/v5"
+	"github.com/vektah/gqlparser/v2/ast"
 
 	"hosting-kit/auth"
+	"hosting-kit/logger"
 	"hosting-kit/mid"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
 )
 
 type HandlerConfig struct {
-	PlanBus    plan.ExtBusiness
-	ServerBus  server.ExtBusiness
-	AuthClient auth.Client
-	Prefix     string
+	PlanBus        plan.ExtBusiness
+	ServerBus      server.ExtBusiness
+	IdempotencyBus idempotency.ExtBusiness
+	SnapshotBus    snapshot.ExtBusiness
+	SSHKeyBus      sshkey.ExtBusiness
+	ScheduleBus    schedule.ExtBusiness
+	ProjectBus     project.ExtBusiness
+	BillingBus     billing.ExtBusiness
+	AuthClient     auth.Client
+	Log            *logger.Logger
+	Prefix         string
 }
 
 func RegisterRoutes(router *chi.Mux, cfg HandlerConfig) {
 	resolver := &Resolver{
-		PlanBus:   cfg.PlanBus,
-		ServerBus: cfg.ServerBus,
+		PlanBus:        cfg.PlanBus,
+		ServerBus:      cfg.ServerBus,
+		IdempotencyBus: cfg.IdempotencyBus,
+		SnapshotBus:    cfg.SnapshotBus,
+		SSHKeyBus:      cfg.SSHKeyBus,
+		ScheduleBus:    cfg.ScheduleBus,
+		ProjectBus:     cfg.ProjectBus,
+		BillingBus:     cfg.BillingBus,
+		Log:            cfg.Log,
 	}
 	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AroundOperations(limitScope)
 
 	url := cfg.Prefix + "/graphql"
 
@@ -32,3 +58,19 @@ func RegisterRoutes(router *chi.Mux, cfg HandlerConfig) {
 		r.Handle("/playground", playground.Handler("GraphQL Playground", url))
 	})
 }
+
+// limitScope rejects mutations sent with an API token that only allows
+// reading, the same way the REST API rejects everything but GET for it.
+func limitScope(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+		return next(ctx)
+	}
+
+	claims, err := auth.GetClaims(ctx)
+	if err == nil && !claims.Allows(auth.ScopeServers) {
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "%s", auth.ErrForbidden.Error()))
+	}
+
+	return next(ctx)
+}
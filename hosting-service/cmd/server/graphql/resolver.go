@@ -1,8 +1,19 @@
 package graphql
 
 import (
+	"context"
+	"hosting-kit/auth"
+	"hosting-kit/logger"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
+
+	"github.com/google/uuid"
 )
 
 // This file will not be regenerated automatically.
@@ -10,6 +21,55 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	PlanBus   plan.ExtBusiness
-	ServerBus server.ExtBusiness
+	PlanBus        plan.ExtBusiness
+	ServerBus      server.ExtBusiness
+	IdempotencyBus idempotency.ExtBusiness
+	SnapshotBus    snapshot.ExtBusiness
+	SSHKeyBus      sshkey.ExtBusiness
+	ScheduleBus    schedule.ExtBusiness
+	ProjectBus     project.ExtBusiness
+	BillingBus     billing.ExtBusiness
+	Log            *logger.Logger
+}
+
+// orderRequest and actionRequest identify a mutation behind an idempotency
+// key, so a replay with a different payload is rejected.
+type orderRequest struct {
+	Op        string      `json:"op"`
+	Name      string      `json:"name"`
+	PlanID    uuid.UUID   `json:"planId"`
+	ProjectID *uuid.UUID  `json:"projectId,omitempty"`
+	Region    string      `json:"region,omitempty"`
+	SSHKeyIDs []uuid.UUID `json:"sshKeyIds,omitempty"`
+}
+
+type actionRequest struct {
+	Op       string     `json:"op"`
+	ServerID uuid.UUID  `json:"serverId"`
+	Action   string     `json:"action"`
+	PlanID   *uuid.UUID `json:"planId,omitempty"`
+}
+
+// idempotent runs fn through the idempotency keys when the client sent one.
+func (r *Resolver) idempotent(ctx context.Context, userID uuid.UUID, key *string, fingerprint any, fn func(ctx context.Context) (server.Server, error)) (server.Server, error) {
+	if key == nil {
+		return fn(ctx)
+	}
+
+	return idempotency.Run(ctx, r.IdempotencyBus, userID, *key, fingerprint, fn)
+}
+
+// adminContext lets the admin fields act on servers of any user. Users who
+// are not administrators get auth.ErrForbidden.
+func adminContext(ctx context.Context) (context.Context, auth.Claims, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, auth.Claims{}, err
+	}
+
+	if !claims.IsAdmin {
+		return nil, auth.Claims{}, auth.ErrForbidden
+	}
+
+	return server.WithAdmin(ctx, claims), claims, nil
 }
@@ -10,8 +10,14 @@ import (
 	"fmt"
 	"hosting-kit/auth"
 	"hosting-kit/page"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
 
 	"github.com/google/uuid"
 )
@@ -27,12 +33,21 @@ func (r *mutationResolver) CreatePlan(ctx context.Context, input CreatePlanInput
 		return nil, auth.ErrForbidden
 	}
 
-	newPlan, err := r.PlanBus.Create(ctx, plan.CreatePlanParams{
+	params := plan.CreatePlanParams{
 		Name:     input.Name,
 		CPUCores: input.CPUCores,
 		RAMMB:    input.RAMMb,
 		DiskGB:   input.DiskGb,
-	})
+	}
+
+	if input.RunningHourlyPrice != nil {
+		params.RunningHourlyPrice = int64(*input.RunningHourlyPrice)
+	}
+	if input.AllocatedHourlyPrice != nil {
+		params.AllocatedHourlyPrice = int64(*input.AllocatedHourlyPrice)
+	}
+
+	newPlan, err := r.PlanBus.Create(ctx, params)
 
 	if err != nil {
 		if errors.Is(err, plan.ErrValidation) {
@@ -56,11 +71,33 @@ func (r *mutationResolver) OrderServer(ctx context.Context, input OrderServerInp
 		return nil, errors.New("invalid plan ID format")
 	}
 
-	newServer, err := r.ServerBus.Create(ctx, input.Name, planUUID, claims.UserID)
+	projectID, err := toProjectID(input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	sshKeyIDs, err := toSSHKeyIDs(input.SSHKeyIds)
+	if err != nil {
+		return nil, err
+	}
+
+	placement := toPlacement(input.Region)
+
+	fingerprint := orderRequest{Op: "order", Name: input.Name, PlanID: planUUID, ProjectID: projectID, Region: placement.Region, SSHKeyIDs: sshKeyIDs}
+
+	newServer, err := r.idempotent(ctx, claims.UserID, input.IdempotencyKey, fingerprint, func(ctx context.Context) (server.Server, error) {
+		return r.ServerBus.Create(ctx, input.Name, planUUID, projectID, placement, sshKeyIDs, claims.UserID)
+	})
 	if err != nil {
+		if errors.Is(err, idempotency.ErrValidation) || errors.Is(err, idempotency.ErrKeyReused) || errors.Is(err, idempotency.ErrInProgress) {
+			return nil, err
+		}
 		if errors.Is(err, plan.ErrPlanNotFound) {
 			return nil, err
 		}
+		if errors.Is(err, server.ErrQuotaExceeded) || errors.Is(err, server.ErrSagaConflict) || errors.Is(err, server.ErrAccessDenied) {
+			return nil, err
+		}
 		if errors.Is(err, server.ErrValidation) {
 			return nil, err
 		}
@@ -71,7 +108,7 @@ func (r *mutationResolver) OrderServer(ctx context.Context, input OrderServerInp
 }
 
 // ManageServer is the resolver for the manageServer field.
-func (r *mutationResolver) ManageServer(ctx context.Context, serverID string, action ServerAction) (*Server, error) {
+func (r *mutationResolver) ManageServer(ctx context.Context, serverID string, action ServerAction, planID *string, expectedVersion *int, idempotencyKey *string) (*Server, error) {
 	claims, err := auth.GetClaims(ctx)
 	if err != nil {
 		return nil, err
@@ -82,19 +119,48 @@ func (r *mutationResolver) ManageServer(ctx context.Context, serverID string, ac
 		return nil, errors.New("invalid server ID format")
 	}
 
-	var currentServer server.Server
+	if expectedVersion != nil {
+		ctx = server.WithExpectedVersion(ctx, *expectedVersion)
+	}
+
+	var planUUID *uuid.UUID
 
 	switch action {
-	case ServerActionStart:
-		currentServer, err = r.ServerBus.Start(ctx, serverUUID, claims.UserID)
-	case ServerActionStop:
-		currentServer, err = r.ServerBus.Stop(ctx, serverUUID, claims.UserID)
-	case ServerActionDelete:
-		currentServer, err = r.ServerBus.Delete(ctx, serverUUID, claims.UserID)
+	case ServerActionStart, ServerActionStop, ServerActionReboot, ServerActionRetryProvision, ServerActionDelete, ServerActionRestore:
+	case ServerActionResize:
+		if planID == nil {
+			return nil, errors.New("planId is required for RESIZE")
+		}
+		parsed, parseErr := uuid.Parse(*planID)
+		if parseErr != nil {
+			return nil, errors.New("invalid plan ID format")
+		}
+		planUUID = &parsed
 	default:
 		return nil, fmt.Errorf("unknown action: %s", action)
 	}
 
+	fingerprint := actionRequest{Op: "action", ServerID: serverUUID, Action: string(action), PlanID: planUUID}
+
+	currentServer, err := r.idempotent(ctx, claims.UserID, idempotencyKey, fingerprint, func(ctx context.Context) (server.Server, error) {
+		switch action {
+		case ServerActionStart:
+			return r.ServerBus.Start(ctx, serverUUID, claims.UserID)
+		case ServerActionStop:
+			return r.ServerBus.Stop(ctx, serverUUID, claims.UserID)
+		case ServerActionReboot:
+			return r.ServerBus.Reboot(ctx, serverUUID, claims.UserID)
+		case ServerActionRetryProvision:
+			return r.ServerBus.RetryProvision(ctx, serverUUID, claims.UserID)
+		case ServerActionDelete:
+			return r.ServerBus.Delete(ctx, serverUUID, claims.UserID)
+		case ServerActionRestore:
+			return r.ServerBus.Restore(ctx, serverUUID, claims.UserID)
+		default:
+			return r.ServerBus.Resize(ctx, serverUUID, *planUUID, claims.UserID)
+		}
+	})
+
 	if err != nil {
 		if errors.Is(err, server.ErrServerNotFound) {
 			return nil, err
@@ -102,96 +168,1119 @@ func (r *mutationResolver) ManageServer(ctx context.Context, serverID string, ac
 		if errors.Is(err, server.ErrValidation) {
 			return nil, err
 		}
+		if errors.Is(err, server.ErrInvalidPlan) || errors.Is(err, server.ErrNoResources) || errors.Is(err, server.ErrQuotaExceeded) {
+			return nil, err
+		}
+		if errors.Is(err, server.ErrConflict) || errors.Is(err, server.ErrSagaConflict) || errors.Is(err, server.ErrAccessDenied) {
+			return nil, err
+		}
+		if errors.Is(err, idempotency.ErrValidation) || errors.Is(err, idempotency.ErrKeyReused) || errors.Is(err, idempotency.ErrInProgress) {
+			return nil, err
+		}
 		return nil, errors.New("internal server error")
 	}
 
 	return toServer(currentServer), nil
 }
 
-// Plans is the resolver for the plans field.
-func (r *queryResolver) Plans(ctx context.Context, pg int, ps int) (*PlanCollection, error) {
-	parsedPage := page.Parse(pg, ps)
-	plans, count, err := r.PlanBus.Search(ctx, parsedPage)
+// ManageServers is the resolver for the manageServers field.
+func (r *mutationResolver) ManageServers(ctx context.Context, serverIds []string, filter *ServerFilter, action ServerAction, planID *string) ([]*ServerActionResult, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var target server.BulkTarget
+
+	target.ServerIDs, err = toServerIDs(serverIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter != nil {
+		queryFilter, err := toQueryFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		target.Filter = &queryFilter
+	}
+
+	var planUUID *uuid.UUID
+	if planID != nil {
+		parsed, err := uuid.Parse(*planID)
+		if err != nil {
+			return nil, errors.New("invalid plan ID format")
+		}
+		planUUID = &parsed
+	}
+
+	results, err := r.ServerBus.BulkAction(ctx, target, server.ActionType(action), planUUID, claims.UserID)
 	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return nil, err
+		}
 		return nil, errors.New("internal server error")
 	}
 
-	return toPlanCollection(plans, parsedPage, count), nil
+	for _, res := range results {
+		if res.Err != nil && toActionError(res.Err).Code == ServerActionErrorCodeInternal {
+			r.Log.Error(ctx, "bulk server action", "server_id", res.ServerID, "action", action, "err", res.Err)
+		}
+	}
+
+	return toActionResults(results), nil
 }
 
-// Servers is the resolver for the servers field.
-func (r *queryResolver) Servers(ctx context.Context, pg int, ps int) (*ServerCollection, error) {
+// AdminManageServer is the resolver for the adminManageServer field.
+func (r *mutationResolver) AdminManageServer(ctx context.Context, serverID string, action AdminServerAction, expectedVersion *int) (*Server, error) {
+	ctx, claims, err := adminContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUUID, err := uuid.Parse(serverID)
+	if err != nil {
+		return nil, errors.New("invalid server ID format")
+	}
+
+	if expectedVersion != nil {
+		ctx = server.WithExpectedVersion(ctx, *expectedVersion)
+	}
+
+	var result server.Server
+
+	switch action {
+	case AdminServerActionForceStop:
+		result, err = r.ServerBus.ForceStop(ctx, serverUUID)
+	case AdminServerActionForceDelete:
+		result, err = r.ServerBus.ForceDelete(ctx, serverUUID)
+	case AdminServerActionResetProvision:
+		result, err = r.ServerBus.ResetProvision(ctx, serverUUID)
+	default:
+		return nil, fmt.Errorf("unknown action: %s", action)
+	}
+
+	r.Log.Info(ctx, "admin server action", "admin_id", claims.UserID, "server_id", serverUUID, "action", action, "ok", err == nil)
+
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrValidation) || errors.Is(err, server.ErrConflict) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServer(result), nil
+}
+
+// CreateSnapshot is the resolver for the createSnapshot field.
+func (r *mutationResolver) CreateSnapshot(ctx context.Context, serverID string, name string) (*Snapshot, error) {
 	claims, err := auth.GetClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	parsedPage := page.Parse(pg, ps)
-	servers, count, err := r.ServerBus.Search(ctx, parsedPage, claims.UserID)
+	serverUUID, err := uuid.Parse(serverID)
+	if err != nil {
+		return nil, errors.New("invalid server ID format")
+	}
+
+	snap, err := r.SnapshotBus.Create(ctx, serverUUID, name, claims.UserID)
 	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
+			return nil, server.ErrServerNotFound
+		}
+		if errors.Is(err, snapshot.ErrValidation) || errors.Is(err, server.ErrNoResources) {
+			return nil, err
+		}
 		return nil, errors.New("internal server error")
 	}
 
-	return toServerCollection(servers, parsedPage, count), nil
+	return toSnapshot(snap), nil
 }
 
-// Plan is the resolver for the plan field.
-func (r *queryResolver) Plan(ctx context.Context, id string) (*Plan, error) {
-	planUUID, err := uuid.Parse(id)
+// RestoreSnapshot is the resolver for the restoreSnapshot field.
+func (r *mutationResolver) RestoreSnapshot(ctx context.Context, snapshotID string) (*Snapshot, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotID)
+	if err != nil {
+		return nil, errors.New("invalid snapshot ID format")
+	}
+
+	snap, err := r.SnapshotBus.Restore(ctx, snapshotUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		if errors.Is(err, snapshot.ErrValidation) || errors.Is(err, server.ErrValidation) || errors.Is(err, server.ErrConflict) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSnapshot(snap), nil
+}
+
+// DeleteSnapshot is the resolver for the deleteSnapshot field.
+func (r *mutationResolver) DeleteSnapshot(ctx context.Context, snapshotID string) (*Snapshot, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotID)
+	if err != nil {
+		return nil, errors.New("invalid snapshot ID format")
+	}
+
+	snap, err := r.SnapshotBus.Delete(ctx, snapshotUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		if errors.Is(err, snapshot.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSnapshot(snap), nil
+}
+
+// CreateServerFromSnapshot is the resolver for the createServerFromSnapshot field.
+func (r *mutationResolver) CreateServerFromSnapshot(ctx context.Context, snapshotID string, name string, planID string, sshKeyIds []string, projectID *string, region *string) (*Server, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotID)
+	if err != nil {
+		return nil, errors.New("invalid snapshot ID format")
+	}
+
+	planUUID, err := uuid.Parse(planID)
 	if err != nil {
 		return nil, errors.New("invalid plan ID format")
 	}
 
-	newPlan, err := r.PlanBus.FindByID(ctx, planUUID)
+	projectUUID, err := toProjectID(projectID)
 	if err != nil {
-		if errors.Is(err, plan.ErrPlanNotFound) {
+		return nil, err
+	}
+
+	sshKeyIDs, err := toSSHKeyIDs(sshKeyIds)
+	if err != nil {
+		return nil, err
+	}
+
+	newServer, err := r.SnapshotBus.CreateServer(ctx, snapshotUUID, name, planUUID, projectUUID, toPlacement(region), sshKeyIDs, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		if errors.Is(err, snapshot.ErrValidation) || errors.Is(err, plan.ErrPlanNotFound) {
+			return nil, err
+		}
+		if errors.Is(err, server.ErrValidation) || errors.Is(err, server.ErrInvalidPlan) || errors.Is(err, server.ErrNoResources) || errors.Is(err, server.ErrQuotaExceeded) || errors.Is(err, server.ErrSagaConflict) || errors.Is(err, server.ErrAccessDenied) {
 			return nil, err
 		}
 		return nil, errors.New("internal server error")
 	}
 
-	return toPlan(newPlan), nil
+	return toServer(newServer), nil
 }
 
-// Server is the resolver for the server field.
-func (r *queryResolver) Server(ctx context.Context, id string) (*Server, error) {
+// AddSSHKey is the resolver for the addSSHKey field.
+func (r *mutationResolver) AddSSHKey(ctx context.Context, name string, publicKey string) (*SSHKey, error) {
 	claims, err := auth.GetClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	serverUUID, err := uuid.Parse(id)
+	key, err := r.SSHKeyBus.Create(ctx, name, publicKey, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sshkey.ErrValidation) || errors.Is(err, sshkey.ErrDuplicate) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSSHKey(key), nil
+}
+
+// DeleteSSHKey is the resolver for the deleteSSHKey field.
+func (r *mutationResolver) DeleteSSHKey(ctx context.Context, id string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	keyUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, errors.New("invalid ssh key ID format")
+	}
+
+	if err := r.SSHKeyBus.Delete(ctx, keyUUID, claims.UserID); err != nil {
+		if errors.Is(err, sshkey.ErrKeyNotFound) || errors.Is(err, sshkey.ErrAccessDenied) {
+			return false, sshkey.ErrKeyNotFound
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// CreateSchedule is the resolver for the createSchedule field.
+func (r *mutationResolver) CreateSchedule(ctx context.Context, input CreateScheduleInput) (*Schedule, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUUID, err := uuid.Parse(input.ServerID)
 	if err != nil {
 		return nil, errors.New("invalid server ID format")
 	}
 
-	newServer, err := r.ServerBus.FindByID(ctx, serverUUID, claims.UserID)
+	ns, err := toBusNewSchedule(input)
 	if err != nil {
-		if errors.Is(err, server.ErrServerNotFound) {
+		return nil, err
+	}
+
+	sched, err := r.ScheduleBus.Create(ctx, serverUUID, ns, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
+			return nil, server.ErrServerNotFound
+		}
+		if errors.Is(err, schedule.ErrValidation) {
 			return nil, err
 		}
 		return nil, errors.New("internal server error")
 	}
 
-	return toServer(newServer), nil
+	return toSchedule(sched), nil
 }
 
-// Plan is the resolver for the plan field.
-func (r *serverResolver) Plan(ctx context.Context, obj *Server) (*Plan, error) {
-	planUUID, err := uuid.Parse(obj.PlanID)
+// UpdateSchedule is the resolver for the updateSchedule field.
+func (r *mutationResolver) UpdateSchedule(ctx context.Context, id string, input UpdateScheduleInput) (*Schedule, error) {
+	claims, err := auth.GetClaims(ctx)
 	if err != nil {
-		return nil, errors.New("invalid plan ID format")
+		return nil, err
 	}
 
-	newPlan, err := r.PlanBus.FindByID(ctx, planUUID)
+	scheduleUUID, err := uuid.Parse(id)
 	if err != nil {
-		if errors.Is(err, plan.ErrPlanNotFound) {
-			return nil, nil
+		return nil, errors.New("invalid schedule ID format")
+	}
+
+	us, err := toBusUpdateSchedule(input)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := r.ScheduleBus.Update(ctx, scheduleUUID, us, claims.UserID)
+	if err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return nil, schedule.ErrScheduleNotFound
+		}
+		if errors.Is(err, schedule.ErrValidation) {
+			return nil, err
 		}
 		return nil, errors.New("internal server error")
 	}
 
-	return toPlan(newPlan), nil
+	return toSchedule(sched), nil
+}
+
+// DeleteSchedule is the resolver for the deleteSchedule field.
+func (r *mutationResolver) DeleteSchedule(ctx context.Context, id string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	scheduleUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, errors.New("invalid schedule ID format")
+	}
+
+	if err := r.ScheduleBus.Delete(ctx, scheduleUUID, claims.UserID); err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return false, schedule.ErrScheduleNotFound
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, name string) (*Project, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := r.ProjectBus.Create(ctx, name, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProject(p), nil
+}
+
+// RenameProject is the resolver for the renameProject field.
+func (r *mutationResolver) RenameProject(ctx context.Context, id string, name string) (*Project, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	p, err := r.ProjectBus.Rename(ctx, projectUUID, name, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProject(p), nil
+}
+
+// DeleteProject is the resolver for the deleteProject field.
+func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	projectUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, errors.New("invalid project ID format")
+	}
+
+	if err := r.ProjectBus.Delete(ctx, projectUUID, claims.UserID); err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return false, project.ErrProjectNotFound
+		}
+		if errors.Is(err, project.ErrValidation) || errors.Is(err, project.ErrProjectNotEmpty) {
+			return false, err
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// SetProjectMemberRole is the resolver for the setProjectMemberRole field.
+func (r *mutationResolver) SetProjectMemberRole(ctx context.Context, projectID string, userID string, role ProjectRole) (*ProjectMember, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID format")
+	}
+
+	member, err := r.ProjectBus.SetRole(ctx, projectUUID, userUUID, project.Role(role), claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		if errors.Is(err, project.ErrNotMember) {
+			return nil, project.ErrNotMember
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProjectMember(member), nil
+}
+
+// RemoveProjectMember is the resolver for the removeProjectMember field.
+func (r *mutationResolver) RemoveProjectMember(ctx context.Context, projectID string, userID string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return false, errors.New("invalid project ID format")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return false, errors.New("invalid user ID format")
+	}
+
+	if err := r.ProjectBus.RemoveMember(ctx, projectUUID, userUUID, claims.UserID); err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return false, project.ErrProjectNotFound
+		}
+		if errors.Is(err, project.ErrNotMember) {
+			return false, project.ErrNotMember
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return false, err
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// InviteToProject is the resolver for the inviteToProject field.
+func (r *mutationResolver) InviteToProject(ctx context.Context, projectID string, userID string, role ProjectRole) (*Invitation, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID format")
+	}
+
+	inv, err := r.ProjectBus.Invite(ctx, projectUUID, userUUID, project.Role(role), claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		if errors.Is(err, project.ErrValidation) || errors.Is(err, project.ErrDuplicate) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toInvitation(inv), nil
+}
+
+// AcceptInvitation is the resolver for the acceptInvitation field.
+func (r *mutationResolver) AcceptInvitation(ctx context.Context, id string) (*ProjectMember, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid invitation ID format")
+	}
+
+	member, err := r.ProjectBus.AcceptInvitation(ctx, invitationUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrInvitationNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrInvitationNotFound
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProjectMember(member), nil
+}
+
+// DeclineInvitation is the resolver for the declineInvitation field.
+func (r *mutationResolver) DeclineInvitation(ctx context.Context, id string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	invitationUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, errors.New("invalid invitation ID format")
+	}
+
+	if err := r.ProjectBus.DeclineInvitation(ctx, invitationUUID, claims.UserID); err != nil {
+		if errors.Is(err, project.ErrInvitationNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return false, project.ErrInvitationNotFound
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return false, err
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// RevokeInvitation is the resolver for the revokeInvitation field.
+func (r *mutationResolver) RevokeInvitation(ctx context.Context, id string) (bool, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	invitationUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, errors.New("invalid invitation ID format")
+	}
+
+	if err := r.ProjectBus.RevokeInvitation(ctx, invitationUUID, claims.UserID); err != nil {
+		if errors.Is(err, project.ErrInvitationNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return false, project.ErrInvitationNotFound
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return false, err
+		}
+		return false, errors.New("internal server error")
+	}
+
+	return true, nil
+}
+
+// Plans is the resolver for the plans field.
+func (r *queryResolver) Plans(ctx context.Context, pg int, ps int) (*PlanCollection, error) {
+	parsedPage := page.Parse(pg, ps)
+	plans, count, err := r.PlanBus.Search(ctx, parsedPage)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toPlanCollection(plans, parsedPage, count), nil
+}
+
+// PlansConnection is the resolver for the plansConnection field.
+func (r *queryResolver) PlansConnection(ctx context.Context, first *int, after *string, last *int, before *string) (*PlanConnection, error) {
+	cur, err := toCursor(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	edges, doc, err := r.PlanBus.SearchByCursor(ctx, cur)
+	if err != nil {
+		if errors.Is(err, page.ErrInvalidCursor) {
+			return nil, page.ErrInvalidCursor
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toPlanConnection(edges, doc), nil
+}
+
+// Servers is the resolver for the servers field.
+func (r *queryResolver) Servers(ctx context.Context, pg int, ps int, filter *ServerFilter, orderBy *ServerOrder) (*ServerCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryFilter, err := toQueryFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := toOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	servers, count, err := r.ServerBus.Search(ctx, queryFilter, order, parsedPage, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServerCollection(servers, parsedPage, count), nil
+}
+
+// ServersConnection is the resolver for the serversConnection field.
+func (r *queryResolver) ServersConnection(ctx context.Context, first *int, after *string, last *int, before *string, filter *ServerFilter, orderBy *ServerOrder) (*ServerConnection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cur, err := toCursor(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	queryFilter, err := toQueryFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := toOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	edges, doc, err := r.ServerBus.SearchByCursor(ctx, queryFilter, order, cur, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) || errors.Is(err, page.ErrInvalidCursor) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServerConnection(edges, doc), nil
+}
+
+// Plan is the resolver for the plan field.
+func (r *queryResolver) Plan(ctx context.Context, id string) (*Plan, error) {
+	planUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid plan ID format")
+	}
+
+	newPlan, err := r.PlanBus.FindByID(ctx, planUUID)
+	if err != nil {
+		if errors.Is(err, plan.ErrPlanNotFound) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toPlan(newPlan), nil
+}
+
+// Server is the resolver for the server field.
+func (r *queryResolver) Server(ctx context.Context, id string) (*Server, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid server ID format")
+	}
+
+	newServer, err := r.ServerBus.FindByID(ctx, serverUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServer(newServer), nil
+}
+
+// AdminServers is the resolver for the adminServers field.
+func (r *queryResolver) AdminServers(ctx context.Context, pg int, ps int, filter *ServerFilter, orderBy *ServerOrder) (*ServerCollection, error) {
+	ctx, claims, err := adminContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryFilter, err := toQueryFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := toOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	servers, count, err := r.ServerBus.Search(ctx, queryFilter, order, parsedPage, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServerCollection(servers, parsedPage, count), nil
+}
+
+// AdminServer is the resolver for the adminServer field.
+func (r *queryResolver) AdminServer(ctx context.Context, id string) (*Server, error) {
+	ctx, claims, err := adminContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid server ID format")
+	}
+
+	found, err := r.ServerBus.FindByID(ctx, serverUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServer(found), nil
+}
+
+// Snapshots is the resolver for the snapshots field.
+func (r *queryResolver) Snapshots(ctx context.Context, serverID *string, pg int, ps int) (*SnapshotCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter snapshot.QueryFilter
+	if serverID != nil {
+		serverUUID, err := uuid.Parse(*serverID)
+		if err != nil {
+			return nil, errors.New("invalid server ID format")
+		}
+		filter.ServerID = &serverUUID
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	snaps, count, err := r.SnapshotBus.Search(ctx, filter, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toSnapshotCollection(snaps, parsedPage, count), nil
+}
+
+// Snapshot is the resolver for the snapshot field.
+func (r *queryResolver) Snapshot(ctx context.Context, id string) (*Snapshot, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid snapshot ID format")
+	}
+
+	snap, err := r.SnapshotBus.FindByID(ctx, snapshotUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSnapshot(snap), nil
+}
+
+// SSHKeys is the resolver for the sshKeys field.
+func (r *queryResolver) SSHKeys(ctx context.Context, pg int, ps int) (*SSHKeyCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	keys, count, err := r.SSHKeyBus.Search(ctx, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toSSHKeyCollection(keys, parsedPage, count), nil
+}
+
+// SSHKey is the resolver for the sshKey field.
+func (r *queryResolver) SSHKey(ctx context.Context, id string) (*SSHKey, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid ssh key ID format")
+	}
+
+	key, err := r.SSHKeyBus.FindByID(ctx, keyUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sshkey.ErrKeyNotFound) || errors.Is(err, sshkey.ErrAccessDenied) {
+			return nil, sshkey.ErrKeyNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSSHKey(key), nil
+}
+
+// Schedules is the resolver for the schedules field.
+func (r *queryResolver) Schedules(ctx context.Context, serverID *string, pg int, ps int) (*ScheduleCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter schedule.QueryFilter
+	if serverID != nil {
+		serverUUID, err := uuid.Parse(*serverID)
+		if err != nil {
+			return nil, errors.New("invalid server ID format")
+		}
+		filter.ServerID = &serverUUID
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	schedules, count, err := r.ScheduleBus.Search(ctx, filter, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toScheduleCollection(schedules, parsedPage, count), nil
+}
+
+// Schedule is the resolver for the schedule field.
+func (r *queryResolver) Schedule(ctx context.Context, id string) (*Schedule, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid schedule ID format")
+	}
+
+	sched, err := r.ScheduleBus.FindByID(ctx, scheduleUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return nil, schedule.ErrScheduleNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toSchedule(sched), nil
+}
+
+// Usage is the resolver for the usage field.
+func (r *queryResolver) Usage(ctx context.Context) (*UsageStatement, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := r.BillingBus.Usage(ctx, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toUsageStatement(stmt), nil
+}
+
+// Invoices is the resolver for the invoices field.
+func (r *queryResolver) Invoices(ctx context.Context, pg int, ps int) (*InvoiceCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	invoices, count, err := r.BillingBus.SearchInvoices(ctx, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toInvoiceCollection(invoices, parsedPage, count), nil
+}
+
+// Invoice is the resolver for the invoice field.
+func (r *queryResolver) Invoice(ctx context.Context, id string) (*Invoice, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid invoice ID format")
+	}
+
+	invoice, err := r.BillingBus.FindInvoiceByID(ctx, invoiceUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, billing.ErrInvoiceNotFound) || errors.Is(err, billing.ErrAccessDenied) {
+			return nil, billing.ErrInvoiceNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toInvoice(invoice), nil
+}
+
+// Projects is the resolver for the projects field.
+func (r *queryResolver) Projects(ctx context.Context, pg int, ps int) (*ProjectCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	projects, count, err := r.ProjectBus.Search(ctx, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toProjectCollection(projects, parsedPage, count), nil
+}
+
+// Project is the resolver for the project field.
+func (r *queryResolver) Project(ctx context.Context, id string) (*Project, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	p, err := r.ProjectBus.FindByID(ctx, projectUUID, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProject(p), nil
+}
+
+// ProjectMembers is the resolver for the projectMembers field.
+func (r *queryResolver) ProjectMembers(ctx context.Context, projectID string, pg int, ps int) (*ProjectMemberCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	members, count, err := r.ProjectBus.Members(ctx, projectUUID, parsedPage, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toProjectMemberCollection(members, parsedPage, count), nil
+}
+
+// ProjectInvitations is the resolver for the projectInvitations field.
+func (r *queryResolver) ProjectInvitations(ctx context.Context, projectID string, pg int, ps int) (*InvitationCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, errors.New("invalid project ID format")
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	invs, count, err := r.ProjectBus.ProjectInvitations(ctx, projectUUID, parsedPage, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied) {
+			return nil, project.ErrProjectNotFound
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toInvitationCollection(invs, parsedPage, count), nil
+}
+
+// Invitations is the resolver for the invitations field.
+func (r *queryResolver) Invitations(ctx context.Context, pg int, ps int) (*InvitationCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPage := page.Parse(pg, ps)
+	invs, count, err := r.ProjectBus.Invitations(ctx, parsedPage, claims.UserID)
+	if err != nil {
+		return nil, errors.New("internal server error")
+	}
+
+	return toInvitationCollection(invs, parsedPage, count), nil
+}
+
+// Plan is the resolver for the plan field.
+func (r *serverResolver) Plan(ctx context.Context, obj *Server) (*Plan, error) {
+	planUUID, err := uuid.Parse(obj.PlanID)
+	if err != nil {
+		return nil, errors.New("invalid plan ID format")
+	}
+
+	newPlan, err := r.PlanBus.FindByID(ctx, planUUID)
+	if err != nil {
+		if errors.Is(err, plan.ErrPlanNotFound) {
+			return nil, nil
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toPlan(newPlan), nil
+}
+
+// History is the resolver for the history field.
+func (r *serverResolver) History(ctx context.Context, obj *Server, pg int, ps int) (*ServerEventCollection, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUUID, err := uuid.Parse(obj.ID)
+	if err != nil {
+		return nil, errors.New("invalid server ID format")
+	}
+
+	// The parent server may come from an admin field, whose context does not
+	// reach this resolver, so administrators read any history.
+	ctx = server.WithAdmin(ctx, claims)
+
+	parsedPage := page.Parse(pg, ps)
+	events, count, err := r.ServerBus.History(ctx, serverUUID, parsedPage, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return nil, err
+		}
+		return nil, errors.New("internal server error")
+	}
+
+	return toServerEventCollection(events, parsedPage, count), nil
 }
 
 // Mutation returns MutationResolver implementation.
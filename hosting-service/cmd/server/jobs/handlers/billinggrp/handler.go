@@ -0,0 +1,34 @@
+package billinggrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/billing"
+)
+
+type handlers struct {
+	billingBus billing.ExtBusiness
+	batchSize  int
+	log        *logger.Logger
+}
+
+func new(billingBus billing.ExtBusiness, batchSize int, log *logger.Logger) *handlers {
+	return &handlers{
+		billingBus: billingBus,
+		batchSize:  batchSize,
+		log:        log,
+	}
+}
+
+func (h *handlers) GenerateInvoices(ctx context.Context) error {
+	created, err := h.billingBus.GenerateInvoices(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if created > 0 {
+		h.log.Info(ctx, "invoices generated", "count", created)
+	}
+
+	return nil
+}
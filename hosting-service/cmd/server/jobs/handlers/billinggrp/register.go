@@ -0,0 +1,28 @@
+package billinggrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/billing"
+	"time"
+)
+
+type Config struct {
+	BillingBus billing.ExtBusiness
+	Interval   time.Duration
+	BatchSize  int
+	Log        *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.BillingBus, cfg.BatchSize, cfg.Log)
+
+	const name = "billing.invoices"
+
+	wrappedJob := worker.LogErrors(func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}, name, handlers.GenerateInvoices)
+
+	manager.Every(name, cfg.Interval, wrappedJob)
+}
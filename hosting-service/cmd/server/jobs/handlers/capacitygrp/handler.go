@@ -0,0 +1,40 @@
+package capacitygrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/capacity"
+)
+
+type handlers struct {
+	capacityBus capacity.ExtBusiness
+	repair      bool
+	log         *logger.Logger
+}
+
+func new(capacityBus capacity.ExtBusiness, repair bool, log *logger.Logger) *handlers {
+	return &handlers{
+		capacityBus: capacityBus,
+		repair:      repair,
+		log:         log,
+	}
+}
+
+func (h *handlers) Reconcile(ctx context.Context) error {
+	report, err := h.capacityBus.Reconcile(ctx, h.repair)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range report.Pools {
+		if p.Repaired {
+			h.log.Info(ctx, "pool repaired", "pool_id", p.PoolID)
+			continue
+		}
+		if p.Drifting() {
+			h.log.Warn(ctx, "pool drifting", "pool_id", p.PoolID, "known", p.Known, "drift", p.Drift, "note", p.Note)
+		}
+	}
+
+	return nil
+}
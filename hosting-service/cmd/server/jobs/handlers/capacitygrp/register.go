@@ -0,0 +1,28 @@
+package capacitygrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/capacity"
+	"time"
+)
+
+type Config struct {
+	CapacityBus capacity.ExtBusiness
+	Interval    time.Duration
+	Repair      bool
+	Log         *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.CapacityBus, cfg.Repair, cfg.Log)
+
+	const name = "capacity.reconcile"
+
+	wrappedJob := worker.LogErrors(func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}, name, handlers.Reconcile)
+
+	manager.Every(name, cfg.Interval, wrappedJob)
+}
@@ -0,0 +1,34 @@
+package idempotencygrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/idempotency"
+)
+
+type handlers struct {
+	idempotencyBus idempotency.ExtBusiness
+	batchSize      int
+	log            *logger.Logger
+}
+
+func new(idempotencyBus idempotency.ExtBusiness, batchSize int, log *logger.Logger) *handlers {
+	return &handlers{
+		idempotencyBus: idempotencyBus,
+		batchSize:      batchSize,
+		log:            log,
+	}
+}
+
+func (h *handlers) Purge(ctx context.Context) error {
+	purged, err := h.idempotencyBus.Purge(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		h.log.Info(ctx, "expired idempotency keys purged", "count", purged)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package idempotencygrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/idempotency"
+	"time"
+)
+
+type Config struct {
+	IdempotencyBus idempotency.ExtBusiness
+	Interval       time.Duration
+	BatchSize      int
+	Log            *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.IdempotencyBus, cfg.BatchSize, cfg.Log)
+
+	const name = "idempotency.purge"
+
+	wrappedJob := worker.LogErrors(func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}, name, handlers.Purge)
+
+	manager.Every(name, cfg.Interval, wrappedJob)
+}
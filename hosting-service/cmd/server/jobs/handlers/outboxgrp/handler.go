@@ -0,0 +1,34 @@
+package outboxgrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/outbox"
+)
+
+type handlers struct {
+	outboxBus outbox.ExtBusiness
+	batchSize int
+	log       *logger.Logger
+}
+
+func new(outboxBus outbox.ExtBusiness, batchSize int, log *logger.Logger) *handlers {
+	return &handlers{
+		outboxBus: outboxBus,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+func (h *handlers) Relay(ctx context.Context) error {
+	sent, err := h.outboxBus.Relay(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if sent > 0 {
+		h.log.Info(ctx, "outbox messages relayed", "count", sent)
+	}
+
+	return nil
+}
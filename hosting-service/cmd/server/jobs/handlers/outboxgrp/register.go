@@ -0,0 +1,28 @@
+package outboxgrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/outbox"
+	"time"
+)
+
+type Config struct {
+	OutboxBus outbox.ExtBusiness
+	Interval  time.Duration
+	BatchSize int
+	Log       *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.OutboxBus, cfg.BatchSize, cfg.Log)
+
+	const name = "outbox.relay"
+
+	wrappedJob := worker.LogErrors(func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}, name, handlers.Relay)
+
+	manager.Every(name, cfg.Interval, wrappedJob)
+}
@@ -0,0 +1,34 @@
+package schedulegrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/schedule"
+)
+
+type handlers struct {
+	scheduleBus schedule.ExtBusiness
+	batchSize   int
+	log         *logger.Logger
+}
+
+func new(scheduleBus schedule.ExtBusiness, batchSize int, log *logger.Logger) *handlers {
+	return &handlers{
+		scheduleBus: scheduleBus,
+		batchSize:   batchSize,
+		log:         log,
+	}
+}
+
+func (h *handlers) RunDue(ctx context.Context) error {
+	ran, err := h.scheduleBus.RunDue(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if ran > 0 {
+		h.log.Info(ctx, "schedules run", "count", ran)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package schedulegrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/schedule"
+	"time"
+)
+
+type Config struct {
+	ScheduleBus schedule.ExtBusiness
+	Interval    time.Duration
+	BatchSize   int
+	Log         *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.ScheduleBus, cfg.BatchSize, cfg.Log)
+
+	const name = "schedule.run"
+
+	wrappedJob := worker.LogErrors(func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}, name, handlers.RunDue)
+
+	manager.Every(name, cfg.Interval, wrappedJob)
+}
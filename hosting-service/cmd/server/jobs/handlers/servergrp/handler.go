@@ -0,0 +1,64 @@
+package servergrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-service/internal/server"
+)
+
+type handlers struct {
+	serverBus      server.ExtBusiness
+	batchSize      int
+	purgeBatchSize int
+	reapBatchSize  int
+	log            *logger.Logger
+}
+
+func new(serverBus server.ExtBusiness, batchSize int, purgeBatchSize int, reapBatchSize int, log *logger.Logger) *handlers {
+	return &handlers{
+		serverBus:      serverBus,
+		batchSize:      batchSize,
+		purgeBatchSize: purgeBatchSize,
+		reapBatchSize:  reapBatchSize,
+		log:            log,
+	}
+}
+
+func (h *handlers) ResumeSagas(ctx context.Context) error {
+	finished, err := h.serverBus.ResumeSagas(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+
+	if finished > 0 {
+		h.log.Info(ctx, "server sagas resumed", "count", finished)
+	}
+
+	return nil
+}
+
+func (h *handlers) PurgeDeleted(ctx context.Context) error {
+	purged, err := h.serverBus.PurgeDeleted(ctx, h.purgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		h.log.Info(ctx, "deleted servers purged", "count", purged)
+	}
+
+	return nil
+}
+
+func (h *handlers) ReapPending(ctx context.Context) error {
+	reaped, err := h.serverBus.ReapPending(ctx, h.reapBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if reaped > 0 {
+		h.log.Info(ctx, "stuck pending servers reaped", "count", reaped)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package servergrp
+
+import (
+	"context"
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/internal/server"
+	"time"
+)
+
+type Config struct {
+	ServerBus      server.ExtBusiness
+	Interval       time.Duration
+	BatchSize      int
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+	ReapInterval   time.Duration
+	ReapBatchSize  int
+	Log            *logger.Logger
+}
+
+func Register(manager *worker.Manager, cfg Config) {
+	handlers := new(cfg.ServerBus, cfg.BatchSize, cfg.PurgeBatchSize, cfg.ReapBatchSize, cfg.Log)
+
+	logErrors := func(ctx context.Context, err error, job string) {
+		cfg.Log.Error(ctx, "job failed", "error", err, "job", job)
+	}
+
+	const sagas = "server.sagas"
+	manager.Every(sagas, cfg.Interval, worker.LogErrors(logErrors, sagas, handlers.ResumeSagas))
+
+	const purge = "server.purge"
+	manager.Every(purge, cfg.PurgeInterval, worker.LogErrors(logErrors, purge, handlers.PurgeDeleted))
+
+	const reap = "server.reap"
+	manager.Every(reap, cfg.ReapInterval, worker.LogErrors(logErrors, reap, handlers.ReapPending))
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"hosting-kit/logger"
+	"hosting-kit/worker"
+	"hosting-service/cmd/server/jobs/handlers/billinggrp"
+	"hosting-service/cmd/server/jobs/handlers/capacitygrp"
+	"hosting-service/cmd/server/jobs/handlers/idempotencygrp"
+	"hosting-service/cmd/server/jobs/handlers/outboxgrp"
+	"hosting-service/cmd/server/jobs/handlers/schedulegrp"
+	"hosting-service/cmd/server/jobs/handlers/servergrp"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/idempotency"
+	"hosting-service/internal/outbox"
+	"hosting-service/internal/schedule"
+	"hosting-service/internal/server"
+	"time"
+)
+
+type Config struct {
+	OutboxBus        outbox.ExtBusiness
+	OutboxInterval   time.Duration
+	OutboxBatch      int
+	ServerBus        server.ExtBusiness
+	SagaInterval     time.Duration
+	SagaBatch        int
+	DeleteInterval   time.Duration
+	DeleteBatch      int
+	ReapInterval     time.Duration
+	ReapBatch        int
+	IdempotencyBus   idempotency.ExtBusiness
+	PurgeInterval    time.Duration
+	PurgeBatch       int
+	BillingBus       billing.ExtBusiness
+	InvoiceInterval  time.Duration
+	InvoiceBatch     int
+	CapacityBus      capacity.ExtBusiness
+	CheckInterval    time.Duration
+	RepairCapacity   bool
+	ScheduleBus      schedule.ExtBusiness
+	ScheduleInterval time.Duration
+	ScheduleBatch    int
+	Log              *logger.Logger
+}
+
+func RegisterAll(manager *worker.Manager, cfg Config) {
+	outboxgrp.Register(
+		manager,
+		outboxgrp.Config{
+			OutboxBus: cfg.OutboxBus,
+			Interval:  cfg.OutboxInterval,
+			BatchSize: cfg.OutboxBatch,
+			Log:       cfg.Log,
+		},
+	)
+
+	servergrp.Register(
+		manager,
+		servergrp.Config{
+			ServerBus:      cfg.ServerBus,
+			Interval:       cfg.SagaInterval,
+			BatchSize:      cfg.SagaBatch,
+			PurgeInterval:  cfg.DeleteInterval,
+			PurgeBatchSize: cfg.DeleteBatch,
+			ReapInterval:   cfg.ReapInterval,
+			ReapBatchSize:  cfg.ReapBatch,
+			Log:            cfg.Log,
+		},
+	)
+
+	idempotencygrp.Register(
+		manager,
+		idempotencygrp.Config{
+			IdempotencyBus: cfg.IdempotencyBus,
+			Interval:       cfg.PurgeInterval,
+			BatchSize:      cfg.PurgeBatch,
+			Log:            cfg.Log,
+		},
+	)
+
+	billinggrp.Register(
+		manager,
+		billinggrp.Config{
+			BillingBus: cfg.BillingBus,
+			Interval:   cfg.InvoiceInterval,
+			BatchSize:  cfg.InvoiceBatch,
+			Log:        cfg.Log,
+		},
+	)
+
+	capacitygrp.Register(
+		manager,
+		capacitygrp.Config{
+			CapacityBus: cfg.CapacityBus,
+			Interval:    cfg.CheckInterval,
+			Repair:      cfg.RepairCapacity,
+			Log:         cfg.Log,
+		},
+	)
+
+	schedulegrp.Register(
+		manager,
+		schedulegrp.Config{
+			ScheduleBus: cfg.ScheduleBus,
+			Interval:    cfg.ScheduleInterval,
+			BatchSize:   cfg.ScheduleBatch,
+			Log:         cfg.Log,
+		},
+	)
+}
@@ -33,20 +33,22 @@ func Register(manager *messaging.MessageManager, cfg Config) error {
 
 	wrappedHandler := messaging.LogErrors(func(ctx context.Context, err error, key string) {
 		cfg.Log.Error(ctx, "message processing failed", "error", err, "routing_key", key)
-	}, handlers.HandleProvisionResult)
-
-	err = manager.Subscribe(cfg.QueueName,
-		events.ProvisionResultKeyPattern,
-		topology.EventsExchange,
-		wrappedHandler,
-		&messaging.DLQConfig{
-			ExchangeName: topology.DLXExchange,
-			RoutingKey:   topology.GetDLQKey(cfg.QueueName),
-		},
-	)
-
-	if err != nil {
-		return fmt.Errorf("servergrp: subscribe provision failed: %w", err)
+	}, handlers.HandleResult)
+
+	for _, pattern := range []string{events.ProvisionResultKeyPattern, events.DeprovisionResultKeyPattern, events.PowerResultKeyPattern} {
+		err = manager.Subscribe(cfg.QueueName,
+			pattern,
+			topology.EventsExchange,
+			wrappedHandler,
+			&messaging.DLQConfig{
+				ExchangeName: topology.DLXExchange,
+				RoutingKey:   topology.GetDLQKey(cfg.QueueName),
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("servergrp: subscribe %s failed: %w", pattern, err)
+		}
 	}
 
 	return nil
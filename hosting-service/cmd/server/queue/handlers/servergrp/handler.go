@@ -23,12 +23,18 @@ func new(serverBus server.ExtBusiness, log *logger.Logger) *handlers {
 	}
 }
 
-func (h *handlers) HandleProvisionResult(ctx context.Context, body []byte, routingKey string) error {
+func (h *handlers) HandleResult(ctx context.Context, body []byte, routingKey string) error {
 	switch routingKey {
 	case events.ProvisionSucceededKey:
 		return h.handleSuccessProvision(ctx, body)
 	case events.ProvisionFailedKey:
 		return h.handleFailureProvision(ctx, body)
+	case events.DeprovisionSucceededKey:
+		return h.handleSuccessDeprovision(ctx, body)
+	case events.PowerSucceededKey:
+		return h.handleSuccessPower(ctx, body)
+	case events.PowerFailedKey:
+		return h.handleFailurePower(ctx, body)
 	default:
 		return fmt.Errorf("%w: unknown routing key: %s", messaging.ErrPermanentFailure, routingKey)
 	}
@@ -44,9 +50,10 @@ func (h *handlers) handleSuccessProvision(ctx context.Context, body []byte) erro
 	h.log.Info(ctx, "provisioning succeeded",
 		"server_id", event.ServerID,
 		"ip_address", event.IPv4Address,
+		"addresses", len(event.Addresses),
 	)
 
-	if err := h.serverBus.SetIPAddress(ctx, event.ServerID, event.IPv4Address); err != nil {
+	if err := h.serverBus.SetAddresses(ctx, event.ServerID, toBusAddresses(event)); err != nil {
 		if errors.Is(err, server.ErrServerNotFound) {
 			return fmt.Errorf("%w: server with ID: '%s' not found", messaging.ErrPermanentFailure, event.ServerID)
 		}
@@ -58,6 +65,29 @@ func (h *handlers) handleSuccessProvision(ctx context.Context, body []byte) erro
 	return nil
 }
 
+// toBusAddresses reads the addresses of the event. Publishers that predate
+// the address list only send the primary IPv4 address.
+func toBusAddresses(event events.ServerProvisionedEvent) []server.Address {
+	if len(event.Addresses) == 0 {
+		return []server.Address{{
+			Address: event.IPv4Address,
+			Family:  server.FamilyIPv4,
+			Primary: true,
+		}}
+	}
+
+	addrs := make([]server.Address, len(event.Addresses))
+	for i, a := range event.Addresses {
+		addrs[i] = server.Address{
+			Address:    a.Address,
+			Family:     server.AddressFamily(a.Family),
+			Primary:    a.Primary,
+			ReverseDNS: a.ReverseDNS,
+		}
+	}
+	return addrs
+}
+
 func (h *handlers) handleFailureProvision(ctx context.Context, body []byte) error {
 	var event events.ServerProvisionFailedEvent
 
@@ -70,10 +100,83 @@ func (h *handlers) handleFailureProvision(ctx context.Context, body []byte) erro
 		"reason", event.Reason,
 	)
 
-	if err := h.serverBus.SetProvisioningFailed(ctx, event.ServerID); err != nil {
+	if err := h.serverBus.SetProvisioningFailed(ctx, event.ServerID, event.Reason); err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return fmt.Errorf("%w: server with ID: '%s' not found", messaging.ErrPermanentFailure, event.ServerID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (h *handlers) handleSuccessDeprovision(ctx context.Context, body []byte) error {
+	var event events.ServerDeprovisionedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal ServerDeprovisionedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "deprovisioning succeeded",
+		"server_id", event.ServerID,
+	)
+
+	if err := h.serverBus.CompleteDeprovision(ctx, event.ServerID); err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return fmt.Errorf("%w: server with ID: '%s' not found", messaging.ErrPermanentFailure, event.ServerID)
+		}
+		if errors.Is(err, server.ErrValidation) {
+			return fmt.Errorf("%w: server with ID: '%s' has validation errors: %v", messaging.ErrPermanentFailure, event.ServerID, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *handlers) handleSuccessPower(ctx context.Context, body []byte) error {
+	var event events.ServerPowerSucceededEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal ServerPowerSucceededEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "power action succeeded",
+		"server_id", event.ServerID,
+		"action", event.Action,
+	)
+
+	if err := h.serverBus.CompletePowerAction(ctx, event.ServerID, server.ActionType(event.Action)); err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return fmt.Errorf("%w: server with ID: '%s' not found", messaging.ErrPermanentFailure, event.ServerID)
+		}
+		if errors.Is(err, server.ErrValidation) {
+			return fmt.Errorf("%w: server with ID: '%s' has validation errors: %v", messaging.ErrPermanentFailure, event.ServerID, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *handlers) handleFailurePower(ctx context.Context, body []byte) error {
+	var event events.ServerPowerFailedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal ServerPowerFailedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "power action failed reported",
+		"server_id", event.ServerID,
+		"action", event.Action,
+		"reason", event.Reason,
+	)
+
+	if err := h.serverBus.FailPowerAction(ctx, event.ServerID, server.ActionType(event.Action)); err != nil {
 		if errors.Is(err, server.ErrServerNotFound) {
 			return fmt.Errorf("%w: server with ID: '%s' not found", messaging.ErrPermanentFailure, event.ServerID)
 		}
+		if errors.Is(err, server.ErrValidation) {
+			return fmt.Errorf("%w: server with ID: '%s' has validation errors: %v", messaging.ErrPermanentFailure, event.ServerID, err)
+		}
 		return err
 	}
 
@@ -0,0 +1,55 @@
+package snapshotgrp
+
+import (
+	"context"
+	"fmt"
+	"hosting-contracts/provisioning-service/queue/events"
+	"hosting-contracts/topology"
+	"hosting-kit/logger"
+	"hosting-kit/messaging"
+	"hosting-service/internal/snapshot"
+)
+
+type Config struct {
+	SnapshotBus snapshot.ExtBusiness
+	QueueName   string
+	Log         *logger.Logger
+}
+
+func Register(manager *messaging.MessageManager, cfg Config) error {
+	handlers := new(cfg.SnapshotBus, cfg.Log)
+
+	err := manager.Subscribe(
+		topology.GetDLQQueueName(cfg.QueueName),
+		topology.GetDLQKey(cfg.QueueName),
+		topology.DLXExchange,
+		handlers.HandleDLQ,
+		nil,
+	)
+
+	if err != nil {
+		return fmt.Errorf("snapshotgrp: subscribe dlq failed: %w", err)
+	}
+
+	wrappedHandler := messaging.LogErrors(func(ctx context.Context, err error, key string) {
+		cfg.Log.Error(ctx, "message processing failed", "error", err, "routing_key", key)
+	}, handlers.HandleResult)
+
+	for _, pattern := range []string{events.SnapshotCreateResultKeyPattern, events.SnapshotRestoreResultKeyPattern, events.SnapshotDeleteResultKeyPattern} {
+		err = manager.Subscribe(cfg.QueueName,
+			pattern,
+			topology.EventsExchange,
+			wrappedHandler,
+			&messaging.DLQConfig{
+				ExchangeName: topology.DLXExchange,
+				RoutingKey:   topology.GetDLQKey(cfg.QueueName),
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("snapshotgrp: subscribe %s failed: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package snapshotgrp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hosting-contracts/provisioning-service/queue/events"
+	"hosting-kit/logger"
+	"hosting-kit/messaging"
+	"hosting-service/internal/snapshot"
+
+	"github.com/google/uuid"
+)
+
+type handlers struct {
+	snapshotBus snapshot.ExtBusiness
+	log         *logger.Logger
+}
+
+func new(snapshotBus snapshot.ExtBusiness, log *logger.Logger) *handlers {
+	return &handlers{
+		snapshotBus: snapshotBus,
+		log:         log,
+	}
+}
+
+func (h *handlers) HandleResult(ctx context.Context, body []byte, routingKey string) error {
+	switch routingKey {
+	case events.SnapshotCreatedKey:
+		return h.handleSuccessCreate(ctx, body)
+	case events.SnapshotCreateFailedKey:
+		return h.handleFailureCreate(ctx, body)
+	case events.SnapshotRestoredKey:
+		return h.handleSuccessRestore(ctx, body)
+	case events.SnapshotRestoreFailedKey:
+		return h.handleFailureRestore(ctx, body)
+	case events.SnapshotDeletedKey:
+		return h.handleSuccessDelete(ctx, body)
+	default:
+		return fmt.Errorf("%w: unknown routing key: %s", messaging.ErrPermanentFailure, routingKey)
+	}
+}
+
+func (h *handlers) handleSuccessCreate(ctx context.Context, body []byte) error {
+	var event events.SnapshotCreatedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal SnapshotCreatedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "snapshot creation succeeded",
+		"snapshot_id", event.SnapshotID,
+	)
+
+	return toPermanent(h.snapshotBus.CompleteCreate(ctx, event.SnapshotID), event.SnapshotID)
+}
+
+func (h *handlers) handleFailureCreate(ctx context.Context, body []byte) error {
+	var event events.SnapshotCreateFailedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal SnapshotCreateFailedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "snapshot creation failed reported",
+		"snapshot_id", event.SnapshotID,
+		"reason", event.Reason,
+	)
+
+	return toPermanent(h.snapshotBus.FailCreate(ctx, event.SnapshotID, event.Reason), event.SnapshotID)
+}
+
+func (h *handlers) handleSuccessRestore(ctx context.Context, body []byte) error {
+	var event events.SnapshotRestoredEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal SnapshotRestoredEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "snapshot restore succeeded",
+		"snapshot_id", event.SnapshotID,
+		"server_id", event.ServerID,
+	)
+
+	return toPermanent(h.snapshotBus.CompleteRestore(ctx, event.SnapshotID), event.SnapshotID)
+}
+
+func (h *handlers) handleFailureRestore(ctx context.Context, body []byte) error {
+	var event events.SnapshotRestoreFailedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal SnapshotRestoreFailedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "snapshot restore failed reported",
+		"snapshot_id", event.SnapshotID,
+		"server_id", event.ServerID,
+		"reason", event.Reason,
+	)
+
+	return toPermanent(h.snapshotBus.FailRestore(ctx, event.SnapshotID, event.Reason), event.SnapshotID)
+}
+
+func (h *handlers) handleSuccessDelete(ctx context.Context, body []byte) error {
+	var event events.SnapshotDeletedEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: unmarshal SnapshotDeletedEvent failed: %v", messaging.ErrPermanentFailure, err)
+	}
+
+	h.log.Info(ctx, "snapshot deletion succeeded",
+		"snapshot_id", event.SnapshotID,
+	)
+
+	return toPermanent(h.snapshotBus.CompleteDelete(ctx, event.SnapshotID), event.SnapshotID)
+}
+
+func toPermanent(err error, snapshotID uuid.UUID) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, snapshot.ErrSnapshotNotFound) {
+		return fmt.Errorf("%w: snapshot with ID: '%s' not found", messaging.ErrPermanentFailure, snapshotID)
+	}
+	if errors.Is(err, snapshot.ErrValidation) {
+		return fmt.Errorf("%w: snapshot with ID: '%s' has validation errors: %v", messaging.ErrPermanentFailure, snapshotID, err)
+	}
+	return err
+}
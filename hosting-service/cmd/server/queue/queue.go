@@ -4,13 +4,17 @@ import (
 	"hosting-kit/logger"
 	"hosting-kit/messaging"
 	"hosting-service/cmd/server/queue/handlers/servergrp"
+	"hosting-service/cmd/server/queue/handlers/snapshotgrp"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
 )
 
 type Config struct {
-	ServerBus server.ExtBusiness
-	QueueName string
-	Log       *logger.Logger
+	ServerBus         server.ExtBusiness
+	SnapshotBus       snapshot.ExtBusiness
+	QueueName         string
+	SnapshotQueueName string
+	Log               *logger.Logger
 }
 
 func RegisterAll(manager *messaging.MessageManager, cfg Config) error {
@@ -26,5 +30,17 @@ func RegisterAll(manager *messaging.MessageManager, cfg Config) error {
 		return err
 	}
 
+	err = snapshotgrp.Register(
+		manager,
+		snapshotgrp.Config{
+			SnapshotBus: cfg.SnapshotBus,
+			QueueName:   cfg.SnapshotQueueName,
+			Log:         cfg.Log,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
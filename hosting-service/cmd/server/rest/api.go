@@ -1,23 +1,61 @@
 package rest
 
 import (
+	"hosting-kit/logger"
+	"hosting-service/cmd/server/rest/handlers/billinggrp"
+	"hosting-service/cmd/server/rest/handlers/capacitygrp"
 	"hosting-service/cmd/server/rest/handlers/plangrp"
+	"hosting-service/cmd/server/rest/handlers/projectgrp"
+	"hosting-service/cmd/server/rest/handlers/quotagrp"
+	"hosting-service/cmd/server/rest/handlers/regiongrp"
 	"hosting-service/cmd/server/rest/handlers/rootgrp"
+	"hosting-service/cmd/server/rest/handlers/schedulegrp"
 	"hosting-service/cmd/server/rest/handlers/servergrp"
+	"hosting-service/cmd/server/rest/handlers/snapshotgrp"
+	"hosting-service/cmd/server/rest/handlers/sshkeygrp"
+	"hosting-service/cmd/server/rest/handlers/tokengrp"
+	"hosting-service/internal/apitoken"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/quota"
+	"hosting-service/internal/region"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
 )
 
 type API struct {
 	*plangrp.PlanHandlers
 	*servergrp.ServerHandlers
+	*quotagrp.QuotaHandlers
+	*snapshotgrp.SnapshotHandlers
+	*sshkeygrp.SSHKeyHandlers
+	*schedulegrp.ScheduleHandlers
+	*projectgrp.ProjectHandlers
+	*billinggrp.BillingHandlers
+	*capacitygrp.CapacityHandlers
+	*tokengrp.TokenHandlers
+	*regiongrp.RegionHandlers
 	*rootgrp.RootHandlers
 }
 
-func New(planBus plan.ExtBusiness, serverBus server.ExtBusiness, prefix string) *API {
+func New(planBus plan.ExtBusiness, serverBus server.ExtBusiness, idempotencyBus idempotency.ExtBusiness, quotaBus quota.ExtBusiness, snapshotBus snapshot.ExtBusiness, sshKeyBus sshkey.ExtBusiness, billingBus billing.ExtBusiness, capacityBus capacity.ExtBusiness, scheduleBus schedule.ExtBusiness, projectBus project.ExtBusiness, apiTokenBus apitoken.ExtBusiness, regionBus region.ExtBusiness, log *logger.Logger, prefix string) *API {
 	return &API{
-		PlanHandlers:   plangrp.New(planBus, prefix),
-		ServerHandlers: servergrp.New(serverBus, prefix),
-		RootHandlers:   rootgrp.New(prefix),
+		PlanHandlers:     plangrp.New(planBus, prefix),
+		ServerHandlers:   servergrp.New(serverBus, snapshotBus, idempotencyBus, log, prefix),
+		QuotaHandlers:    quotagrp.New(quotaBus, prefix),
+		SnapshotHandlers: snapshotgrp.New(snapshotBus, prefix),
+		SSHKeyHandlers:   sshkeygrp.New(sshKeyBus, prefix),
+		ScheduleHandlers: schedulegrp.New(scheduleBus, prefix),
+		ProjectHandlers:  projectgrp.New(projectBus, prefix),
+		BillingHandlers:  billinggrp.New(billingBus, prefix),
+		CapacityHandlers: capacitygrp.New(capacityBus, prefix),
+		TokenHandlers:    tokengrp.New(apiTokenBus, prefix),
+		RegionHandlers:   regiongrp.New(regionBus, prefix),
+		RootHandlers:     rootgrp.New(prefix),
 	}
 }
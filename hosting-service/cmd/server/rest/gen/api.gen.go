@@ -17,24 +17,433 @@ import (
 )
 
 const (
+	BearerAuthScopes = "bearerAuth.Scopes"
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for AdminServerActionRequestAction.
+const (
+	FORCEDELETE    AdminServerActionRequestAction = "FORCE_DELETE"
+	FORCESTOP      AdminServerActionRequestAction = "FORCE_STOP"
+	RESETPROVISION AdminServerActionRequestAction = "RESET_PROVISION"
+)
+
+// Defines values for ApiTokenScope.
+const (
+	ApiTokenScopeADMIN   ApiTokenScope = "ADMIN"
+	ApiTokenScopeREAD    ApiTokenScope = "READ"
+	ApiTokenScopeSERVERS ApiTokenScope = "SERVERS"
+)
+
+// Defines values for BatchServerActionErrorCode.
+const (
+	ACCESSDENIED  BatchServerActionErrorCode = "ACCESS_DENIED"
+	CONFLICT      BatchServerActionErrorCode = "CONFLICT"
+	INTERNAL      BatchServerActionErrorCode = "INTERNAL"
+	INVALIDPLAN   BatchServerActionErrorCode = "INVALID_PLAN"
+	INVALIDSTATUS BatchServerActionErrorCode = "INVALID_STATUS"
+	NORESOURCES   BatchServerActionErrorCode = "NO_RESOURCES"
+	NOTFOUND      BatchServerActionErrorCode = "NOT_FOUND"
+	QUOTAEXCEEDED BatchServerActionErrorCode = "QUOTA_EXCEEDED"
+)
+
+// Defines values for InvitationStatus.
+const (
+	InvitationStatusACCEPTED InvitationStatus = "ACCEPTED"
+	InvitationStatusDECLINED InvitationStatus = "DECLINED"
+	InvitationStatusEXPIRED  InvitationStatus = "EXPIRED"
+	InvitationStatusPENDING  InvitationStatus = "PENDING"
+	InvitationStatusREVOKED  InvitationStatus = "REVOKED"
+)
+
+// Defines values for ProjectRole.
+const (
+	ProjectRoleADMIN    ProjectRole = "ADMIN"
+	ProjectRoleOPERATOR ProjectRole = "OPERATOR"
+	ProjectRoleOWNER    ProjectRole = "OWNER"
+	ProjectRoleVIEWER   ProjectRole = "VIEWER"
+)
+
+// Defines values for ScheduledAction.
+const (
+	ScheduledActionREBOOT ScheduledAction = "REBOOT"
+	ScheduledActionSTART  ScheduledAction = "START"
+	ScheduledActionSTOP   ScheduledAction = "STOP"
+)
+
 // Defines values for ServerStatus.
 const (
-	PENDING         ServerStatus = "PENDING"
-	PROVISIONFAILED ServerStatus = "PROVISION_FAILED"
-	RUNNING         ServerStatus = "RUNNING"
-	STOPPED         ServerStatus = "STOPPED"
+	ServerStatusDELETEDPENDING  ServerStatus = "DELETED_PENDING"
+	ServerStatusDELETING        ServerStatus = "DELETING"
+	ServerStatusPENDING         ServerStatus = "PENDING"
+	ServerStatusPROVISIONFAILED ServerStatus = "PROVISION_FAILED"
+	ServerStatusREBOOTING       ServerStatus = "REBOOTING"
+	ServerStatusRESTORING       ServerStatus = "RESTORING"
+	ServerStatusRUNNING         ServerStatus = "RUNNING"
+	ServerStatusSTARTING        ServerStatus = "STARTING"
+	ServerStatusSTOPPED         ServerStatus = "STOPPED"
+	ServerStatusSTOPPING        ServerStatus = "STOPPING"
 )
 
 // Defines values for ServerActionRequestAction.
 const (
-	DELETE ServerActionRequestAction = "DELETE"
-	START  ServerActionRequestAction = "START"
-	STOP   ServerActionRequestAction = "STOP"
+	DELETE         ServerActionRequestAction = "DELETE"
+	REBOOT         ServerActionRequestAction = "REBOOT"
+	RESIZE         ServerActionRequestAction = "RESIZE"
+	RESTORE        ServerActionRequestAction = "RESTORE"
+	RETRYPROVISION ServerActionRequestAction = "RETRY_PROVISION"
+	START          ServerActionRequestAction = "START"
+	STOP           ServerActionRequestAction = "STOP"
+)
+
+// Defines values for ServerAddressFamily.
+const (
+	IPV4 ServerAddressFamily = "IPV4"
+	IPV6 ServerAddressFamily = "IPV6"
+)
+
+// Defines values for ServerFilterStatus.
+const (
+	ServerFilterStatusDELETEDPENDING  ServerFilterStatus = "DELETED_PENDING"
+	ServerFilterStatusDELETING        ServerFilterStatus = "DELETING"
+	ServerFilterStatusPENDING         ServerFilterStatus = "PENDING"
+	ServerFilterStatusPROVISIONFAILED ServerFilterStatus = "PROVISION_FAILED"
+	ServerFilterStatusREBOOTING       ServerFilterStatus = "REBOOTING"
+	ServerFilterStatusRESTORING       ServerFilterStatus = "RESTORING"
+	ServerFilterStatusRUNNING         ServerFilterStatus = "RUNNING"
+	ServerFilterStatusSTARTING        ServerFilterStatus = "STARTING"
+	ServerFilterStatusSTOPPED         ServerFilterStatus = "STOPPED"
+	ServerFilterStatusSTOPPING        ServerFilterStatus = "STOPPING"
+)
+
+// Defines values for SnapshotStatus.
+const (
+	SnapshotStatusAVAILABLE SnapshotStatus = "AVAILABLE"
+	SnapshotStatusCREATING  SnapshotStatus = "CREATING"
+	SnapshotStatusDELETING  SnapshotStatus = "DELETING"
+	SnapshotStatusFAILED    SnapshotStatus = "FAILED"
+	SnapshotStatusRESTORING SnapshotStatus = "RESTORING"
+)
+
+// Defines values for UsageLineItemMeter.
+const (
+	UsageLineItemMeterALLOCATED UsageLineItemMeter = "ALLOCATED"
+	UsageLineItemMeterRUNNING   UsageLineItemMeter = "RUNNING"
+)
+
+// Defines values for ListAllServersParamsStatus.
+const (
+	ListAllServersParamsStatusDELETEDPENDING  ListAllServersParamsStatus = "DELETED_PENDING"
+	ListAllServersParamsStatusDELETING        ListAllServersParamsStatus = "DELETING"
+	ListAllServersParamsStatusPENDING         ListAllServersParamsStatus = "PENDING"
+	ListAllServersParamsStatusPROVISIONFAILED ListAllServersParamsStatus = "PROVISION_FAILED"
+	ListAllServersParamsStatusREBOOTING       ListAllServersParamsStatus = "REBOOTING"
+	ListAllServersParamsStatusRESTORING       ListAllServersParamsStatus = "RESTORING"
+	ListAllServersParamsStatusRUNNING         ListAllServersParamsStatus = "RUNNING"
+	ListAllServersParamsStatusSTARTING        ListAllServersParamsStatus = "STARTING"
+	ListAllServersParamsStatusSTOPPED         ListAllServersParamsStatus = "STOPPED"
+	ListAllServersParamsStatusSTOPPING        ListAllServersParamsStatus = "STOPPING"
+)
+
+// Defines values for ListAllServersParamsOrderBy.
+const (
+	ListAllServersParamsOrderByCreatedAt   ListAllServersParamsOrderBy = "createdAt"
+	ListAllServersParamsOrderByIpv4Address ListAllServersParamsOrderBy = "ipv4Address"
+	ListAllServersParamsOrderByName        ListAllServersParamsOrderBy = "name"
+	ListAllServersParamsOrderByStatus      ListAllServersParamsOrderBy = "status"
+)
+
+// Defines values for ListAllServersParamsDirection.
+const (
+	ListAllServersParamsDirectionASC  ListAllServersParamsDirection = "ASC"
+	ListAllServersParamsDirectionDESC ListAllServersParamsDirection = "DESC"
+)
+
+// Defines values for ListServersParamsStatus.
+const (
+	DELETEDPENDING  ListServersParamsStatus = "DELETED_PENDING"
+	DELETING        ListServersParamsStatus = "DELETING"
+	PENDING         ListServersParamsStatus = "PENDING"
+	PROVISIONFAILED ListServersParamsStatus = "PROVISION_FAILED"
+	REBOOTING       ListServersParamsStatus = "REBOOTING"
+	RESTORING       ListServersParamsStatus = "RESTORING"
+	RUNNING         ListServersParamsStatus = "RUNNING"
+	STARTING        ListServersParamsStatus = "STARTING"
+	STOPPED         ListServersParamsStatus = "STOPPED"
+	STOPPING        ListServersParamsStatus = "STOPPING"
+)
+
+// Defines values for ListServersParamsOrderBy.
+const (
+	ListServersParamsOrderByCreatedAt   ListServersParamsOrderBy = "createdAt"
+	ListServersParamsOrderByIpv4Address ListServersParamsOrderBy = "ipv4Address"
+	ListServersParamsOrderByName        ListServersParamsOrderBy = "name"
+	ListServersParamsOrderByStatus      ListServersParamsOrderBy = "status"
+)
+
+// Defines values for ListServersParamsDirection.
+const (
+	ListServersParamsDirectionASC  ListServersParamsDirection = "ASC"
+	ListServersParamsDirectionDESC ListServersParamsDirection = "DESC"
 )
 
+// AdminServerActionRequest defines model for AdminServerActionRequest.
+type AdminServerActionRequest struct {
+	// Action FORCE_STOP — остановить работающий или зависший в STARTING/REBOOTING/STOPPING сервер; FORCE_DELETE — удалить сервер в любом статусе, кроме DELETING, не дожидаясь окончания срока восстановления; RESET_PROVISION — повторно отправить команду создания зависшего в PENDING сервера
+	Action AdminServerActionRequestAction `json:"action"`
+}
+
+// AdminServerActionRequestAction FORCE_STOP — остановить работающий или зависший в STARTING/REBOOTING/STOPPING сервер; FORCE_DELETE — удалить сервер в любом статусе, кроме DELETING, не дожидаясь окончания срока восстановления; RESET_PROVISION — повторно отправить команду создания зависшего в PENDING сервера
+type AdminServerActionRequestAction string
+
+// ApiToken defines model for ApiToken.
+type ApiToken struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links     `json:"_links"`
+	CreatedAt       time.Time `json:"createdAt"`
+
+	// ExpiresAt Не задано для бессрочных токенов
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// Prefix Начало секрета, например hst_Kq1x0NsQ
+	Prefix string `json:"prefix"`
+
+	// Scope READ разрешает только чтение; SERVERS также заказ и управление серверами и остальные изменения; ADMIN также запросы администратора
+	Scope ApiTokenScope `json:"scope"`
+}
+
+// ApiTokenCollectionResponse defines model for ApiTokenCollectionResponse.
+type ApiTokenCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Tokens []ApiToken `json:"tokens"`
+	} `json:"_embedded"`
+
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
+
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
+
+// ApiTokenScope READ разрешает только чтение; SERVERS также заказ и управление серверами и остальные изменения; ADMIN также запросы администратора
+type ApiTokenScope string
+
+// BatchServerActionError defines model for BatchServerActionError.
+type BatchServerActionError struct {
+	Code    BatchServerActionErrorCode `json:"code"`
+	Message string                     `json:"message"`
+}
+
+// BatchServerActionErrorCode defines model for BatchServerActionError.Code.
+type BatchServerActionErrorCode string
+
+// BatchServerActionRequest defines model for BatchServerActionRequest.
+type BatchServerActionRequest struct {
+	// Action DELETE переводит сервер в DELETED_PENDING, ресурсы остаются зарезервированными до purgeAt; RESTORE возвращает такой сервер в прежний статус
+	Action ServerActionRequestAction `json:"action"`
+
+	// Filter Отбор своих серверов; заданные поля объединяются через И
+	Filter *ServerFilter `json:"filter,omitempty"`
+
+	// PlanId ID нового плана, обязателен для RESIZE
+	PlanId    *openapi_types.UUID   `json:"planId,omitempty"`
+	ServerIds *[]openapi_types.UUID `json:"serverIds,omitempty"`
+}
+
+// BatchServerActionResponse defines model for BatchServerActionResponse.
+type BatchServerActionResponse struct {
+	Results []BatchServerActionResult `json:"results"`
+}
+
+// BatchServerActionResult defines model for BatchServerActionResult.
+type BatchServerActionResult struct {
+	Error    *BatchServerActionError `json:"error,omitempty"`
+	Server   *Server                 `json:"server,omitempty"`
+	ServerId openapi_types.UUID      `json:"serverId"`
+	Success  bool                    `json:"success"`
+}
+
+// CapacityPool defines model for CapacityPool.
+type CapacityPool struct {
+	// Allocated capacity минус available
+	Allocated *PoolResources `json:"allocated,omitempty"`
+	Available *PoolResources `json:"available,omitempty"`
+
+	// Capacity Отсутствует, если емкость пула неизвестна
+	Capacity *PoolResources `json:"capacity,omitempty"`
+
+	// Drift allocated минус expected. Положительные значения - утекшие ресурсы, отрицательные - возвращенные повторно
+	Drift *PoolResources `json:"drift,omitempty"`
+
+	// Drifting true, если пул расходится с серверами или его нельзя сверить
+	Drifting bool `json:"drifting"`
+
+	// Expected Ресурсы серверов, снимков и незавершенных заказов в пуле
+	Expected PoolResources `json:"expected"`
+
+	// Known false, если сервису ресурсов пул неизвестен
+	Known bool    `json:"known"`
+	Name  *string `json:"name,omitempty"`
+
+	// Note Почему пул нельзя сверить или он не был исправлен
+	Note     *string            `json:"note,omitempty"`
+	PoolId   openapi_types.UUID `json:"poolId"`
+	Repaired bool               `json:"repaired"`
+}
+
+// CapacityReport defines model for CapacityReport.
+type CapacityReport struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links          `json:"_links"`
+	CheckedAt       time.Time      `json:"checkedAt"`
+	Drifting        bool           `json:"drifting"`
+	Pools           []CapacityPool `json:"pools"`
+	Repair          bool           `json:"repair"`
+
+	// Unsettled Заказы, которые могли занять ресурсы пула, но еще не записали его
+	Unsettled int `json:"unsettled"`
+}
+
+// CreateApiTokenRequest defines model for CreateApiTokenRequest.
+type CreateApiTokenRequest struct {
+	// ExpiresAt Момент в будущем, после которого токен перестает действовать; по умолчанию бессрочный
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Name      string     `json:"name"`
+
+	// Scope READ разрешает только чтение; SERVERS также заказ и управление серверами и остальные изменения; ADMIN также запросы администратора
+	Scope ApiTokenScope `json:"scope"`
+}
+
+// CreateInvitationRequest defines model for CreateInvitationRequest.
+type CreateInvitationRequest struct {
+	// Role VIEWER видит проект и его серверы; OPERATOR также включает, выключает и перезагружает серверы; ADMIN также заказывает, изменяет и удаляет серверы и управляет участниками; OWNER также переименовывает и удаляет проект
+	Role ProjectRole `json:"role"`
+
+	// UserId ID приглашаемого пользователя
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// CreateProjectRequest defines model for CreateProjectRequest.
+type CreateProjectRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateScheduleRequest defines model for CreateScheduleRequest.
+type CreateScheduleRequest struct {
+	Action ScheduledAction `json:"action"`
+	Cron   *string         `json:"cron,omitempty"`
+	RunAt  *time.Time      `json:"runAt,omitempty"`
+
+	// Timezone По умолчанию UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CreateSnapshotRequest defines model for CreateSnapshotRequest.
+type CreateSnapshotRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateSshKeyRequest defines model for CreateSshKeyRequest.
+type CreateSshKeyRequest struct {
+	Name string `json:"name"`
+
+	// PublicKey Строка из файла *.pub, например ssh-ed25519 AAAA... user@host
+	PublicKey string `json:"publicKey"`
+}
+
+// CreatedApiToken defines model for CreatedApiToken.
+type CreatedApiToken struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links              `json:"_links"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	ExpiresAt       *time.Time         `json:"expiresAt,omitempty"`
+	Id              openapi_types.UUID `json:"id"`
+	Name            string             `json:"name"`
+	Prefix          string             `json:"prefix"`
+
+	// Scope READ разрешает только чтение; SERVERS также заказ и управление серверами и остальные изменения; ADMIN также запросы администратора
+	Scope ApiTokenScope `json:"scope"`
+
+	// Token Секрет для заголовка Authorization: Bearer; больше не будет показан
+	Token string `json:"token"`
+}
+
+// CursorMetadata Информация о курсорной пагинации
+type CursorMetadata struct {
+	// EndCursor Курсор последнего элемента страницы
+	EndCursor       *string `json:"endCursor,omitempty"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+
+	// Size Размер страницы
+	Size int `json:"size"`
+
+	// StartCursor Курсор первого элемента страницы
+	StartCursor *string `json:"startCursor,omitempty"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links              `json:"_links"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	ExpiresAt       time.Time          `json:"expiresAt"`
+	Id              openapi_types.UUID `json:"id"`
+	InvitedBy       openapi_types.UUID `json:"invitedBy"`
+	InviteeId       openapi_types.UUID `json:"inviteeId"`
+	ProjectId       openapi_types.UUID `json:"projectId"`
+
+	// Role VIEWER видит проект и его серверы; OPERATOR также включает, выключает и перезагружает серверы; ADMIN также заказывает, изменяет и удаляет серверы и управляет участниками; OWNER также переименовывает и удаляет проект
+	Role   ProjectRole      `json:"role"`
+	Status InvitationStatus `json:"status"`
+}
+
+// InvitationStatus defines model for Invitation.Status.
+type InvitationStatus string
+
+// InvitationCollectionResponse defines model for InvitationCollectionResponse.
+type InvitationCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Invitations []Invitation `json:"invitations"`
+	} `json:"_embedded"`
+
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
+
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
+
+// Invoice defines model for Invoice.
+type Invoice struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links              `json:"_links"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	Currency        string             `json:"currency"`
+	Id              openapi_types.UUID `json:"id"`
+	Items           []UsageLineItem    `json:"items"`
+	PeriodEnd       time.Time          `json:"periodEnd"`
+	PeriodStart     time.Time          `json:"periodStart"`
+
+	// Total Итог в копейках
+	Total int64 `json:"total"`
+}
+
+// InvoiceCollectionResponse defines model for InvoiceCollectionResponse.
+type InvoiceCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Invoices []Invoice `json:"invoices"`
+	} `json:"_embedded"`
+
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
+
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
+
 // Link defines model for Link.
 type Link struct {
 	Href string `json:"href"`
@@ -53,6 +462,15 @@ type OrderServerRequest struct {
 
 	// PlanId ID плана с витрины (/plans)
 	PlanId openapi_types.UUID `json:"planId"`
+
+	// ProjectId ID проекта, в котором заказывается сервер; по умолчанию личный проект
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+
+	// Region Регион из /regions, в котором нужно разместить сервер; по умолчанию любой
+	Region *string `json:"region,omitempty"`
+
+	// SshKeyIds ID своих SSH-ключей (/ssh-keys), которые будут установлены на сервер
+	SshKeyIds *[]openapi_types.UUID `json:"sshKeyIds,omitempty"`
 }
 
 // PageMetadata Информация о пагинации
@@ -85,45 +503,63 @@ type PlanCollectionResponse struct {
 	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
 	UnderscoreLinks Links `json:"_links"`
 
+	// Cursor Информация о курсорной пагинации
+	Cursor *CursorMetadata `json:"cursor,omitempty"`
+
 	// Page Информация о пагинации
-	Page PageMetadata `json:"page"`
+	Page *PageMetadata `json:"page,omitempty"`
 }
 
-// RootResource defines model for RootResource.
-type RootResource struct {
-	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
-	UnderscoreLinks Links `json:"_links"`
+// PoolResources defines model for PoolResources.
+type PoolResources struct {
+	CpuCores int `json:"cpuCores"`
+	DiskGb   int `json:"diskGb"`
+	IpCount  int `json:"ipCount"`
+	RamMb    int `json:"ramMb"`
 }
 
-// Server defines model for Server.
-type Server struct {
-	IPv4Address *string `json:"IPv4Address,omitempty"`
-
+// Project defines model for Project.
+type Project struct {
 	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
 	UnderscoreLinks Links              `json:"_links"`
 	CreatedAt       time.Time          `json:"createdAt"`
+	CreatedBy       openapi_types.UUID `json:"createdBy"`
 	Id              openapi_types.UUID `json:"id"`
 	Name            string             `json:"name"`
-	PlanId          openapi_types.UUID `json:"planId"`
-	PoolId          openapi_types.UUID `json:"poolId"`
-	Status          ServerStatus       `json:"status"`
+
+	// Personal Личный проект пользователя, в который попадают серверы, заказанные без проекта
+	Personal bool `json:"personal"`
 }
 
-// ServerStatus defines model for Server.Status.
-type ServerStatus string
+// ProjectCollectionResponse defines model for ProjectCollectionResponse.
+type ProjectCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Projects []Project `json:"projects"`
+	} `json:"_embedded"`
 
-// ServerActionRequest defines model for ServerActionRequest.
-type ServerActionRequest struct {
-	Action ServerActionRequestAction `json:"action"`
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
+
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
 }
 
-// ServerActionRequestAction defines model for ServerActionRequest.Action.
-type ServerActionRequestAction string
+// ProjectMember defines model for ProjectMember.
+type ProjectMember struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links              `json:"_links"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	ProjectId       openapi_types.UUID `json:"projectId"`
 
-// ServerCollectionResponse defines model for ServerCollectionResponse.
-type ServerCollectionResponse struct {
+	// Role VIEWER видит проект и его серверы; OPERATOR также включает, выключает и перезагружает серверы; ADMIN также заказывает, изменяет и удаляет серверы и управляет участниками; OWNER также переименовывает и удаляет проект
+	Role   ProjectRole        `json:"role"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// ProjectMemberCollectionResponse defines model for ProjectMemberCollectionResponse.
+type ProjectMemberCollectionResponse struct {
 	UnderscoreEmbedded struct {
-		Servers []Server `json:"servers"`
+		Members []ProjectMember `json:"members"`
 	} `json:"_embedded"`
 
 	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
@@ -133,809 +569,7281 @@ type ServerCollectionResponse struct {
 	Page PageMetadata `json:"page"`
 }
 
-// ServerPlan defines model for ServerPlan.
-type ServerPlan struct {
+// ProjectRole VIEWER видит проект и его серверы; OPERATOR также включает, выключает и перезагружает серверы; ADMIN также заказывает, изменяет и удаляет серверы и управляет участниками; OWNER также переименовывает и удаляет проект
+type ProjectRole string
+
+// Quota defines model for Quota.
+type Quota struct {
 	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
-	UnderscoreLinks Links              `json:"_links"`
-	CpuCores        int                `json:"cpuCores"`
-	DiskGb          int                `json:"diskGb"`
-	Id              openapi_types.UUID `json:"id"`
-	IpCount         int                `json:"ipCount"`
-	Name            string             `json:"name"`
-	RamMb           int                `json:"ramMb"`
-}
+	UnderscoreLinks Links `json:"_links"`
 
-// ServerPlanCreateRequest defines model for ServerPlanCreateRequest.
-type ServerPlanCreateRequest struct {
-	CpuCores int    `json:"cpuCores"`
-	DiskGb   int    `json:"diskGb"`
-	IpCount  int    `json:"ipCount"`
-	Name     string `json:"name"`
-	RamMb    int    `json:"ramMb"`
+	// Custom true, если действует индивидуальная квота, а не квота по умолчанию
+	Custom bool               `json:"custom"`
+	Limits QuotaResources     `json:"limits"`
+	Usage  QuotaResources     `json:"usage"`
+	UserId openapi_types.UUID `json:"userId"`
 }
 
-// StatusResponse defines model for StatusResponse.
-type StatusResponse struct {
-	Message string `json:"message"`
+// QuotaOverride defines model for QuotaOverride.
+type QuotaOverride struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links              `json:"_links"`
+	Limits          QuotaResources     `json:"limits"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+	UserId          openapi_types.UUID `json:"userId"`
 }
 
-// Page defines model for Page.
-type Page = int
+// QuotaOverrideCollectionResponse defines model for QuotaOverrideCollectionResponse.
+type QuotaOverrideCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Overrides []QuotaOverride `json:"overrides"`
+	} `json:"_embedded"`
 
-// PageSize defines model for PageSize.
-type PageSize = int
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-// BadRequest defines model for BadRequest.
-type BadRequest = StatusResponse
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
 
-// Conflict defines model for Conflict.
-type Conflict = StatusResponse
+// QuotaResources defines model for QuotaResources.
+type QuotaResources struct {
+	CpuCores int `json:"cpuCores"`
+	DiskGb   int `json:"diskGb"`
+	IpCount  int `json:"ipCount"`
+	RamMb    int `json:"ramMb"`
+	Servers  int `json:"servers"`
+}
 
-// NotFound defines model for NotFound.
-type NotFound = StatusResponse
+// Region defines model for Region.
+type Region struct {
+	// Available Сумма свободных ресурсов пулов региона
+	Available PoolResources `json:"available"`
 
-// ListPlansParams defines parameters for ListPlans.
-type ListPlansParams struct {
-	// Page Номер запрашиваемой страницы
-	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+	// Name Имя региона, например eu-central
+	Name string `json:"name"`
 
-	// PageSize Количество элементов на странице.
-	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+	// Pools Количество пулов в регионе
+	Pools int `json:"pools"`
 }
 
-// ListServersParams defines parameters for ListServers.
-type ListServersParams struct {
-	// Page Номер запрашиваемой страницы
-	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+// RegionCollectionResponse defines model for RegionCollectionResponse.
+type RegionCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Regions []Region `json:"regions"`
+	} `json:"_embedded"`
 
-	// PageSize Количество элементов на странице.
-	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 }
 
-// CreatePlanJSONRequestBody defines body for CreatePlan for application/json ContentType.
-type CreatePlanJSONRequestBody = ServerPlanCreateRequest
+// RootResource defines model for RootResource.
+type RootResource struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
+}
 
-// OrderServerJSONRequestBody defines body for OrderServer for application/json ContentType.
-type OrderServerJSONRequestBody = OrderServerRequest
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links           `json:"_links"`
+	Action          ScheduledAction `json:"action"`
+	CreatedAt       time.Time       `json:"createdAt"`
 
-// PerformServerActionJSONRequestBody defines body for PerformServerAction for application/json ContentType.
-type PerformServerActionJSONRequestBody = ServerActionRequest
+	// Cron Выражение cron для повторяющегося запуска, например 0 8 * * 1-5
+	Cron    *string            `json:"cron,omitempty"`
+	Enabled bool               `json:"enabled"`
+	Id      openapi_types.UUID `json:"id"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Точка входа (Root)
-	// (GET /)
-	GetRoot(w http.ResponseWriter, r *http.Request)
-	// Получить список доступных планов
-	// (GET /plans)
-	ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams)
-	// Создание нового плана
-	// (POST /plans)
-	CreatePlan(w http.ResponseWriter, r *http.Request)
-	// Получить детальную информацию о плане
-	// (GET /plans/{planId})
-	GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID)
-	// Получить список всех заказанных серверов
-	// (GET /servers)
-	ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams)
-	// Заказать новый сервер
-	// (POST /servers)
-	OrderServer(w http.ResponseWriter, r *http.Request)
-	// Получить детальную информацию о сервере
-	// (GET /servers/{serverId})
-	GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
-	// Выполнить действие над сервером
-	// (POST /servers/{serverId}/actions)
-	PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
-}
+	// LastError Причина, по которой последний запуск не изменил сервер
+	LastError *string    `json:"lastError,omitempty"`
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	// NextRunAt Время следующего запуска, отсутствует у выключенных и выполненных однократных расписаний
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
 
-type Unimplemented struct{}
+	// RunAt Время однократного запуска
+	RunAt    *time.Time         `json:"runAt,omitempty"`
+	ServerId openapi_types.UUID `json:"serverId"`
 
-// Точка входа (Root)
-// (GET /)
-func (_ Unimplemented) GetRoot(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Timezone Часовой пояс IANA, в котором вычисляется cron
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// Получить список доступных планов
-// (GET /plans)
-func (_ Unimplemented) ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ScheduleCollectionResponse defines model for ScheduleCollectionResponse.
+type ScheduleCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Schedules []Schedule `json:"schedules"`
+	} `json:"_embedded"`
 
-// Создание нового плана
-// (POST /plans)
-func (_ Unimplemented) CreatePlan(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-// Получить детальную информацию о плане
-// (GET /plans/{planId})
-func (_ Unimplemented) GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
 }
 
-// Получить список всех заказанных серверов
-// (GET /servers)
-func (_ Unimplemented) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ScheduledAction defines model for ScheduledAction.
+type ScheduledAction string
 
-// Заказать новый сервер
-// (POST /servers)
-func (_ Unimplemented) OrderServer(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Server defines model for Server.
+type Server struct {
+	// IPv4Address Основной IPv4-адрес сервера
+	IPv4Address *string `json:"IPv4Address,omitempty"`
 
-// Получить детальную информацию о сервере
-// (GET /servers/{serverId})
-func (_ Unimplemented) GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-// Выполнить действие над сервером
-// (POST /servers/{serverId}/actions)
-func (_ Unimplemented) PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Addresses Все IPv4- и IPv6-адреса сервера
+	Addresses *[]ServerAddress `json:"addresses,omitempty"`
+	CreatedAt time.Time        `json:"createdAt"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// FailureReason Причина последней неудачной попытки создания
+	FailureReason *string            `json:"failureReason,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+	Name          string             `json:"name"`
+	OwnerId       openapi_types.UUID `json:"ownerId"`
+	PlanId        openapi_types.UUID `json:"planId"`
+	PoolId        openapi_types.UUID `json:"poolId"`
+	ProjectId     openapi_types.UUID `json:"projectId"`
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// ProvisionAttempts Количество попыток создания сервера
+	ProvisionAttempts int `json:"provisionAttempts"`
 
-// GetRoot operation middleware
-func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Request) {
+	// PurgeAt Момент окончательного удаления сервера в статусе DELETED_PENDING; до него сервер можно восстановить
+	PurgeAt *time.Time `json:"purgeAt,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRoot(w, r)
-	}))
+	// Region Регион пула, в котором размещен сервер
+	Region string `json:"region"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// SnapshotId ID снимка, из которого создан сервер
+	SnapshotId *openapi_types.UUID `json:"snapshotId,omitempty"`
+	Status     ServerStatus        `json:"status"`
 
-	handler.ServeHTTP(w, r)
+	// Version Версия сервера, растет при каждом изменении
+	Version int `json:"version"`
 }
 
-// ListPlans operation middleware
-func (siw *ServerInterfaceWrapper) ListPlans(w http.ResponseWriter, r *http.Request) {
+// ServerStatus defines model for Server.Status.
+type ServerStatus string
 
-	var err error
+// ServerActionRequest defines model for ServerActionRequest.
+type ServerActionRequest struct {
+	// Action DELETE переводит сервер в DELETED_PENDING, ресурсы остаются зарезервированными до purgeAt; RESTORE возвращает такой сервер в прежний статус
+	Action ServerActionRequestAction `json:"action"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPlansParams
+	// PlanId ID нового плана, обязателен для RESIZE
+	PlanId *openapi_types.UUID `json:"planId,omitempty"`
+}
 
-	// ------------- Optional query parameter "page" -------------
+// ServerActionRequestAction DELETE переводит сервер в DELETED_PENDING, ресурсы остаются зарезервированными до purgeAt; RESTORE возвращает такой сервер в прежний статус
+type ServerActionRequestAction string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+// ServerAddress defines model for ServerAddress.
+type ServerAddress struct {
+	Address string              `json:"address"`
+	Family  ServerAddressFamily `json:"family"`
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// Primary Основной адрес своего семейства
+	Primary bool `json:"primary"`
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
-	}
+	// ReverseDns Имя в обратной зоне DNS (PTR)
+	ReverseDns *string `json:"reverseDns,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPlans(w, r, params)
-	}))
+// ServerAddressFamily defines model for ServerAddress.Family.
+type ServerAddressFamily string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ServerCollectionResponse defines model for ServerCollectionResponse.
+type ServerCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Servers []Server `json:"servers"`
+	} `json:"_embedded"`
 
-	handler.ServeHTTP(w, r)
-}
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-// CreatePlan operation middleware
-func (siw *ServerInterfaceWrapper) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	// Cursor Информация о курсорной пагинации
+	Cursor *CursorMetadata `json:"cursor,omitempty"`
 
-	ctx := r.Context()
+	// Page Информация о пагинации
+	Page *PageMetadata `json:"page,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// ServerEvent defines model for ServerEvent.
+type ServerEvent struct {
+	// Actor ID пользователя или system для изменений, выполненных сервисом
+	Actor     string             `json:"actor"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
 
-	r = r.WithContext(ctx)
+	// NewStatus Статус после изменения, DELETED для удаленного сервера
+	NewStatus string `json:"newStatus"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePlan(w, r)
-	}))
+	// OldStatus Статус до изменения, отсутствует для создания сервера
+	OldStatus *string `json:"oldStatus,omitempty"`
+	Reason    string  `json:"reason"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ServerFilter Отбор серверов своих проектов; заданные поля объединяются через И
+type ServerFilter struct {
+	CreatedFrom *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo   *time.Time `json:"createdTo,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// IpAddress Любой IPv4- или IPv6-адрес сервера
+	IpAddress *string `json:"ipAddress,omitempty"`
+
+	// Name Подстрока имени сервера (без учета регистра)
+	Name      *string             `json:"name,omitempty"`
+	PlanId    *openapi_types.UUID `json:"planId,omitempty"`
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+	Status    *ServerFilterStatus `json:"status,omitempty"`
 }
 
-// GetPlanById operation middleware
-func (siw *ServerInterfaceWrapper) GetPlanById(w http.ResponseWriter, r *http.Request) {
+// ServerFilterStatus defines model for ServerFilter.Status.
+type ServerFilterStatus string
 
-	var err error
+// ServerHistoryResponse defines model for ServerHistoryResponse.
+type ServerHistoryResponse struct {
+	UnderscoreEmbedded struct {
+		Events []ServerEvent `json:"events"`
+	} `json:"_embedded"`
 
-	// ------------- Path parameter "planId" -------------
-	var planId openapi_types.UUID
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "planId", chi.URLParam(r, "planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
-		return
-	}
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPlanById(w, r, planId)
-	}))
+// ServerPlan defines model for ServerPlan.
+type ServerPlan struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// AllocatedHourlyPrice Цена часа удержания ресурсов сервером, в копейках
+	AllocatedHourlyPrice int64              `json:"allocatedHourlyPrice"`
+	CpuCores             int                `json:"cpuCores"`
+	DiskGb               int                `json:"diskGb"`
+	Id                   openapi_types.UUID `json:"id"`
+	IpCount              int                `json:"ipCount"`
+	Name                 string             `json:"name"`
+	RamMb                int                `json:"ramMb"`
+
+	// RunningHourlyPrice Цена часа работы сервера в статусе RUNNING, в копейках
+	RunningHourlyPrice int64 `json:"runningHourlyPrice"`
+}
 
-	handler.ServeHTTP(w, r)
+// ServerPlanCreateRequest defines model for ServerPlanCreateRequest.
+type ServerPlanCreateRequest struct {
+	// AllocatedHourlyPrice Цена часа удержания ресурсов сервером, в копейках. По умолчанию 0
+	AllocatedHourlyPrice *int64 `json:"allocatedHourlyPrice,omitempty"`
+	CpuCores             int    `json:"cpuCores"`
+	DiskGb               int    `json:"diskGb"`
+	IpCount              int    `json:"ipCount"`
+	Name                 string `json:"name"`
+	RamMb                int    `json:"ramMb"`
+
+	// RunningHourlyPrice Цена часа работы сервера в статусе RUNNING, в копейках. По умолчанию 0
+	RunningHourlyPrice *int64 `json:"runningHourlyPrice,omitempty"`
 }
 
-// ListServers operation middleware
-func (siw *ServerInterfaceWrapper) ListServers(w http.ResponseWriter, r *http.Request) {
+// SetProjectMemberRoleRequest defines model for SetProjectMemberRoleRequest.
+type SetProjectMemberRoleRequest struct {
+	// Role VIEWER видит проект и его серверы; OPERATOR также включает, выключает и перезагружает серверы; ADMIN также заказывает, изменяет и удаляет серверы и управляет участниками; OWNER также переименовывает и удаляет проект
+	Role ProjectRole `json:"role"`
+}
 
-	var err error
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links     `json:"_links"`
+	CreatedAt       time.Time `json:"createdAt"`
+
+	// FailureReason Причина последней неудачной операции со снимком
+	FailureReason *string            `json:"failureReason,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+	Name          string             `json:"name"`
+	ServerId      openapi_types.UUID `json:"serverId"`
+
+	// SizeGb Место, занятое снимком в пуле сервера
+	SizeGb int            `json:"sizeGb"`
+	Status SnapshotStatus `json:"status"`
+}
 
-	ctx := r.Context()
+// SnapshotStatus defines model for Snapshot.Status.
+type SnapshotStatus string
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// SnapshotCollectionResponse defines model for SnapshotCollectionResponse.
+type SnapshotCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		Snapshots []Snapshot `json:"snapshots"`
+	} `json:"_embedded"`
 
-	r = r.WithContext(ctx)
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServersParams
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
 
-	// ------------- Optional query parameter "page" -------------
+// SshKey defines model for SshKey.
+type SshKey struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links     `json:"_links"`
+	CreatedAt       time.Time `json:"createdAt"`
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	// Fingerprint Отпечаток SHA256, например SHA256:Kq1x0NsQMk24BnIQo8pDXHPDkNJtHxWjF6IOZpM6tJo
+	Fingerprint string             `json:"fingerprint"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// PublicKey Ключ в формате OpenSSH
+	PublicKey string `json:"publicKey"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
-	}
+// SshKeyCollectionResponse defines model for SshKeyCollectionResponse.
+type SshKeyCollectionResponse struct {
+	UnderscoreEmbedded struct {
+		SshKeys []SshKey `json:"sshKeys"`
+	} `json:"_embedded"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServers(w, r, params)
-	}))
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links `json:"_links"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Page Информация о пагинации
+	Page PageMetadata `json:"page"`
+}
 
-	handler.ServeHTTP(w, r)
+// StatusResponse defines model for StatusResponse.
+type StatusResponse struct {
+	Message string `json:"message"`
 }
 
-// OrderServer operation middleware
-func (siw *ServerInterfaceWrapper) OrderServer(w http.ResponseWriter, r *http.Request) {
+// UpdateProjectRequest defines model for UpdateProjectRequest.
+type UpdateProjectRequest struct {
+	Name string `json:"name"`
+}
 
-	ctx := r.Context()
+// UpdateScheduleRequest defines model for UpdateScheduleRequest.
+type UpdateScheduleRequest struct {
+	Action   *ScheduledAction `json:"action,omitempty"`
+	Cron     *string          `json:"cron,omitempty"`
+	Enabled  *bool            `json:"enabled,omitempty"`
+	RunAt    *time.Time       `json:"runAt,omitempty"`
+	Timezone *string          `json:"timezone,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// UsageLineItem defines model for UsageLineItem.
+type UsageLineItem struct {
+	// Amount Сумма позиции в копейках, округляется вверх
+	Amount int64 `json:"amount"`
 
-	r = r.WithContext(ctx)
+	// HourlyPrice Цена часа по тарифу, в копейках
+	HourlyPrice int64 `json:"hourlyPrice"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.OrderServer(w, r)
-	}))
+	// Meter RUNNING - время в статусе RUNNING, ALLOCATED - время удержания ресурсов подготовленным сервером
+	Meter  UsageLineItemMeter `json:"meter"`
+	PlanId openapi_types.UUID `json:"planId"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Seconds Учтенное время в секундах
+	Seconds  int64              `json:"seconds"`
+	ServerId openapi_types.UUID `json:"serverId"`
+}
 
-	handler.ServeHTTP(w, r)
+// UsageLineItemMeter RUNNING - время в статусе RUNNING, ALLOCATED - время удержания ресурсов подготовленным сервером
+type UsageLineItemMeter string
+
+// UsageStatement defines model for UsageStatement.
+type UsageStatement struct {
+	// UnderscoreLinks Контейнер для гипермедиа-ссылок.
+	UnderscoreLinks Links           `json:"_links"`
+	Currency        string          `json:"currency"`
+	Items           []UsageLineItem `json:"items"`
+	PeriodEnd       time.Time       `json:"periodEnd"`
+	PeriodStart     time.Time       `json:"periodStart"`
+
+	// Total Итог в копейках
+	Total int64 `json:"total"`
 }
 
-// GetServerById operation middleware
-func (siw *ServerInterfaceWrapper) GetServerById(w http.ResponseWriter, r *http.Request) {
+// After defines model for After.
+type After = string
 
-	var err error
+// Before defines model for Before.
+type Before = string
 
-	// ------------- Path parameter "serverId" -------------
-	var serverId openapi_types.UUID
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
-		return
-	}
+// Limit defines model for Limit.
+type Limit = int
 
-	ctx := r.Context()
+// Page defines model for Page.
+type Page = int
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// PageSize defines model for PageSize.
+type PageSize = int
 
-	r = r.WithContext(ctx)
+// BadRequest defines model for BadRequest.
+type BadRequest = StatusResponse
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServerById(w, r, serverId)
-	}))
+// Conflict defines model for Conflict.
+type Conflict = StatusResponse
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Forbidden defines model for Forbidden.
+type Forbidden = StatusResponse
 
-	handler.ServeHTTP(w, r)
+// IdempotencyKeyReused defines model for IdempotencyKeyReused.
+type IdempotencyKeyReused = StatusResponse
+
+// NotFound defines model for NotFound.
+type NotFound = StatusResponse
+
+// ListQuotaOverridesParams defines parameters for ListQuotaOverrides.
+type ListQuotaOverridesParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-// PerformServerAction operation middleware
-func (siw *ServerInterfaceWrapper) PerformServerAction(w http.ResponseWriter, r *http.Request) {
+// ListAllServersParams defines parameters for ListAllServers.
+type ListAllServersParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-	var err error
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
-	// ------------- Path parameter "serverId" -------------
-	var serverId openapi_types.UUID
+	// Limit Размер страницы в режиме курсоров. Включает курсорную пагинацию вместо номеров страниц.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
-		return
-	}
+	// After Непрозрачный курсор: вернуть элементы после него
+	After *After `form:"after,omitempty" json:"after,omitempty"`
 
-	ctx := r.Context()
+	// Before Непрозрачный курсор: вернуть элементы перед ним
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// OwnerId Фильтр по ID владельца
+	OwnerId *openapi_types.UUID `form:"ownerId,omitempty" json:"ownerId,omitempty"`
 
-	r = r.WithContext(ctx)
+	// PoolId Фильтр по ID пула ресурсов
+	PoolId *openapi_types.UUID `form:"poolId,omitempty" json:"poolId,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PerformServerAction(w, r, serverId)
-	}))
+	// Status Фильтр по статусу сервера
+	Status *ListAllServersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// PlanId Фильтр по ID плана
+	PlanId *openapi_types.UUID `form:"planId,omitempty" json:"planId,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Name Поиск по подстроке в имени сервера (без учета регистра)
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// OrderBy Поле сортировки
+	OrderBy *ListAllServersParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	// Direction Направление сортировки
+	Direction *ListAllServersParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// ListAllServersParamsStatus defines parameters for ListAllServers.
+type ListAllServersParamsStatus string
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// ListAllServersParamsOrderBy defines parameters for ListAllServers.
+type ListAllServersParamsOrderBy string
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// ListAllServersParamsDirection defines parameters for ListAllServers.
+type ListAllServersParamsDirection string
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// PerformAdminServerActionParams defines parameters for PerformAdminServerAction.
+type PerformAdminServerActionParams struct {
+	// IfMatch ETag сервера, полученный ранее. Действие выполняется, только если сервер с тех пор не изменился
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// GetAnyServerHistoryParams defines parameters for GetAnyServerHistory.
+type GetAnyServerHistoryParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// ListInvoicesParams defines parameters for ListInvoices.
+type ListInvoicesParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// ListInvitationsParams defines parameters for ListInvitations.
+type ListInvitationsParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// ListPlansParams defines parameters for ListPlans.
+type ListPlansParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// Limit Размер страницы в режиме курсоров. Включает курсорную пагинацию вместо номеров страниц.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// After Непрозрачный курсор: вернуть элементы после него
+	After *After `form:"after,omitempty" json:"after,omitempty"`
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	// Before Непрозрачный курсор: вернуть элементы перед ним
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// ListProjectInvitationsParams defines parameters for ListProjectInvitations.
+type ListProjectInvitationsParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ListProjectMembersParams defines parameters for ListProjectMembers.
+type ListProjectMembersParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/", wrapper.GetRoot)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/plans", wrapper.ListPlans)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/plans", wrapper.CreatePlan)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/plans/{planId}", wrapper.GetPlanById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/servers", wrapper.ListServers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/servers", wrapper.OrderServer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/servers/{serverId}", wrapper.GetServerById)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/servers/{serverId}/actions", wrapper.PerformServerAction)
-	})
+// ListSchedulesParams defines parameters for ListSchedules.
+type ListSchedulesParams struct {
+	// ServerId Фильтр по ID сервера
+	ServerId *openapi_types.UUID `form:"serverId,omitempty" json:"serverId,omitempty"`
 
-	return r
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-type BadRequestJSONResponse StatusResponse
+// ListServersParams defines parameters for ListServers.
+type ListServersParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-type ConflictJSONResponse StatusResponse
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
-type NotFoundJSONResponse StatusResponse
+	// Limit Размер страницы в режиме курсоров. Включает курсорную пагинацию вместо номеров страниц.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 
-type GetRootRequestObject struct {
-}
+	// After Непрозрачный курсор: вернуть элементы после него
+	After *After `form:"after,omitempty" json:"after,omitempty"`
 
-type GetRootResponseObject interface {
-	VisitGetRootResponse(w http.ResponseWriter) error
-}
+	// Before Непрозрачный курсор: вернуть элементы перед ним
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
 
-type GetRoot200ApplicationHalPlusJSONResponse RootResource
+	// Status Фильтр по статусу сервера
+	Status *ListServersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-func (response GetRoot200ApplicationHalPlusJSONResponse) VisitGetRootResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(200)
+	// ProjectId Фильтр по ID проекта
+	ProjectId *openapi_types.UUID `form:"projectId,omitempty" json:"projectId,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PlanId Фильтр по ID плана
+	PlanId *openapi_types.UUID `form:"planId,omitempty" json:"planId,omitempty"`
 
-type ListPlansRequestObject struct {
-	Params ListPlansParams
-}
+	// Name Поиск по подстроке в имени сервера (без учета регистра)
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
-type ListPlansResponseObject interface {
-	VisitListPlansResponse(w http.ResponseWriter) error
-}
+	// IpAddress Фильтр по любому IPv4- или IPv6-адресу сервера
+	IpAddress *string `form:"ipAddress,omitempty" json:"ipAddress,omitempty"`
 
-type ListPlans200ApplicationHalPlusJSONResponse PlanCollectionResponse
+	// CreatedFrom Серверы, созданные не раньше этого момента
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
 
-func (response ListPlans200ApplicationHalPlusJSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(200)
+	// CreatedTo Серверы, созданные не позже этого момента
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// OrderBy Поле сортировки
+	OrderBy *ListServersParamsOrderBy `form:"orderBy,omitempty" json:"orderBy,omitempty"`
 
-type CreatePlanRequestObject struct {
-	Body *CreatePlanJSONRequestBody
+	// Direction Направление сортировки
+	Direction *ListServersParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
 }
 
-type CreatePlanResponseObject interface {
-	VisitCreatePlanResponse(w http.ResponseWriter) error
-}
+// ListServersParamsStatus defines parameters for ListServers.
+type ListServersParamsStatus string
 
-type CreatePlan201JSONResponse ServerPlan
+// ListServersParamsOrderBy defines parameters for ListServers.
+type ListServersParamsOrderBy string
 
-func (response CreatePlan201JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// ListServersParamsDirection defines parameters for ListServers.
+type ListServersParamsDirection string
 
-	return json.NewEncoder(w).Encode(response)
+// OrderServerParams defines parameters for OrderServer.
+type OrderServerParams struct {
+	// IdempotencyKey Уникальный ключ запроса. Повтор с тем же ключом вернет результат первого запроса, не выполняя его снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-type CreatePlan400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response CreatePlan400JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PerformServerActionParams defines parameters for PerformServerAction.
+type PerformServerActionParams struct {
+	// IdempotencyKey Уникальный ключ запроса. Повтор с тем же ключом вернет результат первого запроса, не выполняя его снова
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// IfMatch ETag сервера, полученный ранее. Действие выполняется, только если сервер с тех пор не изменился
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type GetPlanByIdRequestObject struct {
-	PlanId openapi_types.UUID `json:"planId"`
-}
+// GetServerHistoryParams defines parameters for GetServerHistory.
+type GetServerHistoryParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-type GetPlanByIdResponseObject interface {
-	VisitGetPlanByIdResponse(w http.ResponseWriter) error
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-type GetPlanById200ApplicationHalPlusJSONResponse ServerPlan
+// ListSnapshotsParams defines parameters for ListSnapshots.
+type ListSnapshotsParams struct {
+	// ServerId Фильтр по ID сервера
+	ServerId *openapi_types.UUID `form:"serverId,omitempty" json:"serverId,omitempty"`
 
-func (response GetPlanById200ApplicationHalPlusJSONResponse) VisitGetPlanByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(200)
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-type GetPlanById404JSONResponse StatusResponse
+// ListSshKeysParams defines parameters for ListSshKeys.
+type ListSshKeysParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-func (response GetPlanById404JSONResponse) VisitGetPlanByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ListApiTokensParams defines parameters for ListApiTokens.
+type ListApiTokensParams struct {
+	// Page Номер запрашиваемой страницы
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице.
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
-type ListServersRequestObject struct {
-	Params ListServersParams
+// SetDefaultQuotaJSONRequestBody defines body for SetDefaultQuota for application/json ContentType.
+type SetDefaultQuotaJSONRequestBody = QuotaResources
+
+// SetUserQuotaJSONRequestBody defines body for SetUserQuota for application/json ContentType.
+type SetUserQuotaJSONRequestBody = QuotaResources
+
+// PerformAdminServerActionJSONRequestBody defines body for PerformAdminServerAction for application/json ContentType.
+type PerformAdminServerActionJSONRequestBody = AdminServerActionRequest
+
+// CreatePlanJSONRequestBody defines body for CreatePlan for application/json ContentType.
+type CreatePlanJSONRequestBody = ServerPlanCreateRequest
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectRequest
+
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProjectRequest
+
+// InviteToProjectJSONRequestBody defines body for InviteToProject for application/json ContentType.
+type InviteToProjectJSONRequestBody = CreateInvitationRequest
+
+// SetProjectMemberRoleJSONRequestBody defines body for SetProjectMemberRole for application/json ContentType.
+type SetProjectMemberRoleJSONRequestBody = SetProjectMemberRoleRequest
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = UpdateScheduleRequest
+
+// OrderServerJSONRequestBody defines body for OrderServer for application/json ContentType.
+type OrderServerJSONRequestBody = OrderServerRequest
+
+// BatchServerActionsJSONRequestBody defines body for BatchServerActions for application/json ContentType.
+type BatchServerActionsJSONRequestBody = BatchServerActionRequest
+
+// PerformServerActionJSONRequestBody defines body for PerformServerAction for application/json ContentType.
+type PerformServerActionJSONRequestBody = ServerActionRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = CreateScheduleRequest
+
+// CreateSnapshotJSONRequestBody defines body for CreateSnapshot for application/json ContentType.
+type CreateSnapshotJSONRequestBody = CreateSnapshotRequest
+
+// CreateServerFromSnapshotJSONRequestBody defines body for CreateServerFromSnapshot for application/json ContentType.
+type CreateServerFromSnapshotJSONRequestBody = OrderServerRequest
+
+// CreateSshKeyJSONRequestBody defines body for CreateSshKey for application/json ContentType.
+type CreateSshKeyJSONRequestBody = CreateSshKeyRequest
+
+// CreateApiTokenJSONRequestBody defines body for CreateApiToken for application/json ContentType.
+type CreateApiTokenJSONRequestBody = CreateApiTokenRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Точка входа (Root)
+	// (GET /)
+	GetRoot(w http.ResponseWriter, r *http.Request)
+	// Сверить ресурсы пулов с сервисом ресурсов (только для администраторов)
+	// (GET /admin/capacity)
+	GetCapacityReport(w http.ResponseWriter, r *http.Request)
+	// Сверить ресурсы пулов и исправить счетчики (только для администраторов)
+	// (POST /admin/capacity/repair)
+	RepairCapacity(w http.ResponseWriter, r *http.Request)
+	// Получить список индивидуальных квот (только для администраторов)
+	// (GET /admin/quotas)
+	ListQuotaOverrides(w http.ResponseWriter, r *http.Request, params ListQuotaOverridesParams)
+	// Получить квоту по умолчанию (только для администраторов)
+	// (GET /admin/quotas/default)
+	GetDefaultQuota(w http.ResponseWriter, r *http.Request)
+	// Изменить квоту по умолчанию (только для администраторов)
+	// (PUT /admin/quotas/default)
+	SetDefaultQuota(w http.ResponseWriter, r *http.Request)
+	// Удалить индивидуальную квоту, вернув пользователю квоту по умолчанию (только для администраторов)
+	// (DELETE /admin/quotas/{userId})
+	DeleteUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Получить квоту пользователя и его использование (только для администраторов)
+	// (GET /admin/quotas/{userId})
+	GetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Задать индивидуальную квоту пользователя (только для администраторов)
+	// (PUT /admin/quotas/{userId})
+	SetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Получить список серверов всех пользователей
+	// (GET /admin/servers)
+	ListAllServers(w http.ResponseWriter, r *http.Request, params ListAllServersParams)
+	// Получить детальную информацию о любом сервере
+	// (GET /admin/servers/{serverId})
+	GetAnyServer(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
+	// Выполнить принудительное действие над любым сервером
+	// (POST /admin/servers/{serverId}/actions)
+	PerformAdminServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformAdminServerActionParams)
+	// Получить историю изменений состояния любого сервера
+	// (GET /admin/servers/{serverId}/history)
+	GetAnyServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetAnyServerHistoryParams)
+	// Получить список своих счетов
+	// (GET /billing/invoices)
+	ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams)
+	// Получить счет
+	// (GET /billing/invoices/{invoiceId})
+	GetInvoiceById(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Получить использование за текущий месяц
+	// (GET /billing/usage)
+	GetCurrentUsage(w http.ResponseWriter, r *http.Request)
+	// Получить список действующих приглашений пользователя
+	// (GET /invitations)
+	ListInvitations(w http.ResponseWriter, r *http.Request, params ListInvitationsParams)
+	// Отозвать приглашение
+	// (DELETE /invitations/{invitationId})
+	RevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID)
+	// Принять приглашение
+	// (POST /invitations/{invitationId}/accept)
+	AcceptInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID)
+	// Отклонить приглашение
+	// (POST /invitations/{invitationId}/decline)
+	DeclineInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID)
+	// Получить список доступных планов
+	// (GET /plans)
+	ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams)
+	// Создание нового плана
+	// (POST /plans)
+	CreatePlan(w http.ResponseWriter, r *http.Request)
+	// Получить детальную информацию о плане
+	// (GET /plans/{planId})
+	GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID)
+	// Получить список проектов, в которых состоит пользователь
+	// (GET /projects)
+	ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams)
+	// Создать проект
+	// (POST /projects)
+	CreateProject(w http.ResponseWriter, r *http.Request)
+	// Удалить проект
+	// (DELETE /projects/{projectId})
+	DeleteProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Получить проект
+	// (GET /projects/{projectId})
+	GetProjectById(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Переименовать проект
+	// (PATCH /projects/{projectId})
+	UpdateProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Получить список действующих приглашений в проект
+	// (GET /projects/{projectId}/invitations)
+	ListProjectInvitations(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectInvitationsParams)
+	// Пригласить пользователя в проект
+	// (POST /projects/{projectId}/invitations)
+	InviteToProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Получить список участников проекта
+	// (GET /projects/{projectId}/members)
+	ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectMembersParams)
+	// Исключить участника из проекта
+	// (DELETE /projects/{projectId}/members/{userId})
+	RemoveProjectMember(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID)
+	// Изменить роль участника
+	// (PUT /projects/{projectId}/members/{userId})
+	SetProjectMemberRole(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID)
+	// Получить свою квоту и текущее использование
+	// (GET /quota)
+	GetMyQuota(w http.ResponseWriter, r *http.Request)
+	// Получить список регионов со свободными ресурсами
+	// (GET /regions)
+	ListRegions(w http.ResponseWriter, r *http.Request)
+	// Получить список своих расписаний
+	// (GET /schedules)
+	ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams)
+	// Удалить расписание
+	// (DELETE /schedules/{scheduleId})
+	DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Получить расписание
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Изменить расписание
+	// (PATCH /schedules/{scheduleId})
+	UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Получить список всех заказанных серверов
+	// (GET /servers)
+	ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams)
+	// Заказать новый сервер
+	// (POST /servers)
+	OrderServer(w http.ResponseWriter, r *http.Request, params OrderServerParams)
+	// Выполнить действие над несколькими серверами
+	// (POST /servers/actions:batch)
+	BatchServerActions(w http.ResponseWriter, r *http.Request)
+	// Получить детальную информацию о сервере
+	// (GET /servers/{serverId})
+	GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
+	// Выполнить действие над сервером
+	// (POST /servers/{serverId}/actions)
+	PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformServerActionParams)
+	// Получить историю изменений состояния сервера
+	// (GET /servers/{serverId}/history)
+	GetServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetServerHistoryParams)
+	// Создать расписание для сервера
+	// (POST /servers/{serverId}/schedules)
+	CreateSchedule(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
+	// Создать снимок остановленного сервера
+	// (POST /servers/{serverId}/snapshots)
+	CreateSnapshot(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID)
+	// Получить список своих снимков
+	// (GET /snapshots)
+	ListSnapshots(w http.ResponseWriter, r *http.Request, params ListSnapshotsParams)
+	// Удалить снимок
+	// (DELETE /snapshots/{snapshotId})
+	DeleteSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID)
+	// Получить снимок
+	// (GET /snapshots/{snapshotId})
+	GetSnapshotById(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID)
+	// Восстановить сервер из снимка
+	// (POST /snapshots/{snapshotId}/restore)
+	RestoreSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID)
+	// Заказать новый сервер из снимка
+	// (POST /snapshots/{snapshotId}/servers)
+	CreateServerFromSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID)
+	// Получить список своих SSH-ключей
+	// (GET /ssh-keys)
+	ListSshKeys(w http.ResponseWriter, r *http.Request, params ListSshKeysParams)
+	// Добавить публичный SSH-ключ
+	// (POST /ssh-keys)
+	CreateSshKey(w http.ResponseWriter, r *http.Request)
+	// Удалить SSH-ключ
+	// (DELETE /ssh-keys/{keyId})
+	DeleteSshKey(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID)
+	// Получить SSH-ключ
+	// (GET /ssh-keys/{keyId})
+	GetSshKeyById(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID)
+	// Получить список своих API-токенов
+	// (GET /tokens)
+	ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams)
+	// Создать API-токен
+	// (POST /tokens)
+	CreateApiToken(w http.ResponseWriter, r *http.Request)
+	// Отозвать API-токен
+	// (DELETE /tokens/{tokenId})
+	RevokeApiToken(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID)
+	// Получить API-токен
+	// (GET /tokens/{tokenId})
+	GetApiTokenById(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID)
 }
 
-type ListServersResponseObject interface {
-	VisitListServersResponse(w http.ResponseWriter) error
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Точка входа (Root)
+// (GET /)
+func (_ Unimplemented) GetRoot(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ListServers200ApplicationHalPlusJSONResponse ServerCollectionResponse
+// Сверить ресурсы пулов с сервисом ресурсов (только для администраторов)
+// (GET /admin/capacity)
+func (_ Unimplemented) GetCapacityReport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response ListServers200ApplicationHalPlusJSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(200)
+// Сверить ресурсы пулов и исправить счетчики (только для администраторов)
+// (POST /admin/capacity/repair)
+func (_ Unimplemented) RepairCapacity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Получить список индивидуальных квот (только для администраторов)
+// (GET /admin/quotas)
+func (_ Unimplemented) ListQuotaOverrides(w http.ResponseWriter, r *http.Request, params ListQuotaOverridesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type OrderServerRequestObject struct {
-	Body *OrderServerJSONRequestBody
+// Получить квоту по умолчанию (только для администраторов)
+// (GET /admin/quotas/default)
+func (_ Unimplemented) GetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type OrderServerResponseObject interface {
-	VisitOrderServerResponse(w http.ResponseWriter) error
+// Изменить квоту по умолчанию (только для администраторов)
+// (PUT /admin/quotas/default)
+func (_ Unimplemented) SetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type OrderServer202ApplicationHalPlusJSONResponse Server
+// Удалить индивидуальную квоту, вернув пользователю квоту по умолчанию (только для администраторов)
+// (DELETE /admin/quotas/{userId})
+func (_ Unimplemented) DeleteUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response OrderServer202ApplicationHalPlusJSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(202)
+// Получить квоту пользователя и его использование (только для администраторов)
+// (GET /admin/quotas/{userId})
+func (_ Unimplemented) GetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Задать индивидуальную квоту пользователя (только для администраторов)
+// (PUT /admin/quotas/{userId})
+func (_ Unimplemented) SetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type OrderServer400JSONResponse struct{ BadRequestJSONResponse }
+// Получить список серверов всех пользователей
+// (GET /admin/servers)
+func (_ Unimplemented) ListAllServers(w http.ResponseWriter, r *http.Request, params ListAllServersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response OrderServer400JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// Получить детальную информацию о любом сервере
+// (GET /admin/servers/{serverId})
+func (_ Unimplemented) GetAnyServer(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Выполнить принудительное действие над любым сервером
+// (POST /admin/servers/{serverId}/actions)
+func (_ Unimplemented) PerformAdminServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformAdminServerActionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type OrderServer409JSONResponse struct{ ConflictJSONResponse }
+// Получить историю изменений состояния любого сервера
+// (GET /admin/servers/{serverId}/history)
+func (_ Unimplemented) GetAnyServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetAnyServerHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response OrderServer409JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// Получить список своих счетов
+// (GET /billing/invoices)
+func (_ Unimplemented) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Получить счет
+// (GET /billing/invoices/{invoiceId})
+func (_ Unimplemented) GetInvoiceById(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetServerByIdRequestObject struct {
-	ServerId openapi_types.UUID `json:"serverId"`
+// Получить использование за текущий месяц
+// (GET /billing/usage)
+func (_ Unimplemented) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список действующих приглашений пользователя
+// (GET /invitations)
+func (_ Unimplemented) ListInvitations(w http.ResponseWriter, r *http.Request, params ListInvitationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать приглашение
+// (DELETE /invitations/{invitationId})
+func (_ Unimplemented) RevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Принять приглашение
+// (POST /invitations/{invitationId}/accept)
+func (_ Unimplemented) AcceptInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отклонить приглашение
+// (POST /invitations/{invitationId}/decline)
+func (_ Unimplemented) DeclineInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список доступных планов
+// (GET /plans)
+func (_ Unimplemented) ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание нового плана
+// (POST /plans)
+func (_ Unimplemented) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить детальную информацию о плане
+// (GET /plans/{planId})
+func (_ Unimplemented) GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список проектов, в которых состоит пользователь
+// (GET /projects)
+func (_ Unimplemented) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать проект
+// (POST /projects)
+func (_ Unimplemented) CreateProject(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить проект
+// (DELETE /projects/{projectId})
+func (_ Unimplemented) DeleteProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить проект
+// (GET /projects/{projectId})
+func (_ Unimplemented) GetProjectById(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать проект
+// (PATCH /projects/{projectId})
+func (_ Unimplemented) UpdateProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список действующих приглашений в проект
+// (GET /projects/{projectId}/invitations)
+func (_ Unimplemented) ListProjectInvitations(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectInvitationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пригласить пользователя в проект
+// (POST /projects/{projectId}/invitations)
+func (_ Unimplemented) InviteToProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список участников проекта
+// (GET /projects/{projectId}/members)
+func (_ Unimplemented) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исключить участника из проекта
+// (DELETE /projects/{projectId}/members/{userId})
+func (_ Unimplemented) RemoveProjectMember(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить роль участника
+// (PUT /projects/{projectId}/members/{userId})
+func (_ Unimplemented) SetProjectMemberRole(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить свою квоту и текущее использование
+// (GET /quota)
+func (_ Unimplemented) GetMyQuota(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список регионов со свободными ресурсами
+// (GET /regions)
+func (_ Unimplemented) ListRegions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список своих расписаний
+// (GET /schedules)
+func (_ Unimplemented) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить расписание
+// (DELETE /schedules/{scheduleId})
+func (_ Unimplemented) DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить расписание
+// (GET /schedules/{scheduleId})
+func (_ Unimplemented) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить расписание
+// (PATCH /schedules/{scheduleId})
+func (_ Unimplemented) UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список всех заказанных серверов
+// (GET /servers)
+func (_ Unimplemented) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заказать новый сервер
+// (POST /servers)
+func (_ Unimplemented) OrderServer(w http.ResponseWriter, r *http.Request, params OrderServerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выполнить действие над несколькими серверами
+// (POST /servers/actions:batch)
+func (_ Unimplemented) BatchServerActions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить детальную информацию о сервере
+// (GET /servers/{serverId})
+func (_ Unimplemented) GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выполнить действие над сервером
+// (POST /servers/{serverId}/actions)
+func (_ Unimplemented) PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformServerActionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить историю изменений состояния сервера
+// (GET /servers/{serverId}/history)
+func (_ Unimplemented) GetServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetServerHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать расписание для сервера
+// (POST /servers/{serverId}/schedules)
+func (_ Unimplemented) CreateSchedule(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать снимок остановленного сервера
+// (POST /servers/{serverId}/snapshots)
+func (_ Unimplemented) CreateSnapshot(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список своих снимков
+// (GET /snapshots)
+func (_ Unimplemented) ListSnapshots(w http.ResponseWriter, r *http.Request, params ListSnapshotsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить снимок
+// (DELETE /snapshots/{snapshotId})
+func (_ Unimplemented) DeleteSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить снимок
+// (GET /snapshots/{snapshotId})
+func (_ Unimplemented) GetSnapshotById(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить сервер из снимка
+// (POST /snapshots/{snapshotId}/restore)
+func (_ Unimplemented) RestoreSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заказать новый сервер из снимка
+// (POST /snapshots/{snapshotId}/servers)
+func (_ Unimplemented) CreateServerFromSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetServerByIdResponseObject interface {
-	VisitGetServerByIdResponse(w http.ResponseWriter) error
+// Получить список своих SSH-ключей
+// (GET /ssh-keys)
+func (_ Unimplemented) ListSshKeys(w http.ResponseWriter, r *http.Request, params ListSshKeysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить публичный SSH-ключ
+// (POST /ssh-keys)
+func (_ Unimplemented) CreateSshKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить SSH-ключ
+// (DELETE /ssh-keys/{keyId})
+func (_ Unimplemented) DeleteSshKey(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить SSH-ключ
+// (GET /ssh-keys/{keyId})
+func (_ Unimplemented) GetSshKeyById(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список своих API-токенов
+// (GET /tokens)
+func (_ Unimplemented) ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать API-токен
+// (POST /tokens)
+func (_ Unimplemented) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API-токен
+// (DELETE /tokens/{tokenId})
+func (_ Unimplemented) RevokeApiToken(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить API-токен
+// (GET /tokens/{tokenId})
+func (_ Unimplemented) GetApiTokenById(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetRoot operation middleware
+func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapacityReport operation middleware
+func (siw *ServerInterfaceWrapper) GetCapacityReport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapacityReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RepairCapacity operation middleware
+func (siw *ServerInterfaceWrapper) RepairCapacity(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RepairCapacity(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuotaOverrides operation middleware
+func (siw *ServerInterfaceWrapper) ListQuotaOverrides(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuotaOverridesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuotaOverrides(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDefaultQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDefaultQuota(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetDefaultQuota operation middleware
+func (siw *ServerInterfaceWrapper) SetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDefaultQuota(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserQuota operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserQuota(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserQuota(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetUserQuota(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserQuota(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserQuota operation middleware
+func (siw *ServerInterfaceWrapper) SetUserQuota(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserQuota(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAllServers operation middleware
+func (siw *ServerInterfaceWrapper) ListAllServers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllServersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ownerId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ownerId", r.URL.Query(), &params.OwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "poolId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "poolId", r.URL.Query(), &params.PoolId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "poolId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "planId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "planId", r.URL.Query(), &params.PlanId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", r.URL.Query(), &params.Direction)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "direction", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAllServers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAnyServer operation middleware
+func (siw *ServerInterfaceWrapper) GetAnyServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnyServer(w, r, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PerformAdminServerAction operation middleware
+func (siw *ServerInterfaceWrapper) PerformAdminServerAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PerformAdminServerActionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PerformAdminServerAction(w, r, serverId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAnyServerHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetAnyServerHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnyServerHistoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnyServerHistory(w, r, serverId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInvoices operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInvoicesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvoices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInvoiceById operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoiceById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInvoiceById(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUsage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInvitations operation middleware
+func (siw *ServerInterfaceWrapper) ListInvitations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInvitationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvitations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeInvitation(w, r, invitationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptInvitation(w, r, invitationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeclineInvitation operation middleware
+func (siw *ServerInterfaceWrapper) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeclineInvitation(w, r, invitationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPlans operation middleware
+func (siw *ServerInterfaceWrapper) ListPlans(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPlansParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlans(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePlan operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlan(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePlan(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlanById operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "planId" -------------
+	var planId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", chi.URLParam(r, "planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanById(w, r, planId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProject(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectById operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectById(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProject(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectInvitations operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectInvitations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectInvitationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectInvitations(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteToProject operation middleware
+func (siw *ServerInterfaceWrapper) InviteToProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteToProject(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectMembersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectMembers(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveProjectMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveProjectMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveProjectMember(w, r, projectId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectMemberRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectMemberRole(w, r, projectId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetMyQuota(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyQuota(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegions operation middleware
+func (siw *ServerInterfaceWrapper) ListRegions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSchedulesParams
+
+	// ------------- Optional query parameter "serverId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "serverId", r.URL.Query(), &params.ServerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSchedules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSchedule(w, r, scheduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduleById(w, r, scheduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchedule(w, r, scheduleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServers operation middleware
+func (siw *ServerInterfaceWrapper) ListServers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "planId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "planId", r.URL.Query(), &params.PlanId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ipAddress" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ipAddress", r.URL.Query(), &params.IpAddress)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ipAddress", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "orderBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderBy", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", r.URL.Query(), &params.Direction)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "direction", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrderServer operation middleware
+func (siw *ServerInterfaceWrapper) OrderServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrderServerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrderServer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BatchServerActions operation middleware
+func (siw *ServerInterfaceWrapper) BatchServerActions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchServerActions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerById operation middleware
+func (siw *ServerInterfaceWrapper) GetServerById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerById(w, r, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PerformServerAction operation middleware
+func (siw *ServerInterfaceWrapper) PerformServerAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PerformServerActionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PerformServerAction(w, r, serverId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetServerHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServerHistoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerHistory(w, r, serverId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSchedule(w, r, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSnapshot(w, r, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSnapshotsParams
+
+	// ------------- Optional query parameter "serverId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "serverId", r.URL.Query(), &params.ServerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSnapshots(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSnapshot(w, r, snapshotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSnapshotById operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshotById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSnapshotById(w, r, snapshotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) RestoreSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreSnapshot(w, r, snapshotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServerFromSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateServerFromSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServerFromSnapshot(w, r, snapshotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSshKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListSshKeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSshKeysParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSshKeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSshKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateSshKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSshKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSshKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSshKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", chi.URLParam(r, "keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSshKey(w, r, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSshKeyById operation middleware
+func (siw *ServerInterfaceWrapper) GetSshKeyById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", chi.URLParam(r, "keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSshKeyById(w, r, keyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApiTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListApiTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApiTokensParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApiTokens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApiToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApiToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeApiToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", chi.URLParam(r, "tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiToken(w, r, tokenId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiTokenById operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTokenById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", chi.URLParam(r, "tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiTokenById(w, r, tokenId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/", wrapper.GetRoot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/capacity", wrapper.GetCapacityReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/capacity/repair", wrapper.RepairCapacity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/quotas", wrapper.ListQuotaOverrides)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/quotas/default", wrapper.GetDefaultQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/quotas/default", wrapper.SetDefaultQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/quotas/{userId}", wrapper.DeleteUserQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/quotas/{userId}", wrapper.GetUserQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/quotas/{userId}", wrapper.SetUserQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/servers", wrapper.ListAllServers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/servers/{serverId}", wrapper.GetAnyServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/servers/{serverId}/actions", wrapper.PerformAdminServerAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/servers/{serverId}/history", wrapper.GetAnyServerHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/billing/invoices", wrapper.ListInvoices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/billing/invoices/{invoiceId}", wrapper.GetInvoiceById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/billing/usage", wrapper.GetCurrentUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/invitations", wrapper.ListInvitations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/invitations/{invitationId}", wrapper.RevokeInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations/{invitationId}/accept", wrapper.AcceptInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/invitations/{invitationId}/decline", wrapper.DeclineInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/plans", wrapper.ListPlans)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/plans", wrapper.CreatePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/plans/{planId}", wrapper.GetPlanById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects", wrapper.ListProjects)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects", wrapper.CreateProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}", wrapper.DeleteProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}", wrapper.GetProjectById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}", wrapper.UpdateProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/invitations", wrapper.ListProjectInvitations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/invitations", wrapper.InviteToProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/members", wrapper.ListProjectMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/members/{userId}", wrapper.RemoveProjectMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/projects/{projectId}/members/{userId}", wrapper.SetProjectMemberRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quota", wrapper.GetMyQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/regions", wrapper.ListRegions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules", wrapper.ListSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/schedules/{scheduleId}", wrapper.DeleteSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules/{scheduleId}", wrapper.GetScheduleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/schedules/{scheduleId}", wrapper.UpdateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/servers", wrapper.ListServers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/servers", wrapper.OrderServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/servers/actions:batch", wrapper.BatchServerActions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/servers/{serverId}", wrapper.GetServerById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/servers/{serverId}/actions", wrapper.PerformServerAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/servers/{serverId}/history", wrapper.GetServerHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/servers/{serverId}/schedules", wrapper.CreateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/servers/{serverId}/snapshots", wrapper.CreateSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/snapshots", wrapper.ListSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/snapshots/{snapshotId}", wrapper.DeleteSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/snapshots/{snapshotId}", wrapper.GetSnapshotById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/snapshots/{snapshotId}/restore", wrapper.RestoreSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/snapshots/{snapshotId}/servers", wrapper.CreateServerFromSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ssh-keys", wrapper.ListSshKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ssh-keys", wrapper.CreateSshKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/ssh-keys/{keyId}", wrapper.DeleteSshKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ssh-keys/{keyId}", wrapper.GetSshKeyById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tokens", wrapper.ListApiTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tokens", wrapper.CreateApiToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tokens/{tokenId}", wrapper.RevokeApiToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tokens/{tokenId}", wrapper.GetApiTokenById)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse StatusResponse
+
+type ConflictJSONResponse StatusResponse
+
+type ForbiddenJSONResponse StatusResponse
+
+type IdempotencyKeyReusedJSONResponse StatusResponse
+
+type NotFoundJSONResponse StatusResponse
+
+type GetRootRequestObject struct {
+}
+
+type GetRootResponseObject interface {
+	VisitGetRootResponse(w http.ResponseWriter) error
+}
+
+type GetRoot200ApplicationHalPlusJSONResponse RootResource
+
+func (response GetRoot200ApplicationHalPlusJSONResponse) VisitGetRootResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacityReportRequestObject struct {
+}
+
+type GetCapacityReportResponseObject interface {
+	VisitGetCapacityReportResponse(w http.ResponseWriter) error
+}
+
+type GetCapacityReport200JSONResponse CapacityReport
+
+func (response GetCapacityReport200JSONResponse) VisitGetCapacityReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RepairCapacityRequestObject struct {
+}
+
+type RepairCapacityResponseObject interface {
+	VisitRepairCapacityResponse(w http.ResponseWriter) error
+}
+
+type RepairCapacity200JSONResponse CapacityReport
+
+func (response RepairCapacity200JSONResponse) VisitRepairCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotaOverridesRequestObject struct {
+	Params ListQuotaOverridesParams
+}
+
+type ListQuotaOverridesResponseObject interface {
+	VisitListQuotaOverridesResponse(w http.ResponseWriter) error
+}
+
+type ListQuotaOverrides200ApplicationHalPlusJSONResponse QuotaOverrideCollectionResponse
+
+func (response ListQuotaOverrides200ApplicationHalPlusJSONResponse) VisitListQuotaOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDefaultQuotaRequestObject struct {
+}
+
+type GetDefaultQuotaResponseObject interface {
+	VisitGetDefaultQuotaResponse(w http.ResponseWriter) error
+}
+
+type GetDefaultQuota200JSONResponse QuotaResources
+
+func (response GetDefaultQuota200JSONResponse) VisitGetDefaultQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDefaultQuotaRequestObject struct {
+	Body *SetDefaultQuotaJSONRequestBody
+}
+
+type SetDefaultQuotaResponseObject interface {
+	VisitSetDefaultQuotaResponse(w http.ResponseWriter) error
+}
+
+type SetDefaultQuota200JSONResponse QuotaResources
+
+func (response SetDefaultQuota200JSONResponse) VisitSetDefaultQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetDefaultQuota400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetDefaultQuota400JSONResponse) VisitSetDefaultQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserQuotaRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type DeleteUserQuotaResponseObject interface {
+	VisitDeleteUserQuotaResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserQuota204Response struct {
+}
+
+func (response DeleteUserQuota204Response) VisitDeleteUserQuotaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUserQuota404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteUserQuota404JSONResponse) VisitDeleteUserQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserQuotaRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type GetUserQuotaResponseObject interface {
+	VisitGetUserQuotaResponse(w http.ResponseWriter) error
+}
+
+type GetUserQuota200JSONResponse Quota
+
+func (response GetUserQuota200JSONResponse) VisitGetUserQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserQuotaRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *SetUserQuotaJSONRequestBody
+}
+
+type SetUserQuotaResponseObject interface {
+	VisitSetUserQuotaResponse(w http.ResponseWriter) error
+}
+
+type SetUserQuota200JSONResponse QuotaOverride
+
+func (response SetUserQuota200JSONResponse) VisitSetUserQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserQuota400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserQuota400JSONResponse) VisitSetUserQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAllServersRequestObject struct {
+	Params ListAllServersParams
+}
+
+type ListAllServersResponseObject interface {
+	VisitListAllServersResponse(w http.ResponseWriter) error
+}
+
+type ListAllServers200ApplicationHalPlusJSONResponse ServerCollectionResponse
+
+func (response ListAllServers200ApplicationHalPlusJSONResponse) VisitListAllServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAllServers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAllServers400JSONResponse) VisitListAllServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnyServerRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+}
+
+type GetAnyServerResponseObject interface {
+	VisitGetAnyServerResponse(w http.ResponseWriter) error
+}
+
+type GetAnyServer200ResponseHeaders struct {
+	ETag string
+}
+
+type GetAnyServer200ApplicationHalPlusJSONResponse struct {
+	Body    Server
+	Headers GetAnyServer200ResponseHeaders
+}
+
+func (response GetAnyServer200ApplicationHalPlusJSONResponse) VisitGetAnyServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnyServer404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAnyServer404JSONResponse) VisitGetAnyServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformAdminServerActionRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Params   PerformAdminServerActionParams
+	Body     *PerformAdminServerActionJSONRequestBody
+}
+
+type PerformAdminServerActionResponseObject interface {
+	VisitPerformAdminServerActionResponse(w http.ResponseWriter) error
+}
+
+type PerformAdminServerAction202ResponseHeaders struct {
+	ETag string
+}
+
+type PerformAdminServerAction202JSONResponse struct {
+	Body    Server
+	Headers PerformAdminServerAction202ResponseHeaders
+}
+
+func (response PerformAdminServerAction202JSONResponse) VisitPerformAdminServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PerformAdminServerAction400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PerformAdminServerAction400JSONResponse) VisitPerformAdminServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformAdminServerAction404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PerformAdminServerAction404JSONResponse) VisitPerformAdminServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformAdminServerAction409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PerformAdminServerAction409JSONResponse) VisitPerformAdminServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnyServerHistoryRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Params   GetAnyServerHistoryParams
+}
+
+type GetAnyServerHistoryResponseObject interface {
+	VisitGetAnyServerHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetAnyServerHistory200ApplicationHalPlusJSONResponse ServerHistoryResponse
+
+func (response GetAnyServerHistory200ApplicationHalPlusJSONResponse) VisitGetAnyServerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnyServerHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAnyServerHistory404JSONResponse) VisitGetAnyServerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoicesRequestObject struct {
+	Params ListInvoicesParams
+}
+
+type ListInvoicesResponseObject interface {
+	VisitListInvoicesResponse(w http.ResponseWriter) error
+}
+
+type ListInvoices200ApplicationHalPlusJSONResponse InvoiceCollectionResponse
+
+func (response ListInvoices200ApplicationHalPlusJSONResponse) VisitListInvoicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvoiceByIdRequestObject struct {
+	InvoiceId openapi_types.UUID `json:"invoiceId"`
+}
+
+type GetInvoiceByIdResponseObject interface {
+	VisitGetInvoiceByIdResponse(w http.ResponseWriter) error
+}
+
+type GetInvoiceById200ApplicationHalPlusJSONResponse Invoice
+
+func (response GetInvoiceById200ApplicationHalPlusJSONResponse) VisitGetInvoiceByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvoiceById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetInvoiceById404JSONResponse) VisitGetInvoiceByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUsageRequestObject struct {
+}
+
+type GetCurrentUsageResponseObject interface {
+	VisitGetCurrentUsageResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUsage200ApplicationHalPlusJSONResponse UsageStatement
+
+func (response GetCurrentUsage200ApplicationHalPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvitationsRequestObject struct {
+	Params ListInvitationsParams
+}
+
+type ListInvitationsResponseObject interface {
+	VisitListInvitationsResponse(w http.ResponseWriter) error
+}
+
+type ListInvitations200ApplicationHalPlusJSONResponse InvitationCollectionResponse
+
+func (response ListInvitations200ApplicationHalPlusJSONResponse) VisitListInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvitationRequestObject struct {
+	InvitationId openapi_types.UUID `json:"invitationId"`
+}
+
+type RevokeInvitationResponseObject interface {
+	VisitRevokeInvitationResponse(w http.ResponseWriter) error
+}
+
+type RevokeInvitation204Response struct {
+}
+
+func (response RevokeInvitation204Response) VisitRevokeInvitationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeInvitation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeInvitation400JSONResponse) VisitRevokeInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvitation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeInvitation404JSONResponse) VisitRevokeInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitationRequestObject struct {
+	InvitationId openapi_types.UUID `json:"invitationId"`
+}
+
+type AcceptInvitationResponseObject interface {
+	VisitAcceptInvitationResponse(w http.ResponseWriter) error
+}
+
+type AcceptInvitation200ApplicationHalPlusJSONResponse ProjectMember
+
+func (response AcceptInvitation200ApplicationHalPlusJSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AcceptInvitation400JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptInvitation404JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineInvitationRequestObject struct {
+	InvitationId openapi_types.UUID `json:"invitationId"`
+}
+
+type DeclineInvitationResponseObject interface {
+	VisitDeclineInvitationResponse(w http.ResponseWriter) error
+}
+
+type DeclineInvitation204Response struct {
+}
+
+func (response DeclineInvitation204Response) VisitDeclineInvitationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeclineInvitation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeclineInvitation400JSONResponse) VisitDeclineInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineInvitation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeclineInvitation404JSONResponse) VisitDeclineInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlansRequestObject struct {
+	Params ListPlansParams
+}
+
+type ListPlansResponseObject interface {
+	VisitListPlansResponse(w http.ResponseWriter) error
+}
+
+type ListPlans200ApplicationHalPlusJSONResponse PlanCollectionResponse
+
+func (response ListPlans200ApplicationHalPlusJSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlans400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPlans400JSONResponse) VisitListPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlanRequestObject struct {
+	Body *CreatePlanJSONRequestBody
+}
+
+type CreatePlanResponseObject interface {
+	VisitCreatePlanResponse(w http.ResponseWriter) error
+}
+
+type CreatePlan201JSONResponse ServerPlan
+
+func (response CreatePlan201JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlan400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePlan400JSONResponse) VisitCreatePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanByIdRequestObject struct {
+	PlanId openapi_types.UUID `json:"planId"`
+}
+
+type GetPlanByIdResponseObject interface {
+	VisitGetPlanByIdResponse(w http.ResponseWriter) error
+}
+
+type GetPlanById200ApplicationHalPlusJSONResponse ServerPlan
+
+func (response GetPlanById200ApplicationHalPlusJSONResponse) VisitGetPlanByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlanById404JSONResponse StatusResponse
+
+func (response GetPlanById404JSONResponse) VisitGetPlanByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectsRequestObject struct {
+	Params ListProjectsParams
+}
+
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
+
+type ListProjects200ApplicationHalPlusJSONResponse ProjectCollectionResponse
+
+func (response ListProjects200ApplicationHalPlusJSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProjectRequestObject struct {
+	Body *CreateProjectJSONRequestBody
+}
+
+type CreateProjectResponseObject interface {
+	VisitCreateProjectResponse(w http.ResponseWriter) error
+}
+
+type CreateProject201ApplicationHalPlusJSONResponse Project
+
+func (response CreateProject201ApplicationHalPlusJSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateProject400JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject204Response struct {
+}
+
+func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProject400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteProject400JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeleteProject409JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectByIdRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+}
+
+type GetProjectByIdResponseObject interface {
+	VisitGetProjectByIdResponse(w http.ResponseWriter) error
+}
+
+type GetProjectById200ApplicationHalPlusJSONResponse Project
+
+func (response GetProjectById200ApplicationHalPlusJSONResponse) VisitGetProjectByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetProjectById404JSONResponse) VisitGetProjectByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProjectRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Body      *UpdateProjectJSONRequestBody
+}
+
+type UpdateProjectResponseObject interface {
+	VisitUpdateProjectResponse(w http.ResponseWriter) error
+}
+
+type UpdateProject200ApplicationHalPlusJSONResponse Project
+
+func (response UpdateProject200ApplicationHalPlusJSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateProject400JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectInvitationsRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Params    ListProjectInvitationsParams
+}
+
+type ListProjectInvitationsResponseObject interface {
+	VisitListProjectInvitationsResponse(w http.ResponseWriter) error
+}
+
+type ListProjectInvitations200ApplicationHalPlusJSONResponse InvitationCollectionResponse
+
+func (response ListProjectInvitations200ApplicationHalPlusJSONResponse) VisitListProjectInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectInvitations404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListProjectInvitations404JSONResponse) VisitListProjectInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteToProjectRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Body      *InviteToProjectJSONRequestBody
+}
+
+type InviteToProjectResponseObject interface {
+	VisitInviteToProjectResponse(w http.ResponseWriter) error
+}
+
+type InviteToProject201ApplicationHalPlusJSONResponse Invitation
+
+func (response InviteToProject201ApplicationHalPlusJSONResponse) VisitInviteToProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteToProject400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response InviteToProject400JSONResponse) VisitInviteToProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteToProject404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response InviteToProject404JSONResponse) VisitInviteToProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteToProject409JSONResponse struct{ ConflictJSONResponse }
+
+func (response InviteToProject409JSONResponse) VisitInviteToProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectMembersRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Params    ListProjectMembersParams
+}
+
+type ListProjectMembersResponseObject interface {
+	VisitListProjectMembersResponse(w http.ResponseWriter) error
+}
+
+type ListProjectMembers200ApplicationHalPlusJSONResponse ProjectMemberCollectionResponse
+
+func (response ListProjectMembers200ApplicationHalPlusJSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListProjectMembers404JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectMemberRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	UserId    openapi_types.UUID `json:"userId"`
+}
+
+type RemoveProjectMemberResponseObject interface {
+	VisitRemoveProjectMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveProjectMember204Response struct {
+}
+
+func (response RemoveProjectMember204Response) VisitRemoveProjectMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveProjectMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveProjectMember400JSONResponse) VisitRemoveProjectMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveProjectMember404JSONResponse) VisitRemoveProjectMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetProjectMemberRoleRequestObject struct {
+	ProjectId openapi_types.UUID `json:"projectId"`
+	UserId    openapi_types.UUID `json:"userId"`
+	Body      *SetProjectMemberRoleJSONRequestBody
+}
+
+type SetProjectMemberRoleResponseObject interface {
+	VisitSetProjectMemberRoleResponse(w http.ResponseWriter) error
+}
+
+type SetProjectMemberRole200ApplicationHalPlusJSONResponse ProjectMember
+
+func (response SetProjectMemberRole200ApplicationHalPlusJSONResponse) VisitSetProjectMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetProjectMemberRole400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetProjectMemberRole400JSONResponse) VisitSetProjectMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetProjectMemberRole404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetProjectMemberRole404JSONResponse) VisitSetProjectMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyQuotaRequestObject struct {
+}
+
+type GetMyQuotaResponseObject interface {
+	VisitGetMyQuotaResponse(w http.ResponseWriter) error
+}
+
+type GetMyQuota200JSONResponse Quota
+
+func (response GetMyQuota200JSONResponse) VisitGetMyQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegionsRequestObject struct {
+}
+
+type ListRegionsResponseObject interface {
+	VisitListRegionsResponse(w http.ResponseWriter) error
+}
+
+type ListRegions200JSONResponse RegionCollectionResponse
+
+func (response ListRegions200JSONResponse) VisitListRegionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchedulesRequestObject struct {
+	Params ListSchedulesParams
+}
+
+type ListSchedulesResponseObject interface {
+	VisitListSchedulesResponse(w http.ResponseWriter) error
+}
+
+type ListSchedules200ApplicationHalPlusJSONResponse ScheduleCollectionResponse
+
+func (response ListSchedules200ApplicationHalPlusJSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScheduleRequestObject struct {
+	ScheduleId openapi_types.UUID `json:"scheduleId"`
+}
+
+type DeleteScheduleResponseObject interface {
+	VisitDeleteScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeleteSchedule204Response struct {
+}
+
+func (response DeleteSchedule204Response) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSchedule404JSONResponse) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleByIdRequestObject struct {
+	ScheduleId openapi_types.UUID `json:"scheduleId"`
+}
+
+type GetScheduleByIdResponseObject interface {
+	VisitGetScheduleByIdResponse(w http.ResponseWriter) error
+}
+
+type GetScheduleById200ApplicationHalPlusJSONResponse Schedule
+
+func (response GetScheduleById200ApplicationHalPlusJSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetScheduleById404JSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateScheduleRequestObject struct {
+	ScheduleId openapi_types.UUID `json:"scheduleId"`
+	Body       *UpdateScheduleJSONRequestBody
+}
+
+type UpdateScheduleResponseObject interface {
+	VisitUpdateScheduleResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchedule200ApplicationHalPlusJSONResponse Schedule
+
+func (response UpdateSchedule200ApplicationHalPlusJSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSchedule400JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateSchedule404JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServersRequestObject struct {
+	Params ListServersParams
+}
+
+type ListServersResponseObject interface {
+	VisitListServersResponse(w http.ResponseWriter) error
+}
+
+type ListServers200ApplicationHalPlusJSONResponse ServerCollectionResponse
+
+func (response ListServers200ApplicationHalPlusJSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListServers400JSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OrderServerRequestObject struct {
+	Params OrderServerParams
+	Body   *OrderServerJSONRequestBody
+}
+
+type OrderServerResponseObject interface {
+	VisitOrderServerResponse(w http.ResponseWriter) error
+}
+
+type OrderServer202ApplicationHalPlusJSONResponse Server
+
+func (response OrderServer202ApplicationHalPlusJSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OrderServer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response OrderServer400JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OrderServer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response OrderServer403JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OrderServer409JSONResponse struct{ ConflictJSONResponse }
+
+func (response OrderServer409JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OrderServer422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response OrderServer422JSONResponse) VisitOrderServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchServerActionsRequestObject struct {
+	Body *BatchServerActionsJSONRequestBody
+}
+
+type BatchServerActionsResponseObject interface {
+	VisitBatchServerActionsResponse(w http.ResponseWriter) error
+}
+
+type BatchServerActions200JSONResponse BatchServerActionResponse
+
+func (response BatchServerActions200JSONResponse) VisitBatchServerActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchServerActions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BatchServerActions400JSONResponse) VisitBatchServerActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerByIdRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+}
+
+type GetServerByIdResponseObject interface {
+	VisitGetServerByIdResponse(w http.ResponseWriter) error
+}
+
+type GetServerById200ResponseHeaders struct {
+	ETag string
+}
+
+type GetServerById200ApplicationHalPlusJSONResponse struct {
+	Body    Server
+	Headers GetServerById200ResponseHeaders
+}
+
+func (response GetServerById200ApplicationHalPlusJSONResponse) VisitGetServerByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServerById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetServerById404JSONResponse) VisitGetServerByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformServerActionRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Params   PerformServerActionParams
+	Body     *PerformServerActionJSONRequestBody
+}
+
+type PerformServerActionResponseObject interface {
+	VisitPerformServerActionResponse(w http.ResponseWriter) error
+}
+
+type PerformServerAction202ResponseHeaders struct {
+	ETag string
+}
+
+type PerformServerAction202JSONResponse struct {
+	Body    Server
+	Headers PerformServerAction202ResponseHeaders
+}
+
+func (response PerformServerAction202JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PerformServerAction400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PerformServerAction400JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformServerAction403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PerformServerAction403JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformServerAction404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PerformServerAction404JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformServerAction409JSONResponse StatusResponse
+
+func (response PerformServerAction409JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PerformServerAction422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response PerformServerAction422JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerHistoryRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Params   GetServerHistoryParams
+}
+
+type GetServerHistoryResponseObject interface {
+	VisitGetServerHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetServerHistory200ApplicationHalPlusJSONResponse ServerHistoryResponse
+
+func (response GetServerHistory200ApplicationHalPlusJSONResponse) VisitGetServerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetServerHistory404JSONResponse) VisitGetServerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScheduleRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Body     *CreateScheduleJSONRequestBody
+}
+
+type CreateScheduleResponseObject interface {
+	VisitCreateScheduleResponse(w http.ResponseWriter) error
+}
+
+type CreateSchedule201ApplicationHalPlusJSONResponse Schedule
+
+func (response CreateSchedule201ApplicationHalPlusJSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSchedule400JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateSchedule404JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSnapshotRequestObject struct {
+	ServerId openapi_types.UUID `json:"serverId"`
+	Body     *CreateSnapshotJSONRequestBody
+}
+
+type CreateSnapshotResponseObject interface {
+	VisitCreateSnapshotResponse(w http.ResponseWriter) error
+}
+
+type CreateSnapshot202ApplicationHalPlusJSONResponse Snapshot
+
+func (response CreateSnapshot202ApplicationHalPlusJSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSnapshot400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSnapshot400JSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSnapshot404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateSnapshot404JSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSnapshot409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateSnapshot409JSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSnapshotsRequestObject struct {
+	Params ListSnapshotsParams
+}
+
+type ListSnapshotsResponseObject interface {
+	VisitListSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type ListSnapshots200ApplicationHalPlusJSONResponse SnapshotCollectionResponse
+
+func (response ListSnapshots200ApplicationHalPlusJSONResponse) VisitListSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSnapshotRequestObject struct {
+	SnapshotId openapi_types.UUID `json:"snapshotId"`
+}
+
+type DeleteSnapshotResponseObject interface {
+	VisitDeleteSnapshotResponse(w http.ResponseWriter) error
+}
+
+type DeleteSnapshot202ApplicationHalPlusJSONResponse Snapshot
+
+func (response DeleteSnapshot202ApplicationHalPlusJSONResponse) VisitDeleteSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSnapshot404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSnapshot404JSONResponse) VisitDeleteSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSnapshot409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeleteSnapshot409JSONResponse) VisitDeleteSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotByIdRequestObject struct {
+	SnapshotId openapi_types.UUID `json:"snapshotId"`
+}
+
+type GetSnapshotByIdResponseObject interface {
+	VisitGetSnapshotByIdResponse(w http.ResponseWriter) error
+}
+
+type GetSnapshotById200ApplicationHalPlusJSONResponse Snapshot
+
+func (response GetSnapshotById200ApplicationHalPlusJSONResponse) VisitGetSnapshotByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSnapshotById404JSONResponse) VisitGetSnapshotByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreSnapshotRequestObject struct {
+	SnapshotId openapi_types.UUID `json:"snapshotId"`
+}
+
+type RestoreSnapshotResponseObject interface {
+	VisitRestoreSnapshotResponse(w http.ResponseWriter) error
+}
+
+type RestoreSnapshot202ApplicationHalPlusJSONResponse Snapshot
+
+func (response RestoreSnapshot202ApplicationHalPlusJSONResponse) VisitRestoreSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreSnapshot404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreSnapshot404JSONResponse) VisitRestoreSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreSnapshot409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RestoreSnapshot409JSONResponse) VisitRestoreSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerFromSnapshotRequestObject struct {
+	SnapshotId openapi_types.UUID `json:"snapshotId"`
+	Body       *CreateServerFromSnapshotJSONRequestBody
+}
+
+type CreateServerFromSnapshotResponseObject interface {
+	VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error
+}
+
+type CreateServerFromSnapshot202ApplicationHalPlusJSONResponse Server
+
+func (response CreateServerFromSnapshot202ApplicationHalPlusJSONResponse) VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerFromSnapshot400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateServerFromSnapshot400JSONResponse) VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerFromSnapshot403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateServerFromSnapshot403JSONResponse) VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerFromSnapshot404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateServerFromSnapshot404JSONResponse) VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerFromSnapshot409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateServerFromSnapshot409JSONResponse) VisitCreateServerFromSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSshKeysRequestObject struct {
+	Params ListSshKeysParams
+}
+
+type ListSshKeysResponseObject interface {
+	VisitListSshKeysResponse(w http.ResponseWriter) error
+}
+
+type ListSshKeys200ApplicationHalPlusJSONResponse SshKeyCollectionResponse
+
+func (response ListSshKeys200ApplicationHalPlusJSONResponse) VisitListSshKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSshKeyRequestObject struct {
+	Body *CreateSshKeyJSONRequestBody
+}
+
+type CreateSshKeyResponseObject interface {
+	VisitCreateSshKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateSshKey201ApplicationHalPlusJSONResponse SshKey
+
+func (response CreateSshKey201ApplicationHalPlusJSONResponse) VisitCreateSshKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSshKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSshKey400JSONResponse) VisitCreateSshKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSshKey409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateSshKey409JSONResponse) VisitCreateSshKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSshKeyRequestObject struct {
+	KeyId openapi_types.UUID `json:"keyId"`
+}
+
+type DeleteSshKeyResponseObject interface {
+	VisitDeleteSshKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteSshKey204Response struct {
+}
+
+func (response DeleteSshKey204Response) VisitDeleteSshKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSshKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSshKey404JSONResponse) VisitDeleteSshKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSshKeyByIdRequestObject struct {
+	KeyId openapi_types.UUID `json:"keyId"`
+}
+
+type GetSshKeyByIdResponseObject interface {
+	VisitGetSshKeyByIdResponse(w http.ResponseWriter) error
+}
+
+type GetSshKeyById200ApplicationHalPlusJSONResponse SshKey
+
+func (response GetSshKeyById200ApplicationHalPlusJSONResponse) VisitGetSshKeyByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSshKeyById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSshKeyById404JSONResponse) VisitGetSshKeyByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiTokensRequestObject struct {
+	Params ListApiTokensParams
+}
+
+type ListApiTokensResponseObject interface {
+	VisitListApiTokensResponse(w http.ResponseWriter) error
+}
+
+type ListApiTokens200ApplicationHalPlusJSONResponse ApiTokenCollectionResponse
+
+func (response ListApiTokens200ApplicationHalPlusJSONResponse) VisitListApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiTokenRequestObject struct {
+	Body *CreateApiTokenJSONRequestBody
+}
+
+type CreateApiTokenResponseObject interface {
+	VisitCreateApiTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateApiToken201ApplicationHalPlusJSONResponse CreatedApiToken
+
+func (response CreateApiToken201ApplicationHalPlusJSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiToken400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateApiToken400JSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiTokenRequestObject struct {
+	TokenId openapi_types.UUID `json:"tokenId"`
+}
+
+type RevokeApiTokenResponseObject interface {
+	VisitRevokeApiTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeApiToken204Response struct {
+}
+
+func (response RevokeApiToken204Response) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeApiToken404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeApiToken404JSONResponse) VisitRevokeApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTokenByIdRequestObject struct {
+	TokenId openapi_types.UUID `json:"tokenId"`
+}
+
+type GetApiTokenByIdResponseObject interface {
+	VisitGetApiTokenByIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiTokenById200ApplicationHalPlusJSONResponse ApiToken
+
+func (response GetApiTokenById200ApplicationHalPlusJSONResponse) VisitGetApiTokenByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiTokenById404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetApiTokenById404JSONResponse) VisitGetApiTokenByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Точка входа (Root)
+	// (GET /)
+	GetRoot(ctx context.Context, request GetRootRequestObject) (GetRootResponseObject, error)
+	// Сверить ресурсы пулов с сервисом ресурсов (только для администраторов)
+	// (GET /admin/capacity)
+	GetCapacityReport(ctx context.Context, request GetCapacityReportRequestObject) (GetCapacityReportResponseObject, error)
+	// Сверить ресурсы пулов и исправить счетчики (только для администраторов)
+	// (POST /admin/capacity/repair)
+	RepairCapacity(ctx context.Context, request RepairCapacityRequestObject) (RepairCapacityResponseObject, error)
+	// Получить список индивидуальных квот (только для администраторов)
+	// (GET /admin/quotas)
+	ListQuotaOverrides(ctx context.Context, request ListQuotaOverridesRequestObject) (ListQuotaOverridesResponseObject, error)
+	// Получить квоту по умолчанию (только для администраторов)
+	// (GET /admin/quotas/default)
+	GetDefaultQuota(ctx context.Context, request GetDefaultQuotaRequestObject) (GetDefaultQuotaResponseObject, error)
+	// Изменить квоту по умолчанию (только для администраторов)
+	// (PUT /admin/quotas/default)
+	SetDefaultQuota(ctx context.Context, request SetDefaultQuotaRequestObject) (SetDefaultQuotaResponseObject, error)
+	// Удалить индивидуальную квоту, вернув пользователю квоту по умолчанию (только для администраторов)
+	// (DELETE /admin/quotas/{userId})
+	DeleteUserQuota(ctx context.Context, request DeleteUserQuotaRequestObject) (DeleteUserQuotaResponseObject, error)
+	// Получить квоту пользователя и его использование (только для администраторов)
+	// (GET /admin/quotas/{userId})
+	GetUserQuota(ctx context.Context, request GetUserQuotaRequestObject) (GetUserQuotaResponseObject, error)
+	// Задать индивидуальную квоту пользователя (только для администраторов)
+	// (PUT /admin/quotas/{userId})
+	SetUserQuota(ctx context.Context, request SetUserQuotaRequestObject) (SetUserQuotaResponseObject, error)
+	// Получить список серверов всех пользователей
+	// (GET /admin/servers)
+	ListAllServers(ctx context.Context, request ListAllServersRequestObject) (ListAllServersResponseObject, error)
+	// Получить детальную информацию о любом сервере
+	// (GET /admin/servers/{serverId})
+	GetAnyServer(ctx context.Context, request GetAnyServerRequestObject) (GetAnyServerResponseObject, error)
+	// Выполнить принудительное действие над любым сервером
+	// (POST /admin/servers/{serverId}/actions)
+	PerformAdminServerAction(ctx context.Context, request PerformAdminServerActionRequestObject) (PerformAdminServerActionResponseObject, error)
+	// Получить историю изменений состояния любого сервера
+	// (GET /admin/servers/{serverId}/history)
+	GetAnyServerHistory(ctx context.Context, request GetAnyServerHistoryRequestObject) (GetAnyServerHistoryResponseObject, error)
+	// Получить список своих счетов
+	// (GET /billing/invoices)
+	ListInvoices(ctx context.Context, request ListInvoicesRequestObject) (ListInvoicesResponseObject, error)
+	// Получить счет
+	// (GET /billing/invoices/{invoiceId})
+	GetInvoiceById(ctx context.Context, request GetInvoiceByIdRequestObject) (GetInvoiceByIdResponseObject, error)
+	// Получить использование за текущий месяц
+	// (GET /billing/usage)
+	GetCurrentUsage(ctx context.Context, request GetCurrentUsageRequestObject) (GetCurrentUsageResponseObject, error)
+	// Получить список действующих приглашений пользователя
+	// (GET /invitations)
+	ListInvitations(ctx context.Context, request ListInvitationsRequestObject) (ListInvitationsResponseObject, error)
+	// Отозвать приглашение
+	// (DELETE /invitations/{invitationId})
+	RevokeInvitation(ctx context.Context, request RevokeInvitationRequestObject) (RevokeInvitationResponseObject, error)
+	// Принять приглашение
+	// (POST /invitations/{invitationId}/accept)
+	AcceptInvitation(ctx context.Context, request AcceptInvitationRequestObject) (AcceptInvitationResponseObject, error)
+	// Отклонить приглашение
+	// (POST /invitations/{invitationId}/decline)
+	DeclineInvitation(ctx context.Context, request DeclineInvitationRequestObject) (DeclineInvitationResponseObject, error)
+	// Получить список доступных планов
+	// (GET /plans)
+	ListPlans(ctx context.Context, request ListPlansRequestObject) (ListPlansResponseObject, error)
+	// Создание нового плана
+	// (POST /plans)
+	CreatePlan(ctx context.Context, request CreatePlanRequestObject) (CreatePlanResponseObject, error)
+	// Получить детальную информацию о плане
+	// (GET /plans/{planId})
+	GetPlanById(ctx context.Context, request GetPlanByIdRequestObject) (GetPlanByIdResponseObject, error)
+	// Получить список проектов, в которых состоит пользователь
+	// (GET /projects)
+	ListProjects(ctx context.Context, request ListProjectsRequestObject) (ListProjectsResponseObject, error)
+	// Создать проект
+	// (POST /projects)
+	CreateProject(ctx context.Context, request CreateProjectRequestObject) (CreateProjectResponseObject, error)
+	// Удалить проект
+	// (DELETE /projects/{projectId})
+	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
+	// Получить проект
+	// (GET /projects/{projectId})
+	GetProjectById(ctx context.Context, request GetProjectByIdRequestObject) (GetProjectByIdResponseObject, error)
+	// Переименовать проект
+	// (PATCH /projects/{projectId})
+	UpdateProject(ctx context.Context, request UpdateProjectRequestObject) (UpdateProjectResponseObject, error)
+	// Получить список действующих приглашений в проект
+	// (GET /projects/{projectId}/invitations)
+	ListProjectInvitations(ctx context.Context, request ListProjectInvitationsRequestObject) (ListProjectInvitationsResponseObject, error)
+	// Пригласить пользователя в проект
+	// (POST /projects/{projectId}/invitations)
+	InviteToProject(ctx context.Context, request InviteToProjectRequestObject) (InviteToProjectResponseObject, error)
+	// Получить список участников проекта
+	// (GET /projects/{projectId}/members)
+	ListProjectMembers(ctx context.Context, request ListProjectMembersRequestObject) (ListProjectMembersResponseObject, error)
+	// Исключить участника из проекта
+	// (DELETE /projects/{projectId}/members/{userId})
+	RemoveProjectMember(ctx context.Context, request RemoveProjectMemberRequestObject) (RemoveProjectMemberResponseObject, error)
+	// Изменить роль участника
+	// (PUT /projects/{projectId}/members/{userId})
+	SetProjectMemberRole(ctx context.Context, request SetProjectMemberRoleRequestObject) (SetProjectMemberRoleResponseObject, error)
+	// Получить свою квоту и текущее использование
+	// (GET /quota)
+	GetMyQuota(ctx context.Context, request GetMyQuotaRequestObject) (GetMyQuotaResponseObject, error)
+	// Получить список регионов со свободными ресурсами
+	// (GET /regions)
+	ListRegions(ctx context.Context, request ListRegionsRequestObject) (ListRegionsResponseObject, error)
+	// Получить список своих расписаний
+	// (GET /schedules)
+	ListSchedules(ctx context.Context, request ListSchedulesRequestObject) (ListSchedulesResponseObject, error)
+	// Удалить расписание
+	// (DELETE /schedules/{scheduleId})
+	DeleteSchedule(ctx context.Context, request DeleteScheduleRequestObject) (DeleteScheduleResponseObject, error)
+	// Получить расписание
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(ctx context.Context, request GetScheduleByIdRequestObject) (GetScheduleByIdResponseObject, error)
+	// Изменить расписание
+	// (PATCH /schedules/{scheduleId})
+	UpdateSchedule(ctx context.Context, request UpdateScheduleRequestObject) (UpdateScheduleResponseObject, error)
+	// Получить список всех заказанных серверов
+	// (GET /servers)
+	ListServers(ctx context.Context, request ListServersRequestObject) (ListServersResponseObject, error)
+	// Заказать новый сервер
+	// (POST /servers)
+	OrderServer(ctx context.Context, request OrderServerRequestObject) (OrderServerResponseObject, error)
+	// Выполнить действие над несколькими серверами
+	// (POST /servers/actions:batch)
+	BatchServerActions(ctx context.Context, request BatchServerActionsRequestObject) (BatchServerActionsResponseObject, error)
+	// Получить детальную информацию о сервере
+	// (GET /servers/{serverId})
+	GetServerById(ctx context.Context, request GetServerByIdRequestObject) (GetServerByIdResponseObject, error)
+	// Выполнить действие над сервером
+	// (POST /servers/{serverId}/actions)
+	PerformServerAction(ctx context.Context, request PerformServerActionRequestObject) (PerformServerActionResponseObject, error)
+	// Получить историю изменений состояния сервера
+	// (GET /servers/{serverId}/history)
+	GetServerHistory(ctx context.Context, request GetServerHistoryRequestObject) (GetServerHistoryResponseObject, error)
+	// Создать расписание для сервера
+	// (POST /servers/{serverId}/schedules)
+	CreateSchedule(ctx context.Context, request CreateScheduleRequestObject) (CreateScheduleResponseObject, error)
+	// Создать снимок остановленного сервера
+	// (POST /servers/{serverId}/snapshots)
+	CreateSnapshot(ctx context.Context, request CreateSnapshotRequestObject) (CreateSnapshotResponseObject, error)
+	// Получить список своих снимков
+	// (GET /snapshots)
+	ListSnapshots(ctx context.Context, request ListSnapshotsRequestObject) (ListSnapshotsResponseObject, error)
+	// Удалить снимок
+	// (DELETE /snapshots/{snapshotId})
+	DeleteSnapshot(ctx context.Context, request DeleteSnapshotRequestObject) (DeleteSnapshotResponseObject, error)
+	// Получить снимок
+	// (GET /snapshots/{snapshotId})
+	GetSnapshotById(ctx context.Context, request GetSnapshotByIdRequestObject) (GetSnapshotByIdResponseObject, error)
+	// Восстановить сервер из снимка
+	// (POST /snapshots/{snapshotId}/restore)
+	RestoreSnapshot(ctx context.Context, request RestoreSnapshotRequestObject) (RestoreSnapshotResponseObject, error)
+	// Заказать новый сервер из снимка
+	// (POST /snapshots/{snapshotId}/servers)
+	CreateServerFromSnapshot(ctx context.Context, request CreateServerFromSnapshotRequestObject) (CreateServerFromSnapshotResponseObject, error)
+	// Получить список своих SSH-ключей
+	// (GET /ssh-keys)
+	ListSshKeys(ctx context.Context, request ListSshKeysRequestObject) (ListSshKeysResponseObject, error)
+	// Добавить публичный SSH-ключ
+	// (POST /ssh-keys)
+	CreateSshKey(ctx context.Context, request CreateSshKeyRequestObject) (CreateSshKeyResponseObject, error)
+	// Удалить SSH-ключ
+	// (DELETE /ssh-keys/{keyId})
+	DeleteSshKey(ctx context.Context, request DeleteSshKeyRequestObject) (DeleteSshKeyResponseObject, error)
+	// Получить SSH-ключ
+	// (GET /ssh-keys/{keyId})
+	GetSshKeyById(ctx context.Context, request GetSshKeyByIdRequestObject) (GetSshKeyByIdResponseObject, error)
+	// Получить список своих API-токенов
+	// (GET /tokens)
+	ListApiTokens(ctx context.Context, request ListApiTokensRequestObject) (ListApiTokensResponseObject, error)
+	// Создать API-токен
+	// (POST /tokens)
+	CreateApiToken(ctx context.Context, request CreateApiTokenRequestObject) (CreateApiTokenResponseObject, error)
+	// Отозвать API-токен
+	// (DELETE /tokens/{tokenId})
+	RevokeApiToken(ctx context.Context, request RevokeApiTokenRequestObject) (RevokeApiTokenResponseObject, error)
+	// Получить API-токен
+	// (GET /tokens/{tokenId})
+	GetApiTokenById(ctx context.Context, request GetApiTokenByIdRequestObject) (GetApiTokenByIdResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetRoot operation middleware
+func (sh *strictHandler) GetRoot(w http.ResponseWriter, r *http.Request) {
+	var request GetRootRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRoot(ctx, request.(GetRootRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRoot")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRootResponseObject); ok {
+		if err := validResponse.VisitGetRootResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCapacityReport operation middleware
+func (sh *strictHandler) GetCapacityReport(w http.ResponseWriter, r *http.Request) {
+	var request GetCapacityReportRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapacityReport(ctx, request.(GetCapacityReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapacityReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapacityReportResponseObject); ok {
+		if err := validResponse.VisitGetCapacityReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RepairCapacity operation middleware
+func (sh *strictHandler) RepairCapacity(w http.ResponseWriter, r *http.Request) {
+	var request RepairCapacityRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RepairCapacity(ctx, request.(RepairCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RepairCapacity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RepairCapacityResponseObject); ok {
+		if err := validResponse.VisitRepairCapacityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListQuotaOverrides operation middleware
+func (sh *strictHandler) ListQuotaOverrides(w http.ResponseWriter, r *http.Request, params ListQuotaOverridesParams) {
+	var request ListQuotaOverridesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListQuotaOverrides(ctx, request.(ListQuotaOverridesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListQuotaOverrides")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListQuotaOverridesResponseObject); ok {
+		if err := validResponse.VisitListQuotaOverridesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDefaultQuota operation middleware
+func (sh *strictHandler) GetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+	var request GetDefaultQuotaRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDefaultQuota(ctx, request.(GetDefaultQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDefaultQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDefaultQuotaResponseObject); ok {
+		if err := validResponse.VisitGetDefaultQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetDefaultQuota operation middleware
+func (sh *strictHandler) SetDefaultQuota(w http.ResponseWriter, r *http.Request) {
+	var request SetDefaultQuotaRequestObject
+
+	var body SetDefaultQuotaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetDefaultQuota(ctx, request.(SetDefaultQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetDefaultQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetDefaultQuotaResponseObject); ok {
+		if err := validResponse.VisitSetDefaultQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserQuota operation middleware
+func (sh *strictHandler) DeleteUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request DeleteUserQuotaRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserQuota(ctx, request.(DeleteUserQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserQuotaResponseObject); ok {
+		if err := validResponse.VisitDeleteUserQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserQuota operation middleware
+func (sh *strictHandler) GetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request GetUserQuotaRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserQuota(ctx, request.(GetUserQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserQuotaResponseObject); ok {
+		if err := validResponse.VisitGetUserQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserQuota operation middleware
+func (sh *strictHandler) SetUserQuota(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request SetUserQuotaRequestObject
+
+	request.UserId = userId
+
+	var body SetUserQuotaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserQuota(ctx, request.(SetUserQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserQuotaResponseObject); ok {
+		if err := validResponse.VisitSetUserQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAllServers operation middleware
+func (sh *strictHandler) ListAllServers(w http.ResponseWriter, r *http.Request, params ListAllServersParams) {
+	var request ListAllServersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAllServers(ctx, request.(ListAllServersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAllServers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAllServersResponseObject); ok {
+		if err := validResponse.VisitListAllServersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnyServer operation middleware
+func (sh *strictHandler) GetAnyServer(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	var request GetAnyServerRequestObject
+
+	request.ServerId = serverId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnyServer(ctx, request.(GetAnyServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnyServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnyServerResponseObject); ok {
+		if err := validResponse.VisitGetAnyServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PerformAdminServerAction operation middleware
+func (sh *strictHandler) PerformAdminServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformAdminServerActionParams) {
+	var request PerformAdminServerActionRequestObject
+
+	request.ServerId = serverId
+	request.Params = params
+
+	var body PerformAdminServerActionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PerformAdminServerAction(ctx, request.(PerformAdminServerActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PerformAdminServerAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PerformAdminServerActionResponseObject); ok {
+		if err := validResponse.VisitPerformAdminServerActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnyServerHistory operation middleware
+func (sh *strictHandler) GetAnyServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetAnyServerHistoryParams) {
+	var request GetAnyServerHistoryRequestObject
+
+	request.ServerId = serverId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnyServerHistory(ctx, request.(GetAnyServerHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnyServerHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnyServerHistoryResponseObject); ok {
+		if err := validResponse.VisitGetAnyServerHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvoices operation middleware
+func (sh *strictHandler) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
+	var request ListInvoicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoices(ctx, request.(ListInvoicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvoicesResponseObject); ok {
+		if err := validResponse.VisitListInvoicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetInvoiceById operation middleware
+func (sh *strictHandler) GetInvoiceById(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	var request GetInvoiceByIdRequestObject
+
+	request.InvoiceId = invoiceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInvoiceById(ctx, request.(GetInvoiceByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInvoiceById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetInvoiceByIdResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentUsage operation middleware
+func (sh *strictHandler) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentUsageRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUsage(ctx, request.(GetCurrentUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentUsageResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvitations operation middleware
+func (sh *strictHandler) ListInvitations(w http.ResponseWriter, r *http.Request, params ListInvitationsParams) {
+	var request ListInvitationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvitations(ctx, request.(ListInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvitations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvitationsResponseObject); ok {
+		if err := validResponse.VisitListInvitationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeInvitation operation middleware
+func (sh *strictHandler) RevokeInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	var request RevokeInvitationRequestObject
+
+	request.InvitationId = invitationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeInvitation(ctx, request.(RevokeInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeInvitationResponseObject); ok {
+		if err := validResponse.VisitRevokeInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptInvitation operation middleware
+func (sh *strictHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	var request AcceptInvitationRequestObject
+
+	request.InvitationId = invitationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptInvitation(ctx, request.(AcceptInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptInvitationResponseObject); ok {
+		if err := validResponse.VisitAcceptInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeclineInvitation operation middleware
+func (sh *strictHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request, invitationId openapi_types.UUID) {
+	var request DeclineInvitationRequestObject
+
+	request.InvitationId = invitationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeclineInvitation(ctx, request.(DeclineInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeclineInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeclineInvitationResponseObject); ok {
+		if err := validResponse.VisitDeclineInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPlans operation middleware
+func (sh *strictHandler) ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams) {
+	var request ListPlansRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlans(ctx, request.(ListPlansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlans")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlansResponseObject); ok {
+		if err := validResponse.VisitListPlansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePlan operation middleware
+func (sh *strictHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
+	var request CreatePlanRequestObject
+
+	var body CreatePlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePlan(ctx, request.(CreatePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePlanResponseObject); ok {
+		if err := validResponse.VisitCreatePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPlanById operation middleware
+func (sh *strictHandler) GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID) {
+	var request GetPlanByIdRequestObject
+
+	request.PlanId = planId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlanById(ctx, request.(GetPlanByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlanById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlanByIdResponseObject); ok {
+		if err := validResponse.VisitGetPlanByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+	var request ListProjectsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProject operation middleware
+func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var request CreateProjectRequestObject
+
+	var body CreateProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
+		if err := validResponse.VisitCreateProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	var request DeleteProjectRequestObject
+
+	request.ProjectId = projectId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProjectById operation middleware
+func (sh *strictHandler) GetProjectById(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	var request GetProjectByIdRequestObject
+
+	request.ProjectId = projectId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectById(ctx, request.(GetProjectByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectByIdResponseObject); ok {
+		if err := validResponse.VisitGetProjectByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateProject operation middleware
+func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	var request UpdateProjectRequestObject
+
+	request.ProjectId = projectId
+
+	var body UpdateProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
+		if err := validResponse.VisitUpdateProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjectInvitations operation middleware
+func (sh *strictHandler) ListProjectInvitations(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectInvitationsParams) {
+	var request ListProjectInvitationsRequestObject
+
+	request.ProjectId = projectId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjectInvitations(ctx, request.(ListProjectInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjectInvitations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectInvitationsResponseObject); ok {
+		if err := validResponse.VisitListProjectInvitationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InviteToProject operation middleware
+func (sh *strictHandler) InviteToProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	var request InviteToProjectRequestObject
+
+	request.ProjectId = projectId
+
+	var body InviteToProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteToProject(ctx, request.(InviteToProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteToProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InviteToProjectResponseObject); ok {
+		if err := validResponse.VisitInviteToProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjectMembers operation middleware
+func (sh *strictHandler) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectMembersParams) {
+	var request ListProjectMembersRequestObject
+
+	request.ProjectId = projectId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjectMembers(ctx, request.(ListProjectMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjectMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectMembersResponseObject); ok {
+		if err := validResponse.VisitListProjectMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveProjectMember operation middleware
+func (sh *strictHandler) RemoveProjectMember(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID) {
+	var request RemoveProjectMemberRequestObject
+
+	request.ProjectId = projectId
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveProjectMember(ctx, request.(RemoveProjectMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveProjectMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveProjectMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveProjectMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetProjectMemberRole operation middleware
+func (sh *strictHandler) SetProjectMemberRole(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, userId openapi_types.UUID) {
+	var request SetProjectMemberRoleRequestObject
+
+	request.ProjectId = projectId
+	request.UserId = userId
+
+	var body SetProjectMemberRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetProjectMemberRole(ctx, request.(SetProjectMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetProjectMemberRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetProjectMemberRoleResponseObject); ok {
+		if err := validResponse.VisitSetProjectMemberRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyQuota operation middleware
+func (sh *strictHandler) GetMyQuota(w http.ResponseWriter, r *http.Request) {
+	var request GetMyQuotaRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyQuota(ctx, request.(GetMyQuotaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyQuota")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMyQuotaResponseObject); ok {
+		if err := validResponse.VisitGetMyQuotaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRegions operation middleware
+func (sh *strictHandler) ListRegions(w http.ResponseWriter, r *http.Request) {
+	var request ListRegionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegions(ctx, request.(ListRegionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegionsResponseObject); ok {
+		if err := validResponse.VisitListRegionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSchedules operation middleware
+func (sh *strictHandler) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	var request ListSchedulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSchedules(ctx, request.(ListSchedulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSchedules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSchedulesResponseObject); ok {
+		if err := validResponse.VisitListSchedulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSchedule operation middleware
+func (sh *strictHandler) DeleteSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	var request DeleteScheduleRequestObject
+
+	request.ScheduleId = scheduleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSchedule(ctx, request.(DeleteScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteScheduleResponseObject); ok {
+		if err := validResponse.VisitDeleteScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScheduleById operation middleware
+func (sh *strictHandler) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	var request GetScheduleByIdRequestObject
+
+	request.ScheduleId = scheduleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScheduleById(ctx, request.(GetScheduleByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScheduleById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScheduleByIdResponseObject); ok {
+		if err := validResponse.VisitGetScheduleByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSchedule operation middleware
+func (sh *strictHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	var request UpdateScheduleRequestObject
+
+	request.ScheduleId = scheduleId
+
+	var body UpdateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSchedule(ctx, request.(UpdateScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateScheduleResponseObject); ok {
+		if err := validResponse.VisitUpdateScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListServers operation middleware
+func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
+	var request ListServersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServers(ctx, request.(ListServersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListServersResponseObject); ok {
+		if err := validResponse.VisitListServersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OrderServer operation middleware
+func (sh *strictHandler) OrderServer(w http.ResponseWriter, r *http.Request, params OrderServerParams) {
+	var request OrderServerRequestObject
+
+	request.Params = params
+
+	var body OrderServerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OrderServer(ctx, request.(OrderServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OrderServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OrderServerResponseObject); ok {
+		if err := validResponse.VisitOrderServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchServerActions operation middleware
+func (sh *strictHandler) BatchServerActions(w http.ResponseWriter, r *http.Request) {
+	var request BatchServerActionsRequestObject
+
+	var body BatchServerActionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchServerActions(ctx, request.(BatchServerActionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchServerActions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchServerActionsResponseObject); ok {
+		if err := validResponse.VisitBatchServerActionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServerById operation middleware
+func (sh *strictHandler) GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	var request GetServerByIdRequestObject
+
+	request.ServerId = serverId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServerById(ctx, request.(GetServerByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServerById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerByIdResponseObject); ok {
+		if err := validResponse.VisitGetServerByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PerformServerAction operation middleware
+func (sh *strictHandler) PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params PerformServerActionParams) {
+	var request PerformServerActionRequestObject
+
+	request.ServerId = serverId
+	request.Params = params
+
+	var body PerformServerActionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PerformServerAction(ctx, request.(PerformServerActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PerformServerAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PerformServerActionResponseObject); ok {
+		if err := validResponse.VisitPerformServerActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServerHistory operation middleware
+func (sh *strictHandler) GetServerHistory(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID, params GetServerHistoryParams) {
+	var request GetServerHistoryRequestObject
+
+	request.ServerId = serverId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServerHistory(ctx, request.(GetServerHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServerHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerHistoryResponseObject); ok {
+		if err := validResponse.VisitGetServerHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSchedule operation middleware
+func (sh *strictHandler) CreateSchedule(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	var request CreateScheduleRequestObject
+
+	request.ServerId = serverId
+
+	var body CreateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSchedule(ctx, request.(CreateScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSchedule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateScheduleResponseObject); ok {
+		if err := validResponse.VisitCreateScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServerById200ApplicationHalPlusJSONResponse Server
+// CreateSnapshot operation middleware
+func (sh *strictHandler) CreateSnapshot(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
+	var request CreateSnapshotRequestObject
 
-func (response GetServerById200ApplicationHalPlusJSONResponse) VisitGetServerByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/hal+json")
-	w.WriteHeader(200)
+	request.ServerId = serverId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateSnapshotJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetServerById404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSnapshot(ctx, request.(CreateSnapshotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSnapshot")
+	}
 
-func (response GetServerById404JSONResponse) VisitGetServerByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateSnapshotResponseObject); ok {
+		if err := validResponse.VisitCreateSnapshotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PerformServerActionRequestObject struct {
-	ServerId openapi_types.UUID `json:"serverId"`
-	Body     *PerformServerActionJSONRequestBody
-}
+// ListSnapshots operation middleware
+func (sh *strictHandler) ListSnapshots(w http.ResponseWriter, r *http.Request, params ListSnapshotsParams) {
+	var request ListSnapshotsRequestObject
 
-type PerformServerActionResponseObject interface {
-	VisitPerformServerActionResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type PerformServerAction202JSONResponse Server
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSnapshots(ctx, request.(ListSnapshotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSnapshots")
+	}
 
-func (response PerformServerAction202JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSnapshotsResponseObject); ok {
+		if err := validResponse.VisitListSnapshotsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PerformServerAction400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response PerformServerAction400JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteSnapshot operation middleware
+func (sh *strictHandler) DeleteSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	var request DeleteSnapshotRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.SnapshotId = snapshotId
 
-type PerformServerAction404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSnapshot(ctx, request.(DeleteSnapshotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSnapshot")
+	}
 
-func (response PerformServerAction404JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSnapshotResponseObject); ok {
+		if err := validResponse.VisitDeleteSnapshotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PerformServerAction409JSONResponse StatusResponse
+// GetSnapshotById operation middleware
+func (sh *strictHandler) GetSnapshotById(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	var request GetSnapshotByIdRequestObject
 
-func (response PerformServerAction409JSONResponse) VisitPerformServerActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.SnapshotId = snapshotId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSnapshotById(ctx, request.(GetSnapshotByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSnapshotById")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Точка входа (Root)
-	// (GET /)
-	GetRoot(ctx context.Context, request GetRootRequestObject) (GetRootResponseObject, error)
-	// Получить список доступных планов
-	// (GET /plans)
-	ListPlans(ctx context.Context, request ListPlansRequestObject) (ListPlansResponseObject, error)
-	// Создание нового плана
-	// (POST /plans)
-	CreatePlan(ctx context.Context, request CreatePlanRequestObject) (CreatePlanResponseObject, error)
-	// Получить детальную информацию о плане
-	// (GET /plans/{planId})
-	GetPlanById(ctx context.Context, request GetPlanByIdRequestObject) (GetPlanByIdResponseObject, error)
-	// Получить список всех заказанных серверов
-	// (GET /servers)
-	ListServers(ctx context.Context, request ListServersRequestObject) (ListServersResponseObject, error)
-	// Заказать новый сервер
-	// (POST /servers)
-	OrderServer(ctx context.Context, request OrderServerRequestObject) (OrderServerResponseObject, error)
-	// Получить детальную информацию о сервере
-	// (GET /servers/{serverId})
-	GetServerById(ctx context.Context, request GetServerByIdRequestObject) (GetServerByIdResponseObject, error)
-	// Выполнить действие над сервером
-	// (POST /servers/{serverId}/actions)
-	PerformServerAction(ctx context.Context, request PerformServerActionRequestObject) (PerformServerActionResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSnapshotByIdResponseObject); ok {
+		if err := validResponse.VisitGetSnapshotByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// RestoreSnapshot operation middleware
+func (sh *strictHandler) RestoreSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	var request RestoreSnapshotRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.SnapshotId = snapshotId
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreSnapshot(ctx, request.(RestoreSnapshotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreSnapshot")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreSnapshotResponseObject); ok {
+		if err := validResponse.VisitRestoreSnapshotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetRoot operation middleware
-func (sh *strictHandler) GetRoot(w http.ResponseWriter, r *http.Request) {
-	var request GetRootRequestObject
+// CreateServerFromSnapshot operation middleware
+func (sh *strictHandler) CreateServerFromSnapshot(w http.ResponseWriter, r *http.Request, snapshotId openapi_types.UUID) {
+	var request CreateServerFromSnapshotRequestObject
+
+	request.SnapshotId = snapshotId
+
+	var body CreateServerFromSnapshotJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRoot(ctx, request.(GetRootRequestObject))
+		return sh.ssi.CreateServerFromSnapshot(ctx, request.(CreateServerFromSnapshotRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRoot")
+		handler = middleware(handler, "CreateServerFromSnapshot")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRootResponseObject); ok {
-		if err := validResponse.VisitGetRootResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateServerFromSnapshotResponseObject); ok {
+		if err := validResponse.VisitCreateServerFromSnapshotResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -943,25 +7851,25 @@ func (sh *strictHandler) GetRoot(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListPlans operation middleware
-func (sh *strictHandler) ListPlans(w http.ResponseWriter, r *http.Request, params ListPlansParams) {
-	var request ListPlansRequestObject
+// ListSshKeys operation middleware
+func (sh *strictHandler) ListSshKeys(w http.ResponseWriter, r *http.Request, params ListSshKeysParams) {
+	var request ListSshKeysRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPlans(ctx, request.(ListPlansRequestObject))
+		return sh.ssi.ListSshKeys(ctx, request.(ListSshKeysRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPlans")
+		handler = middleware(handler, "ListSshKeys")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPlansResponseObject); ok {
-		if err := validResponse.VisitListPlansResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSshKeysResponseObject); ok {
+		if err := validResponse.VisitListSshKeysResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -969,11 +7877,11 @@ func (sh *strictHandler) ListPlans(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreatePlan operation middleware
-func (sh *strictHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
-	var request CreatePlanRequestObject
+// CreateSshKey operation middleware
+func (sh *strictHandler) CreateSshKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateSshKeyRequestObject
 
-	var body CreatePlanJSONRequestBody
+	var body CreateSshKeyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -981,18 +7889,18 @@ func (sh *strictHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePlan(ctx, request.(CreatePlanRequestObject))
+		return sh.ssi.CreateSshKey(ctx, request.(CreateSshKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePlan")
+		handler = middleware(handler, "CreateSshKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePlanResponseObject); ok {
-		if err := validResponse.VisitCreatePlanResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSshKeyResponseObject); ok {
+		if err := validResponse.VisitCreateSshKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1000,25 +7908,25 @@ func (sh *strictHandler) CreatePlan(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPlanById operation middleware
-func (sh *strictHandler) GetPlanById(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID) {
-	var request GetPlanByIdRequestObject
+// DeleteSshKey operation middleware
+func (sh *strictHandler) DeleteSshKey(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID) {
+	var request DeleteSshKeyRequestObject
 
-	request.PlanId = planId
+	request.KeyId = keyId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlanById(ctx, request.(GetPlanByIdRequestObject))
+		return sh.ssi.DeleteSshKey(ctx, request.(DeleteSshKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlanById")
+		handler = middleware(handler, "DeleteSshKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPlanByIdResponseObject); ok {
-		if err := validResponse.VisitGetPlanByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSshKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteSshKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1026,25 +7934,51 @@ func (sh *strictHandler) GetPlanById(w http.ResponseWriter, r *http.Request, pla
 	}
 }
 
-// ListServers operation middleware
-func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
-	var request ListServersRequestObject
+// GetSshKeyById operation middleware
+func (sh *strictHandler) GetSshKeyById(w http.ResponseWriter, r *http.Request, keyId openapi_types.UUID) {
+	var request GetSshKeyByIdRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSshKeyById(ctx, request.(GetSshKeyByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSshKeyById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSshKeyByIdResponseObject); ok {
+		if err := validResponse.VisitGetSshKeyByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApiTokens operation middleware
+func (sh *strictHandler) ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams) {
+	var request ListApiTokensRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListServers(ctx, request.(ListServersRequestObject))
+		return sh.ssi.ListApiTokens(ctx, request.(ListApiTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListServers")
+		handler = middleware(handler, "ListApiTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListServersResponseObject); ok {
-		if err := validResponse.VisitListServersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListApiTokensResponseObject); ok {
+		if err := validResponse.VisitListApiTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1052,11 +7986,11 @@ func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// OrderServer operation middleware
-func (sh *strictHandler) OrderServer(w http.ResponseWriter, r *http.Request) {
-	var request OrderServerRequestObject
+// CreateApiToken operation middleware
+func (sh *strictHandler) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiTokenRequestObject
 
-	var body OrderServerJSONRequestBody
+	var body CreateApiTokenJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1064,18 +7998,18 @@ func (sh *strictHandler) OrderServer(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.OrderServer(ctx, request.(OrderServerRequestObject))
+		return sh.ssi.CreateApiToken(ctx, request.(CreateApiTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "OrderServer")
+		handler = middleware(handler, "CreateApiToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(OrderServerResponseObject); ok {
-		if err := validResponse.VisitOrderServerResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateApiTokenResponseObject); ok {
+		if err := validResponse.VisitCreateApiTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1083,25 +8017,25 @@ func (sh *strictHandler) OrderServer(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetServerById operation middleware
-func (sh *strictHandler) GetServerById(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
-	var request GetServerByIdRequestObject
+// RevokeApiToken operation middleware
+func (sh *strictHandler) RevokeApiToken(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID) {
+	var request RevokeApiTokenRequestObject
 
-	request.ServerId = serverId
+	request.TokenId = tokenId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetServerById(ctx, request.(GetServerByIdRequestObject))
+		return sh.ssi.RevokeApiToken(ctx, request.(RevokeApiTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetServerById")
+		handler = middleware(handler, "RevokeApiToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetServerByIdResponseObject); ok {
-		if err := validResponse.VisitGetServerByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeApiTokenResponseObject); ok {
+		if err := validResponse.VisitRevokeApiTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1109,32 +8043,25 @@ func (sh *strictHandler) GetServerById(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// PerformServerAction operation middleware
-func (sh *strictHandler) PerformServerAction(w http.ResponseWriter, r *http.Request, serverId openapi_types.UUID) {
-	var request PerformServerActionRequestObject
-
-	request.ServerId = serverId
+// GetApiTokenById operation middleware
+func (sh *strictHandler) GetApiTokenById(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID) {
+	var request GetApiTokenByIdRequestObject
 
-	var body PerformServerActionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.TokenId = tokenId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PerformServerAction(ctx, request.(PerformServerActionRequestObject))
+		return sh.ssi.GetApiTokenById(ctx, request.(GetApiTokenByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PerformServerAction")
+		handler = middleware(handler, "GetApiTokenById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PerformServerActionResponseObject); ok {
-		if err := validResponse.VisitPerformServerActionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetApiTokenByIdResponseObject); ok {
+		if err := validResponse.VisitGetApiTokenByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,81 @@
+package billinggrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/billing"
+)
+
+type BillingHandlers struct {
+	billingBus billing.ExtBusiness
+	prefix     string
+}
+
+func New(billingBus billing.ExtBusiness, prefix string) *BillingHandlers {
+	return &BillingHandlers{
+		billingBus: billingBus,
+		prefix:     prefix,
+	}
+}
+
+func (h *BillingHandlers) GetCurrentUsage(ctx context.Context, request gen.GetCurrentUsageRequestObject) (gen.GetCurrentUsageResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := h.billingBus.Usage(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetCurrentUsage200ApplicationHalPlusJSONResponse(toUsageStatement(stmt, h.prefix)), nil
+}
+
+func (h *BillingHandlers) ListInvoices(ctx context.Context, request gen.ListInvoicesRequestObject) (gen.ListInvoicesResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, total, err := h.billingBus.SearchInvoices(ctx, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListInvoices200ApplicationHalPlusJSONResponse(toInvoiceCollectionResponse(invoices, pg, total, h.prefix)), nil
+}
+
+func (h *BillingHandlers) GetInvoiceById(ctx context.Context, request gen.GetInvoiceByIdRequestObject) (gen.GetInvoiceByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := h.billingBus.FindInvoiceByID(ctx, request.InvoiceId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, billing.ErrInvoiceNotFound) || errors.Is(err, billing.ErrAccessDenied) {
+			return gen.GetInvoiceById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: billing.ErrInvoiceNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetInvoiceById200ApplicationHalPlusJSONResponse(toInvoice(invoice, h.prefix)), nil
+}
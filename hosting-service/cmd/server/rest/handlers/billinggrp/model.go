@@ -0,0 +1,70 @@
+package billinggrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/billing"
+)
+
+func toLineItems(items []billing.LineItem) []gen.UsageLineItem {
+	res := make([]gen.UsageLineItem, len(items))
+	for i, item := range items {
+		res[i] = gen.UsageLineItem{
+			ServerId:    item.ServerID,
+			PlanId:      item.PlanID,
+			Meter:       gen.UsageLineItemMeter(item.Meter),
+			Seconds:     item.Seconds,
+			HourlyPrice: item.HourlyPrice,
+			Amount:      item.Amount,
+		}
+	}
+	return res
+}
+
+func toUsageStatement(s billing.Statement, prefix string) gen.UsageStatement {
+	return gen.UsageStatement{
+		PeriodStart: s.PeriodStart,
+		PeriodEnd:   s.PeriodEnd,
+		Items:       toLineItems(s.Items),
+		Total:       s.Total,
+		Currency:    billing.Currency,
+		UnderscoreLinks: gen.Links{
+			"self":     gen.Link{Href: fmt.Sprintf("%s/billing/usage", prefix)},
+			"invoices": gen.Link{Href: fmt.Sprintf("%s/billing/invoices", prefix)},
+		},
+	}
+}
+
+func toInvoice(inv billing.Invoice, prefix string) gen.Invoice {
+	return gen.Invoice{
+		Id:          inv.ID,
+		PeriodStart: inv.PeriodStart,
+		PeriodEnd:   inv.PeriodEnd,
+		Items:       toLineItems(inv.Items),
+		Total:       inv.Total,
+		Currency:    inv.Currency,
+		CreatedAt:   inv.CreatedAt,
+		UnderscoreLinks: gen.Links{
+			"self": gen.Link{Href: fmt.Sprintf("%s/billing/invoices/%s", prefix, inv.ID)},
+		},
+	}
+}
+
+func toInvoiceCollectionResponse(invoices []billing.Invoice, pg page.Page, total int, prefix string) gen.InvoiceCollectionResponse {
+	items := make([]gen.Invoice, len(invoices))
+	for i, inv := range invoices {
+		items[i] = toInvoice(inv, prefix)
+	}
+
+	return gen.InvoiceCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Invoices []gen.Invoice `json:"invoices"`
+		}{
+			Invoices: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/billing/invoices", prefix), pg, total),
+	}
+}
@@ -0,0 +1,37 @@
+package capacitygrp
+
+import (
+	"context"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/capacity"
+)
+
+type CapacityHandlers struct {
+	capacityBus capacity.ExtBusiness
+	prefix      string
+}
+
+func New(capacityBus capacity.ExtBusiness, prefix string) *CapacityHandlers {
+	return &CapacityHandlers{
+		capacityBus: capacityBus,
+		prefix:      prefix,
+	}
+}
+
+func (c *CapacityHandlers) GetCapacityReport(ctx context.Context, request gen.GetCapacityReportRequestObject) (gen.GetCapacityReportResponseObject, error) {
+	report, err := c.capacityBus.Reconcile(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetCapacityReport200JSONResponse(toCapacityReport(report, c.prefix)), nil
+}
+
+func (c *CapacityHandlers) RepairCapacity(ctx context.Context, request gen.RepairCapacityRequestObject) (gen.RepairCapacityResponseObject, error) {
+	report, err := c.capacityBus.Reconcile(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.RepairCapacity200JSONResponse(toCapacityReport(report, c.prefix)), nil
+}
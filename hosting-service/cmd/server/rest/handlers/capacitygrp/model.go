@@ -0,0 +1,69 @@
+package capacitygrp
+
+import (
+	"fmt"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/server"
+)
+
+func toPoolResources(r server.Resources) gen.PoolResources {
+	return gen.PoolResources{
+		CpuCores: r.CPUCores,
+		RamMb:    r.RAMMB,
+		DiskGb:   r.DiskGB,
+		IpCount:  r.IPCount,
+	}
+}
+
+func toOptionalPoolResources(r *server.Resources) *gen.PoolResources {
+	if r == nil {
+		return nil
+	}
+
+	res := toPoolResources(*r)
+	return &res
+}
+
+func toCapacityPool(p capacity.PoolReport) gen.CapacityPool {
+	pool := gen.CapacityPool{
+		PoolId:    p.PoolID,
+		Known:     p.Known,
+		Drifting:  p.Drifting(),
+		Repaired:  p.Repaired,
+		Expected:  toPoolResources(p.Expected),
+		Capacity:  toOptionalPoolResources(p.Capacity),
+		Allocated: toOptionalPoolResources(p.Allocated),
+		Drift:     toOptionalPoolResources(p.Drift),
+	}
+
+	if p.Known {
+		pool.Name = &p.Name
+		pool.Available = toOptionalPoolResources(&p.Available)
+	}
+
+	if p.Note != "" {
+		pool.Note = &p.Note
+	}
+
+	return pool
+}
+
+func toCapacityReport(r capacity.Report, prefix string) gen.CapacityReport {
+	pools := make([]gen.CapacityPool, len(r.Pools))
+	for i, p := range r.Pools {
+		pools[i] = toCapacityPool(p)
+	}
+
+	return gen.CapacityReport{
+		CheckedAt: r.CheckedAt,
+		Repair:    r.Repair,
+		Drifting:  r.Drifting(),
+		Unsettled: r.Unsettled,
+		Pools:     pools,
+		UnderscoreLinks: gen.Links{
+			"self":   gen.Link{Href: fmt.Sprintf("%s/admin/capacity", prefix)},
+			"repair": gen.Link{Href: fmt.Sprintf("%s/admin/capacity/repair", prefix)},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"hosting-kit/page"
 	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
 	"hosting-service/internal/plan"
 )
 
@@ -21,6 +22,27 @@ func New(planBus plan.ExtBusiness, prefix string) *PlanHandlers {
 }
 
 func (p *PlanHandlers) ListPlans(ctx context.Context, request gen.ListPlansRequestObject) (gen.ListPlansResponseObject, error) {
+	cur, ok, err := pagination.ParseCursor(request.Params.Limit, request.Params.After, request.Params.Before)
+	if err != nil {
+		return gen.ListPlans400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	if ok {
+		edges, doc, err := p.planBus.SearchByCursor(ctx, cur)
+		if err != nil {
+			if errors.Is(err, page.ErrInvalidCursor) {
+				return gen.ListPlans400JSONResponse{
+					BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+				}, nil
+			}
+			return nil, err
+		}
+
+		return gen.ListPlans200ApplicationHalPlusJSONResponse(toPlanCursorResponse(edges, cur, doc, p.prefix)), nil
+	}
+
 	pageNum := 1
 	pageSize := 10
 
@@ -43,13 +65,22 @@ func (p *PlanHandlers) ListPlans(ctx context.Context, request gen.ListPlansReque
 }
 
 func (p *PlanHandlers) CreatePlan(ctx context.Context, request gen.CreatePlanRequestObject) (gen.CreatePlanResponseObject, error) {
-	newPlan, err := p.planBus.Create(ctx, plan.CreatePlanParams{
+	params := plan.CreatePlanParams{
 		Name:     request.Body.Name,
 		CPUCores: request.Body.CpuCores,
 		RAMMB:    request.Body.RamMb,
 		DiskGB:   request.Body.DiskGb,
 		IpCount:  request.Body.IpCount,
-	})
+	}
+
+	if request.Body.RunningHourlyPrice != nil {
+		params.RunningHourlyPrice = *request.Body.RunningHourlyPrice
+	}
+	if request.Body.AllocatedHourlyPrice != nil {
+		params.AllocatedHourlyPrice = *request.Body.AllocatedHourlyPrice
+	}
+
+	newPlan, err := p.planBus.Create(ctx, params)
 
 	if err != nil {
 		if errors.Is(err, plan.ErrValidation) {
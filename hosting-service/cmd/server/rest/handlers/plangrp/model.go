@@ -14,13 +14,15 @@ func toPlan(p plan.Plan, prefix string) gen.ServerPlan {
 	}
 
 	return gen.ServerPlan{
-		Id:              p.ID,
-		Name:            p.Name,
-		CpuCores:        p.CPUCores,
-		RamMb:           p.RAMMB,
-		DiskGb:          p.DiskGB,
-		IpCount:         p.IpCount,
-		UnderscoreLinks: links,
+		Id:                   p.ID,
+		Name:                 p.Name,
+		CpuCores:             p.CPUCores,
+		RamMb:                p.RAMMB,
+		DiskGb:               p.DiskGB,
+		IpCount:              p.IpCount,
+		RunningHourlyPrice:   p.RunningHourlyPrice,
+		AllocatedHourlyPrice: p.AllocatedHourlyPrice,
+		UnderscoreLinks:      links,
 	}
 }
 
@@ -30,13 +32,34 @@ func toPlanCollectionResponse(plans []plan.Plan, pg page.Page, total int, prefix
 		items[i] = toPlan(p, prefix)
 	}
 
+	md := pagination.ToMetaData(pg, total)
+
 	return gen.PlanCollectionResponse{
 		UnderscoreEmbedded: struct {
 			Plans []gen.ServerPlan `json:"plans"`
 		}{
 			Plans: items,
 		},
-		Page:            pagination.ToMetaData(pg, total),
+		Page:            &md,
 		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/plans", prefix), pg, total),
 	}
 }
+
+func toPlanCursorResponse(edges []page.Edge[plan.Plan], cur page.Cursor, doc page.CursorDocument, prefix string) gen.PlanCollectionResponse {
+	items := make([]gen.ServerPlan, len(edges))
+	for i, e := range edges {
+		items[i] = toPlan(e.Node, prefix)
+	}
+
+	md := pagination.ToCursorMetaData(doc)
+
+	return gen.PlanCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Plans []gen.ServerPlan `json:"plans"`
+		}{
+			Plans: items,
+		},
+		Cursor:          &md,
+		UnderscoreLinks: pagination.ToCursorLinks(fmt.Sprintf("%s/plans", prefix), nil, cur, doc),
+	}
+}
@@ -0,0 +1,394 @@
+package projectgrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/project"
+)
+
+type ProjectHandlers struct {
+	projectBus project.ExtBusiness
+	prefix     string
+}
+
+func New(projectBus project.ExtBusiness, prefix string) *ProjectHandlers {
+	return &ProjectHandlers{
+		projectBus: projectBus,
+		prefix:     prefix,
+	}
+}
+
+func (h *ProjectHandlers) ListProjects(ctx context.Context, request gen.ListProjectsRequestObject) (gen.ListProjectsResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, total, err := h.projectBus.Search(ctx, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListProjects200ApplicationHalPlusJSONResponse(toProjectCollectionResponse(projects, pg, total, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) CreateProject(ctx context.Context, request gen.CreateProjectRequestObject) (gen.CreateProjectResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := h.projectBus.Create(ctx, request.Body.Name, claims.UserID)
+	if err != nil {
+		if errors.Is(err, project.ErrValidation) {
+			return gen.CreateProject400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateProject201ApplicationHalPlusJSONResponse(toProject(p, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) GetProjectById(ctx context.Context, request gen.GetProjectByIdRequestObject) (gen.GetProjectByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := h.projectBus.FindByID(ctx, request.ProjectId, claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.GetProjectById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetProjectById200ApplicationHalPlusJSONResponse(toProject(p, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) UpdateProject(ctx context.Context, request gen.UpdateProjectRequestObject) (gen.UpdateProjectResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := h.projectBus.Rename(ctx, request.ProjectId, request.Body.Name, claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.UpdateProject404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.UpdateProject400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateProject200ApplicationHalPlusJSONResponse(toProject(p, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) DeleteProject(ctx context.Context, request gen.DeleteProjectRequestObject) (gen.DeleteProjectResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.projectBus.Delete(ctx, request.ProjectId, claims.UserID); err != nil {
+		if isProjectNotFound(err) {
+			return gen.DeleteProject404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.DeleteProject400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrProjectNotEmpty) {
+			return gen.DeleteProject409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeleteProject204Response{}, nil
+}
+
+func (h *ProjectHandlers) ListProjectMembers(ctx context.Context, request gen.ListProjectMembersRequestObject) (gen.ListProjectMembersResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, total, err := h.projectBus.Members(ctx, request.ProjectId, pg, claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.ListProjectMembers404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.ListProjectMembers200ApplicationHalPlusJSONResponse(toMemberCollectionResponse(request.ProjectId, members, pg, total, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) SetProjectMemberRole(ctx context.Context, request gen.SetProjectMemberRoleRequestObject) (gen.SetProjectMemberRoleResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.projectBus.SetRole(ctx, request.ProjectId, request.UserId, project.Role(request.Body.Role), claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.SetProjectMemberRole404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrNotMember) {
+			return gen.SetProjectMemberRole404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrNotMember.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.SetProjectMemberRole400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.SetProjectMemberRole200ApplicationHalPlusJSONResponse(toMember(member, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) RemoveProjectMember(ctx context.Context, request gen.RemoveProjectMemberRequestObject) (gen.RemoveProjectMemberResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.projectBus.RemoveMember(ctx, request.ProjectId, request.UserId, claims.UserID); err != nil {
+		if isProjectNotFound(err) {
+			return gen.RemoveProjectMember404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrNotMember) {
+			return gen.RemoveProjectMember404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrNotMember.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.RemoveProjectMember400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.RemoveProjectMember204Response{}, nil
+}
+
+func (h *ProjectHandlers) ListProjectInvitations(ctx context.Context, request gen.ListProjectInvitationsRequestObject) (gen.ListProjectInvitationsResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invs, total, err := h.projectBus.ProjectInvitations(ctx, request.ProjectId, pg, claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.ListProjectInvitations404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	selfLink := projectInvitationsLink(request.ProjectId, h.prefix)
+
+	return gen.ListProjectInvitations200ApplicationHalPlusJSONResponse(toInvitationCollectionResponse(invs, selfLink, pg, total, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) InviteToProject(ctx context.Context, request gen.InviteToProjectRequestObject) (gen.InviteToProjectResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := h.projectBus.Invite(ctx, request.ProjectId, request.Body.UserId, project.Role(request.Body.Role), claims.UserID)
+	if err != nil {
+		if isProjectNotFound(err) {
+			return gen.InviteToProject404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrProjectNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.InviteToProject400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrDuplicate) {
+			return gen.InviteToProject409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.InviteToProject201ApplicationHalPlusJSONResponse(toInvitation(inv, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) ListInvitations(ctx context.Context, request gen.ListInvitationsRequestObject) (gen.ListInvitationsResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invs, total, err := h.projectBus.Invitations(ctx, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListInvitations200ApplicationHalPlusJSONResponse(toInvitationCollectionResponse(invs, h.prefix+"/invitations", pg, total, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) AcceptInvitation(ctx context.Context, request gen.AcceptInvitationRequestObject) (gen.AcceptInvitationResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.projectBus.AcceptInvitation(ctx, request.InvitationId, claims.UserID)
+	if err != nil {
+		if isInvitationNotFound(err) {
+			return gen.AcceptInvitation404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrInvitationNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.AcceptInvitation400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.AcceptInvitation200ApplicationHalPlusJSONResponse(toMember(member, h.prefix)), nil
+}
+
+func (h *ProjectHandlers) DeclineInvitation(ctx context.Context, request gen.DeclineInvitationRequestObject) (gen.DeclineInvitationResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.projectBus.DeclineInvitation(ctx, request.InvitationId, claims.UserID); err != nil {
+		if isInvitationNotFound(err) {
+			return gen.DeclineInvitation404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrInvitationNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.DeclineInvitation400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeclineInvitation204Response{}, nil
+}
+
+func (h *ProjectHandlers) RevokeInvitation(ctx context.Context, request gen.RevokeInvitationRequestObject) (gen.RevokeInvitationResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.projectBus.RevokeInvitation(ctx, request.InvitationId, claims.UserID); err != nil {
+		if isInvitationNotFound(err) {
+			return gen.RevokeInvitation404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: project.ErrInvitationNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, project.ErrValidation) {
+			return gen.RevokeInvitation400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.RevokeInvitation204Response{}, nil
+}
+
+// isProjectNotFound hides projects the user cannot see behind the same
+// response as projects that do not exist.
+func isProjectNotFound(err error) bool {
+	return errors.Is(err, project.ErrProjectNotFound) || errors.Is(err, project.ErrAccessDenied)
+}
+
+func isInvitationNotFound(err error) bool {
+	return errors.Is(err, project.ErrInvitationNotFound) || errors.Is(err, project.ErrAccessDenied)
+}
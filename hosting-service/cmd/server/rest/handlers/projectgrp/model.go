@@ -0,0 +1,133 @@
+package projectgrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/project"
+
+	"github.com/google/uuid"
+)
+
+func toProject(p project.Project, prefix string) gen.Project {
+	selfLink := fmt.Sprintf("%s/projects/%s", prefix, p.ID)
+
+	links := gen.Links{
+		"self":        gen.Link{Href: selfLink},
+		"members":     gen.Link{Href: selfLink + "/members"},
+		"invitations": gen.Link{Href: selfLink + "/invitations"},
+		"servers":     gen.Link{Href: fmt.Sprintf("%s/servers?projectId=%s", prefix, p.ID)},
+		"update":      gen.Link{Href: selfLink},
+	}
+	if !p.Personal {
+		links["delete"] = gen.Link{Href: selfLink}
+	}
+
+	return gen.Project{
+		Id:              p.ID,
+		Name:            p.Name,
+		Personal:        p.Personal,
+		CreatedBy:       p.CreatedBy,
+		CreatedAt:       p.CreatedAt,
+		UnderscoreLinks: links,
+	}
+}
+
+func toProjectCollectionResponse(projects []project.Project, pg page.Page, total int, prefix string) gen.ProjectCollectionResponse {
+	items := make([]gen.Project, len(projects))
+	for i, p := range projects {
+		items[i] = toProject(p, prefix)
+	}
+
+	return gen.ProjectCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Projects []gen.Project `json:"projects"`
+		}{
+			Projects: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/projects", prefix), pg, total),
+	}
+}
+
+func toMember(m project.Member, prefix string) gen.ProjectMember {
+	selfLink := fmt.Sprintf("%s/projects/%s/members/%s", prefix, m.ProjectID, m.UserID)
+
+	return gen.ProjectMember{
+		ProjectId: m.ProjectID,
+		UserId:    m.UserID,
+		Role:      gen.ProjectRole(m.Role),
+		CreatedAt: m.CreatedAt,
+		UnderscoreLinks: gen.Links{
+			"self":    gen.Link{Href: selfLink},
+			"project": gen.Link{Href: fmt.Sprintf("%s/projects/%s", prefix, m.ProjectID)},
+			"update":  gen.Link{Href: selfLink},
+			"delete":  gen.Link{Href: selfLink},
+		},
+	}
+}
+
+func toMemberCollectionResponse(projectID uuid.UUID, members []project.Member, pg page.Page, total int, prefix string) gen.ProjectMemberCollectionResponse {
+	items := make([]gen.ProjectMember, len(members))
+	for i, m := range members {
+		items[i] = toMember(m, prefix)
+	}
+
+	return gen.ProjectMemberCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Members []gen.ProjectMember `json:"members"`
+		}{
+			Members: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/projects/%s/members", prefix, projectID), pg, total),
+	}
+}
+
+func toInvitation(inv project.Invitation, prefix string) gen.Invitation {
+	selfLink := fmt.Sprintf("%s/invitations/%s", prefix, inv.ID)
+
+	links := gen.Links{
+		"self":    gen.Link{Href: selfLink},
+		"project": gen.Link{Href: fmt.Sprintf("%s/projects/%s", prefix, inv.ProjectID)},
+	}
+	if inv.Status == project.InvitationPending {
+		links["accept"] = gen.Link{Href: selfLink + "/accept"}
+		links["decline"] = gen.Link{Href: selfLink + "/decline"}
+		links["revoke"] = gen.Link{Href: selfLink}
+	}
+
+	return gen.Invitation{
+		Id:              inv.ID,
+		ProjectId:       inv.ProjectID,
+		InviteeId:       inv.InviteeID,
+		InvitedBy:       inv.InvitedBy,
+		Role:            gen.ProjectRole(inv.Role),
+		Status:          gen.InvitationStatus(inv.Status),
+		CreatedAt:       inv.CreatedAt,
+		ExpiresAt:       inv.ExpiresAt,
+		UnderscoreLinks: links,
+	}
+}
+
+func toInvitationCollectionResponse(invs []project.Invitation, baseURL string, pg page.Page, total int, prefix string) gen.InvitationCollectionResponse {
+	items := make([]gen.Invitation, len(invs))
+	for i, inv := range invs {
+		items[i] = toInvitation(inv, prefix)
+	}
+
+	return gen.InvitationCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Invitations []gen.Invitation `json:"invitations"`
+		}{
+			Invitations: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(baseURL, pg, total),
+	}
+}
+
+func projectInvitationsLink(projectID uuid.UUID, prefix string) string {
+	return fmt.Sprintf("%s/projects/%s/invitations", prefix, projectID)
+}
@@ -0,0 +1,116 @@
+package quotagrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/quota"
+)
+
+type QuotaHandlers struct {
+	quotaBus quota.ExtBusiness
+	prefix   string
+}
+
+func New(quotaBus quota.ExtBusiness, prefix string) *QuotaHandlers {
+	return &QuotaHandlers{
+		quotaBus: quotaBus,
+		prefix:   prefix,
+	}
+}
+
+func (q *QuotaHandlers) GetMyQuota(ctx context.Context, request gen.GetMyQuotaRequestObject) (gen.GetMyQuotaResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := q.quotaBus.Get(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetMyQuota200JSONResponse(toQuota(current, q.prefix)), nil
+}
+
+func (q *QuotaHandlers) ListQuotaOverrides(ctx context.Context, request gen.ListQuotaOverridesRequestObject) (gen.ListQuotaOverridesResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	overrides, total, err := q.quotaBus.SearchOverrides(ctx, pg)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListQuotaOverrides200ApplicationHalPlusJSONResponse(toOverrideCollectionResponse(overrides, pg, total, q.prefix)), nil
+}
+
+func (q *QuotaHandlers) GetDefaultQuota(ctx context.Context, request gen.GetDefaultQuotaRequestObject) (gen.GetDefaultQuotaResponseObject, error) {
+	limits, err := q.quotaBus.Default(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetDefaultQuota200JSONResponse(toResources(limits)), nil
+}
+
+func (q *QuotaHandlers) SetDefaultQuota(ctx context.Context, request gen.SetDefaultQuotaRequestObject) (gen.SetDefaultQuotaResponseObject, error) {
+	limits, err := q.quotaBus.SetDefault(ctx, toLimits(*request.Body))
+	if err != nil {
+		if errors.Is(err, quota.ErrValidation) {
+			return gen.SetDefaultQuota400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.SetDefaultQuota200JSONResponse(toResources(limits)), nil
+}
+
+func (q *QuotaHandlers) GetUserQuota(ctx context.Context, request gen.GetUserQuotaRequestObject) (gen.GetUserQuotaResponseObject, error) {
+	current, err := q.quotaBus.Get(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.GetUserQuota200JSONResponse(toQuota(current, q.prefix)), nil
+}
+
+func (q *QuotaHandlers) SetUserQuota(ctx context.Context, request gen.SetUserQuotaRequestObject) (gen.SetUserQuotaResponseObject, error) {
+	override, err := q.quotaBus.SetOverride(ctx, request.UserId, toLimits(*request.Body))
+	if err != nil {
+		if errors.Is(err, quota.ErrValidation) {
+			return gen.SetUserQuota400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.SetUserQuota200JSONResponse(toOverride(override, q.prefix)), nil
+}
+
+func (q *QuotaHandlers) DeleteUserQuota(ctx context.Context, request gen.DeleteUserQuotaRequestObject) (gen.DeleteUserQuotaResponseObject, error) {
+	if err := q.quotaBus.DeleteOverride(ctx, request.UserId); err != nil {
+		if errors.Is(err, quota.ErrOverrideNotFound) {
+			return gen.DeleteUserQuota404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: quota.ErrOverrideNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeleteUserQuota204Response{}, nil
+}
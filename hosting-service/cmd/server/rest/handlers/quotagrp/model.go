@@ -0,0 +1,83 @@
+package quotagrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/quota"
+)
+
+func toResources(l quota.Limits) gen.QuotaResources {
+	return gen.QuotaResources{
+		Servers:  l.Servers,
+		CpuCores: l.CPUCores,
+		RamMb:    l.RAMMB,
+		DiskGb:   l.DiskGB,
+		IpCount:  l.IPCount,
+	}
+}
+
+func toUsage(u quota.Usage) gen.QuotaResources {
+	return gen.QuotaResources{
+		Servers:  u.Servers,
+		CpuCores: u.CPUCores,
+		RamMb:    u.RAMMB,
+		DiskGb:   u.DiskGB,
+		IpCount:  u.IPCount,
+	}
+}
+
+func toLimits(r gen.QuotaResources) quota.Limits {
+	return quota.Limits{
+		Servers:  r.Servers,
+		CPUCores: r.CpuCores,
+		RAMMB:    r.RamMb,
+		DiskGB:   r.DiskGb,
+		IPCount:  r.IpCount,
+	}
+}
+
+func toQuota(q quota.Quota, prefix string) gen.Quota {
+	links := gen.Links{
+		"self": gen.Link{Href: fmt.Sprintf("%s/admin/quotas/%s", prefix, q.UserID)},
+	}
+
+	return gen.Quota{
+		UserId:          q.UserID,
+		Limits:          toResources(q.Limits),
+		Usage:           toUsage(q.Usage),
+		Custom:          q.Custom,
+		UnderscoreLinks: links,
+	}
+}
+
+func toOverride(o quota.Override, prefix string) gen.QuotaOverride {
+	links := gen.Links{
+		"self": gen.Link{Href: fmt.Sprintf("%s/admin/quotas/%s", prefix, o.UserID)},
+	}
+
+	return gen.QuotaOverride{
+		UserId:          o.UserID,
+		Limits:          toResources(o.Limits),
+		UpdatedAt:       o.UpdatedAt,
+		UnderscoreLinks: links,
+	}
+}
+
+func toOverrideCollectionResponse(overrides []quota.Override, pg page.Page, total int, prefix string) gen.QuotaOverrideCollectionResponse {
+	items := make([]gen.QuotaOverride, len(overrides))
+	for i, o := range overrides {
+		items[i] = toOverride(o, prefix)
+	}
+
+	return gen.QuotaOverrideCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Overrides []gen.QuotaOverride `json:"overrides"`
+		}{
+			Overrides: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/admin/quotas", prefix), pg, total),
+	}
+}
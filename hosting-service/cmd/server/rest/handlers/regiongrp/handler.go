@@ -0,0 +1,28 @@
+package regiongrp
+
+import (
+	"context"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/region"
+)
+
+type RegionHandlers struct {
+	regionBus region.ExtBusiness
+	prefix    string
+}
+
+func New(regionBus region.ExtBusiness, prefix string) *RegionHandlers {
+	return &RegionHandlers{
+		regionBus: regionBus,
+		prefix:    prefix,
+	}
+}
+
+func (h *RegionHandlers) ListRegions(ctx context.Context, request gen.ListRegionsRequestObject) (gen.ListRegionsResponseObject, error) {
+	regions, err := h.regionBus.Search(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListRegions200JSONResponse(toRegionCollection(regions, h.prefix)), nil
+}
@@ -0,0 +1,36 @@
+package regiongrp
+
+import (
+	"fmt"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/region"
+)
+
+func toRegion(r region.Region) gen.Region {
+	return gen.Region{
+		Name:  r.Name,
+		Pools: r.Pools,
+		Available: gen.PoolResources{
+			CpuCores: r.Available.CPUCores,
+			RamMb:    r.Available.RAMMB,
+			DiskGb:   r.Available.DiskGB,
+			IpCount:  r.Available.IPCount,
+		},
+	}
+}
+
+func toRegionCollection(regions []region.Region, prefix string) gen.RegionCollectionResponse {
+	var resp gen.RegionCollectionResponse
+
+	resp.UnderscoreEmbedded.Regions = make([]gen.Region, len(regions))
+	for i, r := range regions {
+		resp.UnderscoreEmbedded.Regions[i] = toRegion(r)
+	}
+
+	resp.UnderscoreLinks = gen.Links{
+		"self":    gen.Link{Href: fmt.Sprintf("%s/regions", prefix)},
+		"servers": gen.Link{Href: fmt.Sprintf("%s/servers", prefix)},
+	}
+
+	return resp
+}
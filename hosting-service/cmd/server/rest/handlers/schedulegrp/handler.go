@@ -0,0 +1,136 @@
+package schedulegrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/schedule"
+	"hosting-service/internal/server"
+)
+
+type ScheduleHandlers struct {
+	scheduleBus schedule.ExtBusiness
+	prefix      string
+}
+
+func New(scheduleBus schedule.ExtBusiness, prefix string) *ScheduleHandlers {
+	return &ScheduleHandlers{
+		scheduleBus: scheduleBus,
+		prefix:      prefix,
+	}
+}
+
+func (h *ScheduleHandlers) CreateSchedule(ctx context.Context, request gen.CreateScheduleRequestObject) (gen.CreateScheduleResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := h.scheduleBus.Create(ctx, request.ServerId, toBusNewSchedule(*request.Body), claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
+			return gen.CreateSchedule404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, schedule.ErrValidation) {
+			return gen.CreateSchedule400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateSchedule201ApplicationHalPlusJSONResponse(toSchedule(sched, h.prefix)), nil
+}
+
+func (h *ScheduleHandlers) ListSchedules(ctx context.Context, request gen.ListSchedulesRequestObject) (gen.ListSchedulesResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := schedule.QueryFilter{ServerID: request.Params.ServerId}
+
+	schedules, total, err := h.scheduleBus.Search(ctx, filter, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListSchedules200ApplicationHalPlusJSONResponse(toScheduleCollectionResponse(schedules, request.Params, pg, total, h.prefix)), nil
+}
+
+func (h *ScheduleHandlers) GetScheduleById(ctx context.Context, request gen.GetScheduleByIdRequestObject) (gen.GetScheduleByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := h.scheduleBus.FindByID(ctx, request.ScheduleId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return gen.GetScheduleById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: schedule.ErrScheduleNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetScheduleById200ApplicationHalPlusJSONResponse(toSchedule(sched, h.prefix)), nil
+}
+
+func (h *ScheduleHandlers) UpdateSchedule(ctx context.Context, request gen.UpdateScheduleRequestObject) (gen.UpdateScheduleResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := h.scheduleBus.Update(ctx, request.ScheduleId, toBusUpdateSchedule(*request.Body), claims.UserID)
+	if err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return gen.UpdateSchedule404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: schedule.ErrScheduleNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, schedule.ErrValidation) {
+			return gen.UpdateSchedule400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.UpdateSchedule200ApplicationHalPlusJSONResponse(toSchedule(sched, h.prefix)), nil
+}
+
+func (h *ScheduleHandlers) DeleteSchedule(ctx context.Context, request gen.DeleteScheduleRequestObject) (gen.DeleteScheduleResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.scheduleBus.Delete(ctx, request.ScheduleId, claims.UserID); err != nil {
+		if errors.Is(err, schedule.ErrScheduleNotFound) || errors.Is(err, schedule.ErrAccessDenied) {
+			return gen.DeleteSchedule404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: schedule.ErrScheduleNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeleteSchedule204Response{}, nil
+}
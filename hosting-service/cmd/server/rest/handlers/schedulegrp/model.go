@@ -0,0 +1,88 @@
+package schedulegrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/schedule"
+	"hosting-service/internal/server"
+	"net/url"
+)
+
+func toSchedule(s schedule.Schedule, prefix string) gen.Schedule {
+	selfLink := fmt.Sprintf("%s/schedules/%s", prefix, s.ID)
+
+	return gen.Schedule{
+		Id:        s.ID,
+		ServerId:  s.ServerID,
+		Action:    gen.ScheduledAction(s.Action),
+		RunAt:     s.RunAt,
+		Cron:      s.Cron,
+		Timezone:  s.Timezone,
+		Enabled:   s.Enabled,
+		NextRunAt: s.NextRunAt,
+		LastRunAt: s.LastRunAt,
+		LastError: s.LastError,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+		UnderscoreLinks: gen.Links{
+			"self":   gen.Link{Href: selfLink},
+			"server": gen.Link{Href: fmt.Sprintf("%s/servers/%s", prefix, s.ServerID)},
+			"update": gen.Link{Href: selfLink},
+			"delete": gen.Link{Href: selfLink},
+		},
+	}
+}
+
+func toScheduleCollectionResponse(schedules []schedule.Schedule, params gen.ListSchedulesParams, pg page.Page, total int, prefix string) gen.ScheduleCollectionResponse {
+	items := make([]gen.Schedule, len(schedules))
+	for i, s := range schedules {
+		items[i] = toSchedule(s, prefix)
+	}
+
+	query := url.Values{}
+	if params.ServerId != nil {
+		query.Set("serverId", params.ServerId.String())
+	}
+
+	return gen.ScheduleCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Schedules []gen.Schedule `json:"schedules"`
+		}{
+			Schedules: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToQueryLinks(fmt.Sprintf("%s/schedules", prefix), query, pg, total),
+	}
+}
+
+func toBusNewSchedule(req gen.CreateScheduleRequest) schedule.NewSchedule {
+	ns := schedule.NewSchedule{
+		Action: server.ActionType(req.Action),
+		RunAt:  req.RunAt,
+		Cron:   req.Cron,
+	}
+
+	if req.Timezone != nil {
+		ns.Timezone = *req.Timezone
+	}
+
+	return ns
+}
+
+func toBusUpdateSchedule(req gen.UpdateScheduleRequest) schedule.UpdateSchedule {
+	us := schedule.UpdateSchedule{
+		RunAt:    req.RunAt,
+		Cron:     req.Cron,
+		Timezone: req.Timezone,
+		Enabled:  req.Enabled,
+	}
+
+	if req.Action != nil {
+		action := server.ActionType(*req.Action)
+		us.Action = &action
+	}
+
+	return us
+}
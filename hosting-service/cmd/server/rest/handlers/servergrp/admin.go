@@ -0,0 +1,191 @@
+package servergrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/server"
+)
+
+// The admin handlers run behind mid.RequireAdmin. They mark the context with
+// server.WithAdmin, so the business layer skips the ownership checks and
+// records the administrator in the server history.
+
+func (s *ServerHandlers) ListAllServers(ctx context.Context, request gen.ListAllServersRequestObject) (gen.ListAllServersResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.WithAdmin(ctx, claims)
+
+	params := request.Params
+
+	orderBy, err := toAdminOrderBy(params)
+	if err != nil {
+		return gen.ListAllServers400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	cur, ok, err := pagination.ParseCursor(params.Limit, params.After, params.Before)
+	if err != nil {
+		return gen.ListAllServers400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	if ok {
+		edges, doc, err := s.serverBus.SearchByCursor(ctx, toAdminQueryFilter(params), orderBy, cur, claims.UserID)
+		if err != nil {
+			if errors.Is(err, server.ErrValidation) || errors.Is(err, page.ErrInvalidCursor) {
+				return gen.ListAllServers400JSONResponse{
+					BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+				}, nil
+			}
+			return nil, err
+		}
+
+		return gen.ListAllServers200ApplicationHalPlusJSONResponse(toAdminServerCursorResponse(edges, toAdminListQuery(params), cur, doc, s.prefix)), nil
+	}
+
+	pageNum := 1
+	pageSize := 10
+
+	if params.Page != nil {
+		pageNum = *params.Page
+	}
+
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	servers, count, err := s.serverBus.Search(ctx, toAdminQueryFilter(params), orderBy, pg, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return gen.ListAllServers400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.ListAllServers200ApplicationHalPlusJSONResponse(toAdminServerCollectionResponse(servers, toAdminListQuery(params), pg, count, s.prefix)), nil
+}
+
+func (s *ServerHandlers) GetAnyServer(ctx context.Context, request gen.GetAnyServerRequestObject) (gen.GetAnyServerResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.WithAdmin(ctx, claims)
+
+	serverFound, err := s.serverBus.FindByID(ctx, request.ServerId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return gen.GetAnyServer404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetAnyServer200ApplicationHalPlusJSONResponse{
+		Body:    toAdminServer(serverFound, s.prefix),
+		Headers: gen.GetAnyServer200ResponseHeaders{ETag: toETag(serverFound.Version)},
+	}, nil
+}
+
+func (s *ServerHandlers) GetAnyServerHistory(ctx context.Context, request gen.GetAnyServerHistoryRequestObject) (gen.GetAnyServerHistoryResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.WithAdmin(ctx, claims)
+
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	events, count, err := s.serverBus.History(ctx, request.ServerId, pg, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return gen.GetAnyServerHistory404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetAnyServerHistory200ApplicationHalPlusJSONResponse(toAdminServerHistoryResponse(request.ServerId, events, pg, count, s.prefix)), nil
+}
+
+func (s *ServerHandlers) PerformAdminServerAction(ctx context.Context, request gen.PerformAdminServerActionRequestObject) (gen.PerformAdminServerActionResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = server.WithAdmin(ctx, claims)
+
+	if request.Params.IfMatch != nil {
+		version, ok, err := parseETag(*request.Params.IfMatch)
+		if err != nil {
+			return gen.PerformAdminServerAction400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if ok {
+			ctx = server.WithExpectedVersion(ctx, version)
+		}
+	}
+
+	id := request.ServerId
+	var result server.Server
+
+	switch request.Body.Action {
+	case gen.FORCESTOP:
+		result, err = s.serverBus.ForceStop(ctx, id)
+	case gen.FORCEDELETE:
+		result, err = s.serverBus.ForceDelete(ctx, id)
+	case gen.RESETPROVISION:
+		result, err = s.serverBus.ResetProvision(ctx, id)
+	default:
+		return gen.PerformAdminServerAction400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: "Unknown action"},
+		}, nil
+	}
+
+	s.log.Info(ctx, "admin server action", "admin_id", claims.UserID, "server_id", id, "action", request.Body.Action, "ok", err == nil)
+
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) {
+			return gen.PerformAdminServerAction404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrValidation) || errors.Is(err, server.ErrConflict) {
+			return gen.PerformAdminServerAction409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.PerformAdminServerAction202JSONResponse{
+		Body:    toAdminServer(result, s.prefix),
+		Headers: gen.PerformAdminServerAction202ResponseHeaders{ETag: toETag(result.Version)},
+	}, nil
+}
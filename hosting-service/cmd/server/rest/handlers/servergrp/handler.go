@@ -4,24 +4,47 @@ import (
 	"context"
 	"errors"
 	"hosting-kit/auth"
+	"hosting-kit/logger"
 	"hosting-kit/page"
 	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+
+	"github.com/google/uuid"
 )
 
 type ServerHandlers struct {
-	serverBus server.ExtBusiness
-	prefix    string
+	serverBus      server.ExtBusiness
+	snapshotBus    snapshot.ExtBusiness
+	idempotencyBus idempotency.ExtBusiness
+	log            *logger.Logger
+	prefix         string
 }
 
-func New(serverBus server.ExtBusiness, prefix string) *ServerHandlers {
+func New(serverBus server.ExtBusiness, snapshotBus snapshot.ExtBusiness, idempotencyBus idempotency.ExtBusiness, log *logger.Logger, prefix string) *ServerHandlers {
 	return &ServerHandlers{
-		serverBus: serverBus,
-		prefix:    prefix,
+		serverBus:      serverBus,
+		snapshotBus:    snapshotBus,
+		idempotencyBus: idempotencyBus,
+		log:            log,
+		prefix:         prefix,
 	}
 }
 
 func (s *ServerHandlers) ListServers(ctx context.Context, request gen.ListServersRequestObject) (gen.ListServersResponseObject, error) {
+	cur, ok, err := pagination.ParseCursor(request.Params.Limit, request.Params.After, request.Params.Before)
+	if err != nil {
+		return gen.ListServers400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	if ok {
+		return s.listServersByCursor(ctx, request.Params, cur)
+	}
+
 	pageNum := 1
 	pageSize := 10
 
@@ -40,12 +63,50 @@ func (s *ServerHandlers) ListServers(ctx context.Context, request gen.ListServer
 		return nil, err
 	}
 
-	servers, count, err := s.serverBus.Search(ctx, page, claims.UserID)
+	orderBy, err := toOrderBy(request.Params)
+	if err != nil {
+		return gen.ListServers400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	servers, count, err := s.serverBus.Search(ctx, toQueryFilter(request.Params), orderBy, page, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return gen.ListServers400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.ListServers200ApplicationHalPlusJSONResponse(toServerCollectionResponse(servers, toListQuery(request.Params), page, count, s.prefix)), nil
+}
+
+func (s *ServerHandlers) listServersByCursor(ctx context.Context, params gen.ListServersParams, cur page.Cursor) (gen.ListServersResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy, err := toOrderBy(params)
+	if err != nil {
+		return gen.ListServers400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+
+	edges, doc, err := s.serverBus.SearchByCursor(ctx, toQueryFilter(params), orderBy, cur, claims.UserID)
 	if err != nil {
+		if errors.Is(err, server.ErrValidation) || errors.Is(err, page.ErrInvalidCursor) {
+			return gen.ListServers400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
 		return nil, err
 	}
 
-	return gen.ListServers200ApplicationHalPlusJSONResponse(toServerCollectionResponse(servers, page, count, s.prefix)), nil
+	return gen.ListServers200ApplicationHalPlusJSONResponse(toServerCursorResponse(edges, toListQuery(params), cur, doc, s.prefix)), nil
 }
 
 func (s *ServerHandlers) OrderServer(ctx context.Context, request gen.OrderServerRequestObject) (gen.OrderServerResponseObject, error) {
@@ -54,9 +115,45 @@ func (s *ServerHandlers) OrderServer(ctx context.Context, request gen.OrderServe
 		return nil, err
 	}
 
-	newServer, err := s.serverBus.Create(ctx, request.Body.Name, request.Body.PlanId, claims.UserID)
+	var sshKeyIDs []uuid.UUID
+	if request.Body.SshKeyIds != nil {
+		sshKeyIDs = *request.Body.SshKeyIds
+	}
+
+	placement := toPlacement(request.Body.Region)
+
+	fingerprint := orderRequest{Op: "order", Name: request.Body.Name, PlanID: request.Body.PlanId, ProjectID: request.Body.ProjectId, Region: placement.Region, SSHKeyIDs: sshKeyIDs}
+
+	newServer, err := s.idempotent(ctx, claims.UserID, request.Params.IdempotencyKey, fingerprint, func(ctx context.Context) (server.Server, error) {
+		return s.serverBus.Create(ctx, request.Body.Name, request.Body.PlanId, request.Body.ProjectId, placement, sshKeyIDs, claims.UserID)
+	})
 
 	if err != nil {
+		if errors.Is(err, idempotency.ErrValidation) {
+			return gen.OrderServer400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, idempotency.ErrKeyReused) {
+			return gen.OrderServer422JSONResponse{
+				IdempotencyKeyReusedJSONResponse: gen.IdempotencyKeyReusedJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, idempotency.ErrInProgress) {
+			return gen.OrderServer409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrAccessDenied) {
+			return gen.OrderServer403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: server.ErrAccessDenied.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrQuotaExceeded) {
+			return gen.OrderServer403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: err.Error()},
+			}, nil
+		}
 		if errors.Is(err, server.ErrValidation) {
 			return gen.OrderServer400JSONResponse{
 				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
@@ -72,12 +169,76 @@ func (s *ServerHandlers) OrderServer(ctx context.Context, request gen.OrderServe
 				ConflictJSONResponse: gen.ConflictJSONResponse{Message: server.ErrNoResources.Error()},
 			}, nil
 		}
+		if errors.Is(err, server.ErrSagaConflict) {
+			return gen.OrderServer409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: server.ErrSagaConflict.Error()},
+			}, nil
+		}
 		return nil, err
 	}
 
 	return gen.OrderServer202ApplicationHalPlusJSONResponse(toServer(newServer, s.prefix)), nil
 }
 
+func (s *ServerHandlers) CreateServerFromSnapshot(ctx context.Context, request gen.CreateServerFromSnapshotRequestObject) (gen.CreateServerFromSnapshotResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sshKeyIDs []uuid.UUID
+	if request.Body.SshKeyIds != nil {
+		sshKeyIDs = *request.Body.SshKeyIds
+	}
+
+	newServer, err := s.snapshotBus.CreateServer(ctx, request.SnapshotId, request.Body.Name, request.Body.PlanId, request.Body.ProjectId, toPlacement(request.Body.Region), sshKeyIDs, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return gen.CreateServerFromSnapshot404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: snapshot.ErrSnapshotNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, snapshot.ErrValidation) {
+			return gen.CreateServerFromSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrAccessDenied) {
+			return gen.CreateServerFromSnapshot403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: server.ErrAccessDenied.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrQuotaExceeded) {
+			return gen.CreateServerFromSnapshot403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrValidation) {
+			return gen.CreateServerFromSnapshot400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrInvalidPlan) {
+			return gen.CreateServerFromSnapshot400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: server.ErrInvalidPlan.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrNoResources) {
+			return gen.CreateServerFromSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: server.ErrNoResources.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrSagaConflict) {
+			return gen.CreateServerFromSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: server.ErrSagaConflict.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateServerFromSnapshot202ApplicationHalPlusJSONResponse(toServer(newServer, s.prefix)), nil
+}
+
 func (s *ServerHandlers) GetServerById(ctx context.Context, request gen.GetServerByIdRequestObject) (gen.GetServerByIdResponseObject, error) {
 	claims, err := auth.GetClaims(ctx)
 	if err != nil {
@@ -87,7 +248,7 @@ func (s *ServerHandlers) GetServerById(ctx context.Context, request gen.GetServe
 	serverFound, err := s.serverBus.FindByID(ctx, request.ServerId, claims.UserID)
 
 	if err != nil {
-		if errors.Is(err, server.ErrServerNotFound) {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
 			return gen.GetServerById404JSONResponse{
 				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
 			}, nil
@@ -95,7 +256,42 @@ func (s *ServerHandlers) GetServerById(ctx context.Context, request gen.GetServe
 		return nil, err
 	}
 
-	return gen.GetServerById200ApplicationHalPlusJSONResponse(toServer(serverFound, s.prefix)), nil
+	return gen.GetServerById200ApplicationHalPlusJSONResponse{
+		Body:    toServer(serverFound, s.prefix),
+		Headers: gen.GetServerById200ResponseHeaders{ETag: toETag(serverFound.Version)},
+	}, nil
+}
+
+func (s *ServerHandlers) GetServerHistory(ctx context.Context, request gen.GetServerHistoryRequestObject) (gen.GetServerHistoryResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	page := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, count, err := s.serverBus.History(ctx, request.ServerId, page, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
+			return gen.GetServerHistory404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetServerHistory200ApplicationHalPlusJSONResponse(toServerHistoryResponse(request.ServerId, events, page, count, s.prefix)), nil
 }
 
 func (s *ServerHandlers) PerformServerAction(ctx context.Context, request gen.PerformServerActionRequestObject) (gen.PerformServerActionResponseObject, error) {
@@ -108,32 +304,161 @@ func (s *ServerHandlers) PerformServerAction(ctx context.Context, request gen.Pe
 		return nil, err
 	}
 
+	if request.Params.IfMatch != nil {
+		version, ok, err := parseETag(*request.Params.IfMatch)
+		if err != nil {
+			return gen.PerformServerAction400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if ok {
+			ctx = server.WithExpectedVersion(ctx, version)
+		}
+	}
+
+	var planID uuid.UUID
+
 	switch request.Body.Action {
-	case gen.START:
-		newServer, err = s.serverBus.Start(ctx, id, claims.UserID)
-	case gen.STOP:
-		newServer, err = s.serverBus.Stop(ctx, id, claims.UserID)
-	case gen.DELETE:
-		newServer, err = s.serverBus.Delete(ctx, id, claims.UserID)
+	case gen.START, gen.STOP, gen.REBOOT, gen.RETRYPROVISION, gen.DELETE, gen.RESTORE:
+	case gen.RESIZE:
+		if request.Body.PlanId == nil {
+			return gen.PerformServerAction400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: "planId is required for RESIZE"},
+			}, nil
+		}
+		planID = *request.Body.PlanId
 	default:
 		return gen.PerformServerAction400JSONResponse{
 			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: "Unknown action"},
 		}, nil
 	}
 
+	fingerprint := actionRequest{Op: "action", ServerID: id, Action: string(request.Body.Action), PlanID: request.Body.PlanId}
+
+	newServer, err = s.idempotent(ctx, claims.UserID, request.Params.IdempotencyKey, fingerprint, func(ctx context.Context) (server.Server, error) {
+		switch request.Body.Action {
+		case gen.START:
+			return s.serverBus.Start(ctx, id, claims.UserID)
+		case gen.STOP:
+			return s.serverBus.Stop(ctx, id, claims.UserID)
+		case gen.REBOOT:
+			return s.serverBus.Reboot(ctx, id, claims.UserID)
+		case gen.RETRYPROVISION:
+			return s.serverBus.RetryProvision(ctx, id, claims.UserID)
+		case gen.DELETE:
+			return s.serverBus.Delete(ctx, id, claims.UserID)
+		case gen.RESTORE:
+			return s.serverBus.Restore(ctx, id, claims.UserID)
+		default:
+			return s.serverBus.Resize(ctx, id, planID, claims.UserID)
+		}
+	})
+
 	if err != nil {
+		if errors.Is(err, idempotency.ErrValidation) {
+			return gen.PerformServerAction400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, idempotency.ErrKeyReused) {
+			return gen.PerformServerAction422JSONResponse{
+				IdempotencyKeyReusedJSONResponse: gen.IdempotencyKeyReusedJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, idempotency.ErrInProgress) {
+			return gen.PerformServerAction409JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, server.ErrAccessDenied) {
+			return gen.PerformServerAction403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: server.ErrAccessDenied.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrQuotaExceeded) {
+			return gen.PerformServerAction403JSONResponse{
+				ForbiddenJSONResponse: gen.ForbiddenJSONResponse{Message: err.Error()},
+			}, nil
+		}
 		if errors.Is(err, server.ErrServerNotFound) {
 			return gen.PerformServerAction404JSONResponse{
 				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
 			}, nil
 		}
+		if errors.Is(err, server.ErrInvalidPlan) {
+			return gen.PerformServerAction400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: server.ErrInvalidPlan.Error()},
+			}, nil
+		}
 		if errors.Is(err, server.ErrValidation) {
 			return gen.PerformServerAction409JSONResponse{
 				Message: err.Error(),
 			}, nil
 		}
+		if errors.Is(err, server.ErrNoResources) {
+			return gen.PerformServerAction409JSONResponse{
+				Message: server.ErrNoResources.Error(),
+			}, nil
+		}
+		if errors.Is(err, server.ErrConflict) {
+			return gen.PerformServerAction409JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, server.ErrSagaConflict) {
+			return gen.PerformServerAction409JSONResponse{
+				Message: server.ErrSagaConflict.Error(),
+			}, nil
+		}
 		return nil, err
 	}
 
-	return gen.PerformServerAction202JSONResponse(toServer(newServer, s.prefix)), nil
+	return gen.PerformServerAction202JSONResponse{
+		Body:    toServer(newServer, s.prefix),
+		Headers: gen.PerformServerAction202ResponseHeaders{ETag: toETag(newServer.Version)},
+	}, nil
+}
+
+func (s *ServerHandlers) BatchServerActions(ctx context.Context, request gen.BatchServerActionsRequestObject) (gen.BatchServerActionsResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var target server.BulkTarget
+	if request.Body.ServerIds != nil {
+		target.ServerIDs = *request.Body.ServerIds
+	}
+	if request.Body.Filter != nil {
+		filter := toBatchQueryFilter(*request.Body.Filter)
+		target.Filter = &filter
+	}
+
+	results, err := s.serverBus.BulkAction(ctx, target, server.ActionType(request.Body.Action), request.Body.PlanId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrValidation) {
+			return gen.BatchServerActions400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	for _, res := range results {
+		if res.Err != nil && toBatchError(res.Err).Code == gen.INTERNAL {
+			s.log.Error(ctx, "batch server action", "server_id", res.ServerID, "action", request.Body.Action, "err", res.Err)
+		}
+	}
+
+	return gen.BatchServerActions200JSONResponse(toBatchServerActionResponse(results, s.prefix)), nil
+}
+
+// idempotent runs fn through the idempotency keys when the client sent one,
+// so a retried request returns the first result instead of running again.
+func (s *ServerHandlers) idempotent(ctx context.Context, userID uuid.UUID, key *string, fingerprint any, fn func(ctx context.Context) (server.Server, error)) (server.Server, error) {
+	if key == nil {
+		return fn(ctx)
+	}
+
+	return idempotency.Run(ctx, s.idempotencyBus, userID, *key, fingerprint, fn)
 }
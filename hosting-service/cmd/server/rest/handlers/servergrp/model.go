@@ -1,11 +1,18 @@
 package servergrp
 
 import (
+	"errors"
 	"fmt"
 	"hosting-kit/page"
 	"hosting-service/cmd/server/rest/gen"
 	"hosting-service/cmd/server/rest/pagination"
 	"hosting-service/internal/server"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func toServer(s server.Server, prefix string) gen.Server {
@@ -15,40 +22,434 @@ func toServer(s server.Server, prefix string) gen.Server {
 	actionsLink := fmt.Sprintf("%s/servers/%s/actions", prefix, s.ID)
 
 	links["self"] = gen.Link{Href: selfLink}
+	links["history"] = gen.Link{Href: fmt.Sprintf("%s/servers/%s/history", prefix, s.ID)}
+	links["project"] = gen.Link{Href: fmt.Sprintf("%s/projects/%s", prefix, s.ProjectID)}
 
 	switch s.Status {
 	case server.StatusRunning:
 		links["stop"] = gen.Link{Href: actionsLink}
+		links["reboot"] = gen.Link{Href: actionsLink}
 	case server.StatusStopped:
 		links["start"] = gen.Link{Href: actionsLink}
 		links["delete"] = gen.Link{Href: actionsLink}
+		links["resize"] = gen.Link{Href: actionsLink}
+	case server.StatusProvisionFailed:
+		links["retry_provision"] = gen.Link{Href: actionsLink}
+		links["delete"] = gen.Link{Href: actionsLink}
+	case server.StatusDeletedPending:
+		links["restore"] = gen.Link{Href: actionsLink}
 	}
 
 	return gen.Server{
-		Id:              s.ID,
-		Name:            s.Name,
-		PlanId:          s.PlanID,
-		IPv4Address:     s.IPv4Address,
-		PoolId:          s.PoolID,
-		Status:          gen.ServerStatus(s.Status),
-		CreatedAt:       s.CreatedAt,
-		UnderscoreLinks: links,
+		Id:                s.ID,
+		OwnerId:           s.OwnerID,
+		ProjectId:         s.ProjectID,
+		Name:              s.Name,
+		PlanId:            s.PlanID,
+		IPv4Address:       s.IPv4Address,
+		Addresses:         toAddresses(s.Addresses),
+		PoolId:            s.PoolID,
+		Region:            s.Region,
+		Status:            gen.ServerStatus(s.Status),
+		ProvisionAttempts: s.ProvisionAttempts,
+		Version:           s.Version,
+		FailureReason:     s.FailureReason,
+		PurgeAt:           s.PurgeAt,
+		CreatedAt:         s.CreatedAt,
+		UnderscoreLinks:   links,
+	}
+}
+
+// toAddresses returns nil for a server that has no addresses yet.
+func toAddresses(addrs []server.Address) *[]gen.ServerAddress {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	out := make([]gen.ServerAddress, len(addrs))
+	for i, a := range addrs {
+		out[i] = gen.ServerAddress{
+			Address:    a.Address,
+			Family:     gen.ServerAddressFamily(a.Family),
+			Primary:    a.Primary,
+			ReverseDns: a.ReverseDNS,
+		}
+	}
+	return &out
+}
+
+// orderRequest and actionRequest identify a request behind an idempotency
+// key, so a replay with a different payload is rejected.
+type orderRequest struct {
+	Op        string      `json:"op"`
+	Name      string      `json:"name"`
+	PlanID    uuid.UUID   `json:"planId"`
+	ProjectID *uuid.UUID  `json:"projectId,omitempty"`
+	Region    string      `json:"region,omitempty"`
+	SSHKeyIDs []uuid.UUID `json:"sshKeyIds,omitempty"`
+}
+
+func toPlacement(region *string) server.Placement {
+	if region == nil {
+		return server.Placement{}
 	}
+
+	return server.Placement{Region: *region}
+}
+
+type actionRequest struct {
+	Op       string     `json:"op"`
+	ServerID uuid.UUID  `json:"serverId"`
+	Action   string     `json:"action"`
+	PlanID   *uuid.UUID `json:"planId,omitempty"`
 }
 
-func toServerCollectionResponse(servers []server.Server, pg page.Page, total int, prefix string) gen.ServerCollectionResponse {
+// toETag renders the server version as a strong entity tag.
+func toETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// parseETag reads an If-Match value. The second result is false for "*",
+// which matches any version.
+func parseETag(tag string) (int, bool, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "*" {
+		return 0, false, nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(tag, "W/"), `"`))
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid If-Match value %q", tag)
+	}
+
+	return version, true, nil
+}
+
+func toServerCollectionResponse(servers []server.Server, query url.Values, pg page.Page, total int, prefix string) gen.ServerCollectionResponse {
 	items := make([]gen.Server, len(servers))
 	for i, s := range servers {
 		items[i] = toServer(s, prefix)
 	}
 
+	md := pagination.ToMetaData(pg, total)
+
 	return gen.ServerCollectionResponse{
 		UnderscoreEmbedded: struct {
 			Servers []gen.Server `json:"servers"`
 		}{
 			Servers: items,
 		},
+		Page:            &md,
+		UnderscoreLinks: pagination.ToQueryLinks(fmt.Sprintf("%s/servers", prefix), query, pg, total),
+	}
+}
+
+func toServerCursorResponse(edges []page.Edge[server.Server], query url.Values, cur page.Cursor, doc page.CursorDocument, prefix string) gen.ServerCollectionResponse {
+	items := make([]gen.Server, len(edges))
+	for i, e := range edges {
+		items[i] = toServer(e.Node, prefix)
+	}
+
+	md := pagination.ToCursorMetaData(doc)
+
+	return gen.ServerCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Servers []gen.Server `json:"servers"`
+		}{
+			Servers: items,
+		},
+		Cursor:          &md,
+		UnderscoreLinks: pagination.ToCursorLinks(fmt.Sprintf("%s/servers", prefix), query, cur, doc),
+	}
+}
+
+func toServerEvent(e server.Event) gen.ServerEvent {
+	var oldStatus *string
+	if e.OldStatus != nil {
+		s := string(*e.OldStatus)
+		oldStatus = &s
+	}
+
+	return gen.ServerEvent{
+		Id:        e.ID,
+		Actor:     e.Actor,
+		OldStatus: oldStatus,
+		NewStatus: string(e.NewStatus),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toServerHistoryResponse(serverID uuid.UUID, events []server.Event, pg page.Page, total int, prefix string) gen.ServerHistoryResponse {
+	items := make([]gen.ServerEvent, len(events))
+	for i, e := range events {
+		items[i] = toServerEvent(e)
+	}
+
+	return gen.ServerHistoryResponse{
+		UnderscoreEmbedded: struct {
+			Events []gen.ServerEvent `json:"events"`
+		}{
+			Events: items,
+		},
 		Page:            pagination.ToMetaData(pg, total),
-		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/servers", prefix), pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/servers/%s/history", prefix, serverID), pg, total),
+	}
+}
+
+func toQueryFilter(params gen.ListServersParams) server.QueryFilter {
+	var filter server.QueryFilter
+
+	if params.Status != nil {
+		status := server.ServerStatus(*params.Status)
+		filter.Status = &status
+	}
+
+	filter.ProjectID = params.ProjectId
+	filter.PlanID = params.PlanId
+	filter.Name = params.Name
+	filter.IPAddress = params.IpAddress
+	filter.StartCreatedAt = params.CreatedFrom
+	filter.EndCreatedAt = params.CreatedTo
+
+	return filter
+}
+
+func toBatchQueryFilter(f gen.ServerFilter) server.QueryFilter {
+	var filter server.QueryFilter
+
+	if f.Status != nil {
+		status := server.ServerStatus(*f.Status)
+		filter.Status = &status
+	}
+
+	filter.ProjectID = f.ProjectId
+	filter.PlanID = f.PlanId
+	filter.Name = f.Name
+	filter.IPAddress = f.IpAddress
+	filter.StartCreatedAt = f.CreatedFrom
+	filter.EndCreatedAt = f.CreatedTo
+
+	return filter
+}
+
+// toBatchError types the error of a single server in a batch action. Errors
+// the client cannot act on are reported as INTERNAL without details.
+func toBatchError(err error) *gen.BatchServerActionError {
+	code := gen.INTERNAL
+	message := "internal server error"
+
+	switch {
+	case errors.Is(err, server.ErrServerNotFound):
+		code, message = gen.NOTFOUND, server.ErrServerNotFound.Error()
+	case errors.Is(err, server.ErrAccessDenied):
+		code, message = gen.ACCESSDENIED, server.ErrAccessDenied.Error()
+	case errors.Is(err, server.ErrValidation):
+		code, message = gen.INVALIDSTATUS, err.Error()
+	case errors.Is(err, server.ErrConflict):
+		code, message = gen.CONFLICT, err.Error()
+	case errors.Is(err, server.ErrInvalidPlan):
+		code, message = gen.INVALIDPLAN, server.ErrInvalidPlan.Error()
+	case errors.Is(err, server.ErrNoResources):
+		code, message = gen.NORESOURCES, server.ErrNoResources.Error()
+	case errors.Is(err, server.ErrQuotaExceeded):
+		code, message = gen.QUOTAEXCEEDED, err.Error()
+	}
+
+	return &gen.BatchServerActionError{Code: code, Message: message}
+}
+
+func toBatchServerActionResponse(results []server.BulkResult, prefix string) gen.BatchServerActionResponse {
+	items := make([]gen.BatchServerActionResult, len(results))
+	for i, res := range results {
+		items[i] = gen.BatchServerActionResult{ServerId: res.ServerID, Success: res.Err == nil}
+		if res.Err != nil {
+			items[i].Error = toBatchError(res.Err)
+			continue
+		}
+		srv := toServer(res.Server, prefix)
+		items[i].Server = &srv
+	}
+
+	return gen.BatchServerActionResponse{Results: items}
+}
+
+func toOrderBy(params gen.ListServersParams) (server.OrderBy, error) {
+	var field, direction string
+
+	if params.OrderBy != nil {
+		field = string(*params.OrderBy)
+	}
+
+	if params.Direction != nil {
+		direction = string(*params.Direction)
+	}
+
+	return server.NewOrderBy(field, direction)
+}
+
+// toListQuery keeps the filters and sorting of a listing for its page links.
+func toListQuery(params gen.ListServersParams) url.Values {
+	query := url.Values{}
+
+	if params.Status != nil {
+		query.Set("status", string(*params.Status))
+	}
+	if params.ProjectId != nil {
+		query.Set("projectId", params.ProjectId.String())
+	}
+	if params.PlanId != nil {
+		query.Set("planId", params.PlanId.String())
+	}
+	if params.Name != nil {
+		query.Set("name", *params.Name)
+	}
+	if params.IpAddress != nil {
+		query.Set("ipAddress", *params.IpAddress)
+	}
+	if params.CreatedFrom != nil {
+		query.Set("createdFrom", params.CreatedFrom.Format(time.RFC3339Nano))
+	}
+	if params.CreatedTo != nil {
+		query.Set("createdTo", params.CreatedTo.Format(time.RFC3339Nano))
 	}
+	if params.OrderBy != nil {
+		query.Set("orderBy", string(*params.OrderBy))
+	}
+	if params.Direction != nil {
+		query.Set("direction", string(*params.Direction))
+	}
+
+	return query
+}
+
+// toAdminServer points the links of a server at the admin endpoints.
+func toAdminServer(s server.Server, prefix string) gen.Server {
+	srv := toServer(s, prefix)
+
+	actionsLink := gen.Link{Href: fmt.Sprintf("%s/admin/servers/%s/actions", prefix, s.ID)}
+
+	links := gen.Links{
+		"self":    gen.Link{Href: fmt.Sprintf("%s/admin/servers/%s", prefix, s.ID)},
+		"history": gen.Link{Href: fmt.Sprintf("%s/admin/servers/%s/history", prefix, s.ID)},
+	}
+
+	switch s.Status {
+	case server.StatusRunning, server.StatusStarting, server.StatusRebooting, server.StatusStopping:
+		links["force_stop"] = actionsLink
+	case server.StatusPending:
+		links["reset_provision"] = actionsLink
+	}
+	if s.Status != server.StatusDeleting {
+		links["force_delete"] = actionsLink
+	}
+
+	srv.UnderscoreLinks = links
+
+	return srv
+}
+
+func toAdminServerCollectionResponse(servers []server.Server, query url.Values, pg page.Page, total int, prefix string) gen.ServerCollectionResponse {
+	items := make([]gen.Server, len(servers))
+	for i, s := range servers {
+		items[i] = toAdminServer(s, prefix)
+	}
+
+	md := pagination.ToMetaData(pg, total)
+
+	return gen.ServerCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Servers []gen.Server `json:"servers"`
+		}{
+			Servers: items,
+		},
+		Page:            &md,
+		UnderscoreLinks: pagination.ToQueryLinks(fmt.Sprintf("%s/admin/servers", prefix), query, pg, total),
+	}
+}
+
+func toAdminServerCursorResponse(edges []page.Edge[server.Server], query url.Values, cur page.Cursor, doc page.CursorDocument, prefix string) gen.ServerCollectionResponse {
+	items := make([]gen.Server, len(edges))
+	for i, e := range edges {
+		items[i] = toAdminServer(e.Node, prefix)
+	}
+
+	md := pagination.ToCursorMetaData(doc)
+
+	return gen.ServerCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Servers []gen.Server `json:"servers"`
+		}{
+			Servers: items,
+		},
+		Cursor:          &md,
+		UnderscoreLinks: pagination.ToCursorLinks(fmt.Sprintf("%s/admin/servers", prefix), query, cur, doc),
+	}
+}
+
+func toAdminServerHistoryResponse(serverID uuid.UUID, events []server.Event, pg page.Page, total int, prefix string) gen.ServerHistoryResponse {
+	resp := toServerHistoryResponse(serverID, events, pg, total, prefix)
+	resp.UnderscoreLinks = pagination.ToLinks(fmt.Sprintf("%s/admin/servers/%s/history", prefix, serverID), pg, total)
+
+	return resp
+}
+
+func toAdminQueryFilter(params gen.ListAllServersParams) server.QueryFilter {
+	var filter server.QueryFilter
+
+	if params.Status != nil {
+		status := server.ServerStatus(*params.Status)
+		filter.Status = &status
+	}
+
+	filter.OwnerID = params.OwnerId
+	filter.PoolID = params.PoolId
+	filter.PlanID = params.PlanId
+	filter.Name = params.Name
+
+	return filter
+}
+
+func toAdminOrderBy(params gen.ListAllServersParams) (server.OrderBy, error) {
+	var field, direction string
+
+	if params.OrderBy != nil {
+		field = string(*params.OrderBy)
+	}
+
+	if params.Direction != nil {
+		direction = string(*params.Direction)
+	}
+
+	return server.NewOrderBy(field, direction)
+}
+
+// toAdminListQuery keeps the filters and sorting of an admin listing for its
+// page links.
+func toAdminListQuery(params gen.ListAllServersParams) url.Values {
+	query := url.Values{}
+
+	if params.OwnerId != nil {
+		query.Set("ownerId", params.OwnerId.String())
+	}
+	if params.PoolId != nil {
+		query.Set("poolId", params.PoolId.String())
+	}
+	if params.Status != nil {
+		query.Set("status", string(*params.Status))
+	}
+	if params.PlanId != nil {
+		query.Set("planId", params.PlanId.String())
+	}
+	if params.Name != nil {
+		query.Set("name", *params.Name)
+	}
+	if params.OrderBy != nil {
+		query.Set("orderBy", string(*params.OrderBy))
+	}
+	if params.Direction != nil {
+		query.Set("direction", string(*params.Direction))
+	}
+
+	return query
 }
@@ -0,0 +1,147 @@
+package snapshotgrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+)
+
+type SnapshotHandlers struct {
+	snapshotBus snapshot.ExtBusiness
+	prefix      string
+}
+
+func New(snapshotBus snapshot.ExtBusiness, prefix string) *SnapshotHandlers {
+	return &SnapshotHandlers{
+		snapshotBus: snapshotBus,
+		prefix:      prefix,
+	}
+}
+
+func (h *SnapshotHandlers) CreateSnapshot(ctx context.Context, request gen.CreateSnapshotRequestObject) (gen.CreateSnapshotResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := h.snapshotBus.Create(ctx, request.ServerId, request.Body.Name, claims.UserID)
+	if err != nil {
+		if errors.Is(err, server.ErrServerNotFound) || errors.Is(err, server.ErrAccessDenied) {
+			return gen.CreateSnapshot404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: server.ErrServerNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, snapshot.ErrValidation) {
+			return gen.CreateSnapshot400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, server.ErrNoResources) {
+			return gen.CreateSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: server.ErrNoResources.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateSnapshot202ApplicationHalPlusJSONResponse(toSnapshot(snap, h.prefix)), nil
+}
+
+func (h *SnapshotHandlers) ListSnapshots(ctx context.Context, request gen.ListSnapshotsRequestObject) (gen.ListSnapshotsResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := snapshot.QueryFilter{ServerID: request.Params.ServerId}
+
+	snaps, total, err := h.snapshotBus.Search(ctx, filter, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListSnapshots200ApplicationHalPlusJSONResponse(toSnapshotCollectionResponse(snaps, request.Params, pg, total, h.prefix)), nil
+}
+
+func (h *SnapshotHandlers) GetSnapshotById(ctx context.Context, request gen.GetSnapshotByIdRequestObject) (gen.GetSnapshotByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := h.snapshotBus.FindByID(ctx, request.SnapshotId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return gen.GetSnapshotById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: snapshot.ErrSnapshotNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetSnapshotById200ApplicationHalPlusJSONResponse(toSnapshot(snap, h.prefix)), nil
+}
+
+func (h *SnapshotHandlers) DeleteSnapshot(ctx context.Context, request gen.DeleteSnapshotRequestObject) (gen.DeleteSnapshotResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := h.snapshotBus.Delete(ctx, request.SnapshotId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return gen.DeleteSnapshot404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: snapshot.ErrSnapshotNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, snapshot.ErrValidation) {
+			return gen.DeleteSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeleteSnapshot202ApplicationHalPlusJSONResponse(toSnapshot(snap, h.prefix)), nil
+}
+
+func (h *SnapshotHandlers) RestoreSnapshot(ctx context.Context, request gen.RestoreSnapshotRequestObject) (gen.RestoreSnapshotResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := h.snapshotBus.Restore(ctx, request.SnapshotId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, snapshot.ErrSnapshotNotFound) || errors.Is(err, snapshot.ErrAccessDenied) {
+			return gen.RestoreSnapshot404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: snapshot.ErrSnapshotNotFound.Error()},
+			}, nil
+		}
+		if errors.Is(err, snapshot.ErrValidation) || errors.Is(err, server.ErrValidation) || errors.Is(err, server.ErrConflict) {
+			return gen.RestoreSnapshot409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.RestoreSnapshot202ApplicationHalPlusJSONResponse(toSnapshot(snap, h.prefix)), nil
+}
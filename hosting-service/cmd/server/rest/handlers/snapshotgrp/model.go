@@ -0,0 +1,61 @@
+package snapshotgrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/snapshot"
+	"net/url"
+)
+
+func toSnapshot(s snapshot.Snapshot, prefix string) gen.Snapshot {
+	selfLink := fmt.Sprintf("%s/snapshots/%s", prefix, s.ID)
+
+	links := gen.Links{
+		"self":   gen.Link{Href: selfLink},
+		"server": gen.Link{Href: fmt.Sprintf("%s/servers/%s", prefix, s.ServerID)},
+	}
+
+	switch s.Status {
+	case snapshot.StatusAvailable:
+		links["restore"] = gen.Link{Href: fmt.Sprintf("%s/snapshots/%s/restore", prefix, s.ID)}
+		links["create_server"] = gen.Link{Href: fmt.Sprintf("%s/snapshots/%s/servers", prefix, s.ID)}
+		links["delete"] = gen.Link{Href: selfLink}
+	case snapshot.StatusFailed:
+		links["delete"] = gen.Link{Href: selfLink}
+	}
+
+	return gen.Snapshot{
+		Id:              s.ID,
+		ServerId:        s.ServerID,
+		Name:            s.Name,
+		SizeGb:          s.SizeGB,
+		Status:          gen.SnapshotStatus(s.Status),
+		FailureReason:   s.FailureReason,
+		CreatedAt:       s.CreatedAt,
+		UnderscoreLinks: links,
+	}
+}
+
+func toSnapshotCollectionResponse(snaps []snapshot.Snapshot, params gen.ListSnapshotsParams, pg page.Page, total int, prefix string) gen.SnapshotCollectionResponse {
+	items := make([]gen.Snapshot, len(snaps))
+	for i, s := range snaps {
+		items[i] = toSnapshot(s, prefix)
+	}
+
+	query := url.Values{}
+	if params.ServerId != nil {
+		query.Set("serverId", params.ServerId.String())
+	}
+
+	return gen.SnapshotCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Snapshots []gen.Snapshot `json:"snapshots"`
+		}{
+			Snapshots: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToQueryLinks(fmt.Sprintf("%s/snapshots", prefix), query, pg, total),
+	}
+}
@@ -0,0 +1,109 @@
+package sshkeygrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/sshkey"
+)
+
+type SSHKeyHandlers struct {
+	sshKeyBus sshkey.ExtBusiness
+	prefix    string
+}
+
+func New(sshKeyBus sshkey.ExtBusiness, prefix string) *SSHKeyHandlers {
+	return &SSHKeyHandlers{
+		sshKeyBus: sshKeyBus,
+		prefix:    prefix,
+	}
+}
+
+func (h *SSHKeyHandlers) ListSshKeys(ctx context.Context, request gen.ListSshKeysRequestObject) (gen.ListSshKeysResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, total, err := h.sshKeyBus.Search(ctx, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListSshKeys200ApplicationHalPlusJSONResponse(toSSHKeyCollectionResponse(keys, pg, total, h.prefix)), nil
+}
+
+func (h *SSHKeyHandlers) CreateSshKey(ctx context.Context, request gen.CreateSshKeyRequestObject) (gen.CreateSshKeyResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := h.sshKeyBus.Create(ctx, request.Body.Name, request.Body.PublicKey, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sshkey.ErrValidation) {
+			return gen.CreateSshKey400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		if errors.Is(err, sshkey.ErrDuplicate) {
+			return gen.CreateSshKey409JSONResponse{
+				ConflictJSONResponse: gen.ConflictJSONResponse{Message: sshkey.ErrDuplicate.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateSshKey201ApplicationHalPlusJSONResponse(toSSHKey(key, h.prefix)), nil
+}
+
+func (h *SSHKeyHandlers) GetSshKeyById(ctx context.Context, request gen.GetSshKeyByIdRequestObject) (gen.GetSshKeyByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := h.sshKeyBus.FindByID(ctx, request.KeyId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, sshkey.ErrKeyNotFound) || errors.Is(err, sshkey.ErrAccessDenied) {
+			return gen.GetSshKeyById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: sshkey.ErrKeyNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetSshKeyById200ApplicationHalPlusJSONResponse(toSSHKey(key, h.prefix)), nil
+}
+
+func (h *SSHKeyHandlers) DeleteSshKey(ctx context.Context, request gen.DeleteSshKeyRequestObject) (gen.DeleteSshKeyResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.sshKeyBus.Delete(ctx, request.KeyId, claims.UserID); err != nil {
+		if errors.Is(err, sshkey.ErrKeyNotFound) || errors.Is(err, sshkey.ErrAccessDenied) {
+			return gen.DeleteSshKey404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: sshkey.ErrKeyNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.DeleteSshKey204Response{}, nil
+}
@@ -0,0 +1,42 @@
+package sshkeygrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/sshkey"
+)
+
+func toSSHKey(k sshkey.SSHKey, prefix string) gen.SshKey {
+	selfLink := fmt.Sprintf("%s/ssh-keys/%s", prefix, k.ID)
+
+	return gen.SshKey{
+		Id:          k.ID,
+		Name:        k.Name,
+		PublicKey:   k.PublicKey,
+		Fingerprint: k.Fingerprint,
+		CreatedAt:   k.CreatedAt,
+		UnderscoreLinks: gen.Links{
+			"self":   gen.Link{Href: selfLink},
+			"delete": gen.Link{Href: selfLink},
+		},
+	}
+}
+
+func toSSHKeyCollectionResponse(keys []sshkey.SSHKey, pg page.Page, total int, prefix string) gen.SshKeyCollectionResponse {
+	items := make([]gen.SshKey, len(keys))
+	for i, k := range keys {
+		items[i] = toSSHKey(k, prefix)
+	}
+
+	return gen.SshKeyCollectionResponse{
+		UnderscoreEmbedded: struct {
+			SshKeys []gen.SshKey `json:"sshKeys"`
+		}{
+			SshKeys: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/ssh-keys", prefix), pg, total),
+	}
+}
@@ -0,0 +1,111 @@
+package tokengrp
+
+import (
+	"context"
+	"errors"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/apitoken"
+	"strings"
+)
+
+type TokenHandlers struct {
+	apiTokenBus apitoken.ExtBusiness
+	prefix      string
+}
+
+func New(apiTokenBus apitoken.ExtBusiness, prefix string) *TokenHandlers {
+	return &TokenHandlers{
+		apiTokenBus: apiTokenBus,
+		prefix:      prefix,
+	}
+}
+
+func (h *TokenHandlers) ListApiTokens(ctx context.Context, request gen.ListApiTokensRequestObject) (gen.ListApiTokensResponseObject, error) {
+	pageNum := 1
+	pageSize := 10
+
+	if request.Params.Page != nil {
+		pageNum = *request.Params.Page
+	}
+	if request.Params.PageSize != nil {
+		pageSize = *request.Params.PageSize
+	}
+
+	pg := page.Parse(pageNum, pageSize)
+
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, total, err := h.apiTokenBus.Search(ctx, pg, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ListApiTokens200ApplicationHalPlusJSONResponse(toAPITokenCollectionResponse(tokens, pg, total, h.prefix)), nil
+}
+
+func (h *TokenHandlers) CreateApiToken(ctx context.Context, request gen.CreateApiTokenRequestObject) (gen.CreateApiTokenResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nt := apitoken.NewToken{
+		Name:      request.Body.Name,
+		Scope:     auth.Scope(strings.ToLower(string(request.Body.Scope))),
+		ExpiresAt: request.Body.ExpiresAt,
+	}
+
+	token, secret, err := h.apiTokenBus.Create(ctx, nt, claims)
+	if err != nil {
+		if errors.Is(err, apitoken.ErrValidation) {
+			return gen.CreateApiToken400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.CreateApiToken201ApplicationHalPlusJSONResponse(toCreatedAPIToken(token, secret, h.prefix)), nil
+}
+
+func (h *TokenHandlers) GetApiTokenById(ctx context.Context, request gen.GetApiTokenByIdRequestObject) (gen.GetApiTokenByIdResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.apiTokenBus.FindByID(ctx, request.TokenId, claims.UserID)
+	if err != nil {
+		if errors.Is(err, apitoken.ErrTokenNotFound) || errors.Is(err, apitoken.ErrAccessDenied) {
+			return gen.GetApiTokenById404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: apitoken.ErrTokenNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.GetApiTokenById200ApplicationHalPlusJSONResponse(toAPIToken(token, h.prefix)), nil
+}
+
+func (h *TokenHandlers) RevokeApiToken(ctx context.Context, request gen.RevokeApiTokenRequestObject) (gen.RevokeApiTokenResponseObject, error) {
+	claims, err := auth.GetClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.apiTokenBus.Revoke(ctx, request.TokenId, claims.UserID); err != nil {
+		if errors.Is(err, apitoken.ErrTokenNotFound) || errors.Is(err, apitoken.ErrAccessDenied) {
+			return gen.RevokeApiToken404JSONResponse{
+				NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: apitoken.ErrTokenNotFound.Error()},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return gen.RevokeApiToken204Response{}, nil
+}
@@ -0,0 +1,66 @@
+package tokengrp
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/cmd/server/rest/pagination"
+	"hosting-service/internal/apitoken"
+	"strings"
+)
+
+func toScope(t apitoken.Token) gen.ApiTokenScope {
+	return gen.ApiTokenScope(strings.ToUpper(string(t.Scope)))
+}
+
+func toAPIToken(t apitoken.Token, prefix string) gen.ApiToken {
+	selfLink := fmt.Sprintf("%s/tokens/%s", prefix, t.ID)
+
+	return gen.ApiToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		Scope:     toScope(t),
+		Prefix:    t.Prefix,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+		UnderscoreLinks: gen.Links{
+			"self":   gen.Link{Href: selfLink},
+			"revoke": gen.Link{Href: selfLink},
+		},
+	}
+}
+
+func toCreatedAPIToken(t apitoken.Token, secret string, prefix string) gen.CreatedApiToken {
+	selfLink := fmt.Sprintf("%s/tokens/%s", prefix, t.ID)
+
+	return gen.CreatedApiToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		Scope:     toScope(t),
+		Prefix:    t.Prefix,
+		Token:     secret,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+		UnderscoreLinks: gen.Links{
+			"self":   gen.Link{Href: selfLink},
+			"revoke": gen.Link{Href: selfLink},
+		},
+	}
+}
+
+func toAPITokenCollectionResponse(tokens []apitoken.Token, pg page.Page, total int, prefix string) gen.ApiTokenCollectionResponse {
+	items := make([]gen.ApiToken, len(tokens))
+	for i, t := range tokens {
+		items[i] = toAPIToken(t, prefix)
+	}
+
+	return gen.ApiTokenCollectionResponse{
+		UnderscoreEmbedded: struct {
+			Tokens []gen.ApiToken `json:"tokens"`
+		}{
+			Tokens: items,
+		},
+		Page:            pagination.ToMetaData(pg, total),
+		UnderscoreLinks: pagination.ToLinks(fmt.Sprintf("%s/tokens", prefix), pg, total),
+	}
+}
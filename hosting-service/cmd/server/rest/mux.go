@@ -12,20 +12,40 @@ import (
 
 	"hosting-contracts/hosting-service/openapi"
 	"hosting-service/cmd/server/rest/gen"
+	"hosting-service/internal/apitoken"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/idempotency"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/quota"
+	"hosting-service/internal/region"
+	"hosting-service/internal/schedule"
 	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+	"hosting-service/internal/sshkey"
 )
 
 type Config struct {
-	PlanBus    plan.ExtBusiness
-	ServerBus  server.ExtBusiness
-	Prefix     string
-	AuthClient auth.Client
-	Log        *logger.Logger
+	PlanBus        plan.ExtBusiness
+	ServerBus      server.ExtBusiness
+	IdempotencyBus idempotency.ExtBusiness
+	QuotaBus       quota.ExtBusiness
+	SnapshotBus    snapshot.ExtBusiness
+	SSHKeyBus      sshkey.ExtBusiness
+	BillingBus     billing.ExtBusiness
+	CapacityBus    capacity.ExtBusiness
+	ScheduleBus    schedule.ExtBusiness
+	ProjectBus     project.ExtBusiness
+	APITokenBus    apitoken.ExtBusiness
+	RegionBus      region.ExtBusiness
+	Prefix         string
+	AuthClient     auth.Client
+	Log            *logger.Logger
 }
 
 func RegisterRoutes(router *chi.Mux, cfg Config) {
-	apiImpl := New(cfg.PlanBus, cfg.ServerBus, cfg.Prefix)
+	apiImpl := New(cfg.PlanBus, cfg.ServerBus, cfg.IdempotencyBus, cfg.QuotaBus, cfg.SnapshotBus, cfg.SSHKeyBus, cfg.BillingBus, cfg.CapacityBus, cfg.ScheduleBus, cfg.ProjectBus, cfg.APITokenBus, cfg.RegionBus, cfg.Log, cfg.Prefix)
 
 	strictHandler := gen.NewStrictHandlerWithOptions(apiImpl, nil, gen.StrictHTTPServerOptions{
 		ResponseErrorHandlerFunc: makeResponseErrorHandler(cfg.Log),
@@ -39,6 +59,8 @@ func RegisterRoutes(router *chi.Mux, cfg Config) {
 
 	authen := mid.Authenticate(cfg.AuthClient)
 	adminOnly := mid.RequireAdmin()
+	limitScope := mid.LimitScope()
+	sessionOnly := mid.RequireSession()
 
 	router.Route(cfg.Prefix, func(r chi.Router) {
 		specURL := fmt.Sprintf("%s/swagger/doc.yaml", cfg.Prefix)
@@ -57,15 +79,75 @@ func RegisterRoutes(router *chi.Mux, cfg Config) {
 
 		r.Group(func(r chi.Router) {
 			r.Use(authen)
+			r.Use(limitScope)
 
 			r.Get("/servers", wrapper.ListServers)
 			r.Post("/servers", wrapper.OrderServer)
+			r.Post("/servers/actions:batch", wrapper.BatchServerActions)
 			r.Get("/servers/{serverId}", wrapper.GetServerById)
+			r.Get("/servers/{serverId}/history", wrapper.GetServerHistory)
 			r.Post("/servers/{serverId}/actions", wrapper.PerformServerAction)
+			r.Post("/servers/{serverId}/snapshots", wrapper.CreateSnapshot)
+			r.Post("/servers/{serverId}/schedules", wrapper.CreateSchedule)
+			r.Get("/snapshots", wrapper.ListSnapshots)
+			r.Get("/snapshots/{snapshotId}", wrapper.GetSnapshotById)
+			r.Delete("/snapshots/{snapshotId}", wrapper.DeleteSnapshot)
+			r.Post("/snapshots/{snapshotId}/restore", wrapper.RestoreSnapshot)
+			r.Post("/snapshots/{snapshotId}/servers", wrapper.CreateServerFromSnapshot)
+			r.Get("/ssh-keys", wrapper.ListSshKeys)
+			r.Post("/ssh-keys", wrapper.CreateSshKey)
+			r.Get("/ssh-keys/{keyId}", wrapper.GetSshKeyById)
+			r.Delete("/ssh-keys/{keyId}", wrapper.DeleteSshKey)
+			r.Get("/schedules", wrapper.ListSchedules)
+			r.Get("/schedules/{scheduleId}", wrapper.GetScheduleById)
+			r.Patch("/schedules/{scheduleId}", wrapper.UpdateSchedule)
+			r.Delete("/schedules/{scheduleId}", wrapper.DeleteSchedule)
+			r.Get("/projects", wrapper.ListProjects)
+			r.Post("/projects", wrapper.CreateProject)
+			r.Get("/projects/{projectId}", wrapper.GetProjectById)
+			r.Patch("/projects/{projectId}", wrapper.UpdateProject)
+			r.Delete("/projects/{projectId}", wrapper.DeleteProject)
+			r.Get("/projects/{projectId}/members", wrapper.ListProjectMembers)
+			r.Put("/projects/{projectId}/members/{userId}", wrapper.SetProjectMemberRole)
+			r.Delete("/projects/{projectId}/members/{userId}", wrapper.RemoveProjectMember)
+			r.Get("/projects/{projectId}/invitations", wrapper.ListProjectInvitations)
+			r.Post("/projects/{projectId}/invitations", wrapper.InviteToProject)
+			r.Get("/invitations", wrapper.ListInvitations)
+			r.Delete("/invitations/{invitationId}", wrapper.RevokeInvitation)
+			r.Post("/invitations/{invitationId}/accept", wrapper.AcceptInvitation)
+			r.Post("/invitations/{invitationId}/decline", wrapper.DeclineInvitation)
+			r.Get("/billing/usage", wrapper.GetCurrentUsage)
+			r.Get("/billing/invoices", wrapper.ListInvoices)
+			r.Get("/billing/invoices/{invoiceId}", wrapper.GetInvoiceById)
+			r.Get("/quota", wrapper.GetMyQuota)
+			r.Get("/regions", wrapper.ListRegions)
+
+			r.Group(func(r chi.Router) {
+				r.Use(sessionOnly)
+				r.Get("/tokens", wrapper.ListApiTokens)
+				r.Post("/tokens", wrapper.CreateApiToken)
+				r.Get("/tokens/{tokenId}", wrapper.GetApiTokenById)
+				r.Delete("/tokens/{tokenId}", wrapper.RevokeApiToken)
+			})
 
 			r.Group(func(r chi.Router) {
 				r.Use(adminOnly)
 				r.Post("/plans", wrapper.CreatePlan)
+
+				r.Get("/admin/servers", wrapper.ListAllServers)
+				r.Get("/admin/servers/{serverId}", wrapper.GetAnyServer)
+				r.Get("/admin/servers/{serverId}/history", wrapper.GetAnyServerHistory)
+				r.Post("/admin/servers/{serverId}/actions", wrapper.PerformAdminServerAction)
+
+				r.Get("/admin/quotas", wrapper.ListQuotaOverrides)
+				r.Get("/admin/quotas/default", wrapper.GetDefaultQuota)
+				r.Put("/admin/quotas/default", wrapper.SetDefaultQuota)
+				r.Get("/admin/quotas/{userId}", wrapper.GetUserQuota)
+				r.Put("/admin/quotas/{userId}", wrapper.SetUserQuota)
+				r.Delete("/admin/quotas/{userId}", wrapper.DeleteUserQuota)
+
+				r.Get("/admin/capacity", wrapper.GetCapacityReport)
+				r.Post("/admin/capacity/repair", wrapper.RepairCapacity)
 			})
 		})
 	})
@@ -0,0 +1,214 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTokenNotFound = errors.New("api token not found")
+	ErrValidation    = errors.New("validation error")
+	ErrAccessDenied  = errors.New("access denied")
+)
+
+// prefixLength is how much of the secret, SecretPrefix included, is kept
+// in the clear.
+const prefixLength = len(SecretPrefix) + 8
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	Create(ctx context.Context, token Token) error
+	FindByID(ctx context.Context, ID uuid.UUID) (Token, error)
+	FindByHash(ctx context.Context, hash []byte) (Token, error)
+	FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]Token, int, error)
+	Delete(ctx context.Context, ID uuid.UUID) error
+}
+
+// AdminFinder reports whether a user is an administrator right now.
+type AdminFinder interface {
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+type ExtBusiness interface {
+	Create(ctx context.Context, nt NewToken, claims auth.Claims) (Token, string, error)
+	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Token, error)
+	Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Token, int, error)
+	Revoke(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error
+	Authenticate(ctx context.Context, secret string) (auth.Claims, error)
+}
+
+type Business struct {
+	storer     Storer
+	admins     AdminFinder
+	extensions []Extension
+}
+
+func NewBusiness(storer Storer, admins AdminFinder, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		admins:     admins,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Create issues a token for the user of claims and returns it together with
+// its secret. Only the hash of the secret is stored, so it cannot be shown
+// again. Admin tokens are reserved for administrators.
+func (b *Business) Create(ctx context.Context, nt NewToken, claims auth.Claims) (Token, string, error) {
+	name := strings.TrimSpace(nt.Name)
+	if name == "" {
+		return Token{}, "", fmt.Errorf("%w: token name cannot be empty", ErrValidation)
+	}
+	if len(name) > MaxNameLength {
+		return Token{}, "", fmt.Errorf("%w: token name is longer than %d characters", ErrValidation, MaxNameLength)
+	}
+
+	if !nt.Scope.Valid() {
+		return Token{}, "", fmt.Errorf("%w: unknown scope '%s'", ErrValidation, nt.Scope)
+	}
+	if nt.Scope == auth.ScopeAdmin && !claims.IsAdmin {
+		return Token{}, "", fmt.Errorf("%w: only administrators can create admin tokens", ErrValidation)
+	}
+
+	now := time.Now().UTC()
+
+	var expiresAt *time.Time
+	if nt.ExpiresAt != nil {
+		if !nt.ExpiresAt.After(now) {
+			return Token{}, "", fmt.Errorf("%w: expiry must be in the future", ErrValidation)
+		}
+		t := nt.ExpiresAt.UTC()
+		expiresAt = &t
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return Token{}, "", fmt.Errorf("create: %w", err)
+	}
+
+	token := Token{
+		ID:        uuid.New(),
+		OwnerID:   claims.UserID,
+		Name:      name,
+		Scope:     nt.Scope,
+		Prefix:    secret[:prefixLength],
+		Hash:      hash(secret),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+
+	if err := b.storer.Create(ctx, token); err != nil {
+		return Token{}, "", fmt.Errorf("create: %w", err)
+	}
+
+	return token, secret, nil
+}
+
+func (b *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Token, error) {
+	token, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return Token{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	if token.OwnerID != userID {
+		return Token{}, ErrAccessDenied
+	}
+
+	return token, nil
+}
+
+func (b *Business) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Token, int, error) {
+	tokens, count, err := b.storer.FindAll(ctx, userID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	return tokens, count, nil
+}
+
+// Revoke deletes the token. Requests with its secret fail from then on.
+func (b *Business) Revoke(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	if _, err := b.FindByID(ctx, ID, userID); err != nil {
+		return err
+	}
+
+	if err := b.storer.Delete(ctx, ID); err != nil {
+		return fmt.Errorf("revoke: %w", err)
+	}
+
+	return nil
+}
+
+// Authenticate returns the claims of the token with the secret, which makes
+// the business an auth.Client for bearer credentials. Unknown and expired
+// secrets fail with auth.ErrUnauthorized, and so do admin tokens of users
+// who are no longer administrators.
+func (b *Business) Authenticate(ctx context.Context, secret string) (auth.Claims, error) {
+	if !strings.HasPrefix(secret, SecretPrefix) {
+		return auth.Claims{}, fmt.Errorf("%w: malformed api token", auth.ErrUnauthorized)
+	}
+
+	token, err := b.storer.FindByHash(ctx, hash(secret))
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			return auth.Claims{}, fmt.Errorf("%w: unknown api token", auth.ErrUnauthorized)
+		}
+		return auth.Claims{}, fmt.Errorf("authenticate: %w", err)
+	}
+
+	if token.Expired(time.Now()) {
+		return auth.Claims{}, fmt.Errorf("%w: api token expired", auth.ErrUnauthorized)
+	}
+
+	if token.Scope == auth.ScopeAdmin {
+		isAdmin, err := b.admins.IsAdmin(ctx, token.OwnerID)
+		if err != nil {
+			return auth.Claims{}, fmt.Errorf("authenticate: isadmin: %w", err)
+		}
+		if !isAdmin {
+			return auth.Claims{}, fmt.Errorf("%w: api token owner is no longer an administrator", auth.ErrUnauthorized)
+		}
+	}
+
+	return auth.Claims{
+		UserID:  token.OwnerID,
+		IsAdmin: token.Scope == auth.ScopeAdmin,
+		Scope:   token.Scope,
+	}, nil
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("random: %w", err)
+	}
+
+	return SecretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hash(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
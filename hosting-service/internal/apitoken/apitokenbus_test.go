@@ -0,0 +1,270 @@
+package apitoken_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"hosting-kit/auth"
+	"hosting-kit/page"
+	"hosting-service/internal/apitoken"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	CreateFunc     func(ctx context.Context, token apitoken.Token) error
+	FindByIDFunc   func(ctx context.Context, ID uuid.UUID) (apitoken.Token, error)
+	FindByHashFunc func(ctx context.Context, hash []byte) (apitoken.Token, error)
+	FindAllFunc    func(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]apitoken.Token, int, error)
+	DeleteFunc     func(ctx context.Context, ID uuid.UUID) error
+}
+
+func (m *mockStorer) Create(ctx context.Context, token apitoken.Token) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (apitoken.Token, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return apitoken.Token{}, nil
+}
+
+func (m *mockStorer) FindByHash(ctx context.Context, hash []byte) (apitoken.Token, error) {
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, hash)
+	}
+	return apitoken.Token{}, apitoken.ErrTokenNotFound
+}
+
+func (m *mockStorer) FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]apitoken.Token, int, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx, ownerID, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, ID)
+	}
+	return nil
+}
+
+type mockAdminFinder struct {
+	IsAdminFunc func(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+func (m *mockAdminFinder) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	if m.IsAdminFunc != nil {
+		return m.IsAdminFunc(ctx, userID)
+	}
+	return true, nil
+}
+
+func Test_Create(t *testing.T) {
+	ctx := context.Background()
+	user := auth.Claims{UserID: uuid.New()}
+	admin := auth.Claims{UserID: uuid.New(), IsAdmin: true}
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	type testCase struct {
+		name    string
+		nt      apitoken.NewToken
+		claims  auth.Claims
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success_read", nt: apitoken.NewToken{Name: "ci", Scope: auth.ScopeRead}, claims: user},
+		{name: "success_expiring", nt: apitoken.NewToken{Name: "ci", Scope: auth.ScopeServers, ExpiresAt: &future}, claims: user},
+		{name: "success_admin", nt: apitoken.NewToken{Name: "ops", Scope: auth.ScopeAdmin}, claims: admin},
+		{name: "fail_empty_name", nt: apitoken.NewToken{Name: " ", Scope: auth.ScopeRead}, claims: user, wantErr: apitoken.ErrValidation},
+		{name: "fail_unknown_scope", nt: apitoken.NewToken{Name: "ci", Scope: "write"}, claims: user, wantErr: apitoken.ErrValidation},
+		{name: "fail_admin_scope_for_user", nt: apitoken.NewToken{Name: "ci", Scope: auth.ScopeAdmin}, claims: user, wantErr: apitoken.ErrValidation},
+		{name: "fail_expired", nt: apitoken.NewToken{Name: "ci", Scope: auth.ScopeRead, ExpiresAt: &past}, claims: user, wantErr: apitoken.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored apitoken.Token
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, token apitoken.Token) error {
+					stored = token
+					return nil
+				},
+			}
+
+			bus := apitoken.NewBusiness(st, &mockAdminFinder{})
+
+			got, secret, err := bus.Create(ctx, tt.nt, tt.claims)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.OwnerID != tt.claims.UserID || got.Scope != tt.nt.Scope {
+				t.Errorf("got owner %s scope %s, want %s and %s", got.OwnerID, got.Scope, tt.claims.UserID, tt.nt.Scope)
+			}
+			if !strings.HasPrefix(secret, apitoken.SecretPrefix) || !strings.HasPrefix(secret, got.Prefix) {
+				t.Errorf("secret %q does not start with %q and %q", secret, apitoken.SecretPrefix, got.Prefix)
+			}
+			if bytes.Contains(stored.Hash, []byte(secret)) || len(stored.Hash) == 0 {
+				t.Errorf("stored hash must not be empty or hold the secret")
+			}
+		})
+	}
+}
+
+func Test_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	past := time.Now().Add(-time.Minute)
+	errLookup := errors.New("identity lookup failed")
+
+	type testCase struct {
+		name      string
+		scope     auth.Scope
+		expiresAt *time.Time
+		secret    func(secret string) string
+		admin     bool
+		adminErr  error
+		wantErr   error
+		wantAdmin bool
+	}
+
+	table := []testCase{
+		{name: "success", scope: auth.ScopeServers},
+		{name: "success_admin", scope: auth.ScopeAdmin, admin: true, wantAdmin: true},
+		{name: "fail_admin_demoted", scope: auth.ScopeAdmin, admin: false, wantErr: auth.ErrUnauthorized},
+		{name: "fail_admin_lookup", scope: auth.ScopeAdmin, adminErr: errLookup, wantErr: errLookup},
+		{name: "fail_unknown", scope: auth.ScopeRead, secret: func(s string) string { return s + "x" }, wantErr: auth.ErrUnauthorized},
+		{name: "fail_malformed", scope: auth.ScopeRead, secret: func(s string) string { return "session=abc" }, wantErr: auth.ErrUnauthorized},
+		{name: "fail_expired", scope: auth.ScopeRead, expiresAt: &past, wantErr: auth.ErrUnauthorized},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored apitoken.Token
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, token apitoken.Token) error {
+					stored = token
+					return nil
+				},
+				FindByHashFunc: func(ctx context.Context, hash []byte) (apitoken.Token, error) {
+					if !bytes.Equal(hash, stored.Hash) {
+						return apitoken.Token{}, apitoken.ErrTokenNotFound
+					}
+					return stored, nil
+				},
+			}
+
+			af := &mockAdminFinder{
+				IsAdminFunc: func(ctx context.Context, gotUserID uuid.UUID) (bool, error) {
+					if tt.scope != auth.ScopeAdmin {
+						t.Errorf("admin status looked up for a %s token", tt.scope)
+					}
+					if gotUserID != userID {
+						t.Errorf("admin status of %s looked up, want the owner %s", gotUserID, userID)
+					}
+					return tt.admin, tt.adminErr
+				},
+			}
+
+			bus := apitoken.NewBusiness(st, af)
+
+			_, secret, err := bus.Create(ctx, apitoken.NewToken{Name: "ci", Scope: tt.scope}, auth.Claims{UserID: userID, IsAdmin: true})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			stored.ExpiresAt = tt.expiresAt
+			if tt.secret != nil {
+				secret = tt.secret(secret)
+			}
+
+			claims, err := bus.Authenticate(ctx, secret)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims.UserID != userID || claims.Scope != tt.scope || claims.IsAdmin != tt.wantAdmin {
+				t.Errorf("got claims %+v, want user %s scope %s admin %v", claims, userID, tt.scope, tt.wantAdmin)
+			}
+		})
+	}
+}
+
+func Test_Revoke(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	own := apitoken.Token{ID: uuid.New(), OwnerID: userID}
+	foreign := apitoken.Token{ID: uuid.New(), OwnerID: uuid.New()}
+
+	type testCase struct {
+		name       string
+		id         uuid.UUID
+		wantErr    error
+		wantDelete bool
+	}
+
+	table := []testCase{
+		{name: "success", id: own.ID, wantDelete: true},
+		{name: "fail_foreign", id: foreign.ID, wantErr: apitoken.ErrAccessDenied},
+		{name: "fail_unknown", id: uuid.New(), wantErr: apitoken.ErrTokenNotFound},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (apitoken.Token, error) {
+					switch ID {
+					case own.ID:
+						return own, nil
+					case foreign.ID:
+						return foreign, nil
+					}
+					return apitoken.Token{}, apitoken.ErrTokenNotFound
+				},
+				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			bus := apitoken.NewBusiness(st, &mockAdminFinder{})
+
+			err := bus.Revoke(ctx, tt.id, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deleted != tt.wantDelete {
+				t.Errorf("deleted: got %v, want %v", deleted, tt.wantDelete)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package apitokenotel
+
+import (
+	"context"
+	"hosting-kit/auth"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/apitoken"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus apitoken.ExtBusiness
+}
+
+func NewExtension() apitoken.Extension {
+	return func(bus apitoken.ExtBusiness) apitoken.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Create(ctx context.Context, nt apitoken.NewToken, claims auth.Claims) (apitoken.Token, string, error) {
+	ctx, span := otel.AddSpan(ctx, "apitoken.create")
+	defer span.End()
+
+	return e.bus.Create(ctx, nt, claims)
+}
+
+func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (apitoken.Token, error) {
+	ctx, span := otel.AddSpan(ctx, "apitoken.findbyid")
+	defer span.End()
+
+	return e.bus.FindByID(ctx, ID, userID)
+}
+
+func (e *Extension) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]apitoken.Token, int, error) {
+	ctx, span := otel.AddSpan(ctx, "apitoken.search")
+	defer span.End()
+
+	return e.bus.Search(ctx, pg, userID)
+}
+
+func (e *Extension) Revoke(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "apitoken.revoke")
+	defer span.End()
+
+	return e.bus.Revoke(ctx, ID, userID)
+}
+
+func (e *Extension) Authenticate(ctx context.Context, secret string) (auth.Claims, error) {
+	ctx, span := otel.AddSpan(ctx, "apitoken.authenticate")
+	defer span.End()
+
+	return e.bus.Authenticate(ctx, secret)
+}
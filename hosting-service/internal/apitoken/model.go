@@ -0,0 +1,46 @@
+package apitoken
+
+import (
+	"hosting-kit/auth"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNameLength limits the name users give a token.
+const MaxNameLength = 100
+
+// SecretPrefix starts every token secret, so leaked tokens are easy to find
+// by secret scanners.
+const SecretPrefix = "hst_"
+
+type Token struct {
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+	Name    string
+	Scope   auth.Scope
+
+	// Prefix is the beginning of the secret, shown so users can tell their
+	// tokens apart. The secret itself is only returned when the token is
+	// created.
+	Prefix string
+
+	// Hash is the SHA-256 of the secret.
+	Hash []byte
+
+	// ExpiresAt is empty for tokens that are valid until revoked.
+	ExpiresAt *time.Time
+
+	CreatedAt time.Time
+}
+
+// Expired reports whether the token can no longer be used at now.
+func (t Token) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+type NewToken struct {
+	Name      string
+	Scope     auth.Scope
+	ExpiresAt *time.Time
+}
@@ -0,0 +1,142 @@
+package apitokendb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/apitoken"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, token apitoken.Token) error {
+	const q = `
+	INSERT INTO api_tokens
+		(id, owner_id, name, scope, prefix, token_hash, expires_at, created_at)
+	VALUES
+		(@id, @owner_id, @name, @scope, @prefix, @token_hash, @expires_at, @created_at)`
+
+	dbToken := toDBToken(token)
+
+	args := pgx.NamedArgs{
+		"id":         dbToken.ID,
+		"owner_id":   dbToken.OwnerID,
+		"name":       dbToken.Name,
+		"scope":      dbToken.Scope,
+		"prefix":     dbToken.Prefix,
+		"token_hash": dbToken.Hash,
+		"expires_at": dbToken.ExpiresAt,
+		"created_at": dbToken.CreatedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (apitoken.Token, error) {
+	const q = `
+	SELECT
+		id, owner_id, name, scope, prefix, token_hash, expires_at, created_at
+	FROM
+		api_tokens
+	WHERE
+		id = @id`
+
+	return s.findOne(ctx, q, pgx.NamedArgs{"id": ID})
+}
+
+func (s *Store) FindByHash(ctx context.Context, hash []byte) (apitoken.Token, error) {
+	const q = `
+	SELECT
+		id, owner_id, name, scope, prefix, token_hash, expires_at, created_at
+	FROM
+		api_tokens
+	WHERE
+		token_hash = @token_hash`
+
+	return s.findOne(ctx, q, pgx.NamedArgs{"token_hash": hash})
+}
+
+func (s *Store) findOne(ctx context.Context, q string, args pgx.NamedArgs) (apitoken.Token, error) {
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return apitoken.Token{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbToken, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[tokenDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apitoken.Token{}, apitoken.ErrTokenNotFound
+		}
+		return apitoken.Token{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusToken(dbToken), nil
+}
+
+func (s *Store) FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]apitoken.Token, int, error) {
+	const qCount = `SELECT count(*) FROM api_tokens WHERE owner_id = @owner_id`
+
+	args := pgx.NamedArgs{"owner_id": ownerID}
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		id, owner_id, name, scope, prefix, token_hash, expires_at, created_at
+	FROM
+		api_tokens
+	WHERE
+		owner_id = @owner_id
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbTokens, err := pgx.CollectRows(rows, pgx.RowToStructByName[tokenDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusTokens(dbTokens), total, nil
+}
+
+func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
+	const q = `
+	DELETE FROM api_tokens
+	WHERE id = @id`
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, pgx.NamedArgs{"id": ID}); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package apitokendb
+
+import (
+	"hosting-kit/auth"
+	"hosting-service/internal/apitoken"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type tokenDB struct {
+	ID        uuid.UUID  `db:"id"`
+	OwnerID   uuid.UUID  `db:"owner_id"`
+	Name      string     `db:"name"`
+	Scope     string     `db:"scope"`
+	Prefix    string     `db:"prefix"`
+	Hash      []byte     `db:"token_hash"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func toDBToken(t apitoken.Token) tokenDB {
+	return tokenDB{
+		ID:        t.ID,
+		OwnerID:   t.OwnerID,
+		Name:      t.Name,
+		Scope:     string(t.Scope),
+		Prefix:    t.Prefix,
+		Hash:      t.Hash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func toBusToken(db tokenDB) apitoken.Token {
+	return apitoken.Token{
+		ID:        db.ID,
+		OwnerID:   db.OwnerID,
+		Name:      db.Name,
+		Scope:     auth.Scope(db.Scope),
+		Prefix:    db.Prefix,
+		Hash:      db.Hash,
+		ExpiresAt: db.ExpiresAt,
+		CreatedAt: db.CreatedAt,
+	}
+}
+
+func toBusTokens(dbs []tokenDB) []apitoken.Token {
+	tokens := make([]apitoken.Token, len(dbs))
+	for i, db := range dbs {
+		tokens[i] = toBusToken(db)
+	}
+	return tokens
+}
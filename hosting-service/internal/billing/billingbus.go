@@ -0,0 +1,300 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/internal/plan"
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	ErrAccessDenied    = errors.New("access denied")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	// OpenInterval starts an interval unless the server already has an open
+	// one for the meter.
+	OpenInterval(ctx context.Context, interval Interval) error
+	// CloseInterval ends the open interval of the meter, if there is one.
+	CloseInterval(ctx context.Context, serverID uuid.UUID, meter Meter, at time.Time) error
+	// FindIntervals returns the intervals of the owner that overlap
+	// [from, to), ordered by server, meter and start.
+	FindIntervals(ctx context.Context, ownerID uuid.UUID, from time.Time, to time.Time) ([]Interval, error)
+	// FindUnbilledPeriods returns the calendar months before the one starting
+	// at before in which an owner has usage and no invoice, oldest first.
+	FindUnbilledPeriods(ctx context.Context, before time.Time, limit int) ([]Period, error)
+	// CreateInvoice stores the invoice unless the owner already has one for
+	// the period.
+	CreateInvoice(ctx context.Context, invoice Invoice) error
+	FindInvoiceByID(ctx context.Context, ID uuid.UUID) (Invoice, error)
+	FindInvoices(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]Invoice, int, error)
+}
+
+type PlanFinder interface {
+	FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
+}
+
+type ExtBusiness interface {
+	Record(ctx context.Context, before *server.Server, after *server.Server) error
+	Usage(ctx context.Context, userID uuid.UUID) (Statement, error)
+	GenerateInvoices(ctx context.Context, limit int) (int, error)
+	FindInvoiceByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Invoice, error)
+	SearchInvoices(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Invoice, int, error)
+}
+
+type Business struct {
+	storer     Storer
+	plans      PlanFinder
+	extensions []Extension
+}
+
+func NewBusiness(storer Storer, plans PlanFinder, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		plans:      plans,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Record closes and opens usage intervals for a server write. It runs in the
+// transaction of the write, so the intervals always follow the stored
+// status. A plan change closes the running intervals and opens new ones on
+// the new plan. Every interval keeps the price of its plan at the time it
+// opens, so later price changes only apply to new intervals.
+func (b *Business) Record(ctx context.Context, before *server.Server, after *server.Server) error {
+	now := time.Now().UTC()
+
+	var p plan.Plan
+
+	for _, meter := range Meters {
+		was := before != nil && metered(meter, before.Status)
+		is := after != nil && metered(meter, after.Status)
+		replanned := was && is && before.PlanID != after.PlanID
+
+		if was && (!is || replanned) {
+			if err := b.storer.CloseInterval(ctx, before.ID, meter, now); err != nil {
+				return fmt.Errorf("closeinterval: %w", err)
+			}
+		}
+
+		if is && (!was || replanned) {
+			if p.ID != after.PlanID {
+				found, err := b.plans.FindByID(ctx, after.PlanID)
+				if err != nil {
+					return fmt.Errorf("plan.findbyid: %w", err)
+				}
+				p = found
+			}
+
+			price := p.AllocatedHourlyPrice
+			if meter == MeterRunning {
+				price = p.RunningHourlyPrice
+			}
+
+			interval := Interval{
+				ID:          uuid.New(),
+				ServerID:    after.ID,
+				OwnerID:     after.OwnerID,
+				PlanID:      after.PlanID,
+				Meter:       meter,
+				HourlyPrice: price,
+				StartedAt:   now,
+			}
+
+			if err := b.storer.OpenInterval(ctx, interval); err != nil {
+				return fmt.Errorf("openinterval: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Usage returns the charges of the user from the start of the current
+// calendar month until now.
+func (b *Business) Usage(ctx context.Context, userID uuid.UUID) (Statement, error) {
+	now := time.Now().UTC()
+
+	stmt, err := b.statement(ctx, userID, monthStart(now), now)
+	if err != nil {
+		return Statement{}, fmt.Errorf("usage: %w", err)
+	}
+
+	return stmt, nil
+}
+
+// GenerateInvoices issues the invoices of closed calendar months for up to
+// limit owner and month pairs that have none yet, the oldest month first, so
+// months missed while the job was not running are billed too. It returns the
+// number of invoices created.
+func (b *Business) GenerateInvoices(ctx context.Context, limit int) (int, error) {
+	now := time.Now().UTC()
+
+	periods, err := b.storer.FindUnbilledPeriods(ctx, monthStart(now), limit)
+	if err != nil {
+		return 0, fmt.Errorf("findunbilledperiods: %w", err)
+	}
+
+	created := 0
+	for _, period := range periods {
+		from := period.Start
+		to := from.AddDate(0, 1, 0)
+
+		stmt, err := b.statement(ctx, period.OwnerID, from, to)
+		if err != nil {
+			return created, fmt.Errorf("statement[%s %s]: %w", period.OwnerID, from.Format("2006-01"), err)
+		}
+
+		invoice := Invoice{
+			ID:          uuid.New(),
+			OwnerID:     period.OwnerID,
+			PeriodStart: stmt.PeriodStart,
+			PeriodEnd:   stmt.PeriodEnd,
+			Items:       stmt.Items,
+			Total:       stmt.Total,
+			Currency:    Currency,
+			CreatedAt:   now,
+		}
+
+		if err := b.storer.CreateInvoice(ctx, invoice); err != nil {
+			return created, fmt.Errorf("createinvoice[%s %s]: %w", period.OwnerID, from.Format("2006-01"), err)
+		}
+		created++
+	}
+
+	return created, nil
+}
+
+func (b *Business) FindInvoiceByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Invoice, error) {
+	invoice, err := b.storer.FindInvoiceByID(ctx, ID)
+	if err != nil {
+		return Invoice{}, fmt.Errorf("findinvoicebyid: %w", err)
+	}
+
+	if invoice.OwnerID != userID {
+		return Invoice{}, ErrAccessDenied
+	}
+
+	return invoice, nil
+}
+
+func (b *Business) SearchInvoices(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Invoice, int, error) {
+	invoices, total, err := b.storer.FindInvoices(ctx, userID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searchinvoices: %w", err)
+	}
+
+	return invoices, total, nil
+}
+
+// statement sums the intervals of the owner clipped to [from, to) per
+// server, plan, meter and price and charges them at the hourly price stored
+// on the intervals. Every line item is rounded up to a whole minor unit.
+func (b *Business) statement(ctx context.Context, ownerID uuid.UUID, from time.Time, to time.Time) (Statement, error) {
+	intervals, err := b.storer.FindIntervals(ctx, ownerID, from, to)
+	if err != nil {
+		return Statement{}, fmt.Errorf("findintervals: %w", err)
+	}
+
+	type key struct {
+		serverID uuid.UUID
+		planID   uuid.UUID
+		meter    Meter
+		price    int64
+	}
+
+	var keys []key
+	seconds := make(map[key]int64)
+
+	for _, interval := range intervals {
+		start := interval.StartedAt
+		if start.Before(from) {
+			start = from
+		}
+
+		end := to
+		if interval.EndedAt != nil && interval.EndedAt.Before(to) {
+			end = *interval.EndedAt
+		}
+
+		if !end.After(start) {
+			continue
+		}
+
+		k := key{serverID: interval.ServerID, planID: interval.PlanID, meter: interval.Meter, price: interval.HourlyPrice}
+		if _, ok := seconds[k]; !ok {
+			keys = append(keys, k)
+		}
+		seconds[k] += int64(end.Sub(start) / time.Second)
+	}
+
+	stmt := Statement{
+		OwnerID:     ownerID,
+		PeriodStart: from,
+		PeriodEnd:   to,
+		Items:       make([]LineItem, 0, len(keys)),
+	}
+
+	for _, k := range keys {
+		if seconds[k] == 0 {
+			continue
+		}
+
+		item := LineItem{
+			ServerID:    k.serverID,
+			PlanID:      k.planID,
+			Meter:       k.meter,
+			Seconds:     seconds[k],
+			HourlyPrice: k.price,
+			Amount:      (seconds[k]*k.price + 3599) / 3600,
+		}
+
+		stmt.Items = append(stmt.Items, item)
+		stmt.Total += item.Amount
+	}
+
+	return stmt, nil
+}
+
+// metered reports whether the meter runs for a server in the status. Servers
+// that are not provisioned yet or are being deprovisioned are not charged. A
+// soft-deleted server keeps its resources until it is purged or restored, so
+// it stays on the allocated meter.
+func metered(meter Meter, status server.ServerStatus) bool {
+	switch meter {
+	case MeterRunning:
+		return status == server.StatusRunning
+	case MeterAllocated:
+		switch status {
+		case server.StatusPending, server.StatusProvisionFailed, server.StatusDeleting,
+			server.StatusDeleted:
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,449 @@
+package billing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hosting-kit/page"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/plan"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	OpenIntervalFunc        func(ctx context.Context, interval billing.Interval) error
+	CloseIntervalFunc       func(ctx context.Context, serverID uuid.UUID, meter billing.Meter, at time.Time) error
+	FindIntervalsFunc       func(ctx context.Context, ownerID uuid.UUID, from time.Time, to time.Time) ([]billing.Interval, error)
+	FindUnbilledPeriodsFunc func(ctx context.Context, before time.Time, limit int) ([]billing.Period, error)
+	CreateInvoiceFunc       func(ctx context.Context, invoice billing.Invoice) error
+	FindInvoiceByIDFunc     func(ctx context.Context, ID uuid.UUID) (billing.Invoice, error)
+	FindInvoicesFunc        func(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]billing.Invoice, int, error)
+}
+
+func (m *mockStorer) OpenInterval(ctx context.Context, interval billing.Interval) error {
+	if m.OpenIntervalFunc != nil {
+		return m.OpenIntervalFunc(ctx, interval)
+	}
+	return nil
+}
+
+func (m *mockStorer) CloseInterval(ctx context.Context, serverID uuid.UUID, meter billing.Meter, at time.Time) error {
+	if m.CloseIntervalFunc != nil {
+		return m.CloseIntervalFunc(ctx, serverID, meter, at)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindIntervals(ctx context.Context, ownerID uuid.UUID, from time.Time, to time.Time) ([]billing.Interval, error) {
+	if m.FindIntervalsFunc != nil {
+		return m.FindIntervalsFunc(ctx, ownerID, from, to)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) FindUnbilledPeriods(ctx context.Context, before time.Time, limit int) ([]billing.Period, error) {
+	if m.FindUnbilledPeriodsFunc != nil {
+		return m.FindUnbilledPeriodsFunc(ctx, before, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) CreateInvoice(ctx context.Context, invoice billing.Invoice) error {
+	if m.CreateInvoiceFunc != nil {
+		return m.CreateInvoiceFunc(ctx, invoice)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindInvoiceByID(ctx context.Context, ID uuid.UUID) (billing.Invoice, error) {
+	if m.FindInvoiceByIDFunc != nil {
+		return m.FindInvoiceByIDFunc(ctx, ID)
+	}
+	return billing.Invoice{}, nil
+}
+
+func (m *mockStorer) FindInvoices(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]billing.Invoice, int, error) {
+	if m.FindInvoicesFunc != nil {
+		return m.FindInvoicesFunc(ctx, ownerID, pg)
+	}
+	return nil, 0, nil
+}
+
+type mockPlanFinder struct {
+	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
+}
+
+func (m *mockPlanFinder) FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return plan.Plan{}, nil
+}
+
+func Test_Record(t *testing.T) {
+	ctx := context.Background()
+	planID := uuid.New()
+	otherPlanID := uuid.New()
+
+	plans := map[uuid.UUID]plan.Plan{
+		planID:      {ID: planID, RunningHourlyPrice: 100, AllocatedHourlyPrice: 60},
+		otherPlanID: {ID: otherPlanID, RunningHourlyPrice: 400, AllocatedHourlyPrice: 200},
+	}
+	wantPrice := func(planID uuid.UUID, meter billing.Meter) int64 {
+		if meter == billing.MeterRunning {
+			return plans[planID].RunningHourlyPrice
+		}
+		return plans[planID].AllocatedHourlyPrice
+	}
+
+	srv := func(status server.ServerStatus, planID uuid.UUID) *server.Server {
+		return &server.Server{ID: uuid.New(), OwnerID: uuid.New(), PlanID: planID, Status: status}
+	}
+
+	type testCase struct {
+		name       string
+		before     *server.Server
+		after      *server.Server
+		planErr    error
+		wantErr    bool
+		wantOpened []billing.Meter
+		wantClosed []billing.Meter
+	}
+
+	table := []testCase{
+		{
+			name:  "created_pending",
+			after: srv(server.StatusPending, planID),
+		},
+		{
+			name:       "provisioned",
+			before:     srv(server.StatusPending, planID),
+			after:      srv(server.StatusRunning, planID),
+			wantOpened: []billing.Meter{billing.MeterRunning, billing.MeterAllocated},
+		},
+		{
+			name:       "stopping",
+			before:     srv(server.StatusRunning, planID),
+			after:      srv(server.StatusStopping, planID),
+			wantClosed: []billing.Meter{billing.MeterRunning},
+		},
+		{
+			name:       "started",
+			before:     srv(server.StatusStarting, planID),
+			after:      srv(server.StatusRunning, planID),
+			wantOpened: []billing.Meter{billing.MeterRunning},
+		},
+		{
+			name:   "stopped_to_starting",
+			before: srv(server.StatusStopped, planID),
+			after:  srv(server.StatusStarting, planID),
+		},
+		{
+			name:       "resized",
+			before:     srv(server.StatusStopped, planID),
+			after:      srv(server.StatusStopped, otherPlanID),
+			wantOpened: []billing.Meter{billing.MeterAllocated},
+			wantClosed: []billing.Meter{billing.MeterAllocated},
+		},
+		{
+			// The server holds its resources until it is purged.
+			name:       "soft_deleted",
+			before:     srv(server.StatusRunning, planID),
+			after:      srv(server.StatusDeletedPending, planID),
+			wantClosed: []billing.Meter{billing.MeterRunning},
+		},
+		{
+			name:   "restored",
+			before: srv(server.StatusDeletedPending, planID),
+			after:  srv(server.StatusStopped, planID),
+		},
+		{
+			name:       "purged",
+			before:     srv(server.StatusDeletedPending, planID),
+			after:      srv(server.StatusDeleting, planID),
+			wantClosed: []billing.Meter{billing.MeterAllocated},
+		},
+		{
+			name:       "removed",
+			before:     srv(server.StatusStopped, planID),
+			wantClosed: []billing.Meter{billing.MeterAllocated},
+		},
+		{
+			name:    "fail_plan",
+			before:  srv(server.StatusPending, planID),
+			after:   srv(server.StatusRunning, planID),
+			planErr: plan.ErrPlanNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var opened, closed []billing.Meter
+
+			st := &mockStorer{
+				OpenIntervalFunc: func(ctx context.Context, interval billing.Interval) error {
+					if interval.ServerID != tt.after.ID || interval.PlanID != tt.after.PlanID || interval.OwnerID != tt.after.OwnerID {
+						t.Errorf("interval opened for the wrong server: %+v", interval)
+					}
+					if interval.EndedAt != nil {
+						t.Errorf("interval opened already ended")
+					}
+					if want := wantPrice(interval.PlanID, interval.Meter); interval.HourlyPrice != want {
+						t.Errorf("%s price: got %d, want %d", interval.Meter, interval.HourlyPrice, want)
+					}
+					opened = append(opened, interval.Meter)
+					return nil
+				},
+				CloseIntervalFunc: func(ctx context.Context, serverID uuid.UUID, meter billing.Meter, at time.Time) error {
+					if serverID != tt.before.ID {
+						t.Errorf("interval closed for the wrong server: %s", serverID)
+					}
+					closed = append(closed, meter)
+					return nil
+				},
+			}
+
+			pf := &mockPlanFinder{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+					if tt.planErr != nil {
+						return plan.Plan{}, tt.planErr
+					}
+					return plans[ID], nil
+				},
+			}
+
+			bus := billing.NewBusiness(st, pf)
+
+			err := bus.Record(ctx, tt.before, tt.after)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if len(opened) != 0 {
+					t.Errorf("interval opened without a price: %v", opened)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !equalMeters(opened, tt.wantOpened) {
+				t.Errorf("opened: got %v, want %v", opened, tt.wantOpened)
+			}
+			if !equalMeters(closed, tt.wantClosed) {
+				t.Errorf("closed: got %v, want %v", closed, tt.wantClosed)
+			}
+		})
+	}
+}
+
+func Test_GenerateInvoices(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	serverID := uuid.New()
+
+	small := uuid.New()
+	large := uuid.New()
+
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, -1, 0)
+
+	at := func(t time.Time) *time.Time { return &t }
+
+	intervals := []billing.Interval{
+		// Started in the month before, only two hours fall into the period.
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterRunning, HourlyPrice: 100, StartedAt: from.Add(-time.Hour), EndedAt: at(from.Add(2 * time.Hour))},
+		// 90 minutes and a second, rounded up to a whole unit.
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterAllocated, HourlyPrice: 60, StartedAt: from, EndedAt: at(from.Add(90*time.Minute + time.Second))},
+		// The plan price went up later, the earlier usage keeps its price.
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterAllocated, HourlyPrice: 90, StartedAt: from.Add(2 * time.Hour), EndedAt: at(from.Add(3 * time.Hour))},
+		// Still open, counted until the end of the period.
+		{ServerID: serverID, PlanID: large, Meter: billing.MeterAllocated, HourlyPrice: 200, StartedAt: to.Add(-3 * time.Hour)},
+		// Two intervals on the same plan and price are summed.
+		{ServerID: serverID, PlanID: large, Meter: billing.MeterRunning, HourlyPrice: 400, StartedAt: to.Add(-3 * time.Hour), EndedAt: at(to.Add(-2 * time.Hour))},
+		{ServerID: serverID, PlanID: large, Meter: billing.MeterRunning, HourlyPrice: 400, StartedAt: to.Add(-time.Hour), EndedAt: at(to.Add(time.Hour))},
+	}
+
+	wantItems := []billing.LineItem{
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterRunning, Seconds: 7200, HourlyPrice: 100, Amount: 200},
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterAllocated, Seconds: 5401, HourlyPrice: 60, Amount: 91},
+		{ServerID: serverID, PlanID: small, Meter: billing.MeterAllocated, Seconds: 3600, HourlyPrice: 90, Amount: 90},
+		{ServerID: serverID, PlanID: large, Meter: billing.MeterAllocated, Seconds: 10800, HourlyPrice: 200, Amount: 600},
+		{ServerID: serverID, PlanID: large, Meter: billing.MeterRunning, Seconds: 7200, HourlyPrice: 400, Amount: 800},
+	}
+
+	missed := from.AddDate(0, -1, 0)
+
+	type testCase struct {
+		name        string
+		periods     []time.Time
+		storeErr    error
+		wantErr     bool
+		wantCreated int
+	}
+
+	table := []testCase{
+		{name: "success", periods: []time.Time{from}, wantCreated: 1},
+		// The job did not run last month, the month before is billed now.
+		{name: "missed_month", periods: []time.Time{missed, from}, wantCreated: 2},
+		{name: "fail_intervals", periods: []time.Time{from}, storeErr: errors.New("db connection lost"), wantErr: true},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var invoices []billing.Invoice
+
+			st := &mockStorer{
+				FindUnbilledPeriodsFunc: func(ctx context.Context, before time.Time, limit int) ([]billing.Period, error) {
+					if !before.Equal(to) {
+						t.Errorf("periods before %s, want before the current month %s", before, to)
+					}
+					periods := make([]billing.Period, len(tt.periods))
+					for i, start := range tt.periods {
+						periods[i] = billing.Period{OwnerID: ownerID, Start: start}
+					}
+					return periods, nil
+				},
+				FindIntervalsFunc: func(ctx context.Context, gotOwnerID uuid.UUID, gotFrom time.Time, gotTo time.Time) ([]billing.Interval, error) {
+					if tt.storeErr != nil {
+						return nil, tt.storeErr
+					}
+					if !gotTo.Equal(gotFrom.AddDate(0, 1, 0)) {
+						t.Errorf("period [%s, %s) is not a calendar month", gotFrom, gotTo)
+					}
+					if !gotFrom.Equal(from) {
+						return nil, nil
+					}
+					return intervals, nil
+				},
+				CreateInvoiceFunc: func(ctx context.Context, invoice billing.Invoice) error {
+					invoices = append(invoices, invoice)
+					return nil
+				},
+			}
+
+			// Invoices are priced from the intervals, never from the
+			// current plans.
+			pf := &mockPlanFinder{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+					t.Errorf("plan %s looked up while invoicing", ID)
+					return plan.Plan{}, nil
+				},
+			}
+
+			bus := billing.NewBusiness(st, pf)
+
+			created, err := bus.GenerateInvoices(ctx, 10)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if len(invoices) != 0 {
+					t.Errorf("invoice stored despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created != tt.wantCreated || len(invoices) != tt.wantCreated {
+				t.Fatalf("created: got %d (%d stored), want %d", created, len(invoices), tt.wantCreated)
+			}
+
+			for i, inv := range invoices {
+				if !inv.PeriodStart.Equal(tt.periods[i]) {
+					t.Errorf("invoice %d: got period %s, want %s", i, inv.PeriodStart, tt.periods[i])
+				}
+			}
+
+			inv := invoices[len(invoices)-1]
+			if inv.OwnerID != ownerID || !inv.PeriodStart.Equal(from) || !inv.PeriodEnd.Equal(to) {
+				t.Errorf("unexpected invoice header: %+v", inv)
+			}
+			if inv.Currency != billing.Currency {
+				t.Errorf("currency: got %q, want %q", inv.Currency, billing.Currency)
+			}
+			if len(inv.Items) != len(wantItems) {
+				t.Fatalf("items: got %+v, want %+v", inv.Items, wantItems)
+			}
+			for i := range wantItems {
+				if inv.Items[i] != wantItems[i] {
+					t.Errorf("item %d: got %+v, want %+v", i, inv.Items[i], wantItems[i])
+				}
+			}
+			if inv.Total != 1781 {
+				t.Errorf("total: got %d, want 1781", inv.Total)
+			}
+		})
+	}
+}
+
+func Test_FindInvoiceByID(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+
+	type testCase struct {
+		name     string
+		userID   uuid.UUID
+		storeErr error
+		wantErr  error
+	}
+
+	table := []testCase{
+		{name: "success", userID: ownerID},
+		{name: "fail_not_found", userID: ownerID, storeErr: billing.ErrInvoiceNotFound, wantErr: billing.ErrInvoiceNotFound},
+		{name: "fail_foreign", userID: uuid.New(), wantErr: billing.ErrAccessDenied},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindInvoiceByIDFunc: func(ctx context.Context, ID uuid.UUID) (billing.Invoice, error) {
+					if tt.storeErr != nil {
+						return billing.Invoice{}, tt.storeErr
+					}
+					return billing.Invoice{ID: ID, OwnerID: ownerID}, nil
+				},
+			}
+
+			bus := billing.NewBusiness(st, &mockPlanFinder{})
+
+			got, err := bus.FindInvoiceByID(ctx, invoiceID, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != invoiceID {
+				t.Errorf("got invoice %s, want %s", got.ID, invoiceID)
+			}
+		})
+	}
+}
+
+func equalMeters(got []billing.Meter, want []billing.Meter) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package billingotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/billing"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus billing.ExtBusiness
+}
+
+func NewExtension() billing.Extension {
+	return func(bus billing.ExtBusiness) billing.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Record(ctx context.Context, before *server.Server, after *server.Server) error {
+	ctx, span := otel.AddSpan(ctx, "billing.record")
+	defer span.End()
+
+	return e.bus.Record(ctx, before, after)
+}
+
+func (e *Extension) Usage(ctx context.Context, userID uuid.UUID) (billing.Statement, error) {
+	ctx, span := otel.AddSpan(ctx, "billing.usage")
+	defer span.End()
+
+	return e.bus.Usage(ctx, userID)
+}
+
+func (e *Extension) GenerateInvoices(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "billing.generateinvoices")
+	defer span.End()
+
+	return e.bus.GenerateInvoices(ctx, limit)
+}
+
+func (e *Extension) FindInvoiceByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (billing.Invoice, error) {
+	ctx, span := otel.AddSpan(ctx, "billing.findinvoicebyid")
+	defer span.End()
+
+	return e.bus.FindInvoiceByID(ctx, ID, userID)
+}
+
+func (e *Extension) SearchInvoices(ctx context.Context, pg page.Page, userID uuid.UUID) ([]billing.Invoice, int, error) {
+	ctx, span := otel.AddSpan(ctx, "billing.searchinvoices")
+	defer span.End()
+
+	return e.bus.SearchInvoices(ctx, pg, userID)
+}
@@ -0,0 +1,77 @@
+package billing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Currency is the currency of every price and amount. Amounts are kept in
+// its minor units.
+const Currency = "RUB"
+
+// Meter names what an interval measures.
+type Meter string
+
+const (
+	// MeterRunning runs while the server is RUNNING.
+	MeterRunning Meter = "RUNNING"
+	// MeterAllocated runs while a provisioned server holds its resources,
+	// whatever its power state, including while it waits to be purged.
+	MeterAllocated Meter = "ALLOCATED"
+)
+
+// Meters lists every meter in the order line items are reported.
+var Meters = []Meter{MeterRunning, MeterAllocated}
+
+// Interval is a stretch of time a meter ran for a server on one plan. The
+// interval is open while EndedAt is nil. HourlyPrice is the price of the
+// meter on the plan when the interval opened.
+type Interval struct {
+	ID          uuid.UUID
+	ServerID    uuid.UUID
+	OwnerID     uuid.UUID
+	PlanID      uuid.UUID
+	Meter       Meter
+	HourlyPrice int64
+	StartedAt   time.Time
+	EndedAt     *time.Time
+}
+
+// LineItem is the charge for one meter of one server on one plan over a
+// billing period.
+type LineItem struct {
+	ServerID    uuid.UUID
+	PlanID      uuid.UUID
+	Meter       Meter
+	Seconds     int64
+	HourlyPrice int64
+	Amount      int64
+}
+
+// Statement is the usage of a user over [PeriodStart, PeriodEnd).
+type Statement struct {
+	OwnerID     uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Items       []LineItem
+	Total       int64
+}
+
+// Period is a calendar month of an owner, starting at Start.
+type Period struct {
+	OwnerID uuid.UUID
+	Start   time.Time
+}
+
+// Invoice is the statement of a closed calendar month.
+type Invoice struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Items       []LineItem
+	Total       int64
+	Currency    string
+	CreatedAt   time.Time
+}
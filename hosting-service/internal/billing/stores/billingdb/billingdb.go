@@ -0,0 +1,258 @@
+package billingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/billing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) OpenInterval(ctx context.Context, interval billing.Interval) error {
+	const q = `
+	INSERT INTO usage_intervals
+		(id, server_id, owner_id, plan_id, meter, hourly_price, started_at, ended_at)
+	VALUES
+		(@id, @server_id, @owner_id, @plan_id, @meter, @hourly_price, @started_at, @ended_at)
+	ON CONFLICT (server_id, meter) WHERE ended_at IS NULL DO NOTHING`
+
+	dbInterval := toDBInterval(interval)
+
+	args := pgx.NamedArgs{
+		"id":           dbInterval.ID,
+		"server_id":    dbInterval.ServerID,
+		"owner_id":     dbInterval.OwnerID,
+		"plan_id":      dbInterval.PlanID,
+		"meter":        dbInterval.Meter,
+		"hourly_price": dbInterval.HourlyPrice,
+		"started_at":   dbInterval.StartedAt,
+		"ended_at":     dbInterval.EndedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) CloseInterval(ctx context.Context, serverID uuid.UUID, meter billing.Meter, at time.Time) error {
+	const q = `
+	UPDATE usage_intervals
+	SET
+		ended_at = GREATEST(started_at, @at)
+	WHERE
+		server_id = @server_id AND meter = @meter AND ended_at IS NULL`
+
+	args := pgx.NamedArgs{
+		"server_id": serverID,
+		"meter":     string(meter),
+		"at":        at,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindIntervals(ctx context.Context, ownerID uuid.UUID, from time.Time, to time.Time) ([]billing.Interval, error) {
+	const q = `
+	SELECT
+		id, server_id, owner_id, plan_id, meter, hourly_price, started_at, ended_at
+	FROM
+		usage_intervals
+	WHERE
+		owner_id = @owner_id AND started_at < @to AND (ended_at IS NULL OR ended_at > @from)
+	ORDER BY
+		server_id, meter, started_at`
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+		"from":     from,
+		"to":       to,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbIntervals, err := pgx.CollectRows(rows, pgx.RowToStructByName[intervalDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusIntervals(dbIntervals), nil
+}
+
+// FindUnbilledPeriods expands every interval into the months it overlaps
+// before the month starting at before and leaves out the months already
+// invoiced. Months are cut in UTC, like the invoices.
+func (s *Store) FindUnbilledPeriods(ctx context.Context, before time.Time, limit int) ([]billing.Period, error) {
+	const q = `
+	SELECT DISTINCT
+		u.owner_id, m.period_start
+	FROM
+		usage_intervals u
+	CROSS JOIN LATERAL (
+		SELECT
+			g.month_start AT TIME ZONE 'UTC' AS period_start
+		FROM
+			generate_series(
+				date_trunc('month', u.started_at AT TIME ZONE 'UTC'),
+				date_trunc('month', (LEAST(COALESCE(u.ended_at, @before), @before) - interval '1 microsecond') AT TIME ZONE 'UTC'),
+				interval '1 month'
+			) AS g(month_start)
+	) m
+	WHERE
+		u.started_at < @before
+		AND (u.ended_at IS NULL OR u.ended_at > u.started_at)
+		AND NOT EXISTS (
+			SELECT 1 FROM invoices i WHERE i.owner_id = u.owner_id AND i.period_start = m.period_start
+		)
+	ORDER BY
+		m.period_start, u.owner_id
+	LIMIT
+		@limit`
+
+	args := pgx.NamedArgs{
+		"before": before,
+		"limit":  limit,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbPeriods, err := pgx.CollectRows(rows, pgx.RowToStructByName[periodDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusPeriods(dbPeriods), nil
+}
+
+func (s *Store) CreateInvoice(ctx context.Context, invoice billing.Invoice) error {
+	const q = `
+	INSERT INTO invoices
+		(id, owner_id, period_start, period_end, items, total, currency, created_at)
+	VALUES
+		(@id, @owner_id, @period_start, @period_end, @items, @total, @currency, @created_at)
+	ON CONFLICT (owner_id, period_start) DO NOTHING`
+
+	dbInvoice, err := toDBInvoice(invoice)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	args := pgx.NamedArgs{
+		"id":           dbInvoice.ID,
+		"owner_id":     dbInvoice.OwnerID,
+		"period_start": dbInvoice.PeriodStart,
+		"period_end":   dbInvoice.PeriodEnd,
+		"items":        dbInvoice.Items,
+		"total":        dbInvoice.Total,
+		"currency":     dbInvoice.Currency,
+		"created_at":   dbInvoice.CreatedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindInvoiceByID(ctx context.Context, ID uuid.UUID) (billing.Invoice, error) {
+	const q = `
+	SELECT
+		id, owner_id, period_start, period_end, items, total, currency, created_at
+	FROM
+		invoices
+	WHERE
+		id = @id`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"id": ID})
+	if err != nil {
+		return billing.Invoice{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbInvoice, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[invoiceDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return billing.Invoice{}, billing.ErrInvoiceNotFound
+		}
+		return billing.Invoice{}, fmt.Errorf("db: %w", err)
+	}
+
+	invoice, err := toBusInvoice(dbInvoice)
+	if err != nil {
+		return billing.Invoice{}, fmt.Errorf("db: %w", err)
+	}
+
+	return invoice, nil
+}
+
+func (s *Store) FindInvoices(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]billing.Invoice, int, error) {
+	const qCount = `SELECT count(*) FROM invoices WHERE owner_id = @owner_id`
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+	}
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		id, owner_id, period_start, period_end, items, total, currency, created_at
+	FROM
+		invoices
+	WHERE
+		owner_id = @owner_id
+	ORDER BY
+		period_start DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbInvoices, err := pgx.CollectRows(rows, pgx.RowToStructByName[invoiceDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	invoices, err := toBusInvoices(dbInvoices)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return invoices, total, nil
+}
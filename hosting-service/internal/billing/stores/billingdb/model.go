@@ -0,0 +1,165 @@
+package billingdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"hosting-service/internal/billing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type intervalDB struct {
+	ID          uuid.UUID  `db:"id"`
+	ServerID    uuid.UUID  `db:"server_id"`
+	OwnerID     uuid.UUID  `db:"owner_id"`
+	PlanID      uuid.UUID  `db:"plan_id"`
+	Meter       string     `db:"meter"`
+	HourlyPrice int64      `db:"hourly_price"`
+	StartedAt   time.Time  `db:"started_at"`
+	EndedAt     *time.Time `db:"ended_at"`
+}
+
+func toDBInterval(i billing.Interval) intervalDB {
+	return intervalDB{
+		ID:          i.ID,
+		ServerID:    i.ServerID,
+		OwnerID:     i.OwnerID,
+		PlanID:      i.PlanID,
+		Meter:       string(i.Meter),
+		HourlyPrice: i.HourlyPrice,
+		StartedAt:   i.StartedAt,
+		EndedAt:     i.EndedAt,
+	}
+}
+
+func toBusInterval(db intervalDB) billing.Interval {
+	return billing.Interval{
+		ID:          db.ID,
+		ServerID:    db.ServerID,
+		OwnerID:     db.OwnerID,
+		PlanID:      db.PlanID,
+		Meter:       billing.Meter(db.Meter),
+		HourlyPrice: db.HourlyPrice,
+		StartedAt:   db.StartedAt,
+		EndedAt:     db.EndedAt,
+	}
+}
+
+func toBusIntervals(dbs []intervalDB) []billing.Interval {
+	intervals := make([]billing.Interval, len(dbs))
+	for i, db := range dbs {
+		intervals[i] = toBusInterval(db)
+	}
+	return intervals
+}
+
+type periodDB struct {
+	OwnerID     uuid.UUID `db:"owner_id"`
+	PeriodStart time.Time `db:"period_start"`
+}
+
+func toBusPeriods(dbs []periodDB) []billing.Period {
+	periods := make([]billing.Period, len(dbs))
+	for i, db := range dbs {
+		periods[i] = billing.Period{
+			OwnerID: db.OwnerID,
+			Start:   db.PeriodStart.UTC(),
+		}
+	}
+	return periods
+}
+
+// lineItemDB is the JSON form of a line item in invoices.items.
+type lineItemDB struct {
+	ServerID    uuid.UUID `json:"serverId"`
+	PlanID      uuid.UUID `json:"planId"`
+	Meter       string    `json:"meter"`
+	Seconds     int64     `json:"seconds"`
+	HourlyPrice int64     `json:"hourlyPrice"`
+	Amount      int64     `json:"amount"`
+}
+
+type invoiceDB struct {
+	ID          uuid.UUID `db:"id"`
+	OwnerID     uuid.UUID `db:"owner_id"`
+	PeriodStart time.Time `db:"period_start"`
+	PeriodEnd   time.Time `db:"period_end"`
+	Items       []byte    `db:"items"`
+	Total       int64     `db:"total"`
+	Currency    string    `db:"currency"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func toDBInvoice(inv billing.Invoice) (invoiceDB, error) {
+	items := make([]lineItemDB, len(inv.Items))
+	for i, item := range inv.Items {
+		items[i] = lineItemDB{
+			ServerID:    item.ServerID,
+			PlanID:      item.PlanID,
+			Meter:       string(item.Meter),
+			Seconds:     item.Seconds,
+			HourlyPrice: item.HourlyPrice,
+			Amount:      item.Amount,
+		}
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return invoiceDB{}, fmt.Errorf("marshal items: %w", err)
+	}
+
+	return invoiceDB{
+		ID:          inv.ID,
+		OwnerID:     inv.OwnerID,
+		PeriodStart: inv.PeriodStart,
+		PeriodEnd:   inv.PeriodEnd,
+		Items:       data,
+		Total:       inv.Total,
+		Currency:    inv.Currency,
+		CreatedAt:   inv.CreatedAt,
+	}, nil
+}
+
+func toBusInvoice(db invoiceDB) (billing.Invoice, error) {
+	var items []lineItemDB
+	if err := json.Unmarshal(db.Items, &items); err != nil {
+		return billing.Invoice{}, fmt.Errorf("unmarshal items: %w", err)
+	}
+
+	inv := billing.Invoice{
+		ID:          db.ID,
+		OwnerID:     db.OwnerID,
+		PeriodStart: db.PeriodStart,
+		PeriodEnd:   db.PeriodEnd,
+		Items:       make([]billing.LineItem, len(items)),
+		Total:       db.Total,
+		Currency:    db.Currency,
+		CreatedAt:   db.CreatedAt,
+	}
+
+	for i, item := range items {
+		inv.Items[i] = billing.LineItem{
+			ServerID:    item.ServerID,
+			PlanID:      item.PlanID,
+			Meter:       billing.Meter(item.Meter),
+			Seconds:     item.Seconds,
+			HourlyPrice: item.HourlyPrice,
+			Amount:      item.Amount,
+		}
+	}
+
+	return inv, nil
+}
+
+func toBusInvoices(dbs []invoiceDB) ([]billing.Invoice, error) {
+	invoices := make([]billing.Invoice, len(dbs))
+	for i, db := range dbs {
+		inv, err := toBusInvoice(db)
+		if err != nil {
+			return nil, err
+		}
+		invoices[i] = inv
+	}
+	return invoices, nil
+}
@@ -0,0 +1,224 @@
+package capacity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-service/internal/server"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPoolNotFound      = errors.New("pool not found")
+	ErrCountersChanged   = errors.New("pool counters changed")
+	ErrNotEnoughCapacity = errors.New("pool capacity is below the allocation")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	// Usage sums what the hosting service holds in each pool.
+	Usage(ctx context.Context) (Usage, error)
+}
+
+type PoolManager interface {
+	ListPools(ctx context.Context) ([]Pool, error)
+	// RepairPool sets the available counters of the pool to its capacity
+	// minus allocated, as long as they still equal observed.
+	RepairPool(ctx context.Context, poolID uuid.UUID, observed server.Resources, allocated server.Resources) (Pool, error)
+}
+
+// Recorder publishes the outcome of a run, for example as metrics.
+type Recorder interface {
+	Record(ctx context.Context, report Report)
+}
+
+type ExtBusiness interface {
+	Reconcile(ctx context.Context, repair bool) (Report, error)
+}
+
+type Business struct {
+	storer     Storer
+	pools      PoolManager
+	recorder   Recorder
+	extensions []Extension
+
+	// last holds the drift of the previous run per pool. A repair is only
+	// made for drift that two runs in a row agree on, so resources that are
+	// moving while a run looks at both sides are never "repaired".
+	mu   sync.Mutex
+	last map[uuid.UUID]PoolReport
+}
+
+func NewBusiness(storer Storer, pools PoolManager, recorder Recorder, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		pools:      pools,
+		recorder:   recorder,
+		extensions: extensions,
+		last:       make(map[uuid.UUID]PoolReport),
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Reconcile compares what the hosting service holds in each pool with what
+// the resources service has allocated from it. With repair set, pools that
+// drift by the same amount as in the previous run get their counters
+// corrected. The pools are read before the usage, so a pool that changes in
+// between is refused by the resources service instead of being overwritten.
+func (b *Business) Reconcile(ctx context.Context, repair bool) (Report, error) {
+	pools, err := b.pools.ListPools(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("listpools: %w", err)
+	}
+
+	usage, err := b.storer.Usage(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("usage: %w", err)
+	}
+
+	report := Report{
+		CheckedAt: time.Now().UTC(),
+		Repair:    repair,
+		Unsettled: usage.Unsettled,
+		Pools:     make([]PoolReport, 0, len(pools)),
+	}
+
+	known := make(map[uuid.UUID]bool, len(pools))
+	for _, p := range pools {
+		known[p.ID] = true
+		report.Pools = append(report.Pools, compare(p, usage.Pools[p.ID]))
+	}
+
+	for poolID, expected := range usage.Pools {
+		if known[poolID] {
+			continue
+		}
+		report.Pools = append(report.Pools, PoolReport{
+			PoolID:   poolID,
+			Expected: expected,
+			Note:     "pool is unknown to the resources service",
+		})
+	}
+
+	sort.Slice(report.Pools, func(i, j int) bool {
+		return report.Pools[i].PoolID.String() < report.Pools[j].PoolID.String()
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i := range report.Pools {
+		p := &report.Pools[i]
+		if !repair || !p.Known || !p.Drifting() {
+			continue
+		}
+
+		if err := b.repair(ctx, p, usage.Unsettled); err != nil {
+			return Report{}, err
+		}
+	}
+
+	b.last = make(map[uuid.UUID]PoolReport, len(report.Pools))
+	for _, p := range report.Pools {
+		if p.Drifting() {
+			b.last[p.PoolID] = p
+		}
+	}
+
+	b.recorder.Record(ctx, report)
+
+	return report, nil
+}
+
+// repair corrects one known pool, or tells in p.Note why it was left alone.
+func (b *Business) repair(ctx context.Context, p *PoolReport, unsettled int) error {
+	if unsettled > 0 {
+		p.Note = "orders or resizes are in flight, repair postponed"
+		return nil
+	}
+
+	prev, ok := b.last[p.PoolID]
+	if !ok || prev.Available != p.Available || !sameDrift(prev.Drift, p.Drift) {
+		p.Note = "drift not confirmed by the previous run yet"
+		return nil
+	}
+
+	repaired, err := b.pools.RepairPool(ctx, p.PoolID, p.Available, p.Expected)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrCountersChanged):
+			p.Note = "pool changed during the check"
+			return nil
+		case errors.Is(err, ErrNotEnoughCapacity):
+			p.Note = "pool capacity is below the expected allocation"
+			return nil
+		case errors.Is(err, ErrPoolNotFound):
+			p.Known = false
+			p.Note = "pool is unknown to the resources service"
+			return nil
+		}
+		return fmt.Errorf("repairpool[%s]: %w", p.PoolID, err)
+	}
+
+	*p = compare(repaired, p.Expected)
+	p.Repaired = true
+
+	return nil
+}
+
+// compare builds the report of a pool known to the resources service.
+func compare(p Pool, expected server.Resources) PoolReport {
+	report := PoolReport{
+		PoolID:    p.ID,
+		Name:      p.Name,
+		Known:     true,
+		Expected:  expected,
+		Available: p.Available,
+		Capacity:  p.Capacity,
+	}
+
+	if p.Capacity == nil {
+		report.Note = "capacity is unknown"
+		return report
+	}
+
+	allocated := subtract(*p.Capacity, p.Available)
+	drift := subtract(allocated, expected)
+
+	report.Allocated = &allocated
+	report.Drift = &drift
+
+	return report
+}
+
+func sameDrift(a *server.Resources, b *server.Resources) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func subtract(a server.Resources, b server.Resources) server.Resources {
+	return server.Resources{
+		CPUCores: a.CPUCores - b.CPUCores,
+		RAMMB:    a.RAMMB - b.RAMMB,
+		DiskGB:   a.DiskGB - b.DiskGB,
+		IPCount:  a.IPCount - b.IPCount,
+	}
+}
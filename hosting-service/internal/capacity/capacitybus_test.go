@@ -0,0 +1,301 @@
+package capacity_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	UsageFunc func(ctx context.Context) (capacity.Usage, error)
+}
+
+func (m *mockStorer) Usage(ctx context.Context) (capacity.Usage, error) {
+	if m.UsageFunc != nil {
+		return m.UsageFunc(ctx)
+	}
+	return capacity.Usage{}, nil
+}
+
+type mockPoolManager struct {
+	ListPoolsFunc  func(ctx context.Context) ([]capacity.Pool, error)
+	RepairPoolFunc func(ctx context.Context, poolID uuid.UUID, observed server.Resources, allocated server.Resources) (capacity.Pool, error)
+}
+
+func (m *mockPoolManager) ListPools(ctx context.Context) ([]capacity.Pool, error) {
+	if m.ListPoolsFunc != nil {
+		return m.ListPoolsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockPoolManager) RepairPool(ctx context.Context, poolID uuid.UUID, observed server.Resources, allocated server.Resources) (capacity.Pool, error) {
+	if m.RepairPoolFunc != nil {
+		return m.RepairPoolFunc(ctx, poolID, observed, allocated)
+	}
+	return capacity.Pool{}, nil
+}
+
+type mockRecorder struct {
+	reports []capacity.Report
+}
+
+func (m *mockRecorder) Record(ctx context.Context, report capacity.Report) {
+	m.reports = append(m.reports, report)
+}
+
+func res(cpu, ram, disk, ip int) server.Resources {
+	return server.Resources{CPUCores: cpu, RAMMB: ram, DiskGB: disk, IPCount: ip}
+}
+
+func Test_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+	capacityTotal := res(32, 65536, 1000, 16)
+
+	pool := func(available server.Resources) capacity.Pool {
+		c := capacityTotal
+		return capacity.Pool{ID: poolID, Name: "pool-1", Available: available, Capacity: &c}
+	}
+
+	type testCase struct {
+		name        string
+		pools       []capacity.Pool
+		usage       capacity.Usage
+		wantKnown   bool
+		wantDrift   *server.Resources
+		wantDrifted bool
+	}
+
+	leak := res(2, 2048, 20, 1)
+
+	table := []testCase{
+		{
+			name:      "in_sync",
+			pools:     []capacity.Pool{pool(res(28, 61440, 960, 14))},
+			usage:     capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: res(4, 4096, 40, 2)}},
+			wantKnown: true,
+			wantDrift: &server.Resources{},
+		},
+		{
+			name:        "leaked",
+			pools:       []capacity.Pool{pool(res(26, 59392, 940, 13))},
+			usage:       capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: res(4, 4096, 40, 2)}},
+			wantKnown:   true,
+			wantDrift:   &leak,
+			wantDrifted: true,
+		},
+		{
+			name:        "returned_twice",
+			pools:       []capacity.Pool{pool(capacityTotal)},
+			usage:       capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: res(4, 4096, 40, 2)}},
+			wantKnown:   true,
+			wantDrift:   &server.Resources{CPUCores: -4, RAMMB: -4096, DiskGB: -40, IPCount: -2},
+			wantDrifted: true,
+		},
+		{
+			name:        "capacity_unknown",
+			pools:       []capacity.Pool{{ID: poolID, Available: res(28, 61440, 960, 14)}},
+			usage:       capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: res(4, 4096, 40, 2)}},
+			wantKnown:   true,
+			wantDrifted: true,
+		},
+		{
+			name:        "pool_unknown",
+			usage:       capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: res(4, 4096, 40, 2)}},
+			wantDrifted: true,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			pm := &mockPoolManager{
+				ListPoolsFunc: func(ctx context.Context) ([]capacity.Pool, error) {
+					return tt.pools, nil
+				},
+				RepairPoolFunc: func(ctx context.Context, poolID uuid.UUID, observed server.Resources, allocated server.Resources) (capacity.Pool, error) {
+					t.Errorf("repair not expected without repair mode")
+					return capacity.Pool{}, nil
+				},
+			}
+			st := &mockStorer{
+				UsageFunc: func(ctx context.Context) (capacity.Usage, error) {
+					return tt.usage, nil
+				},
+			}
+			rec := &mockRecorder{}
+
+			bus := capacity.NewBusiness(st, pm, rec)
+
+			report, err := bus.Reconcile(ctx, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(report.Pools) != 1 {
+				t.Fatalf("expected 1 pool, got %d", len(report.Pools))
+			}
+
+			p := report.Pools[0]
+			if p.Known != tt.wantKnown {
+				t.Errorf("known: got %v, want %v", p.Known, tt.wantKnown)
+			}
+			if (p.Drift == nil) != (tt.wantDrift == nil) || (p.Drift != nil && *p.Drift != *tt.wantDrift) {
+				t.Errorf("drift: got %+v, want %+v", p.Drift, tt.wantDrift)
+			}
+			if report.Drifting() != tt.wantDrifted {
+				t.Errorf("drifting: got %v, want %v", report.Drifting(), tt.wantDrifted)
+			}
+			if len(rec.reports) != 1 {
+				t.Errorf("expected the report to be recorded once, got %d", len(rec.reports))
+			}
+		})
+	}
+}
+
+func Test_ReconcileRepair(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+	capacityTotal := res(32, 65536, 1000, 16)
+	expected := res(4, 4096, 40, 2)
+	available := res(26, 59392, 940, 13)
+
+	type testCase struct {
+		name         string
+		capacity     *server.Resources
+		unsettled    int
+		secondAvail  server.Resources
+		repairErr    error
+		wantRepairs  int
+		wantRepaired bool
+		wantNote     bool
+	}
+
+	table := []testCase{
+		{
+			name:         "confirmed_drift",
+			capacity:     &capacityTotal,
+			secondAvail:  available,
+			wantRepairs:  1,
+			wantRepaired: true,
+		},
+		{
+			name:         "capacity_adopted",
+			secondAvail:  available,
+			wantRepairs:  1,
+			wantRepaired: true,
+		},
+		{
+			name:        "drift_moved",
+			capacity:    &capacityTotal,
+			secondAvail: res(25, 58368, 930, 13),
+			wantNote:    true,
+		},
+		{
+			name:        "orders_in_flight",
+			capacity:    &capacityTotal,
+			unsettled:   1,
+			secondAvail: available,
+			wantNote:    true,
+		},
+		{
+			name:        "counters_changed",
+			capacity:    &capacityTotal,
+			secondAvail: available,
+			repairErr:   capacity.ErrCountersChanged,
+			wantRepairs: 1,
+			wantNote:    true,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			current := available
+			repairs := 0
+
+			pm := &mockPoolManager{
+				ListPoolsFunc: func(ctx context.Context) ([]capacity.Pool, error) {
+					return []capacity.Pool{{ID: poolID, Available: current, Capacity: tt.capacity}}, nil
+				},
+				RepairPoolFunc: func(ctx context.Context, id uuid.UUID, observed server.Resources, allocated server.Resources) (capacity.Pool, error) {
+					repairs++
+					if tt.repairErr != nil {
+						return capacity.Pool{}, tt.repairErr
+					}
+					if id != poolID || observed != current || allocated != expected {
+						t.Errorf("unexpected repair: %s %+v %+v", id, observed, allocated)
+					}
+
+					c := capacityTotal
+					if tt.capacity == nil {
+						c = res(observed.CPUCores+allocated.CPUCores, observed.RAMMB+allocated.RAMMB, observed.DiskGB+allocated.DiskGB, observed.IPCount+allocated.IPCount)
+					}
+					avail := res(c.CPUCores-allocated.CPUCores, c.RAMMB-allocated.RAMMB, c.DiskGB-allocated.DiskGB, c.IPCount-allocated.IPCount)
+					return capacity.Pool{ID: id, Available: avail, Capacity: &c}, nil
+				},
+			}
+			st := &mockStorer{
+				UsageFunc: func(ctx context.Context) (capacity.Usage, error) {
+					return capacity.Usage{Pools: map[uuid.UUID]server.Resources{poolID: expected}, Unsettled: tt.unsettled}, nil
+				},
+			}
+
+			bus := capacity.NewBusiness(st, pm, &mockRecorder{})
+
+			first, err := bus.Reconcile(ctx, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first.Pools[0].Repaired || first.Pools[0].Note == "" {
+				t.Errorf("first run must not repair: %+v", first.Pools[0])
+			}
+
+			current = tt.secondAvail
+
+			second, err := bus.Reconcile(ctx, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			p := second.Pools[0]
+			if repairs != tt.wantRepairs {
+				t.Errorf("repairs: got %d, want %d", repairs, tt.wantRepairs)
+			}
+			if p.Repaired != tt.wantRepaired {
+				t.Errorf("repaired: got %v, want %v", p.Repaired, tt.wantRepaired)
+			}
+			if tt.wantRepaired && p.Drifting() {
+				t.Errorf("repaired pool still drifting: %+v", p.Drift)
+			}
+			if (p.Note != "") != tt.wantNote {
+				t.Errorf("note: got %q, want note %v", p.Note, tt.wantNote)
+			}
+		})
+	}
+}
+
+func Test_ReconcileErrors(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	pm := &mockPoolManager{
+		ListPoolsFunc: func(ctx context.Context) ([]capacity.Pool, error) {
+			return nil, errBoom
+		},
+	}
+	rec := &mockRecorder{}
+
+	bus := capacity.NewBusiness(&mockStorer{}, pm, rec)
+
+	if _, err := bus.Reconcile(ctx, false); !errors.Is(err, errBoom) {
+		t.Errorf("expected %v, got %v", errBoom, err)
+	}
+	if len(rec.reports) != 0 {
+		t.Errorf("failed run must not be recorded")
+	}
+}
@@ -0,0 +1,26 @@
+package capacityotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-service/internal/capacity"
+)
+
+type Extension struct {
+	bus capacity.ExtBusiness
+}
+
+func NewExtension() capacity.Extension {
+	return func(bus capacity.ExtBusiness) capacity.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Reconcile(ctx context.Context, repair bool) (capacity.Report, error) {
+	ctx, span := otel.AddSpan(ctx, "capacity.reconcile")
+	defer span.End()
+
+	return e.bus.Reconcile(ctx, repair)
+}
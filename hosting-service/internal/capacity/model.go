@@ -0,0 +1,76 @@
+package capacity
+
+import (
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Pool is a pool as recorded by the resources service.
+type Pool struct {
+	ID        uuid.UUID
+	Name      string
+	Available server.Resources
+	// Capacity is nil for pools the resources service has no capacity for.
+	Capacity *server.Resources
+}
+
+// Usage is what the hosting service holds in each pool: the plans of its
+// servers, the disk of reserved snapshots and reservations of sagas that
+// are not finished yet.
+type Usage struct {
+	Pools map[uuid.UUID]server.Resources
+	// Unsettled counts orders and resizes that may have taken resources
+	// from a pool they have not recorded yet.
+	Unsettled int
+}
+
+// PoolReport compares one pool on both sides.
+type PoolReport struct {
+	PoolID uuid.UUID
+	Name   string
+	// Known is false for pools the hosting service uses but the resources
+	// service does not have.
+	Known     bool
+	Expected  server.Resources
+	Available server.Resources
+	Capacity  *server.Resources
+	// Allocated is Capacity minus Available, nil while Capacity is unknown.
+	Allocated *server.Resources
+	// Drift is Allocated minus Expected. Positive values are leaked
+	// resources, negative values were returned more than once.
+	Drift    *server.Resources
+	Repaired bool
+	// Note tells why a pool could not be compared or was left unrepaired.
+	Note string
+}
+
+// Report is the result of one reconciliation run.
+type Report struct {
+	CheckedAt time.Time
+	Repair    bool
+	Unsettled int
+	Pools     []PoolReport
+}
+
+// Drifting reports whether any pool disagrees or cannot be compared.
+func (r Report) Drifting() bool {
+	for _, p := range r.Pools {
+		if p.Drifting() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Drifting reports whether the pool disagrees with the hosting service or
+// cannot be compared with it.
+func (p PoolReport) Drifting() bool {
+	if !p.Known || p.Drift == nil {
+		return true
+	}
+
+	return *p.Drift != server.Resources{}
+}
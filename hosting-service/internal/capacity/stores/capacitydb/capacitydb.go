@@ -0,0 +1,81 @@
+package capacitydb
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// Usage counts every stored server, including the ones waiting to be
+// deprovisioned, the disk of snapshots that still hold a reservation and
+// the reservations of sagas that have not reached the server table or have
+// not been returned yet. A resize saga holds a change of a server that is
+// already counted at its stored plan, so open resizes are reported as
+// unsettled instead of being added to a pool.
+func (s *Store) Usage(ctx context.Context) (capacity.Usage, error) {
+	const q = `
+	SELECT
+		u.pool_id,
+		SUM(u.cpu_cores) AS cpu_cores,
+		SUM(u.ram_mb)    AS ram_mb,
+		SUM(u.disk_gb)   AS disk_gb,
+		SUM(u.ip_count)  AS ip_count
+	FROM (
+		SELECT s.pool_id, p.cpu_cores, p.ram_mb, p.disk_gb, p.ip_count
+		FROM servers s
+		JOIN plans p ON p.id = s.plan_id
+		UNION ALL
+		SELECT pool_id, 0, 0, size_gb, 0
+		FROM snapshots
+		WHERE reserved
+		UNION ALL
+		SELECT pool_id, cpu_cores, ram_mb, disk_gb, ip_count
+		FROM server_sagas
+		WHERE state IN ('RESERVED', 'RETURNING') AND kind <> 'RESIZE' AND pool_id IS NOT NULL
+	) u
+	GROUP BY
+		u.pool_id`
+
+	const qUnsettled = `
+	SELECT COUNT(*) FROM server_sagas
+	WHERE state = 'STARTED' OR (kind = 'RESIZE' AND state = 'RETURNING')`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q)
+	if err != nil {
+		return capacity.Usage{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbUsages, err := pgx.CollectRows(rows, pgx.RowToStructByName[usageDB])
+	if err != nil {
+		return capacity.Usage{}, fmt.Errorf("db: %w", err)
+	}
+
+	var unsettled int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qUnsettled).Scan(&unsettled); err != nil {
+		return capacity.Usage{}, fmt.Errorf("db: unsettled: %w", err)
+	}
+
+	usage := capacity.Usage{
+		Pools:     make(map[uuid.UUID]server.Resources, len(dbUsages)),
+		Unsettled: unsettled,
+	}
+	for _, db := range dbUsages {
+		usage.Pools[db.PoolID] = toBusResources(db)
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,24 @@
+package capacitydb
+
+import (
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+)
+
+type usageDB struct {
+	PoolID   uuid.UUID `db:"pool_id"`
+	CPUCores int       `db:"cpu_cores"`
+	RAMMB    int       `db:"ram_mb"`
+	DiskGB   int       `db:"disk_gb"`
+	IPCount  int       `db:"ip_count"`
+}
+
+func toBusResources(db usageDB) server.Resources {
+	return server.Resources{
+		CPUCores: db.CPUCores,
+		RAMMB:    db.RAMMB,
+		DiskGB:   db.DiskGB,
+		IPCount:  db.IPCount,
+	}
+}
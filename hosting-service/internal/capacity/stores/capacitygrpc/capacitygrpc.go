@@ -0,0 +1,115 @@
+package capacitygrpc
+
+import (
+	"context"
+	"fmt"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/server"
+	"hosting-service/internal/server/stores/servergrpc/gen"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PoolManager struct {
+	client  gen.ResourcesClient
+	timeOut time.Duration
+}
+
+func NewGrpc(client *grpc.ClientConn, timeOut time.Duration) *PoolManager {
+	return &PoolManager{client: gen.NewResourcesClient(client), timeOut: timeOut}
+}
+
+func (r *PoolManager) ListPools(ctx context.Context) ([]capacity.Pool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
+	defer cancel()
+
+	resp, err := r.client.ListPools(ctx, &gen.ListPoolsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("grpc: %w", err)
+	}
+
+	pools := make([]capacity.Pool, len(resp.GetPools()))
+	for i, state := range resp.GetPools() {
+		p, err := toPool(state)
+		if err != nil {
+			return nil, fmt.Errorf("grpc: %w", err)
+		}
+		pools[i] = p
+	}
+
+	return pools, nil
+}
+
+func (r *PoolManager) RepairPool(ctx context.Context, poolID uuid.UUID, observed server.Resources, allocated server.Resources) (capacity.Pool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
+	defer cancel()
+
+	resp, err := r.client.RepairPool(ctx, &gen.RepairPoolRequest{
+		PoolId:    poolID.String(),
+		Observed:  toGenResource(observed),
+		Allocated: toGenResource(allocated),
+	})
+
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return capacity.Pool{}, capacity.ErrPoolNotFound
+			case codes.Aborted:
+				return capacity.Pool{}, capacity.ErrCountersChanged
+			case codes.FailedPrecondition:
+				return capacity.Pool{}, capacity.ErrNotEnoughCapacity
+			}
+		}
+		return capacity.Pool{}, fmt.Errorf("grpc: %w", err)
+	}
+
+	p, err := toPool(resp.GetPool())
+	if err != nil {
+		return capacity.Pool{}, fmt.Errorf("grpc: %w", err)
+	}
+
+	return p, nil
+}
+
+func toPool(state *gen.PoolState) (capacity.Pool, error) {
+	poolID, err := uuid.Parse(state.GetPoolId())
+	if err != nil {
+		return capacity.Pool{}, err
+	}
+
+	p := capacity.Pool{
+		ID:        poolID,
+		Name:      state.GetName(),
+		Available: toResources(state.GetAvailable()),
+	}
+
+	if state.GetCapacity() != nil {
+		c := toResources(state.GetCapacity())
+		p.Capacity = &c
+	}
+
+	return p, nil
+}
+
+func toResources(r *gen.Resource) server.Resources {
+	return server.Resources{
+		CPUCores: int(r.GetCpuCores()),
+		RAMMB:    int(r.GetRamMb()),
+		DiskGB:   int(r.GetDiskGb()),
+		IPCount:  int(r.GetIpCount()),
+	}
+}
+
+func toGenResource(r server.Resources) *gen.Resource {
+	return &gen.Resource{
+		CpuCores: int32(r.CPUCores),
+		RamMb:    int32(r.RAMMB),
+		DiskGb:   int32(r.DiskGB),
+		IpCount:  int32(r.IPCount),
+	}
+}
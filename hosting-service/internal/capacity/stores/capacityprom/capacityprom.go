@@ -0,0 +1,98 @@
+package capacityprom
+
+import (
+	"context"
+	"hosting-service/internal/capacity"
+	"hosting-service/internal/server"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Recorder exposes the last reconciliation run on the default registry,
+// which the debug service serves at /metrics.
+type Recorder struct {
+	expected   *prometheus.GaugeVec
+	allocated  *prometheus.GaugeVec
+	drift      *prometheus.GaugeVec
+	drifting   prometheus.Gauge
+	unsettled  prometheus.Gauge
+	repairs    prometheus.Counter
+	lastRunSec prometheus.Gauge
+}
+
+func NewRecorder() *Recorder {
+	labels := []string{"pool_id", "resource"}
+
+	r := &Recorder{
+		expected: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "hosting_capacity_expected",
+			Help: "Resources the hosting service holds in the pool.",
+		}, labels),
+		allocated: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "hosting_capacity_allocated",
+			Help: "Resources the resources service has allocated from the pool.",
+		}, labels),
+		drift: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "hosting_capacity_drift",
+			Help: "Allocated minus expected resources. Positive values are leaked resources.",
+		}, labels),
+		drifting: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "hosting_capacity_drifting_pools",
+			Help: "Pools that disagree with the hosting service or cannot be compared.",
+		}),
+		unsettled: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "hosting_capacity_unsettled_orders",
+			Help: "Orders that may hold resources not recorded in a pool yet.",
+		}),
+		repairs: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "hosting_capacity_repairs_total",
+			Help: "Pools whose counters were corrected.",
+		}),
+		lastRunSec: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "hosting_capacity_last_run_timestamp_seconds",
+			Help: "Time of the last reconciliation run.",
+		}),
+	}
+
+	prometheus.MustRegister(r.expected, r.allocated, r.drift, r.drifting, r.unsettled, r.repairs, r.lastRunSec)
+
+	return r
+}
+
+func (r *Recorder) Record(ctx context.Context, report capacity.Report) {
+	// Pools that are gone must not keep their last values.
+	r.expected.Reset()
+	r.allocated.Reset()
+	r.drift.Reset()
+
+	drifting := 0
+	for _, p := range report.Pools {
+		poolID := p.PoolID.String()
+
+		setResources(r.expected, poolID, p.Expected)
+		if p.Allocated != nil {
+			setResources(r.allocated, poolID, *p.Allocated)
+		}
+		if p.Drift != nil {
+			setResources(r.drift, poolID, *p.Drift)
+		}
+
+		if p.Repaired {
+			r.repairs.Inc()
+		}
+		if p.Drifting() {
+			drifting++
+		}
+	}
+
+	r.drifting.Set(float64(drifting))
+	r.unsettled.Set(float64(report.Unsettled))
+	r.lastRunSec.Set(float64(report.CheckedAt.Unix()))
+}
+
+func setResources(g *prometheus.GaugeVec, poolID string, res server.Resources) {
+	g.WithLabelValues(poolID, "cpu_cores").Set(float64(res.CPUCores))
+	g.WithLabelValues(poolID, "ram_mb").Set(float64(res.RAMMB))
+	g.WithLabelValues(poolID, "disk_gb").Set(float64(res.DiskGB))
+	g.WithLabelValues(poolID, "ip_count").Set(float64(res.IPCount))
+}
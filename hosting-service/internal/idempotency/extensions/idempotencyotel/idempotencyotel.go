@@ -0,0 +1,49 @@
+package idempotencyotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-service/internal/idempotency"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus idempotency.ExtBusiness
+}
+
+func NewExtension() idempotency.Extension {
+	return func(bus idempotency.ExtBusiness) idempotency.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Begin(ctx context.Context, userID uuid.UUID, key string, request any) (idempotency.Record, bool, error) {
+	ctx, span := otel.AddSpan(ctx, "idempotency.begin")
+	defer span.End()
+
+	return e.bus.Begin(ctx, userID, key, request)
+}
+
+func (e *Extension) Complete(ctx context.Context, rec idempotency.Record, response any) error {
+	ctx, span := otel.AddSpan(ctx, "idempotency.complete")
+	defer span.End()
+
+	return e.bus.Complete(ctx, rec, response)
+}
+
+func (e *Extension) Abort(ctx context.Context, rec idempotency.Record) error {
+	ctx, span := otel.AddSpan(ctx, "idempotency.abort")
+	defer span.End()
+
+	return e.bus.Abort(ctx, rec)
+}
+
+func (e *Extension) Purge(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "idempotency.purge")
+	defer span.End()
+
+	return e.bus.Purge(ctx, limit)
+}
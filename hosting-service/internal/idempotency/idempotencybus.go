@@ -0,0 +1,197 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrValidation = errors.New("validation error")
+	ErrKeyReused  = errors.New("idempotency key was already used with a different request")
+	ErrInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// MaxKeyLength bounds the keys clients may send.
+const MaxKeyLength = 255
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	// Create stores the record unless the user already has one with the same
+	// key, in which case it returns false and the stored record.
+	Create(ctx context.Context, rec Record) (Record, bool, error)
+	Update(ctx context.Context, rec Record) error
+	Delete(ctx context.Context, userID uuid.UUID, key string) error
+	DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+type ExtBusiness interface {
+	Begin(ctx context.Context, userID uuid.UUID, key string, request any) (Record, bool, error)
+	Complete(ctx context.Context, rec Record, response any) error
+	Abort(ctx context.Context, rec Record) error
+	Purge(ctx context.Context, limit int) (int, error)
+}
+
+type Business struct {
+	cfg        Config
+	storer     Storer
+	extensions []Extension
+}
+
+func NewBusiness(cfg Config, storer Storer, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		cfg:        cfg,
+		storer:     storer,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Begin claims the key for the request. The second result is true when the
+// key was already used for the same request and its stored response should be
+// replayed instead of running the request again.
+func (b *Business) Begin(ctx context.Context, userID uuid.UUID, key string, request any) (Record, bool, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return Record{}, false, fmt.Errorf("%w: idempotency key cannot be empty", ErrValidation)
+	}
+	if len(key) > MaxKeyLength {
+		return Record{}, false, fmt.Errorf("%w: idempotency key cannot be longer than %d characters", ErrValidation, MaxKeyLength)
+	}
+
+	hash, err := hashRequest(request)
+	if err != nil {
+		return Record{}, false, err
+	}
+
+	now := time.Now().UTC()
+	rec := Record{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: hash,
+		State:       StateInProgress,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(b.cfg.TTL),
+	}
+
+	stored, created, err := b.storer.Create(ctx, rec)
+	if err != nil {
+		return Record{}, false, fmt.Errorf("begin: %w", err)
+	}
+	if created {
+		return rec, false, nil
+	}
+
+	// An expired key that was not purged yet is free to use again.
+	if !stored.ExpiresAt.After(now) {
+		if err := b.storer.Delete(ctx, userID, key); err != nil {
+			return Record{}, false, fmt.Errorf("begin: %w", err)
+		}
+		return b.Begin(ctx, userID, key, request)
+	}
+
+	if stored.RequestHash != hash {
+		return Record{}, false, ErrKeyReused
+	}
+
+	if stored.State != StateCompleted {
+		return Record{}, false, ErrInProgress
+	}
+
+	return stored, true, nil
+}
+
+// Complete stores the response of a request started with Begin.
+func (b *Business) Complete(ctx context.Context, rec Record, response any) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("complete: marshal response: %w", err)
+	}
+
+	rec.State = StateCompleted
+	rec.Response = data
+
+	if err := b.storer.Update(ctx, rec); err != nil {
+		return fmt.Errorf("complete: %w", err)
+	}
+
+	return nil
+}
+
+// Abort releases the key of a failed request so the client can retry it.
+func (b *Business) Abort(ctx context.Context, rec Record) error {
+	if err := b.storer.Delete(ctx, rec.UserID, rec.Key); err != nil {
+		return fmt.Errorf("abort: %w", err)
+	}
+
+	return nil
+}
+
+// Purge removes up to limit expired keys and reports how many were removed.
+func (b *Business) Purge(ctx context.Context, limit int) (int, error) {
+	n, err := b.storer.DeleteExpired(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("purge: %w", err)
+	}
+
+	return n, nil
+}
+
+// Run executes fn at most once per user and key. A replay of the same
+// request returns the result of the first run; a failed run releases the key.
+func Run[T any](ctx context.Context, bus ExtBusiness, userID uuid.UUID, key string, request any, fn func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+
+	rec, replay, err := bus.Begin(ctx, userID, key, request)
+	if err != nil {
+		return zero, err
+	}
+
+	if replay {
+		var result T
+		if err := json.Unmarshal(rec.Response, &result); err != nil {
+			return zero, fmt.Errorf("run: unmarshal response: %w", err)
+		}
+		return result, nil
+	}
+
+	result, err := fn(ctx)
+	if err != nil {
+		_ = bus.Abort(ctx, rec)
+		return zero, err
+	}
+
+	// The request already took effect. If the response cannot be stored the
+	// key stays in progress until it expires, which is safer than a rerun.
+	_ = bus.Complete(ctx, rec, result)
+
+	return result, nil
+}
+
+func hashRequest(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("%w: marshal request: %v", ErrValidation, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
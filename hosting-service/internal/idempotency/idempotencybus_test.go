@@ -0,0 +1,236 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"hosting-service/internal/idempotency"
+
+	"github.com/google/uuid"
+)
+
+type memStorer struct {
+	records map[string]idempotency.Record
+}
+
+func newMemStorer() *memStorer {
+	return &memStorer{records: make(map[string]idempotency.Record)}
+}
+
+func (m *memStorer) id(userID uuid.UUID, key string) string {
+	return userID.String() + "/" + key
+}
+
+func (m *memStorer) Create(ctx context.Context, rec idempotency.Record) (idempotency.Record, bool, error) {
+	if stored, ok := m.records[m.id(rec.UserID, rec.Key)]; ok {
+		return stored, false, nil
+	}
+	m.records[m.id(rec.UserID, rec.Key)] = rec
+	return rec, true, nil
+}
+
+func (m *memStorer) Update(ctx context.Context, rec idempotency.Record) error {
+	m.records[m.id(rec.UserID, rec.Key)] = rec
+	return nil
+}
+
+func (m *memStorer) Delete(ctx context.Context, userID uuid.UUID, key string) error {
+	delete(m.records, m.id(userID, key))
+	return nil
+}
+
+func (m *memStorer) DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	n := 0
+	for id, rec := range m.records {
+		if n == limit {
+			break
+		}
+		if !rec.ExpiresAt.After(now) {
+			delete(m.records, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+type result struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+var cfg = idempotency.Config{TTL: time.Hour}
+
+func Test_Run(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	errFailed := errors.New("request failed")
+
+	type request struct {
+		Name string `json:"name"`
+	}
+
+	type call struct {
+		key     string
+		request request
+		fnErr   error
+		wantErr error
+		wantRun bool
+	}
+
+	type testCase struct {
+		name    string
+		prepare func(st *memStorer)
+		calls   []call
+	}
+
+	cases := []testCase{
+		{
+			name: "replay returns first result",
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, wantRun: true},
+				{key: "k1", request: request{Name: "a"}, wantRun: false},
+			},
+		},
+		{
+			name: "different payload",
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, wantRun: true},
+				{key: "k1", request: request{Name: "b"}, wantErr: idempotency.ErrKeyReused},
+			},
+		},
+		{
+			name: "different keys run twice",
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, wantRun: true},
+				{key: "k2", request: request{Name: "a"}, wantRun: true},
+			},
+		},
+		{
+			name: "failed run releases key",
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, fnErr: errFailed, wantErr: errFailed, wantRun: true},
+				{key: "k1", request: request{Name: "a"}, wantRun: true},
+			},
+		},
+		{
+			name: "in progress",
+			prepare: func(st *memStorer) {
+				bus := idempotency.NewBusiness(cfg, st)
+				if _, _, err := bus.Begin(ctx, userID, "k1", request{Name: "a"}); err != nil {
+					t.Fatalf("begin: %v", err)
+				}
+			},
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, wantErr: idempotency.ErrInProgress},
+			},
+		},
+		{
+			name: "expired key is reused",
+			prepare: func(st *memStorer) {
+				bus := idempotency.NewBusiness(idempotency.Config{TTL: -time.Minute}, st)
+				if _, err := idempotency.Run(ctx, bus, userID, "k1", request{Name: "old"}, func(ctx context.Context) (result, error) {
+					return result{Name: "old"}, nil
+				}); err != nil {
+					t.Fatalf("run: %v", err)
+				}
+			},
+			calls: []call{
+				{key: "k1", request: request{Name: "a"}, wantRun: true},
+			},
+		},
+		{
+			name: "empty key",
+			calls: []call{
+				{key: " ", request: request{Name: "a"}, wantErr: idempotency.ErrValidation},
+			},
+		},
+		{
+			name: "key too long",
+			calls: []call{
+				{key: strings.Repeat("k", idempotency.MaxKeyLength+1), request: request{Name: "a"}, wantErr: idempotency.ErrValidation},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := newMemStorer()
+			if tc.prepare != nil {
+				tc.prepare(st)
+			}
+
+			bus := idempotency.NewBusiness(cfg, st)
+
+			var first result
+
+			for i, c := range tc.calls {
+				ran := false
+
+				got, err := idempotency.Run(ctx, bus, userID, c.key, c.request, func(ctx context.Context) (result, error) {
+					ran = true
+					if c.fnErr != nil {
+						return result{}, c.fnErr
+					}
+					return result{ID: uuid.New(), Name: c.request.Name}, nil
+				})
+
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("call %d: expected error %v, got %v", i, c.wantErr, err)
+				}
+				if ran != c.wantRun {
+					t.Fatalf("call %d: expected run %v, got %v", i, c.wantRun, ran)
+				}
+				if err != nil {
+					continue
+				}
+
+				if ran {
+					first = got
+					continue
+				}
+				if got != first {
+					t.Errorf("call %d: expected replayed result %+v, got %+v", i, first, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_Purge(t *testing.T) {
+	ctx := context.Background()
+	st := newMemStorer()
+
+	expired := idempotency.NewBusiness(idempotency.Config{TTL: -time.Minute}, st)
+	live := idempotency.NewBusiness(cfg, st)
+
+	for _, key := range []string{"a", "b", "c"} {
+		if _, _, err := expired.Begin(ctx, uuid.New(), key, key); err != nil {
+			t.Fatalf("begin: %v", err)
+		}
+	}
+	if _, _, err := live.Begin(ctx, uuid.New(), "d", "d"); err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+
+	n, err := live.Purge(ctx, 2)
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 purged, got %d", n)
+	}
+
+	n, err = live.Purge(ctx, 10)
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 purged, got %d", n)
+	}
+	if len(st.records) != 1 {
+		t.Errorf("expected the live key to remain, got %d records", len(st.records))
+	}
+}
@@ -0,0 +1,32 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type State string
+
+const (
+	StateInProgress State = "IN_PROGRESS"
+	StateCompleted  State = "COMPLETED"
+)
+
+// Record remembers a request made with an idempotency key and, once it
+// succeeded, the result handed back to the client.
+type Record struct {
+	UserID      uuid.UUID
+	Key         string
+	RequestHash string
+	State       State
+	Response    json.RawMessage
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+type Config struct {
+	// TTL is how long a key is remembered after its first use.
+	TTL time.Duration
+}
@@ -0,0 +1,154 @@
+package idempotencydb
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-service/internal/idempotency"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// Create inserts the record. When the user already has the key the insert
+// is skipped and the stored record is returned instead.
+func (s *Store) Create(ctx context.Context, rec idempotency.Record) (idempotency.Record, bool, error) {
+	const q = `
+	INSERT INTO idempotency_keys
+		(user_id, key, request_hash, state, response, created_at, expires_at)
+	VALUES
+		(@user_id, @key, @request_hash, @state, @response, @created_at, @expires_at)
+	ON CONFLICT (user_id, key) DO NOTHING`
+
+	dbRec := toDBRecord(rec)
+
+	args := pgx.NamedArgs{
+		"user_id":      dbRec.UserID,
+		"key":          dbRec.Key,
+		"request_hash": dbRec.RequestHash,
+		"state":        dbRec.State,
+		"response":     dbRec.Response,
+		"created_at":   dbRec.CreatedAt,
+		"expires_at":   dbRec.ExpiresAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return rec, true, nil
+	}
+
+	stored, err := s.find(ctx, rec.UserID, rec.Key)
+	if err != nil {
+		return idempotency.Record{}, false, err
+	}
+
+	return stored, false, nil
+}
+
+func (s *Store) find(ctx context.Context, userID uuid.UUID, key string) (idempotency.Record, error) {
+	const q = `
+	SELECT
+		user_id, key, request_hash, state, response, created_at, expires_at
+	FROM
+		idempotency_keys
+	WHERE
+		user_id = @user_id AND key = @key`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"key":     key,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return idempotency.Record{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbRec, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[recordDB])
+	if err != nil {
+		return idempotency.Record{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusRecord(dbRec), nil
+}
+
+func (s *Store) Update(ctx context.Context, rec idempotency.Record) error {
+	const q = `
+	UPDATE idempotency_keys
+	SET
+		state = @state,
+		response = @response
+	WHERE
+		user_id = @user_id AND key = @key`
+
+	dbRec := toDBRecord(rec)
+
+	args := pgx.NamedArgs{
+		"user_id":  dbRec.UserID,
+		"key":      dbRec.Key,
+		"state":    dbRec.State,
+		"response": dbRec.Response,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Delete(ctx context.Context, userID uuid.UUID, key string) error {
+	const q = `
+	DELETE FROM idempotency_keys
+	WHERE
+		user_id = @user_id AND key = @key`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"key":     key,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	const q = `
+	DELETE FROM idempotency_keys
+	WHERE
+		(user_id, key) IN (
+			SELECT user_id, key
+			FROM idempotency_keys
+			WHERE expires_at <= @now
+			LIMIT @limit
+		)`
+
+	args := pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return 0, fmt.Errorf("db: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
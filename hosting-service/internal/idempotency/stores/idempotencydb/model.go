@@ -0,0 +1,42 @@
+package idempotencydb
+
+import (
+	"hosting-service/internal/idempotency"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type recordDB struct {
+	UserID      uuid.UUID `db:"user_id"`
+	Key         string    `db:"key"`
+	RequestHash string    `db:"request_hash"`
+	State       string    `db:"state"`
+	Response    []byte    `db:"response"`
+	CreatedAt   time.Time `db:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+func toDBRecord(r idempotency.Record) recordDB {
+	return recordDB{
+		UserID:      r.UserID,
+		Key:         r.Key,
+		RequestHash: r.RequestHash,
+		State:       string(r.State),
+		Response:    r.Response,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
+
+func toBusRecord(db recordDB) idempotency.Record {
+	return idempotency.Record{
+		UserID:      db.UserID,
+		Key:         db.Key,
+		RequestHash: db.RequestHash,
+		State:       idempotency.State(db.State),
+		Response:    db.Response,
+		CreatedAt:   db.CreatedAt,
+		ExpiresAt:   db.ExpiresAt,
+	}
+}
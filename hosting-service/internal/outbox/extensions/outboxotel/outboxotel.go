@@ -0,0 +1,42 @@
+package outboxotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-service/internal/outbox"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus outbox.ExtBusiness
+}
+
+func NewExtension() outbox.Extension {
+	return func(bus outbox.ExtBusiness) outbox.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error {
+	ctx, span := otel.AddSpan(ctx, "outbox.publish")
+	defer span.End()
+
+	return e.bus.Publish(ctx, exchangeName, routingKey, data)
+}
+
+func (e *Extension) PublishOrdered(ctx context.Context, aggregateID uuid.UUID, exchangeName, routingKey string, data interface{}) error {
+	ctx, span := otel.AddSpan(ctx, "outbox.publishordered")
+	defer span.End()
+
+	return e.bus.PublishOrdered(ctx, aggregateID, exchangeName, routingKey, data)
+}
+
+func (e *Extension) Relay(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "outbox.relay")
+	defer span.End()
+
+	return e.bus.Relay(ctx, limit)
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Message struct {
+	ID uuid.UUID
+
+	// AggregateID orders the message after the earlier messages of the same
+	// aggregate. Messages without one are relayed in any order.
+	AggregateID *uuid.UUID
+
+	Exchange      string
+	RoutingKey    string
+	Payload       json.RawMessage
+	Headers       map[string]string
+	Attempts      int
+	LastError     *string
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+	SentAt        *time.Time
+}
+
+type Backoff struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
@@ -0,0 +1,214 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hosting-kit/messaging"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrValidation = errors.New("validation error")
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	Create(ctx context.Context, msg Message) error
+	// FindPending locks up to limit messages that are due, oldest first. A
+	// message is left out while an older message of its aggregate is unsent,
+	// so a message that backs off holds back the ones published after it.
+	FindPending(ctx context.Context, now time.Time, limit int) ([]Message, error)
+	MarkSent(ctx context.Context, ID uuid.UUID, sentAt time.Time) error
+	MarkFailed(ctx context.Context, msg Message) error
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ExtBusiness interface {
+	Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error
+	PublishOrdered(ctx context.Context, aggregateID uuid.UUID, exchangeName, routingKey string, data interface{}) error
+	Relay(ctx context.Context, limit int) (int, error)
+}
+
+type Business struct {
+	storer     Storer
+	publisher  Publisher
+	tx         Transactor
+	backoff    Backoff
+	extensions []Extension
+}
+
+func NewBusiness(storer Storer, publisher Publisher, tx Transactor, backoff Backoff, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		publisher:  publisher,
+		tx:         tx,
+		backoff:    backoff,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Publish stores the message in the outbox. When the context carries a
+// transaction the message is committed together with the caller's changes.
+func (b *Business) Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error {
+	return b.publish(ctx, nil, exchangeName, routingKey, data)
+}
+
+// PublishOrdered stores the message like Publish. Messages of the same
+// aggregate, e.g. the commands for one server, are relayed in the order they
+// were published, and one that fails holds back the rest.
+func (b *Business) PublishOrdered(ctx context.Context, aggregateID uuid.UUID, exchangeName, routingKey string, data interface{}) error {
+	if aggregateID == uuid.Nil {
+		return fmt.Errorf("%w: aggregate id cannot be empty", ErrValidation)
+	}
+
+	return b.publish(ctx, &aggregateID, exchangeName, routingKey, data)
+}
+
+func (b *Business) publish(ctx context.Context, aggregateID *uuid.UUID, exchangeName, routingKey string, data interface{}) error {
+	if strings.TrimSpace(exchangeName) == "" {
+		return fmt.Errorf("%w: exchange cannot be empty", ErrValidation)
+	}
+	if strings.TrimSpace(routingKey) == "" {
+		return fmt.Errorf("%w: routing key cannot be empty", ErrValidation)
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("%w: marshal payload: %v", ErrValidation, err)
+	}
+
+	headers := make(map[string]string)
+	for k, v := range messaging.InjectTraceHeaders(ctx) {
+		if s, ok := v.(string); ok {
+			headers[k] = s
+		}
+	}
+
+	now := time.Now().UTC()
+
+	msg := Message{
+		ID:            uuid.New(),
+		AggregateID:   aggregateID,
+		Exchange:      exchangeName,
+		RoutingKey:    routingKey,
+		Payload:       payload,
+		Headers:       headers,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+
+	if err := b.storer.Create(ctx, msg); err != nil {
+		return fmt.Errorf("publish: %w", err)
+	}
+
+	return nil
+}
+
+// Relay publishes up to limit pending messages to the broker and returns the
+// number of messages sent. Each message is sent and marked in its own
+// transaction, so a failed write does not resend the messages before it.
+// Failed messages are rescheduled with a backoff.
+func (b *Business) Relay(ctx context.Context, limit int) (int, error) {
+	var sent int
+
+	for i := 0; i < limit; i++ {
+		var found, ok bool
+
+		err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+			msgs, err := b.storer.FindPending(ctx, time.Now().UTC(), 1)
+			if err != nil {
+				return fmt.Errorf("findpending: %w", err)
+			}
+
+			if len(msgs) == 0 {
+				return nil
+			}
+
+			found = true
+			ok, err = b.relay(ctx, msgs[0])
+			return err
+		})
+
+		if err != nil {
+			return sent, fmt.Errorf("relay: %w", err)
+		}
+
+		if !found {
+			break
+		}
+
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// relay sends one message and records the outcome. It reports whether the
+// message was sent.
+func (b *Business) relay(ctx context.Context, msg Message) (bool, error) {
+	if err := b.send(ctx, msg); err != nil {
+		msg.Attempts++
+		reason := err.Error()
+		msg.LastError = &reason
+		msg.NextAttemptAt = time.Now().UTC().Add(b.delay(msg.Attempts))
+
+		if err := b.storer.MarkFailed(ctx, msg); err != nil {
+			return false, fmt.Errorf("markfailed: %w", err)
+		}
+
+		return false, nil
+	}
+
+	if err := b.storer.MarkSent(ctx, msg.ID, time.Now().UTC()); err != nil {
+		return false, fmt.Errorf("marksent: %w", err)
+	}
+
+	return true, nil
+}
+
+func (b *Business) send(ctx context.Context, msg Message) error {
+	headers := make(map[string]interface{}, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	ctx = messaging.ExtractTraceHeaders(ctx, headers)
+
+	return b.publisher.Publish(ctx, msg.Exchange, msg.RoutingKey, msg.Payload)
+}
+
+func (b *Business) delay(attempts int) time.Duration {
+	d := b.backoff.BaseDelay
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= b.backoff.MaxDelay {
+			return b.backoff.MaxDelay
+		}
+	}
+
+	return d
+}
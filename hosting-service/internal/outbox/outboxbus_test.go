@@ -0,0 +1,333 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"hosting-service/internal/outbox"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	CreateFunc      func(ctx context.Context, msg outbox.Message) error
+	FindPendingFunc func(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error)
+	MarkSentFunc    func(ctx context.Context, ID uuid.UUID, sentAt time.Time) error
+	MarkFailedFunc  func(ctx context.Context, msg outbox.Message) error
+}
+
+func (m *mockStorer) Create(ctx context.Context, msg outbox.Message) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, msg)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindPending(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	if m.FindPendingFunc != nil {
+		return m.FindPendingFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) MarkSent(ctx context.Context, ID uuid.UUID, sentAt time.Time) error {
+	if m.MarkSentFunc != nil {
+		return m.MarkSentFunc(ctx, ID, sentAt)
+	}
+	return nil
+}
+
+func (m *mockStorer) MarkFailed(ctx context.Context, msg outbox.Message) error {
+	if m.MarkFailedFunc != nil {
+		return m.MarkFailedFunc(ctx, msg)
+	}
+	return nil
+}
+
+type mockPublisher struct {
+	PublishFunc func(ctx context.Context, exchangeName, routingKey string, data interface{}) error
+}
+
+func (m *mockPublisher) Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error {
+	if m.PublishFunc != nil {
+		return m.PublishFunc(ctx, exchangeName, routingKey, data)
+	}
+	return nil
+}
+
+type mockTransactor struct {
+	calls int
+}
+
+func (m *mockTransactor) WithinTran(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
+var backoff = outbox.Backoff{BaseDelay: time.Second, MaxDelay: 4 * time.Second}
+
+func Test_Publish(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		name       string
+		exchange   string
+		routingKey string
+		data       interface{}
+		st         func() *mockStorer
+		wantErr    error
+	}
+
+	table := []testCase{
+		{
+			name:       "success",
+			exchange:   "events",
+			routingKey: "server.updated",
+			data:       map[string]string{"status": "RUNNING"},
+			st: func() *mockStorer {
+				return &mockStorer{
+					CreateFunc: func(ctx context.Context, msg outbox.Message) error {
+						if msg.SentAt != nil {
+							return errors.New("new message must not be sent")
+						}
+						var payload map[string]string
+						if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+							return err
+						}
+						if payload["status"] != "RUNNING" {
+							return errors.New("payload mismatch")
+						}
+						return nil
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:       "fail_empty_routing_key",
+			exchange:   "events",
+			routingKey: "",
+			data:       struct{}{},
+			st:         func() *mockStorer { return &mockStorer{} },
+			wantErr:    outbox.ErrValidation,
+		},
+		{
+			name:       "fail_unmarshalable_payload",
+			exchange:   "events",
+			routingKey: "server.updated",
+			data:       make(chan int),
+			st:         func() *mockStorer { return &mockStorer{} },
+			wantErr:    outbox.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := outbox.NewBusiness(tt.st(), &mockPublisher{}, &mockTransactor{}, backoff)
+
+			err := bus.Publish(ctx, tt.exchange, tt.routingKey, tt.data)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_PublishOrdered(t *testing.T) {
+	ctx := context.Background()
+	serverID := uuid.New()
+
+	type testCase struct {
+		name        string
+		aggregateID uuid.UUID
+		wantErr     error
+	}
+
+	table := []testCase{
+		{
+			name:        "success",
+			aggregateID: serverID,
+		},
+		{
+			name:        "fail_empty_aggregate",
+			aggregateID: uuid.Nil,
+			wantErr:     outbox.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *outbox.Message
+
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, msg outbox.Message) error {
+					stored = &msg
+					return nil
+				},
+			}
+
+			bus := outbox.NewBusiness(st, &mockPublisher{}, &mockTransactor{}, backoff)
+
+			err := bus.PublishOrdered(ctx, tt.aggregateID, "commands", "server.power", struct{}{})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if stored != nil {
+					t.Errorf("message stored despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stored == nil || stored.AggregateID == nil || *stored.AggregateID != tt.aggregateID {
+				t.Errorf("message not stored under aggregate %s", tt.aggregateID)
+			}
+		})
+	}
+}
+
+func Test_Relay(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("broker unavailable")
+	errDB := errors.New("db connection lost")
+
+	pending := func() []outbox.Message {
+		return []outbox.Message{
+			{ID: uuid.New(), Exchange: "events", RoutingKey: "a", Payload: json.RawMessage(`{}`)},
+			{ID: uuid.New(), Exchange: "events", RoutingKey: "b", Payload: json.RawMessage(`{}`), Attempts: 2},
+		}
+	}
+
+	type testCase struct {
+		name        string
+		limit       int
+		pub         func() *mockPublisher
+		markSentErr map[string]error
+		wantSent    int
+		wantFailed  int
+		wantTx      int
+		wantErr     error
+	}
+
+	table := []testCase{
+		{
+			name:     "success",
+			limit:    10,
+			pub:      func() *mockPublisher { return &mockPublisher{} },
+			wantSent: 2,
+			wantTx:   3,
+		},
+		{
+			name:     "limit_reached",
+			limit:    1,
+			pub:      func() *mockPublisher { return &mockPublisher{} },
+			wantSent: 1,
+			wantTx:   1,
+		},
+		{
+			name:  "broker_failure_reschedules",
+			limit: 10,
+			pub: func() *mockPublisher {
+				return &mockPublisher{
+					PublishFunc: func(ctx context.Context, exchangeName, routingKey string, data interface{}) error {
+						if routingKey == "b" {
+							return errBoom
+						}
+						return nil
+					},
+				}
+			},
+			wantSent:   1,
+			wantFailed: 1,
+			wantTx:     3,
+		},
+		{
+			name:        "fail_mark_sent_keeps_earlier",
+			limit:       10,
+			pub:         func() *mockPublisher { return &mockPublisher{} },
+			markSentErr: map[string]error{"b": errDB},
+			wantSent:    1,
+			wantTx:      2,
+			wantErr:     errDB,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent, failed int
+			queue := pending()
+
+			st := &mockStorer{
+				FindPendingFunc: func(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+					if limit != 1 {
+						t.Errorf("got limit %d, want one message per transaction", limit)
+					}
+					if len(queue) == 0 {
+						return nil, nil
+					}
+					return queue[:1], nil
+				},
+				MarkSentFunc: func(ctx context.Context, ID uuid.UUID, sentAt time.Time) error {
+					if err := tt.markSentErr[queue[0].RoutingKey]; err != nil {
+						return err
+					}
+					queue = queue[1:]
+					sent++
+					return nil
+				},
+				MarkFailedFunc: func(ctx context.Context, msg outbox.Message) error {
+					queue = queue[1:]
+					failed++
+					if msg.Attempts != 3 {
+						t.Errorf("got attempts %d, want 3", msg.Attempts)
+					}
+					if msg.LastError == nil || *msg.LastError != errBoom.Error() {
+						t.Errorf("last error not recorded: %v", msg.LastError)
+					}
+					if delay := time.Until(msg.NextAttemptAt); delay > backoff.MaxDelay {
+						t.Errorf("retry delay %v exceeds max %v", delay, backoff.MaxDelay)
+					}
+					return nil
+				},
+			}
+
+			tx := &mockTransactor{}
+			bus := outbox.NewBusiness(st, tt.pub(), tx, backoff)
+
+			got, err := bus.Relay(ctx, tt.limit)
+
+			if tx.calls != tt.wantTx {
+				t.Errorf("got %d transactions, want %d", tx.calls, tt.wantTx)
+			}
+			if got != tt.wantSent || sent != tt.wantSent {
+				t.Errorf("got sent %d (marked %d), want %d", got, sent, tt.wantSent)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if failed != tt.wantFailed {
+				t.Errorf("got failed %d, want %d", failed, tt.wantFailed)
+			}
+		})
+	}
+}
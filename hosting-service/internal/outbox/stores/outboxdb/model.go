@@ -0,0 +1,62 @@
+package outboxdb
+
+import (
+	"hosting-service/internal/outbox"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type messageDB struct {
+	ID            uuid.UUID         `db:"id"`
+	AggregateID   *uuid.UUID        `db:"aggregate_id"`
+	Exchange      string            `db:"exchange"`
+	RoutingKey    string            `db:"routing_key"`
+	Payload       []byte            `db:"payload"`
+	Headers       map[string]string `db:"headers"`
+	Attempts      int               `db:"attempts"`
+	LastError     *string           `db:"last_error"`
+	CreatedAt     time.Time         `db:"created_at"`
+	NextAttemptAt time.Time         `db:"next_attempt_at"`
+	SentAt        *time.Time        `db:"sent_at"`
+}
+
+func toDBMessage(m outbox.Message) messageDB {
+	return messageDB{
+		ID:            m.ID,
+		AggregateID:   m.AggregateID,
+		Exchange:      m.Exchange,
+		RoutingKey:    m.RoutingKey,
+		Payload:       m.Payload,
+		Headers:       m.Headers,
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		CreatedAt:     m.CreatedAt,
+		NextAttemptAt: m.NextAttemptAt,
+		SentAt:        m.SentAt,
+	}
+}
+
+func toBusMessage(db messageDB) outbox.Message {
+	return outbox.Message{
+		ID:            db.ID,
+		AggregateID:   db.AggregateID,
+		Exchange:      db.Exchange,
+		RoutingKey:    db.RoutingKey,
+		Payload:       db.Payload,
+		Headers:       db.Headers,
+		Attempts:      db.Attempts,
+		LastError:     db.LastError,
+		CreatedAt:     db.CreatedAt,
+		NextAttemptAt: db.NextAttemptAt,
+		SentAt:        db.SentAt,
+	}
+}
+
+func toBusMessages(dbs []messageDB) []outbox.Message {
+	msgs := make([]outbox.Message, len(dbs))
+	for i, db := range dbs {
+		msgs[i] = toBusMessage(db)
+	}
+	return msgs
+}
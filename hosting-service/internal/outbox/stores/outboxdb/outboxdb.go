@@ -0,0 +1,144 @@
+package outboxdb
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-service/internal/outbox"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, msg outbox.Message) error {
+	const q = `
+	INSERT INTO outbox
+		(id, aggregate_id, exchange, routing_key, payload, headers, attempts, last_error, created_at, next_attempt_at, sent_at)
+	VALUES
+		(@id, @aggregate_id, @exchange, @routing_key, @payload, @headers, @attempts, @last_error, @created_at, @next_attempt_at, @sent_at)`
+
+	dbMsg := toDBMessage(msg)
+
+	args := pgx.NamedArgs{
+		"id":              dbMsg.ID,
+		"aggregate_id":    dbMsg.AggregateID,
+		"exchange":        dbMsg.Exchange,
+		"routing_key":     dbMsg.RoutingKey,
+		"payload":         dbMsg.Payload,
+		"headers":         dbMsg.Headers,
+		"attempts":        dbMsg.Attempts,
+		"last_error":      dbMsg.LastError,
+		"created_at":      dbMsg.CreatedAt,
+		"next_attempt_at": dbMsg.NextAttemptAt,
+		"sent_at":         dbMsg.SentAt,
+	}
+
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+// FindPending locks due messages that are not held back by an older unsent
+// message of their aggregate, whether that one is backing off or locked by
+// another relay.
+func (s *Store) FindPending(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	const q = `
+	SELECT
+		id, aggregate_id, exchange, routing_key, payload, headers, attempts, last_error, created_at, next_attempt_at, sent_at
+	FROM
+		outbox o
+	WHERE
+		o.sent_at IS NULL AND
+		o.next_attempt_at <= @now AND
+		NOT EXISTS (
+			SELECT 1
+			FROM outbox e
+			WHERE
+				e.aggregate_id = o.aggregate_id AND
+				e.sent_at IS NULL AND
+				(e.created_at, e.id) < (o.created_at, o.id)
+		)
+	ORDER BY
+		o.created_at ASC, o.id ASC
+	LIMIT
+		@limit
+	FOR UPDATE SKIP LOCKED`
+
+	args := pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbMsgs, err := pgx.CollectRows(rows, pgx.RowToStructByName[messageDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusMessages(dbMsgs), nil
+}
+
+func (s *Store) MarkSent(ctx context.Context, ID uuid.UUID, sentAt time.Time) error {
+	const q = `
+	UPDATE outbox
+	SET
+		sent_at = @sent_at
+	WHERE
+		id = @id`
+
+	args := pgx.NamedArgs{
+		"id":      ID,
+		"sent_at": sentAt,
+	}
+
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) MarkFailed(ctx context.Context, msg outbox.Message) error {
+	const q = `
+	UPDATE outbox
+	SET
+		attempts = @attempts,
+		last_error = @last_error,
+		next_attempt_at = @next_attempt_at
+	WHERE
+		id = @id`
+
+	dbMsg := toDBMessage(msg)
+
+	args := pgx.NamedArgs{
+		"id":              dbMsg.ID,
+		"attempts":        dbMsg.Attempts,
+		"last_error":      dbMsg.LastError,
+		"next_attempt_at": dbMsg.NextAttemptAt,
+	}
+
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
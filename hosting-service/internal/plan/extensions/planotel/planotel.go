@@ -41,3 +41,10 @@ func (e *Extension) Search(ctx context.Context, pg page.Page) ([]plan.Plan, int,
 
 	return e.bus.Search(ctx, pg)
 }
+
+func (e *Extension) SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[plan.Plan], page.CursorDocument, error) {
+	ctx, span := otel.AddSpan(ctx, "plan.searchbycursor")
+	defer span.End()
+
+	return e.bus.SearchByCursor(ctx, cur)
+}
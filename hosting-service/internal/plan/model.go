@@ -11,6 +11,12 @@ type Plan struct {
 	RAMMB    int
 	DiskGB   int
 	IpCount  int
+
+	// RunningHourlyPrice is charged per hour the server is RUNNING and
+	// AllocatedHourlyPrice per hour it holds resources, both in minor
+	// currency units.
+	RunningHourlyPrice   int64
+	AllocatedHourlyPrice int64
 }
 
 type CreatePlanParams struct {
@@ -19,4 +25,7 @@ type CreatePlanParams struct {
 	RAMMB    int
 	DiskGB   int
 	IpCount  int
+
+	RunningHourlyPrice   int64
+	AllocatedHourlyPrice int64
 }
@@ -21,12 +21,14 @@ type Storer interface {
 	FindByID(ctx context.Context, ID uuid.UUID) (Plan, error)
 	Create(ctx context.Context, plan Plan) error
 	FindAll(ctx context.Context, pg page.Page) ([]Plan, int, error)
+	FindAllByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Plan], page.CursorDocument, error)
 }
 
 type ExtBusiness interface {
 	FindByID(ctx context.Context, ID uuid.UUID) (Plan, error)
 	Create(ctx context.Context, params CreatePlanParams) (Plan, error)
 	Search(ctx context.Context, pg page.Page) ([]Plan, int, error)
+	SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Plan], page.CursorDocument, error)
 }
 
 type Business struct {
@@ -70,14 +72,22 @@ func NewPlan(params CreatePlanParams) (Plan, error) {
 	if params.IpCount <= 0 {
 		return Plan{}, fmt.Errorf("%w: IP count must be a positive number", ErrValidation)
 	}
+	if params.RunningHourlyPrice < 0 {
+		return Plan{}, fmt.Errorf("%w: running hourly price cannot be negative", ErrValidation)
+	}
+	if params.AllocatedHourlyPrice < 0 {
+		return Plan{}, fmt.Errorf("%w: allocated hourly price cannot be negative", ErrValidation)
+	}
 
 	plan := Plan{
-		ID:       uuid.New(),
-		Name:     trimmedName,
-		CPUCores: params.CPUCores,
-		RAMMB:    params.RAMMB,
-		DiskGB:   params.DiskGB,
-		IpCount:  params.IpCount,
+		ID:                   uuid.New(),
+		Name:                 trimmedName,
+		CPUCores:             params.CPUCores,
+		RAMMB:                params.RAMMB,
+		DiskGB:               params.DiskGB,
+		IpCount:              params.IpCount,
+		RunningHourlyPrice:   params.RunningHourlyPrice,
+		AllocatedHourlyPrice: params.AllocatedHourlyPrice,
 	}
 
 	return plan, nil
@@ -116,3 +126,12 @@ func (b *Business) Search(ctx context.Context, pg page.Page) ([]Plan, int, error
 
 	return plans, total, nil
 }
+
+func (b *Business) SearchByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[Plan], page.CursorDocument, error) {
+	plans, doc, err := b.storer.FindAllByCursor(ctx, cur)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("searchbycursor: %w", err)
+	}
+
+	return plans, doc, nil
+}
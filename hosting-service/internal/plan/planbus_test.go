@@ -16,6 +16,8 @@ type mockStorer struct {
 	CreateFunc   func(ctx context.Context, p plan.Plan) error
 	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
 	FindAllFunc  func(ctx context.Context, pg page.Page) ([]plan.Plan, int, error)
+
+	FindAllByCursorFunc func(ctx context.Context, cur page.Cursor) ([]page.Edge[plan.Plan], page.CursorDocument, error)
 }
 
 func (m *mockStorer) Create(ctx context.Context, p plan.Plan) error {
@@ -39,6 +41,13 @@ func (m *mockStorer) FindAll(ctx context.Context, pg page.Page) ([]plan.Plan, in
 	return nil, 0, nil
 }
 
+func (m *mockStorer) FindAllByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[plan.Plan], page.CursorDocument, error) {
+	if m.FindAllByCursorFunc != nil {
+		return m.FindAllByCursorFunc(ctx, cur)
+	}
+	return nil, page.CursorDocument{}, nil
+}
+
 func Test_Create(t *testing.T) {
 	validParams := plan.CreatePlanParams{
 		Name:     "Premium",
@@ -7,33 +7,39 @@ import (
 )
 
 type planDB struct {
-	ID       uuid.UUID `db:"id"`
-	Name     string    `db:"name"`
-	CPUCores int       `db:"cpu_cores"`
-	RAMMB    int       `db:"ram_mb"`
-	DiskGB   int       `db:"disk_gb"`
-	IpCount  int       `db:"ip_count"`
+	ID                   uuid.UUID `db:"id"`
+	Name                 string    `db:"name"`
+	CPUCores             int       `db:"cpu_cores"`
+	RAMMB                int       `db:"ram_mb"`
+	DiskGB               int       `db:"disk_gb"`
+	IpCount              int       `db:"ip_count"`
+	RunningHourlyPrice   int64     `db:"running_hourly_price"`
+	AllocatedHourlyPrice int64     `db:"allocated_hourly_price"`
 }
 
 func toDBPlan(p plan.Plan) planDB {
 	return planDB{
-		ID:       p.ID,
-		Name:     p.Name,
-		CPUCores: p.CPUCores,
-		RAMMB:    p.RAMMB,
-		DiskGB:   p.DiskGB,
-		IpCount:  p.IpCount,
+		ID:                   p.ID,
+		Name:                 p.Name,
+		CPUCores:             p.CPUCores,
+		RAMMB:                p.RAMMB,
+		DiskGB:               p.DiskGB,
+		IpCount:              p.IpCount,
+		RunningHourlyPrice:   p.RunningHourlyPrice,
+		AllocatedHourlyPrice: p.AllocatedHourlyPrice,
 	}
 }
 
 func toBusPlan(db planDB) plan.Plan {
 	return plan.Plan{
-		ID:       db.ID,
-		Name:     db.Name,
-		CPUCores: db.CPUCores,
-		RAMMB:    db.RAMMB,
-		DiskGB:   db.DiskGB,
-		IpCount:  db.IpCount,
+		ID:                   db.ID,
+		Name:                 db.Name,
+		CPUCores:             db.CPUCores,
+		RAMMB:                db.RAMMB,
+		DiskGB:               db.DiskGB,
+		IpCount:              db.IpCount,
+		RunningHourlyPrice:   db.RunningHourlyPrice,
+		AllocatedHourlyPrice: db.AllocatedHourlyPrice,
 	}
 }
 
@@ -22,19 +22,21 @@ func NewStore(db *pgxpool.Pool) *Store {
 func (s *Store) Create(ctx context.Context, p plan.Plan) error {
 	const q = `
 	INSERT INTO plans 
-		(id, name, cpu_cores, ram_mb, disk_gb, ip_count)
+		(id, name, cpu_cores, ram_mb, disk_gb, ip_count, running_hourly_price, allocated_hourly_price)
 	VALUES 
-		(@id, @name, @cpu_cores, @ram_mb, @disk_gb, @ip_count)`
+		(@id, @name, @cpu_cores, @ram_mb, @disk_gb, @ip_count, @running_hourly_price, @allocated_hourly_price)`
 
 	dbPlan := toDBPlan(p)
 
 	args := pgx.NamedArgs{
-		"id":        dbPlan.ID,
-		"name":      dbPlan.Name,
-		"cpu_cores": dbPlan.CPUCores,
-		"ram_mb":    dbPlan.RAMMB,
-		"disk_gb":   dbPlan.DiskGB,
-		"ip_count":  dbPlan.IpCount,
+		"id":                     dbPlan.ID,
+		"name":                   dbPlan.Name,
+		"cpu_cores":              dbPlan.CPUCores,
+		"ram_mb":                 dbPlan.RAMMB,
+		"disk_gb":                dbPlan.DiskGB,
+		"ip_count":               dbPlan.IpCount,
+		"running_hourly_price":   dbPlan.RunningHourlyPrice,
+		"allocated_hourly_price": dbPlan.AllocatedHourlyPrice,
 	}
 
 	_, err := s.db.Exec(ctx, q, args)
@@ -48,7 +50,7 @@ func (s *Store) Create(ctx context.Context, p plan.Plan) error {
 func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
 	const q = `
 	SELECT 
-		id, name, cpu_cores, ram_mb, disk_gb, ip_count
+		id, name, cpu_cores, ram_mb, disk_gb, ip_count, running_hourly_price, allocated_hourly_price
 	FROM 
 		plans 
 	WHERE 
@@ -88,7 +90,7 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]plan.Plan, int, er
 
 	const qSelect = `
 	SELECT 
-		id, name, cpu_cores, ram_mb, disk_gb, ip_count
+		id, name, cpu_cores, ram_mb, disk_gb, ip_count, running_hourly_price, allocated_hourly_price
 	FROM 
 		plans
 	ORDER BY 
@@ -112,3 +114,51 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page) ([]plan.Plan, int, er
 
 	return toBusPlans(dbPlans), total, nil
 }
+
+// FindAllByCursor reads plans in id order, starting from the cursor key.
+func (s *Store) FindAllByCursor(ctx context.Context, cur page.Cursor) ([]page.Edge[plan.Plan], page.CursorDocument, error) {
+	args := pgx.NamedArgs{"limit": cur.Limit()}
+
+	var where string
+	order := "id ASC"
+
+	if key, ok := cur.Key(); ok {
+		id, err := uuid.Parse(key.ID)
+		if err != nil || key.Field != "" {
+			return nil, page.CursorDocument{}, page.ErrInvalidCursor
+		}
+		args["cursor_id"] = id
+
+		where = "WHERE id > @cursor_id"
+		if cur.Backward() {
+			where = "WHERE id < @cursor_id"
+			order = "id DESC"
+		}
+	}
+
+	q := `
+	SELECT 
+		id, name, cpu_cores, ram_mb, disk_gb, ip_count, running_hourly_price, allocated_hourly_price
+	FROM 
+		plans
+	` + where + `
+	ORDER BY 
+		` + order + `
+	LIMIT @limit`
+
+	rows, err := s.db.Query(ctx, q, args)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbPlans, err := pgx.CollectRows(rows, pgx.RowToStructByName[planDB])
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	edges, doc := page.Collect(cur, toBusPlans(dbPlans), func(p plan.Plan) page.Key {
+		return page.Key{ID: p.ID.String()}
+	})
+
+	return edges, doc, nil
+}
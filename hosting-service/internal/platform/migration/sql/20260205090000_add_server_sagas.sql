@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS server_sagas (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    state TEXT NOT NULL,
+    server_id UUID,
+    pool_id UUID,
+    cpu_cores INT NOT NULL,
+    ram_mb INT NOT NULL,
+    disk_gb INT NOT NULL,
+    ip_count INT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_server_sagas_open ON server_sagas(state, next_attempt_at)
+    WHERE state IN ('STARTED', 'RESERVED', 'RETURNING');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS server_sagas;
+-- +goose StatementEnd
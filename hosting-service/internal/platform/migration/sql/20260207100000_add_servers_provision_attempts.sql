@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers ADD COLUMN provision_attempts INT NOT NULL DEFAULT 1;
+ALTER TABLE servers ADD COLUMN failure_reason TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers DROP COLUMN failure_reason;
+ALTER TABLE servers DROP COLUMN provision_attempts;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_servers_owner_created_at ON servers(owner_id, created_at DESC, id DESC);
+CREATE INDEX idx_servers_owner_status ON servers(owner_id, status);
+CREATE INDEX idx_servers_owner_plan_id ON servers(owner_id, plan_id);
+CREATE INDEX idx_servers_owner_ipv4_address ON servers(owner_id, ipv4_address);
+CREATE INDEX idx_servers_name_trgm ON servers USING GIN (name gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_servers_name_trgm;
+DROP INDEX IF EXISTS idx_servers_owner_ipv4_address;
+DROP INDEX IF EXISTS idx_servers_owner_plan_id;
+DROP INDEX IF EXISTS idx_servers_owner_status;
+DROP INDEX IF EXISTS idx_servers_owner_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE quota_defaults (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    max_servers INT NOT NULL,
+    max_cpu_cores INT NOT NULL,
+    max_ram_mb INT NOT NULL,
+    max_disk_gb INT NOT NULL,
+    max_ip_count INT NOT NULL
+);
+
+INSERT INTO quota_defaults (max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count)
+VALUES (5, 16, 32768, 500, 5);
+
+CREATE TABLE user_quotas (
+    user_id UUID PRIMARY KEY,
+    max_servers INT NOT NULL,
+    max_cpu_cores INT NOT NULL,
+    max_ram_mb INT NOT NULL,
+    max_disk_gb INT NOT NULL,
+    max_ip_count INT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_quotas;
+DROP TABLE quota_defaults;
+-- +goose StatementEnd
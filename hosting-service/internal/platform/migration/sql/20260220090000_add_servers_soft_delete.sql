@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers ADD COLUMN purge_at TIMESTAMPTZ;
+ALTER TABLE servers ADD COLUMN restore_status TEXT;
+
+CREATE INDEX idx_servers_purge_at ON servers(purge_at) WHERE status = 'DELETED_PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_servers_purge_at;
+
+ALTER TABLE servers DROP COLUMN restore_status;
+ALTER TABLE servers DROP COLUMN purge_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE snapshots (
+    id UUID PRIMARY KEY,
+    server_id UUID NOT NULL,
+    owner_id UUID NOT NULL,
+    pool_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    size_gb INT NOT NULL,
+    status TEXT NOT NULL,
+    failure_reason TEXT,
+    reserved BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_snapshots_owner_created_at ON snapshots(owner_id, created_at DESC, id DESC);
+CREATE INDEX idx_snapshots_server_id ON snapshots(server_id);
+
+ALTER TABLE servers ADD COLUMN snapshot_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers DROP COLUMN snapshot_id;
+
+DROP TABLE IF EXISTS snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ssh_keys (
+    id UUID PRIMARY KEY,
+    owner_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (owner_id, fingerprint)
+);
+
+CREATE INDEX idx_ssh_keys_owner_created_at ON ssh_keys(owner_id, created_at DESC, id DESC);
+
+ALTER TABLE servers ADD COLUMN ssh_keys TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers DROP COLUMN ssh_keys;
+
+DROP TABLE IF EXISTS ssh_keys;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plans
+    ADD COLUMN running_hourly_price BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN allocated_hourly_price BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE usage_intervals (
+    id UUID PRIMARY KEY,
+    server_id UUID NOT NULL,
+    owner_id UUID NOT NULL,
+    plan_id UUID NOT NULL,
+    meter TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_usage_intervals_open ON usage_intervals(server_id, meter) WHERE ended_at IS NULL;
+CREATE INDEX idx_usage_intervals_owner_started_at ON usage_intervals(owner_id, started_at);
+
+-- Servers that already run or hold resources start their meters now.
+INSERT INTO usage_intervals (id, server_id, owner_id, plan_id, meter, started_at)
+SELECT gen_random_uuid(), id, owner_id, plan_id, 'ALLOCATED', now()
+FROM servers
+WHERE status NOT IN ('PENDING', 'PROVISION_FAILED', 'DELETING', 'DELETED_PENDING');
+
+INSERT INTO usage_intervals (id, server_id, owner_id, plan_id, meter, started_at)
+SELECT gen_random_uuid(), id, owner_id, plan_id, 'RUNNING', now()
+FROM servers
+WHERE status = 'RUNNING';
+
+CREATE TABLE invoices (
+    id UUID PRIMARY KEY,
+    owner_id UUID NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    items JSONB NOT NULL,
+    total BIGINT NOT NULL,
+    currency TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (owner_id, period_start)
+);
+
+CREATE INDEX idx_invoices_owner_period_start ON invoices(owner_id, period_start DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS invoices;
+
+DROP TABLE IF EXISTS usage_intervals;
+
+ALTER TABLE plans
+    DROP COLUMN allocated_hourly_price,
+    DROP COLUMN running_hourly_price;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers
+    ADD COLUMN provision_requested_at TIMESTAMPTZ,
+    ADD COLUMN provision_resends INT NOT NULL DEFAULT 0;
+
+UPDATE servers SET provision_requested_at = created_at WHERE status = 'PENDING';
+
+CREATE INDEX idx_servers_pending_requested_at ON servers(provision_requested_at) WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_servers_pending_requested_at;
+
+ALTER TABLE servers
+    DROP COLUMN provision_resends,
+    DROP COLUMN provision_requested_at;
+-- +goose StatementEnd
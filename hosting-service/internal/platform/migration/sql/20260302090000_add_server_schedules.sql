@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE server_schedules (
+    id UUID PRIMARY KEY,
+    server_id UUID NOT NULL REFERENCES servers(id) ON DELETE CASCADE,
+    owner_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    run_at TIMESTAMPTZ,
+    cron TEXT,
+    timezone TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    next_run_at TIMESTAMPTZ,
+    last_run_at TIMESTAMPTZ,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_server_schedules_due ON server_schedules(next_run_at)
+    WHERE enabled AND next_run_at IS NOT NULL;
+CREATE INDEX idx_server_schedules_owner_created_at ON server_schedules(owner_id, created_at DESC, id DESC);
+CREATE INDEX idx_server_schedules_server_id ON server_schedules(server_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS server_schedules;
+-- +goose StatementEnd
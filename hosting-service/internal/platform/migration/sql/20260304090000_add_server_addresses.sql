@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers ADD COLUMN addresses JSONB NOT NULL DEFAULT '[]';
+
+UPDATE servers
+SET addresses = jsonb_build_array(jsonb_build_object('address', ipv4_address, 'family', 'IPV4', 'primary', true))
+WHERE ipv4_address IS NOT NULL;
+
+CREATE INDEX idx_servers_addresses ON servers USING GIN (addresses jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_servers_addresses;
+
+ALTER TABLE servers DROP COLUMN addresses;
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE projects (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    personal BOOLEAN NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_projects_personal ON projects(created_by) WHERE personal;
+
+CREATE TABLE project_members (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX idx_project_members_user_id ON project_members(user_id);
+
+CREATE TABLE project_invitations (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    invitee_id UUID NOT NULL,
+    invited_by UUID NOT NULL,
+    role TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_project_invitations_pending ON project_invitations(project_id, invitee_id)
+    WHERE status = 'PENDING';
+CREATE INDEX idx_project_invitations_invitee_id ON project_invitations(invitee_id, created_at DESC);
+
+-- Every existing owner gets a personal project holding their servers.
+INSERT INTO projects (id, name, personal, created_by, created_at)
+SELECT gen_random_uuid(), 'Personal', TRUE, owner_id, now()
+FROM (SELECT DISTINCT owner_id FROM servers) owners;
+
+INSERT INTO project_members (project_id, user_id, role, created_at)
+SELECT id, created_by, 'OWNER', created_at
+FROM projects;
+
+ALTER TABLE servers ADD COLUMN project_id UUID;
+
+UPDATE servers s
+SET project_id = p.id
+FROM projects p
+WHERE p.personal AND p.created_by = s.owner_id;
+
+ALTER TABLE servers ALTER COLUMN project_id SET NOT NULL;
+ALTER TABLE servers ADD CONSTRAINT fk_servers_project_id FOREIGN KEY (project_id) REFERENCES projects(id);
+
+CREATE INDEX idx_servers_project_id ON servers(project_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_servers_project_id;
+
+ALTER TABLE servers DROP COLUMN project_id;
+
+DROP TABLE IF EXISTS project_invitations;
+DROP TABLE IF EXISTS project_members;
+DROP TABLE IF EXISTS projects;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pools had no region before, the resources service moves them to 'default'.
+ALTER TABLE servers ADD COLUMN region TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE servers ALTER COLUMN region DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers DROP COLUMN region;
+-- +goose StatementEnd
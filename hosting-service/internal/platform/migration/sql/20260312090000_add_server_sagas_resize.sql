@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Resize sagas move a server back from resources to resize_* resources.
+ALTER TABLE server_sagas
+    ADD COLUMN resize_cpu_cores INT,
+    ADD COLUMN resize_ram_mb INT,
+    ADD COLUMN resize_disk_gb INT,
+    ADD COLUMN resize_ip_count INT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE server_sagas
+    DROP COLUMN resize_cpu_cores,
+    DROP COLUMN resize_ram_mb,
+    DROP COLUMN resize_disk_gb,
+    DROP COLUMN resize_ip_count;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The history of a deleted server is authorized against the project of its
+-- events. Events of servers deleted before this migration keep no project
+-- and are only visible to administrators.
+ALTER TABLE server_events ADD COLUMN project_id UUID;
+
+UPDATE server_events e SET project_id = s.project_id FROM servers s WHERE s.id = e.server_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE server_events DROP COLUMN project_id;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per user, locked while an order checks the quota of the user.
+CREATE TABLE quota_locks (
+    user_id UUID PRIMARY KEY
+);
+
+-- Orders count against the quota of their user until their server exists.
+ALTER TABLE server_sagas ADD COLUMN user_id UUID;
+
+CREATE INDEX idx_server_sagas_user_open ON server_sagas(user_id)
+    WHERE kind = 'ORDER' AND state IN ('STARTED', 'RESERVED');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_server_sagas_user_open;
+ALTER TABLE server_sagas DROP COLUMN user_id;
+DROP TABLE quota_locks;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Intervals keep the price they were opened at, so plan price changes do not
+-- reprice usage that already happened.
+ALTER TABLE usage_intervals ADD COLUMN hourly_price BIGINT NOT NULL DEFAULT 0;
+
+UPDATE usage_intervals u
+SET hourly_price = CASE u.meter WHEN 'RUNNING' THEN p.running_hourly_price ELSE p.allocated_hourly_price END
+FROM plans p
+WHERE p.id = u.plan_id;
+
+ALTER TABLE usage_intervals ALTER COLUMN hourly_price DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE usage_intervals DROP COLUMN hourly_price;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Server listings filter by project since servers belong to projects, so the
+-- search indexes lead with project_id like the owner_id ones did.
+CREATE INDEX idx_servers_project_created_at ON servers(project_id, created_at DESC, id DESC);
+CREATE INDEX idx_servers_project_status ON servers(project_id, status);
+CREATE INDEX idx_servers_project_plan_id ON servers(project_id, plan_id);
+CREATE INDEX idx_servers_project_ipv4_address ON servers(project_id, ipv4_address);
+
+-- Covered by idx_servers_project_created_at.
+DROP INDEX IF EXISTS idx_servers_project_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX idx_servers_project_id ON servers(project_id);
+
+DROP INDEX IF EXISTS idx_servers_project_ipv4_address;
+DROP INDEX IF EXISTS idx_servers_project_plan_id;
+DROP INDEX IF EXISTS idx_servers_project_status;
+DROP INDEX IF EXISTS idx_servers_project_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Messages of one aggregate, e.g. the commands for a server, are relayed in
+-- order; the index finds the oldest unsent message of an aggregate.
+ALTER TABLE outbox ADD COLUMN aggregate_id UUID;
+
+CREATE INDEX idx_outbox_aggregate_pending ON outbox(aggregate_id, created_at, id)
+    WHERE sent_at IS NULL AND aggregate_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_aggregate_pending;
+
+ALTER TABLE outbox DROP COLUMN IF EXISTS aggregate_id;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Soft-deleted servers keep their pool reservation until they are purged or
+-- restored, so they stay on the allocated meter. Those already soft deleted
+-- start it again now.
+INSERT INTO usage_intervals (id, server_id, owner_id, plan_id, meter, hourly_price, started_at)
+SELECT gen_random_uuid(), s.id, s.owner_id, s.plan_id, 'ALLOCATED', p.allocated_hourly_price, now()
+FROM servers s
+JOIN plans p ON p.id = s.plan_id
+WHERE s.status = 'DELETED_PENDING'
+ON CONFLICT (server_id, meter) WHERE ended_at IS NULL DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE usage_intervals u
+SET ended_at = GREATEST(u.started_at, now())
+FROM servers s
+WHERE s.id = u.server_id AND s.status = 'DELETED_PENDING' AND u.meter = 'ALLOCATED' AND u.ended_at IS NULL;
+-- +goose StatementEnd
@@ -0,0 +1,141 @@
+package projectotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus project.ExtBusiness
+}
+
+func NewExtension() project.Extension {
+	return func(bus project.ExtBusiness) project.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Create(ctx context.Context, name string, userID uuid.UUID) (project.Project, error) {
+	ctx, span := otel.AddSpan(ctx, "project.create")
+	defer span.End()
+
+	return e.bus.Create(ctx, name, userID)
+}
+
+func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (project.Project, error) {
+	ctx, span := otel.AddSpan(ctx, "project.findbyid")
+	defer span.End()
+
+	return e.bus.FindByID(ctx, ID, userID)
+}
+
+func (e *Extension) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]project.Project, int, error) {
+	ctx, span := otel.AddSpan(ctx, "project.search")
+	defer span.End()
+
+	return e.bus.Search(ctx, pg, userID)
+}
+
+func (e *Extension) Rename(ctx context.Context, ID uuid.UUID, name string, userID uuid.UUID) (project.Project, error) {
+	ctx, span := otel.AddSpan(ctx, "project.rename")
+	defer span.End()
+
+	return e.bus.Rename(ctx, ID, name, userID)
+}
+
+func (e *Extension) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "project.delete")
+	defer span.End()
+
+	return e.bus.Delete(ctx, ID, userID)
+}
+
+func (e *Extension) Members(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]project.Member, int, error) {
+	ctx, span := otel.AddSpan(ctx, "project.members")
+	defer span.End()
+
+	return e.bus.Members(ctx, projectID, pg, userID)
+}
+
+func (e *Extension) SetRole(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, role project.Role, userID uuid.UUID) (project.Member, error) {
+	ctx, span := otel.AddSpan(ctx, "project.setrole")
+	defer span.End()
+
+	return e.bus.SetRole(ctx, projectID, memberID, role, userID)
+}
+
+func (e *Extension) RemoveMember(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "project.removemember")
+	defer span.End()
+
+	return e.bus.RemoveMember(ctx, projectID, memberID, userID)
+}
+
+func (e *Extension) Invite(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID, role project.Role, userID uuid.UUID) (project.Invitation, error) {
+	ctx, span := otel.AddSpan(ctx, "project.invite")
+	defer span.End()
+
+	return e.bus.Invite(ctx, projectID, inviteeID, role, userID)
+}
+
+func (e *Extension) Invitations(ctx context.Context, pg page.Page, userID uuid.UUID) ([]project.Invitation, int, error) {
+	ctx, span := otel.AddSpan(ctx, "project.invitations")
+	defer span.End()
+
+	return e.bus.Invitations(ctx, pg, userID)
+}
+
+func (e *Extension) ProjectInvitations(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]project.Invitation, int, error) {
+	ctx, span := otel.AddSpan(ctx, "project.projectinvitations")
+	defer span.End()
+
+	return e.bus.ProjectInvitations(ctx, projectID, pg, userID)
+}
+
+func (e *Extension) AcceptInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) (project.Member, error) {
+	ctx, span := otel.AddSpan(ctx, "project.acceptinvitation")
+	defer span.End()
+
+	return e.bus.AcceptInvitation(ctx, invitationID, userID)
+}
+
+func (e *Extension) DeclineInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "project.declineinvitation")
+	defer span.End()
+
+	return e.bus.DeclineInvitation(ctx, invitationID, userID)
+}
+
+func (e *Extension) RevokeInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "project.revokeinvitation")
+	defer span.End()
+
+	return e.bus.RevokeInvitation(ctx, invitationID, userID)
+}
+
+func (e *Extension) Role(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Role, error) {
+	ctx, span := otel.AddSpan(ctx, "project.role")
+	defer span.End()
+
+	return e.bus.Role(ctx, projectID, userID)
+}
+
+func (e *Extension) ProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ctx, span := otel.AddSpan(ctx, "project.projectids")
+	defer span.End()
+
+	return e.bus.ProjectIDs(ctx, userID)
+}
+
+func (e *Extension) Personal(ctx context.Context, userID uuid.UUID) (project.Project, error) {
+	ctx, span := otel.AddSpan(ctx, "project.personal")
+	defer span.End()
+
+	return e.bus.Personal(ctx, userID)
+}
@@ -0,0 +1,125 @@
+package project
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNameLength limits the name users give a project.
+const MaxNameLength = 100
+
+// Role is what a member may do in a project. Every role includes the
+// permissions of the roles below it: OWNER, ADMIN, OPERATOR, VIEWER.
+type Role string
+
+const (
+	RoleOwner    Role = "OWNER"
+	RoleAdmin    Role = "ADMIN"
+	RoleOperator Role = "OPERATOR"
+	RoleViewer   Role = "VIEWER"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+	RoleOwner:    4,
+}
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Permission is an operation that needs a minimum role in the project.
+type Permission string
+
+const (
+	// PermView lets a member see the project, its members and its servers
+	// with their history.
+	PermView Permission = "VIEW"
+
+	// PermOperate lets a member start, stop and reboot servers.
+	PermOperate Permission = "OPERATE"
+
+	// PermManage lets a member order, resize, delete and restore servers
+	// and work with their snapshots.
+	PermManage Permission = "MANAGE"
+
+	// PermMembers lets a member invite users and change or remove members
+	// other than the owner.
+	PermMembers Permission = "MEMBERS"
+
+	// PermOwn lets a member rename and delete the project and hand it over
+	// to another member.
+	PermOwn Permission = "OWN"
+)
+
+var permissionRoles = map[Permission]Role{
+	PermView:    RoleViewer,
+	PermOperate: RoleOperator,
+	PermManage:  RoleAdmin,
+	PermMembers: RoleAdmin,
+	PermOwn:     RoleOwner,
+}
+
+// Can reports whether the role grants the permission.
+func (r Role) Can(p Permission) bool {
+	min, ok := permissionRoles[p]
+	if !ok {
+		return false
+	}
+	return roleRanks[r] >= roleRanks[min]
+}
+
+// Project groups servers that its members share.
+type Project struct {
+	ID   uuid.UUID
+	Name string
+
+	// Personal projects are created for every user on first use and hold
+	// the servers ordered without a project. They cannot be deleted.
+	Personal bool
+
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+}
+
+// Member is a user's role in a project. Every project has exactly one OWNER.
+type Member struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	Role      Role
+	CreatedAt time.Time
+}
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "PENDING"
+	InvitationAccepted InvitationStatus = "ACCEPTED"
+	InvitationDeclined InvitationStatus = "DECLINED"
+	InvitationRevoked  InvitationStatus = "REVOKED"
+	InvitationExpired  InvitationStatus = "EXPIRED"
+)
+
+// Invitation offers a user to join a project with a role. It can be accepted
+// until ExpiresAt while it is PENDING.
+type Invitation struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	InviteeID uuid.UUID
+	InvitedBy uuid.UUID
+	Role      Role
+	Status    InvitationStatus
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// InvitationFilter narrows invitation listings to a project or an invitee.
+type InvitationFilter struct {
+	ProjectID *uuid.UUID
+	InviteeID *uuid.UUID
+}
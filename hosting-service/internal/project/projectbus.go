@@ -0,0 +1,619 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrProjectNotFound    = errors.New("project not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrNotMember          = errors.New("not a member of the project")
+	ErrValidation         = errors.New("validation error")
+	ErrAccessDenied       = errors.New("access denied")
+	ErrDuplicate          = errors.New("user is already a member or invited")
+	ErrProjectNotEmpty    = errors.New("project still has servers")
+)
+
+// PersonalName is the name of the personal project of every user.
+const PersonalName = "Personal"
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	// Create returns ErrDuplicate for a second personal project of a user.
+	Create(ctx context.Context, p Project) error
+	FindByID(ctx context.Context, ID uuid.UUID) (Project, error)
+	FindPersonal(ctx context.Context, userID uuid.UUID) (Project, error)
+	// FindAll lists the projects the user is a member of.
+	FindAll(ctx context.Context, userID uuid.UUID, pg page.Page) ([]Project, int, error)
+	Update(ctx context.Context, p Project) error
+	// Delete returns ErrProjectNotEmpty while servers belong to the project.
+	Delete(ctx context.Context, ID uuid.UUID) error
+
+	// AddMember returns ErrDuplicate when the user is already a member.
+	AddMember(ctx context.Context, m Member) error
+	FindMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (Member, error)
+	FindMembers(ctx context.Context, projectID uuid.UUID, pg page.Page) ([]Member, int, error)
+	UpdateMember(ctx context.Context, m Member) error
+	RemoveMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error
+	FindProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+
+	// CreateInvitation returns ErrDuplicate while the user has a PENDING
+	// invitation to the project.
+	CreateInvitation(ctx context.Context, inv Invitation) error
+	FindInvitation(ctx context.Context, ID uuid.UUID) (Invitation, error)
+	FindPendingInvitation(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID) (Invitation, error)
+	UpdateInvitation(ctx context.Context, inv Invitation) error
+	// FindOpenInvitations lists the PENDING invitations that have not
+	// expired at now, the newest first.
+	FindOpenInvitations(ctx context.Context, filter InvitationFilter, now time.Time, pg page.Page) ([]Invitation, int, error)
+}
+
+// Notifier tells users about the invitations they get.
+type Notifier interface {
+	InvitationCreated(ctx context.Context, inv Invitation, p Project) error
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ExtBusiness interface {
+	Create(ctx context.Context, name string, userID uuid.UUID) (Project, error)
+	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Project, error)
+	Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Project, int, error)
+	Rename(ctx context.Context, ID uuid.UUID, name string, userID uuid.UUID) (Project, error)
+	Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error
+	Members(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Member, int, error)
+	SetRole(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, role Role, userID uuid.UUID) (Member, error)
+	RemoveMember(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, userID uuid.UUID) error
+	Invite(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID, role Role, userID uuid.UUID) (Invitation, error)
+	Invitations(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Invitation, int, error)
+	ProjectInvitations(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Invitation, int, error)
+	AcceptInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) (Member, error)
+	DeclineInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error
+	RevokeInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error
+	Role(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (Role, error)
+	ProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	Personal(ctx context.Context, userID uuid.UUID) (Project, error)
+}
+
+type Config struct {
+	// InvitationTTL is how long an invitation can be accepted.
+	InvitationTTL time.Duration
+}
+
+type Business struct {
+	cfg        Config
+	storer     Storer
+	notifier   Notifier
+	tx         Transactor
+	extensions []Extension
+}
+
+func NewBusiness(cfg Config, storer Storer, notifier Notifier, tx Transactor, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		cfg:        cfg,
+		storer:     storer,
+		notifier:   notifier,
+		tx:         tx,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Create adds a project with the user as its owner.
+func (b *Business) Create(ctx context.Context, name string, userID uuid.UUID) (Project, error) {
+	name, err := validateName(name)
+	if err != nil {
+		return Project{}, err
+	}
+
+	p := Project{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := b.createWithOwner(ctx, p); err != nil {
+		return Project{}, err
+	}
+
+	return p, nil
+}
+
+func (b *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Project, error) {
+	p, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return Project{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	if _, err := b.authorize(ctx, p.ID, userID, PermView); err != nil {
+		return Project{}, err
+	}
+
+	return p, nil
+}
+
+// Search lists the projects the user is a member of, the personal project
+// first. The personal project is created if the user has none yet.
+func (b *Business) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Project, int, error) {
+	if _, err := b.Personal(ctx, userID); err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	projects, count, err := b.storer.FindAll(ctx, userID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	return projects, count, nil
+}
+
+func (b *Business) Rename(ctx context.Context, ID uuid.UUID, name string, userID uuid.UUID) (Project, error) {
+	name, err := validateName(name)
+	if err != nil {
+		return Project{}, err
+	}
+
+	p, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return Project{}, fmt.Errorf("rename: %w", err)
+	}
+
+	if _, err := b.authorize(ctx, p.ID, userID, PermOwn); err != nil {
+		return Project{}, err
+	}
+
+	p.Name = name
+
+	if err := b.storer.Update(ctx, p); err != nil {
+		return Project{}, fmt.Errorf("rename: %w", err)
+	}
+
+	return p, nil
+}
+
+// Delete removes a project without servers together with its members and
+// invitations. Personal projects cannot be deleted.
+func (b *Business) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	p, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	if _, err := b.authorize(ctx, p.ID, userID, PermOwn); err != nil {
+		return err
+	}
+
+	if p.Personal {
+		return fmt.Errorf("%w: a personal project cannot be deleted", ErrValidation)
+	}
+
+	if err := b.storer.Delete(ctx, p.ID); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Business) Members(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Member, int, error) {
+	if _, err := b.authorize(ctx, projectID, userID, PermView); err != nil {
+		return nil, 0, err
+	}
+
+	members, count, err := b.storer.FindMembers(ctx, projectID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("members: %w", err)
+	}
+
+	return members, count, nil
+}
+
+// SetRole changes the role of a member. Giving a member the OWNER role hands
+// the project over: only the owner can do it, and the owner becomes an
+// ADMIN. The role of the owner cannot be changed otherwise.
+func (b *Business) SetRole(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, role Role, userID uuid.UUID) (Member, error) {
+	if !role.Valid() {
+		return Member{}, fmt.Errorf("%w: unknown role '%s'", ErrValidation, role)
+	}
+
+	caller, err := b.authorize(ctx, projectID, userID, PermMembers)
+	if err != nil {
+		return Member{}, err
+	}
+
+	target, err := b.storer.FindMember(ctx, projectID, memberID)
+	if err != nil {
+		return Member{}, fmt.Errorf("setrole: %w", err)
+	}
+
+	if target.Role == role {
+		return target, nil
+	}
+
+	if target.Role == RoleOwner {
+		return Member{}, fmt.Errorf("%w: the owner keeps the role until the project is handed over", ErrValidation)
+	}
+
+	if role != RoleOwner {
+		target.Role = role
+		if err := b.storer.UpdateMember(ctx, target); err != nil {
+			return Member{}, fmt.Errorf("setrole: %w", err)
+		}
+		return target, nil
+	}
+
+	if !caller.Role.Can(PermOwn) {
+		return Member{}, ErrAccessDenied
+	}
+
+	p, err := b.storer.FindByID(ctx, projectID)
+	if err != nil {
+		return Member{}, fmt.Errorf("setrole: %w", err)
+	}
+	if p.Personal {
+		return Member{}, fmt.Errorf("%w: a personal project cannot be handed over", ErrValidation)
+	}
+
+	target.Role = RoleOwner
+	caller.Role = RoleAdmin
+
+	err = b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := b.storer.UpdateMember(ctx, caller); err != nil {
+			return fmt.Errorf("setrole: %w", err)
+		}
+		if err := b.storer.UpdateMember(ctx, target); err != nil {
+			return fmt.Errorf("setrole: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return Member{}, err
+	}
+
+	return target, nil
+}
+
+// RemoveMember removes a member from the project. Every member but the owner
+// can leave on their own; removing others needs PermMembers. The servers the
+// member ordered stay in the project.
+func (b *Business) RemoveMember(ctx context.Context, projectID uuid.UUID, memberID uuid.UUID, userID uuid.UUID) error {
+	perm := PermMembers
+	if memberID == userID {
+		perm = PermView
+	}
+
+	if _, err := b.authorize(ctx, projectID, userID, perm); err != nil {
+		return err
+	}
+
+	target, err := b.storer.FindMember(ctx, projectID, memberID)
+	if err != nil {
+		return fmt.Errorf("removemember: %w", err)
+	}
+
+	if target.Role == RoleOwner {
+		return fmt.Errorf("%w: the owner cannot leave the project before handing it over", ErrValidation)
+	}
+
+	if err := b.storer.RemoveMember(ctx, projectID, memberID); err != nil {
+		return fmt.Errorf("removemember: %w", err)
+	}
+
+	return nil
+}
+
+// Invite offers the user a role in the project and notifies them. The OWNER
+// role is only given by handing the project over to a member.
+func (b *Business) Invite(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID, role Role, userID uuid.UUID) (Invitation, error) {
+	if !role.Valid() {
+		return Invitation{}, fmt.Errorf("%w: unknown role '%s'", ErrValidation, role)
+	}
+	if role == RoleOwner {
+		return Invitation{}, fmt.Errorf("%w: users cannot be invited as %s", ErrValidation, RoleOwner)
+	}
+	if inviteeID == uuid.Nil {
+		return Invitation{}, fmt.Errorf("%w: invitee cannot be empty", ErrValidation)
+	}
+
+	p, err := b.storer.FindByID(ctx, projectID)
+	if err != nil {
+		return Invitation{}, fmt.Errorf("invite: %w", err)
+	}
+
+	if _, err := b.authorize(ctx, p.ID, userID, PermMembers); err != nil {
+		return Invitation{}, err
+	}
+
+	_, err = b.storer.FindMember(ctx, p.ID, inviteeID)
+	switch {
+	case err == nil:
+		return Invitation{}, ErrDuplicate
+	case !errors.Is(err, ErrNotMember):
+		return Invitation{}, fmt.Errorf("invite: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	inv := Invitation{
+		ID:        uuid.New(),
+		ProjectID: p.ID,
+		InviteeID: inviteeID,
+		InvitedBy: userID,
+		Role:      role,
+		Status:    InvitationPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(b.cfg.InvitationTTL),
+	}
+
+	err = b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		// An expired invitation no longer blocks a new one.
+		pending, err := b.storer.FindPendingInvitation(ctx, p.ID, inviteeID)
+		switch {
+		case err == nil && pending.ExpiresAt.After(now):
+			return ErrDuplicate
+		case err == nil:
+			pending.Status = InvitationExpired
+			if err := b.storer.UpdateInvitation(ctx, pending); err != nil {
+				return fmt.Errorf("invite: %w", err)
+			}
+		case !errors.Is(err, ErrInvitationNotFound):
+			return fmt.Errorf("invite: %w", err)
+		}
+
+		if err := b.storer.CreateInvitation(ctx, inv); err != nil {
+			return fmt.Errorf("invite: %w", err)
+		}
+
+		if err := b.notifier.InvitationCreated(ctx, inv, p); err != nil {
+			return fmt.Errorf("invite: notifier.invitationcreated: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	return inv, nil
+}
+
+// Invitations lists the open invitations the user got.
+func (b *Business) Invitations(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Invitation, int, error) {
+	invs, count, err := b.storer.FindOpenInvitations(ctx, InvitationFilter{InviteeID: &userID}, time.Now().UTC(), pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invitations: %w", err)
+	}
+
+	return invs, count, nil
+}
+
+// ProjectInvitations lists the open invitations to the project.
+func (b *Business) ProjectInvitations(ctx context.Context, projectID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Invitation, int, error) {
+	if _, err := b.authorize(ctx, projectID, userID, PermMembers); err != nil {
+		return nil, 0, err
+	}
+
+	invs, count, err := b.storer.FindOpenInvitations(ctx, InvitationFilter{ProjectID: &projectID}, time.Now().UTC(), pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("projectinvitations: %w", err)
+	}
+
+	return invs, count, nil
+}
+
+// AcceptInvitation makes the invited user a member with the offered role.
+func (b *Business) AcceptInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) (Member, error) {
+	inv, err := b.openInvitation(ctx, invitationID, userID)
+	if err != nil {
+		return Member{}, err
+	}
+
+	member := Member{
+		ProjectID: inv.ProjectID,
+		UserID:    userID,
+		Role:      inv.Role,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	inv.Status = InvitationAccepted
+
+	err = b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := b.storer.UpdateInvitation(ctx, inv); err != nil {
+			return fmt.Errorf("acceptinvitation: %w", err)
+		}
+
+		if err := b.storer.AddMember(ctx, member); err != nil {
+			return fmt.Errorf("acceptinvitation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Member{}, err
+	}
+
+	return member, nil
+}
+
+func (b *Business) DeclineInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error {
+	inv, err := b.openInvitation(ctx, invitationID, userID)
+	if err != nil {
+		return err
+	}
+
+	inv.Status = InvitationDeclined
+
+	if err := b.storer.UpdateInvitation(ctx, inv); err != nil {
+		return fmt.Errorf("declineinvitation: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeInvitation withdraws a PENDING invitation before it is answered.
+func (b *Business) RevokeInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) error {
+	inv, err := b.storer.FindInvitation(ctx, invitationID)
+	if err != nil {
+		return fmt.Errorf("revokeinvitation: %w", err)
+	}
+
+	if _, err := b.authorize(ctx, inv.ProjectID, userID, PermMembers); err != nil {
+		return err
+	}
+
+	if inv.Status != InvitationPending {
+		return fmt.Errorf("%w: cannot revoke invitation with status '%s', expected PENDING", ErrValidation, inv.Status)
+	}
+
+	inv.Status = InvitationRevoked
+
+	if err := b.storer.UpdateInvitation(ctx, inv); err != nil {
+		return fmt.Errorf("revokeinvitation: %w", err)
+	}
+
+	return nil
+}
+
+// Role returns the role of the user in the project, or ErrNotMember.
+func (b *Business) Role(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (Role, error) {
+	m, err := b.storer.FindMember(ctx, projectID, userID)
+	if err != nil {
+		return "", fmt.Errorf("role: %w", err)
+	}
+
+	return m.Role, nil
+}
+
+// ProjectIDs returns the projects the user is a member of.
+func (b *Business) ProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	ids, err := b.storer.FindProjectIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("projectids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Personal returns the personal project of the user and creates it the
+// first time it is asked for.
+func (b *Business) Personal(ctx context.Context, userID uuid.UUID) (Project, error) {
+	p, err := b.storer.FindPersonal(ctx, userID)
+	if err == nil {
+		return p, nil
+	}
+	if !errors.Is(err, ErrProjectNotFound) {
+		return Project{}, fmt.Errorf("personal: %w", err)
+	}
+
+	p = Project{
+		ID:        uuid.New(),
+		Name:      PersonalName,
+		Personal:  true,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err = b.createWithOwner(ctx, p)
+	if errors.Is(err, ErrDuplicate) {
+		// A concurrent request created it first.
+		p, err = b.storer.FindPersonal(ctx, userID)
+	}
+	if err != nil {
+		return Project{}, fmt.Errorf("personal: %w", err)
+	}
+
+	return p, nil
+}
+
+func (b *Business) createWithOwner(ctx context.Context, p Project) error {
+	owner := Member{
+		ProjectID: p.ID,
+		UserID:    p.CreatedBy,
+		Role:      RoleOwner,
+		CreatedAt: p.CreatedAt,
+	}
+
+	return b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := b.storer.Create(ctx, p); err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+
+		if err := b.storer.AddMember(ctx, owner); err != nil {
+			return fmt.Errorf("addmember: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// authorize returns the member behind userID if their role grants perm.
+// Users outside the project are denied like members with a lower role.
+func (b *Business) authorize(ctx context.Context, projectID uuid.UUID, userID uuid.UUID, perm Permission) (Member, error) {
+	m, err := b.storer.FindMember(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, ErrNotMember) {
+			return Member{}, ErrAccessDenied
+		}
+		return Member{}, fmt.Errorf("findmember: %w", err)
+	}
+
+	if !m.Role.Can(perm) {
+		return Member{}, ErrAccessDenied
+	}
+
+	return m, nil
+}
+
+// openInvitation returns an invitation of the user that can still be
+// answered.
+func (b *Business) openInvitation(ctx context.Context, invitationID uuid.UUID, userID uuid.UUID) (Invitation, error) {
+	inv, err := b.storer.FindInvitation(ctx, invitationID)
+	if err != nil {
+		return Invitation{}, fmt.Errorf("findinvitation: %w", err)
+	}
+
+	if inv.InviteeID != userID {
+		return Invitation{}, ErrAccessDenied
+	}
+
+	if inv.Status != InvitationPending {
+		return Invitation{}, fmt.Errorf("%w: the invitation is %s", ErrValidation, strings.ToLower(string(inv.Status)))
+	}
+
+	if !inv.ExpiresAt.After(time.Now().UTC()) {
+		return Invitation{}, fmt.Errorf("%w: the invitation expired", ErrValidation)
+	}
+
+	return inv, nil
+}
+
+func validateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: project name cannot be empty", ErrValidation)
+	}
+	if len(name) > MaxNameLength {
+		return "", fmt.Errorf("%w: project name is longer than %d characters", ErrValidation, MaxNameLength)
+	}
+	return name, nil
+}
@@ -0,0 +1,742 @@
+package project_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	CreateFunc                func(ctx context.Context, p project.Project) error
+	FindByIDFunc              func(ctx context.Context, ID uuid.UUID) (project.Project, error)
+	FindPersonalFunc          func(ctx context.Context, userID uuid.UUID) (project.Project, error)
+	FindAllFunc               func(ctx context.Context, userID uuid.UUID, pg page.Page) ([]project.Project, int, error)
+	UpdateFunc                func(ctx context.Context, p project.Project) error
+	DeleteFunc                func(ctx context.Context, ID uuid.UUID) error
+	AddMemberFunc             func(ctx context.Context, m project.Member) error
+	FindMemberFunc            func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Member, error)
+	FindMembersFunc           func(ctx context.Context, projectID uuid.UUID, pg page.Page) ([]project.Member, int, error)
+	UpdateMemberFunc          func(ctx context.Context, m project.Member) error
+	RemoveMemberFunc          func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error
+	FindProjectIDsFunc        func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	CreateInvitationFunc      func(ctx context.Context, inv project.Invitation) error
+	FindInvitationFunc        func(ctx context.Context, ID uuid.UUID) (project.Invitation, error)
+	FindPendingInvitationFunc func(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID) (project.Invitation, error)
+	UpdateInvitationFunc      func(ctx context.Context, inv project.Invitation) error
+	FindOpenInvitationsFunc   func(ctx context.Context, filter project.InvitationFilter, now time.Time, pg page.Page) ([]project.Invitation, int, error)
+}
+
+func (m *mockStorer) Create(ctx context.Context, p project.Project) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, p)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (project.Project, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return project.Project{}, nil
+}
+
+func (m *mockStorer) FindPersonal(ctx context.Context, userID uuid.UUID) (project.Project, error) {
+	if m.FindPersonalFunc != nil {
+		return m.FindPersonalFunc(ctx, userID)
+	}
+	return project.Project{}, nil
+}
+
+func (m *mockStorer) FindAll(ctx context.Context, userID uuid.UUID, pg page.Page) ([]project.Project, int, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx, userID, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) Update(ctx context.Context, p project.Project) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, p)
+	}
+	return nil
+}
+
+func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, ID)
+	}
+	return nil
+}
+
+func (m *mockStorer) AddMember(ctx context.Context, member project.Member) error {
+	if m.AddMemberFunc != nil {
+		return m.AddMemberFunc(ctx, member)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Member, error) {
+	if m.FindMemberFunc != nil {
+		return m.FindMemberFunc(ctx, projectID, userID)
+	}
+	return project.Member{}, nil
+}
+
+func (m *mockStorer) FindMembers(ctx context.Context, projectID uuid.UUID, pg page.Page) ([]project.Member, int, error) {
+	if m.FindMembersFunc != nil {
+		return m.FindMembersFunc(ctx, projectID, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) UpdateMember(ctx context.Context, member project.Member) error {
+	if m.UpdateMemberFunc != nil {
+		return m.UpdateMemberFunc(ctx, member)
+	}
+	return nil
+}
+
+func (m *mockStorer) RemoveMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error {
+	if m.RemoveMemberFunc != nil {
+		return m.RemoveMemberFunc(ctx, projectID, userID)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if m.FindProjectIDsFunc != nil {
+		return m.FindProjectIDsFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) CreateInvitation(ctx context.Context, inv project.Invitation) error {
+	if m.CreateInvitationFunc != nil {
+		return m.CreateInvitationFunc(ctx, inv)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindInvitation(ctx context.Context, ID uuid.UUID) (project.Invitation, error) {
+	if m.FindInvitationFunc != nil {
+		return m.FindInvitationFunc(ctx, ID)
+	}
+	return project.Invitation{}, nil
+}
+
+func (m *mockStorer) FindPendingInvitation(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID) (project.Invitation, error) {
+	if m.FindPendingInvitationFunc != nil {
+		return m.FindPendingInvitationFunc(ctx, projectID, inviteeID)
+	}
+	return project.Invitation{}, nil
+}
+
+func (m *mockStorer) UpdateInvitation(ctx context.Context, inv project.Invitation) error {
+	if m.UpdateInvitationFunc != nil {
+		return m.UpdateInvitationFunc(ctx, inv)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindOpenInvitations(ctx context.Context, filter project.InvitationFilter, now time.Time, pg page.Page) ([]project.Invitation, int, error) {
+	if m.FindOpenInvitationsFunc != nil {
+		return m.FindOpenInvitationsFunc(ctx, filter, now, pg)
+	}
+	return nil, 0, nil
+}
+
+type mockNotifier struct {
+	InvitationCreatedFunc func(ctx context.Context, inv project.Invitation, p project.Project) error
+}
+
+func (m *mockNotifier) InvitationCreated(ctx context.Context, inv project.Invitation, p project.Project) error {
+	if m.InvitationCreatedFunc != nil {
+		return m.InvitationCreatedFunc(ctx, inv, p)
+	}
+	return nil
+}
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTran(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// members answers FindMember from the roles of the users in a project.
+func members(roles map[uuid.UUID]project.Role) func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Member, error) {
+	return func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Member, error) {
+		role, ok := roles[userID]
+		if !ok {
+			return project.Member{}, project.ErrNotMember
+		}
+		return project.Member{ProjectID: projectID, UserID: userID, Role: role}, nil
+	}
+}
+
+func Test_Can(t *testing.T) {
+	type testCase struct {
+		role project.Role
+		perm project.Permission
+		want bool
+	}
+
+	table := []testCase{
+		{role: project.RoleViewer, perm: project.PermView, want: true},
+		{role: project.RoleViewer, perm: project.PermOperate, want: false},
+		{role: project.RoleOperator, perm: project.PermOperate, want: true},
+		{role: project.RoleOperator, perm: project.PermManage, want: false},
+		{role: project.RoleAdmin, perm: project.PermManage, want: true},
+		{role: project.RoleAdmin, perm: project.PermMembers, want: true},
+		{role: project.RoleAdmin, perm: project.PermOwn, want: false},
+		{role: project.RoleOwner, perm: project.PermOwn, want: true},
+		{role: project.Role("GUEST"), perm: project.PermView, want: false},
+		{role: project.RoleOwner, perm: project.Permission("FLY"), want: false},
+	}
+
+	for _, tt := range table {
+		t.Run(string(tt.role)+"_"+string(tt.perm), func(t *testing.T) {
+			if got := tt.role.Can(tt.perm); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	type testCase struct {
+		name     string
+		projName string
+		wantName string
+		wantErr  error
+	}
+
+	table := []testCase{
+		{name: "success", projName: "  Backend  ", wantName: "Backend"},
+		{name: "fail_empty_name", projName: " ", wantErr: project.ErrValidation},
+		{name: "fail_name_too_long", projName: strings.Repeat("a", project.MaxNameLength+1), wantErr: project.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var owner *project.Member
+
+			st := &mockStorer{
+				AddMemberFunc: func(ctx context.Context, m project.Member) error {
+					owner = &m
+					return nil
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			got, err := bus.Create(ctx, tt.projName, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name != tt.wantName || got.Personal || got.CreatedBy != userID {
+				t.Errorf("unexpected project: %+v", got)
+			}
+			if owner == nil || owner.UserID != userID || owner.ProjectID != got.ID || owner.Role != project.RoleOwner {
+				t.Errorf("creator must become the owner, got %+v", owner)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+
+	type testCase struct {
+		name      string
+		personal  bool
+		userID    uuid.UUID
+		deleteErr error
+		wantErr   error
+	}
+
+	table := []testCase{
+		{name: "success", userID: ownerID},
+		{name: "fail_admin", userID: adminID, wantErr: project.ErrAccessDenied},
+		{name: "fail_personal", personal: true, userID: ownerID, wantErr: project.ErrValidation},
+		{name: "fail_not_empty", userID: ownerID, deleteErr: project.ErrProjectNotEmpty, wantErr: project.ErrProjectNotEmpty},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (project.Project, error) {
+					return project.Project{ID: ID, Name: "Backend", Personal: tt.personal}, nil
+				},
+				FindMemberFunc: members(map[uuid.UUID]project.Role{ownerID: project.RoleOwner, adminID: project.RoleAdmin}),
+				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error {
+					deleted = tt.deleteErr == nil
+					return tt.deleteErr
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			err := bus.Delete(ctx, uuid.New(), tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if deleted {
+					t.Error("project must not be deleted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deleted {
+				t.Error("project was not deleted")
+			}
+		})
+	}
+}
+
+func Test_SetRole(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+	operatorID := uuid.New()
+	viewerID := uuid.New()
+
+	roles := map[uuid.UUID]project.Role{
+		ownerID:    project.RoleOwner,
+		adminID:    project.RoleAdmin,
+		operatorID: project.RoleOperator,
+		viewerID:   project.RoleViewer,
+	}
+
+	type testCase struct {
+		name      string
+		personal  bool
+		userID    uuid.UUID
+		memberID  uuid.UUID
+		role      project.Role
+		wantRoles map[uuid.UUID]project.Role
+		wantErr   error
+	}
+
+	table := []testCase{
+		{
+			name:      "success_admin_promotes_viewer",
+			userID:    adminID,
+			memberID:  viewerID,
+			role:      project.RoleOperator,
+			wantRoles: map[uuid.UUID]project.Role{viewerID: project.RoleOperator},
+		},
+		{
+			name:      "success_hand_over",
+			userID:    ownerID,
+			memberID:  adminID,
+			role:      project.RoleOwner,
+			wantRoles: map[uuid.UUID]project.Role{ownerID: project.RoleAdmin, adminID: project.RoleOwner},
+		},
+		{
+			name:      "success_unchanged",
+			userID:    adminID,
+			memberID:  viewerID,
+			role:      project.RoleViewer,
+			wantRoles: map[uuid.UUID]project.Role{},
+		},
+		{name: "fail_operator", userID: operatorID, memberID: viewerID, role: project.RoleOperator, wantErr: project.ErrAccessDenied},
+		{name: "fail_not_member", userID: uuid.New(), memberID: viewerID, role: project.RoleOperator, wantErr: project.ErrAccessDenied},
+		{name: "fail_demote_owner", userID: adminID, memberID: ownerID, role: project.RoleAdmin, wantErr: project.ErrValidation},
+		{name: "fail_admin_hands_over", userID: adminID, memberID: viewerID, role: project.RoleOwner, wantErr: project.ErrAccessDenied},
+		{name: "fail_hand_over_personal", personal: true, userID: ownerID, memberID: adminID, role: project.RoleOwner, wantErr: project.ErrValidation},
+		{name: "fail_unknown_role", userID: ownerID, memberID: viewerID, role: project.Role("GUEST"), wantErr: project.ErrValidation},
+		{name: "fail_target_not_member", userID: ownerID, memberID: uuid.New(), role: project.RoleViewer, wantErr: project.ErrNotMember},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := map[uuid.UUID]project.Role{}
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (project.Project, error) {
+					return project.Project{ID: ID, Personal: tt.personal}, nil
+				},
+				FindMemberFunc: members(roles),
+				UpdateMemberFunc: func(ctx context.Context, m project.Member) error {
+					updated[m.UserID] = m.Role
+					return nil
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			got, err := bus.SetRole(ctx, uuid.New(), tt.memberID, tt.role, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if len(updated) != 0 {
+					t.Errorf("members must not change, got %v", updated)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.UserID != tt.memberID || got.Role != tt.role {
+				t.Errorf("got member %+v, want %s as %s", got, tt.memberID, tt.role)
+			}
+			if len(updated) != len(tt.wantRoles) {
+				t.Fatalf("updated %v, want %v", updated, tt.wantRoles)
+			}
+			for id, role := range tt.wantRoles {
+				if updated[id] != role {
+					t.Errorf("member %s: got %s, want %s", id, updated[id], role)
+				}
+			}
+		})
+	}
+}
+
+func Test_RemoveMember(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+	operatorID := uuid.New()
+	viewerID := uuid.New()
+
+	roles := map[uuid.UUID]project.Role{
+		ownerID:    project.RoleOwner,
+		adminID:    project.RoleAdmin,
+		operatorID: project.RoleOperator,
+		viewerID:   project.RoleViewer,
+	}
+
+	type testCase struct {
+		name     string
+		userID   uuid.UUID
+		memberID uuid.UUID
+		wantErr  error
+	}
+
+	table := []testCase{
+		{name: "success_admin_removes_viewer", userID: adminID, memberID: viewerID},
+		{name: "success_viewer_leaves", userID: viewerID, memberID: viewerID},
+		{name: "fail_viewer_removes_operator", userID: viewerID, memberID: operatorID, wantErr: project.ErrAccessDenied},
+		{name: "fail_admin_removes_owner", userID: adminID, memberID: ownerID, wantErr: project.ErrValidation},
+		{name: "fail_owner_leaves", userID: ownerID, memberID: ownerID, wantErr: project.ErrValidation},
+		{name: "fail_stranger_leaves", userID: uuid.New(), memberID: uuid.New(), wantErr: project.ErrAccessDenied},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var removed *uuid.UUID
+
+			st := &mockStorer{
+				FindMemberFunc: members(roles),
+				RemoveMemberFunc: func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error {
+					removed = &userID
+					return nil
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			err := bus.RemoveMember(ctx, uuid.New(), tt.memberID, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if removed != nil {
+					t.Error("member must not be removed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if removed == nil || *removed != tt.memberID {
+				t.Errorf("removed %v, want %s", removed, tt.memberID)
+			}
+		})
+	}
+}
+
+func Test_Invite(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	viewerID := uuid.New()
+	inviteeID := uuid.New()
+	errBoom := errors.New("boom")
+	ttl := 48 * time.Hour
+
+	roles := map[uuid.UUID]project.Role{
+		ownerID:  project.RoleOwner,
+		viewerID: project.RoleViewer,
+	}
+
+	type testCase struct {
+		name        string
+		userID      uuid.UUID
+		inviteeID   uuid.UUID
+		role        project.Role
+		pending     *project.Invitation
+		notifyErr   error
+		wantExpired bool
+		wantErr     error
+	}
+
+	table := []testCase{
+		{name: "success", userID: ownerID, inviteeID: inviteeID, role: project.RoleOperator},
+		{
+			name:        "success_replaces_expired",
+			userID:      ownerID,
+			inviteeID:   inviteeID,
+			role:        project.RoleViewer,
+			pending:     &project.Invitation{ID: uuid.New(), Status: project.InvitationPending, ExpiresAt: time.Now().Add(-time.Hour)},
+			wantExpired: true,
+		},
+		{name: "fail_owner_role", userID: ownerID, inviteeID: inviteeID, role: project.RoleOwner, wantErr: project.ErrValidation},
+		{name: "fail_viewer", userID: viewerID, inviteeID: inviteeID, role: project.RoleViewer, wantErr: project.ErrAccessDenied},
+		{name: "fail_already_member", userID: ownerID, inviteeID: viewerID, role: project.RoleAdmin, wantErr: project.ErrDuplicate},
+		{
+			name:      "fail_pending",
+			userID:    ownerID,
+			inviteeID: inviteeID,
+			role:      project.RoleViewer,
+			pending:   &project.Invitation{ID: uuid.New(), Status: project.InvitationPending, ExpiresAt: time.Now().Add(time.Hour)},
+			wantErr:   project.ErrDuplicate,
+		},
+		{name: "fail_notifier", userID: ownerID, inviteeID: inviteeID, role: project.RoleViewer, notifyErr: errBoom, wantErr: errBoom},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var created, notified *project.Invitation
+			expired := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (project.Project, error) {
+					return project.Project{ID: ID, Name: "Backend"}, nil
+				},
+				FindMemberFunc: members(roles),
+				FindPendingInvitationFunc: func(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID) (project.Invitation, error) {
+					if tt.pending == nil {
+						return project.Invitation{}, project.ErrInvitationNotFound
+					}
+					return *tt.pending, nil
+				},
+				UpdateInvitationFunc: func(ctx context.Context, inv project.Invitation) error {
+					expired = inv.Status == project.InvitationExpired
+					return nil
+				},
+				CreateInvitationFunc: func(ctx context.Context, inv project.Invitation) error {
+					created = &inv
+					return nil
+				},
+			}
+			notifier := &mockNotifier{
+				InvitationCreatedFunc: func(ctx context.Context, inv project.Invitation, p project.Project) error {
+					notified = &inv
+					return tt.notifyErr
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{InvitationTTL: ttl}, st, notifier, &mockTransactor{})
+
+			got, err := bus.Invite(ctx, uuid.New(), tt.inviteeID, tt.role, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created == nil || notified == nil || created.ID != got.ID || notified.ID != got.ID {
+				t.Fatalf("invitation must be stored and notified, got created %v notified %v", created, notified)
+			}
+			if got.Status != project.InvitationPending || got.InviteeID != tt.inviteeID || got.InvitedBy != tt.userID || got.Role != tt.role {
+				t.Errorf("unexpected invitation: %+v", got)
+			}
+			if d := got.ExpiresAt.Sub(got.CreatedAt); d != ttl {
+				t.Errorf("expires after %s, want %s", d, ttl)
+			}
+			if expired != tt.wantExpired {
+				t.Errorf("old invitation expired: got %v, want %v", expired, tt.wantExpired)
+			}
+		})
+	}
+}
+
+func Test_AcceptInvitation(t *testing.T) {
+	ctx := context.Background()
+	inviteeID := uuid.New()
+	projectID := uuid.New()
+
+	open := project.Invitation{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		InviteeID: inviteeID,
+		Role:      project.RoleOperator,
+		Status:    project.InvitationPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	with := func(change func(inv *project.Invitation)) project.Invitation {
+		inv := open
+		change(&inv)
+		return inv
+	}
+
+	type testCase struct {
+		name    string
+		inv     project.Invitation
+		userID  uuid.UUID
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success", inv: open, userID: inviteeID},
+		{name: "fail_other_user", inv: open, userID: uuid.New(), wantErr: project.ErrAccessDenied},
+		{name: "fail_expired", inv: with(func(inv *project.Invitation) { inv.ExpiresAt = time.Now().Add(-time.Minute) }), userID: inviteeID, wantErr: project.ErrValidation},
+		{name: "fail_revoked", inv: with(func(inv *project.Invitation) { inv.Status = project.InvitationRevoked }), userID: inviteeID, wantErr: project.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var added *project.Member
+			var status project.InvitationStatus
+
+			st := &mockStorer{
+				FindInvitationFunc: func(ctx context.Context, ID uuid.UUID) (project.Invitation, error) {
+					return tt.inv, nil
+				},
+				UpdateInvitationFunc: func(ctx context.Context, inv project.Invitation) error {
+					status = inv.Status
+					return nil
+				},
+				AddMemberFunc: func(ctx context.Context, m project.Member) error {
+					added = &m
+					return nil
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			_, err := bus.AcceptInvitation(ctx, tt.inv.ID, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if added != nil || status != "" {
+					t.Error("nothing must change for an invitation that cannot be accepted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != project.InvitationAccepted {
+				t.Errorf("status: got %s, want ACCEPTED", status)
+			}
+			if added == nil || added.ProjectID != projectID || added.UserID != inviteeID || added.Role != project.RoleOperator {
+				t.Errorf("unexpected member: %+v", added)
+			}
+		})
+	}
+}
+
+func Test_Personal(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	existing := project.Project{ID: uuid.New(), Name: project.PersonalName, Personal: true, CreatedBy: userID}
+
+	type testCase struct {
+		name      string
+		finds     []error
+		createErr error
+		wantNew   bool
+	}
+
+	table := []testCase{
+		{name: "existing", finds: []error{nil}},
+		{name: "created", finds: []error{project.ErrProjectNotFound}, wantNew: true},
+		{name: "created_concurrently", finds: []error{project.ErrProjectNotFound, nil}, createErr: project.ErrDuplicate},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			var owner *project.Member
+
+			st := &mockStorer{
+				FindPersonalFunc: func(ctx context.Context, uID uuid.UUID) (project.Project, error) {
+					err := tt.finds[calls]
+					calls++
+					if err != nil {
+						return project.Project{}, err
+					}
+					return existing, nil
+				},
+				CreateFunc: func(ctx context.Context, p project.Project) error {
+					return tt.createErr
+				},
+				AddMemberFunc: func(ctx context.Context, m project.Member) error {
+					owner = &m
+					return nil
+				},
+			}
+
+			bus := project.NewBusiness(project.Config{}, st, &mockNotifier{}, &mockTransactor{})
+
+			got, err := bus.Personal(ctx, userID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if calls != len(tt.finds) {
+				t.Errorf("looked up %d times, want %d", calls, len(tt.finds))
+			}
+			if !got.Personal || got.CreatedBy != userID {
+				t.Errorf("unexpected project: %+v", got)
+			}
+			if tt.wantNew {
+				if got.ID == existing.ID {
+					t.Error("expected a new project")
+				}
+				if owner == nil || owner.UserID != userID || owner.Role != project.RoleOwner {
+					t.Errorf("user must own the new project, got %+v", owner)
+				}
+				return
+			}
+			if got.ID != existing.ID {
+				t.Errorf("got project %s, want %s", got.ID, existing.ID)
+			}
+		})
+	}
+}
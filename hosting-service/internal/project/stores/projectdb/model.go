@@ -0,0 +1,122 @@
+package projectdb
+
+import (
+	"hosting-service/internal/project"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type projectDB struct {
+	ID        uuid.UUID `db:"id"`
+	Name      string    `db:"name"`
+	Personal  bool      `db:"personal"`
+	CreatedBy uuid.UUID `db:"created_by"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBProject(p project.Project) projectDB {
+	return projectDB{
+		ID:        p.ID,
+		Name:      p.Name,
+		Personal:  p.Personal,
+		CreatedBy: p.CreatedBy,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func toBusProject(db projectDB) project.Project {
+	return project.Project{
+		ID:        db.ID,
+		Name:      db.Name,
+		Personal:  db.Personal,
+		CreatedBy: db.CreatedBy,
+		CreatedAt: db.CreatedAt,
+	}
+}
+
+func toBusProjects(dbs []projectDB) []project.Project {
+	projects := make([]project.Project, len(dbs))
+	for i, db := range dbs {
+		projects[i] = toBusProject(db)
+	}
+	return projects
+}
+
+type memberDB struct {
+	ProjectID uuid.UUID `db:"project_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBMember(m project.Member) memberDB {
+	return memberDB{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Role:      string(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func toBusMember(db memberDB) project.Member {
+	return project.Member{
+		ProjectID: db.ProjectID,
+		UserID:    db.UserID,
+		Role:      project.Role(db.Role),
+		CreatedAt: db.CreatedAt,
+	}
+}
+
+func toBusMembers(dbs []memberDB) []project.Member {
+	members := make([]project.Member, len(dbs))
+	for i, db := range dbs {
+		members[i] = toBusMember(db)
+	}
+	return members
+}
+
+type invitationDB struct {
+	ID        uuid.UUID `db:"id"`
+	ProjectID uuid.UUID `db:"project_id"`
+	InviteeID uuid.UUID `db:"invitee_id"`
+	InvitedBy uuid.UUID `db:"invited_by"`
+	Role      string    `db:"role"`
+	Status    string    `db:"status"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func toDBInvitation(inv project.Invitation) invitationDB {
+	return invitationDB{
+		ID:        inv.ID,
+		ProjectID: inv.ProjectID,
+		InviteeID: inv.InviteeID,
+		InvitedBy: inv.InvitedBy,
+		Role:      string(inv.Role),
+		Status:    string(inv.Status),
+		CreatedAt: inv.CreatedAt,
+		ExpiresAt: inv.ExpiresAt,
+	}
+}
+
+func toBusInvitation(db invitationDB) project.Invitation {
+	return project.Invitation{
+		ID:        db.ID,
+		ProjectID: db.ProjectID,
+		InviteeID: db.InviteeID,
+		InvitedBy: db.InvitedBy,
+		Role:      project.Role(db.Role),
+		Status:    project.InvitationStatus(db.Status),
+		CreatedAt: db.CreatedAt,
+		ExpiresAt: db.ExpiresAt,
+	}
+}
+
+func toBusInvitations(dbs []invitationDB) []project.Invitation {
+	invs := make([]project.Invitation, len(dbs))
+	for i, db := range dbs {
+		invs[i] = toBusInvitation(db)
+	}
+	return invs
+}
@@ -0,0 +1,511 @@
+package projectdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, p project.Project) error {
+	const q = `
+	INSERT INTO projects
+		(id, name, personal, created_by, created_at)
+	VALUES
+		(@id, @name, @personal, @created_by, @created_at)
+	ON CONFLICT (created_by) WHERE personal DO NOTHING`
+
+	dbProject := toDBProject(p)
+
+	args := pgx.NamedArgs{
+		"id":         dbProject.ID,
+		"name":       dbProject.Name,
+		"personal":   dbProject.Personal,
+		"created_by": dbProject.CreatedBy,
+		"created_at": dbProject.CreatedAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrDuplicate
+	}
+
+	return nil
+}
+
+func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (project.Project, error) {
+	const q = `
+	SELECT
+		id, name, personal, created_by, created_at
+	FROM
+		projects
+	WHERE
+		id = @id`
+
+	return s.findOne(ctx, q, pgx.NamedArgs{"id": ID})
+}
+
+func (s *Store) FindPersonal(ctx context.Context, userID uuid.UUID) (project.Project, error) {
+	const q = `
+	SELECT
+		id, name, personal, created_by, created_at
+	FROM
+		projects
+	WHERE
+		personal AND created_by = @user_id`
+
+	return s.findOne(ctx, q, pgx.NamedArgs{"user_id": userID})
+}
+
+func (s *Store) findOne(ctx context.Context, q string, args pgx.NamedArgs) (project.Project, error) {
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return project.Project{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbProject, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return project.Project{}, project.ErrProjectNotFound
+		}
+		return project.Project{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusProject(dbProject), nil
+}
+
+func (s *Store) FindAll(ctx context.Context, userID uuid.UUID, pg page.Page) ([]project.Project, int, error) {
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	const qCount = `
+	SELECT count(*) FROM project_members WHERE user_id = @user_id`
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		p.id, p.name, p.personal, p.created_by, p.created_at
+	FROM
+		projects p
+	JOIN
+		project_members m ON m.project_id = p.id
+	WHERE
+		m.user_id = @user_id
+	ORDER BY
+		p.personal DESC, p.created_at DESC, p.id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbProjects, err := pgx.CollectRows(rows, pgx.RowToStructByName[projectDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusProjects(dbProjects), total, nil
+}
+
+func (s *Store) Update(ctx context.Context, p project.Project) error {
+	const q = `
+	UPDATE projects
+	SET
+		name = @name
+	WHERE
+		id = @id`
+
+	dbProject := toDBProject(p)
+
+	args := pgx.NamedArgs{
+		"id":   dbProject.ID,
+		"name": dbProject.Name,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrProjectNotFound
+	}
+
+	return nil
+}
+
+// Delete removes the project unless servers still belong to it. Members and
+// invitations go with it.
+func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
+	const q = `
+	DELETE FROM projects
+	WHERE
+		id = @id
+		AND NOT EXISTS (SELECT 1 FROM servers WHERE project_id = @id)`
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, pgx.NamedArgs{"id": ID})
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrProjectNotEmpty
+	}
+
+	return nil
+}
+
+func (s *Store) AddMember(ctx context.Context, m project.Member) error {
+	const q = `
+	INSERT INTO project_members
+		(project_id, user_id, role, created_at)
+	VALUES
+		(@project_id, @user_id, @role, @created_at)
+	ON CONFLICT (project_id, user_id) DO NOTHING`
+
+	dbMember := toDBMember(m)
+
+	args := pgx.NamedArgs{
+		"project_id": dbMember.ProjectID,
+		"user_id":    dbMember.UserID,
+		"role":       dbMember.Role,
+		"created_at": dbMember.CreatedAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrDuplicate
+	}
+
+	return nil
+}
+
+func (s *Store) FindMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Member, error) {
+	const q = `
+	SELECT
+		project_id, user_id, role, created_at
+	FROM
+		project_members
+	WHERE
+		project_id = @project_id AND user_id = @user_id`
+
+	args := pgx.NamedArgs{
+		"project_id": projectID,
+		"user_id":    userID,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return project.Member{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbMember, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[memberDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return project.Member{}, project.ErrNotMember
+		}
+		return project.Member{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusMember(dbMember), nil
+}
+
+func (s *Store) FindMembers(ctx context.Context, projectID uuid.UUID, pg page.Page) ([]project.Member, int, error) {
+	args := pgx.NamedArgs{
+		"project_id": projectID,
+	}
+
+	const qCount = `
+	SELECT count(*) FROM project_members WHERE project_id = @project_id`
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		project_id, user_id, role, created_at
+	FROM
+		project_members
+	WHERE
+		project_id = @project_id
+	ORDER BY
+		created_at, user_id
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbMembers, err := pgx.CollectRows(rows, pgx.RowToStructByName[memberDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusMembers(dbMembers), total, nil
+}
+
+func (s *Store) UpdateMember(ctx context.Context, m project.Member) error {
+	const q = `
+	UPDATE project_members
+	SET
+		role = @role
+	WHERE
+		project_id = @project_id AND user_id = @user_id`
+
+	dbMember := toDBMember(m)
+
+	args := pgx.NamedArgs{
+		"project_id": dbMember.ProjectID,
+		"user_id":    dbMember.UserID,
+		"role":       dbMember.Role,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrNotMember
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error {
+	const q = `
+	DELETE FROM project_members
+	WHERE
+		project_id = @project_id AND user_id = @user_id`
+
+	args := pgx.NamedArgs{
+		"project_id": projectID,
+		"user_id":    userID,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	const q = `
+	SELECT project_id FROM project_members WHERE user_id = @user_id`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *Store) CreateInvitation(ctx context.Context, inv project.Invitation) error {
+	const q = `
+	INSERT INTO project_invitations
+		(id, project_id, invitee_id, invited_by, role, status, created_at, expires_at)
+	VALUES
+		(@id, @project_id, @invitee_id, @invited_by, @role, @status, @created_at, @expires_at)
+	ON CONFLICT (project_id, invitee_id) WHERE status = 'PENDING' DO NOTHING`
+
+	dbInv := toDBInvitation(inv)
+
+	args := pgx.NamedArgs{
+		"id":         dbInv.ID,
+		"project_id": dbInv.ProjectID,
+		"invitee_id": dbInv.InviteeID,
+		"invited_by": dbInv.InvitedBy,
+		"role":       dbInv.Role,
+		"status":     dbInv.Status,
+		"created_at": dbInv.CreatedAt,
+		"expires_at": dbInv.ExpiresAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrDuplicate
+	}
+
+	return nil
+}
+
+func (s *Store) FindInvitation(ctx context.Context, ID uuid.UUID) (project.Invitation, error) {
+	const q = `
+	SELECT
+		id, project_id, invitee_id, invited_by, role, status, created_at, expires_at
+	FROM
+		project_invitations
+	WHERE
+		id = @id`
+
+	return s.findInvitation(ctx, q, pgx.NamedArgs{"id": ID})
+}
+
+func (s *Store) FindPendingInvitation(ctx context.Context, projectID uuid.UUID, inviteeID uuid.UUID) (project.Invitation, error) {
+	const q = `
+	SELECT
+		id, project_id, invitee_id, invited_by, role, status, created_at, expires_at
+	FROM
+		project_invitations
+	WHERE
+		project_id = @project_id AND invitee_id = @invitee_id AND status = 'PENDING'`
+
+	args := pgx.NamedArgs{
+		"project_id": projectID,
+		"invitee_id": inviteeID,
+	}
+
+	return s.findInvitation(ctx, q, args)
+}
+
+func (s *Store) findInvitation(ctx context.Context, q string, args pgx.NamedArgs) (project.Invitation, error) {
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return project.Invitation{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbInv, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[invitationDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return project.Invitation{}, project.ErrInvitationNotFound
+		}
+		return project.Invitation{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusInvitation(dbInv), nil
+}
+
+func (s *Store) UpdateInvitation(ctx context.Context, inv project.Invitation) error {
+	const q = `
+	UPDATE project_invitations
+	SET
+		status = @status
+	WHERE
+		id = @id`
+
+	dbInv := toDBInvitation(inv)
+
+	args := pgx.NamedArgs{
+		"id":     dbInv.ID,
+		"status": dbInv.Status,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return project.ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) FindOpenInvitations(ctx context.Context, filter project.InvitationFilter, now time.Time, pg page.Page) ([]project.Invitation, int, error) {
+	args := pgx.NamedArgs{
+		"now": now,
+	}
+
+	var where strings.Builder
+	where.WriteString(" WHERE status = 'PENDING' AND expires_at > @now")
+
+	if filter.ProjectID != nil {
+		where.WriteString(" AND project_id = @project_id")
+		args["project_id"] = *filter.ProjectID
+	}
+	if filter.InviteeID != nil {
+		where.WriteString(" AND invitee_id = @invitee_id")
+		args["invitee_id"] = *filter.InviteeID
+	}
+
+	qCount := `SELECT count(*) FROM project_invitations` + where.String()
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	q := `
+	SELECT
+		id, project_id, invitee_id, invited_by, role, status, created_at, expires_at
+	FROM
+		project_invitations` + where.String() + `
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbInvs, err := pgx.CollectRows(rows, pgx.RowToStructByName[invitationDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusInvitations(dbInvs), total, nil
+}
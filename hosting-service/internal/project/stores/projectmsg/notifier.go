@@ -0,0 +1,43 @@
+package projectmsg
+
+import (
+	"context"
+	"fmt"
+	"hosting-contracts/hosting-service/queue/events"
+	"hosting-contracts/topology"
+	"hosting-service/internal/project"
+)
+
+// Publisher is satisfied by the outbox, so the event is stored in the same
+// transaction as the invitation.
+type Publisher interface {
+	Publish(ctx context.Context, exchangeName, routingKey string, data interface{}) error
+}
+
+type Notifier struct {
+	publisher Publisher
+}
+
+func NewNotifier(publisher Publisher) *Notifier {
+	return &Notifier{
+		publisher: publisher,
+	}
+}
+
+func (n *Notifier) InvitationCreated(ctx context.Context, inv project.Invitation, p project.Project) error {
+	event := events.ProjectInvitationCreatedEvent{
+		InvitationID: inv.ID,
+		ProjectID:    p.ID,
+		ProjectName:  p.Name,
+		InviteeID:    inv.InviteeID,
+		InvitedBy:    inv.InvitedBy,
+		Role:         string(inv.Role),
+		ExpiresAt:    inv.ExpiresAt,
+	}
+
+	if err := n.publisher.Publish(ctx, topology.EventsExchange, events.ProjectInvitationCreated, event); err != nil {
+		return fmt.Errorf("msg: failed to queue project invitation event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package quotaotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/quota"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus quota.ExtBusiness
+}
+
+func NewExtension() quota.Extension {
+	return func(bus quota.ExtBusiness) quota.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Get(ctx context.Context, userID uuid.UUID) (quota.Quota, error) {
+	ctx, span := otel.AddSpan(ctx, "quota.get")
+	defer span.End()
+
+	return e.bus.Get(ctx, userID)
+}
+
+func (e *Extension) Lock(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "quota.lock")
+	defer span.End()
+
+	return e.bus.Lock(ctx, userID)
+}
+
+func (e *Extension) Default(ctx context.Context) (quota.Limits, error) {
+	ctx, span := otel.AddSpan(ctx, "quota.default")
+	defer span.End()
+
+	return e.bus.Default(ctx)
+}
+
+func (e *Extension) SetDefault(ctx context.Context, limits quota.Limits) (quota.Limits, error) {
+	ctx, span := otel.AddSpan(ctx, "quota.setdefault")
+	defer span.End()
+
+	return e.bus.SetDefault(ctx, limits)
+}
+
+func (e *Extension) SearchOverrides(ctx context.Context, pg page.Page) ([]quota.Override, int, error) {
+	ctx, span := otel.AddSpan(ctx, "quota.searchoverrides")
+	defer span.End()
+
+	return e.bus.SearchOverrides(ctx, pg)
+}
+
+func (e *Extension) SetOverride(ctx context.Context, userID uuid.UUID, limits quota.Limits) (quota.Override, error) {
+	ctx, span := otel.AddSpan(ctx, "quota.setoverride")
+	defer span.End()
+
+	return e.bus.SetOverride(ctx, userID, limits)
+}
+
+func (e *Extension) DeleteOverride(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "quota.deleteoverride")
+	defer span.End()
+
+	return e.bus.DeleteOverride(ctx, userID)
+}
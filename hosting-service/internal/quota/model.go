@@ -0,0 +1,63 @@
+package quota
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limits caps what a single user may hold across all their servers.
+type Limits struct {
+	Servers  int
+	CPUCores int
+	RAMMB    int
+	DiskGB   int
+	IPCount  int
+}
+
+// Usage is what a user currently holds, or what a request adds to it.
+type Usage struct {
+	Servers  int
+	CPUCores int
+	RAMMB    int
+	DiskGB   int
+	IPCount  int
+}
+
+// Override replaces the default limits for one user.
+type Override struct {
+	UserID    uuid.UUID
+	Limits    Limits
+	UpdatedAt time.Time
+}
+
+// Quota is the effective limits of a user together with their usage.
+type Quota struct {
+	UserID uuid.UUID
+	Limits Limits
+	Usage  Usage
+
+	// Custom is true when the limits come from an override.
+	Custom bool
+}
+
+// Exceeded lists the resources that would go over the limits if extra was
+// added to the current usage. Only growing resources are checked, so a user
+// above a lowered limit can still shrink.
+func (q Quota) Exceeded(extra Usage) []string {
+	var over []string
+
+	check := func(name string, used, add, limit int) {
+		if add > 0 && used+add > limit {
+			over = append(over, name)
+		}
+	}
+
+	check("servers", q.Usage.Servers, extra.Servers, q.Limits.Servers)
+	check("cpu cores", q.Usage.CPUCores, extra.CPUCores, q.Limits.CPUCores)
+	check("ram", q.Usage.RAMMB, extra.RAMMB, q.Limits.RAMMB)
+	check("disk", q.Usage.DiskGB, extra.DiskGB, q.Limits.DiskGB)
+	check("ip addresses", q.Usage.IPCount, extra.IPCount, q.Limits.IPCount)
+
+	return over
+}
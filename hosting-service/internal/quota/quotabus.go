@@ -0,0 +1,184 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrValidation       = errors.New("validation error")
+	ErrOverrideNotFound = errors.New("quota override not found")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	FindDefault(ctx context.Context) (Limits, error)
+	SaveDefault(ctx context.Context, limits Limits) error
+	FindOverride(ctx context.Context, userID uuid.UUID) (Override, error)
+	FindAllOverrides(ctx context.Context, pg page.Page) ([]Override, int, error)
+	SaveOverride(ctx context.Context, o Override) error
+	DeleteOverride(ctx context.Context, userID uuid.UUID) error
+	Usage(ctx context.Context, userID uuid.UUID) (Usage, error)
+	Lock(ctx context.Context, userID uuid.UUID) error
+}
+
+type ExtBusiness interface {
+	Get(ctx context.Context, userID uuid.UUID) (Quota, error)
+	Lock(ctx context.Context, userID uuid.UUID) error
+	Default(ctx context.Context) (Limits, error)
+	SetDefault(ctx context.Context, limits Limits) (Limits, error)
+	SearchOverrides(ctx context.Context, pg page.Page) ([]Override, int, error)
+	SetOverride(ctx context.Context, userID uuid.UUID, limits Limits) (Override, error)
+	DeleteOverride(ctx context.Context, userID uuid.UUID) error
+}
+
+type Business struct {
+	storer     Storer
+	extensions []Extension
+}
+
+func NewBusiness(storer Storer, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Get returns the limits that apply to the user, their override or the
+// default, together with what the user's servers currently hold.
+func (b *Business) Get(ctx context.Context, userID uuid.UUID) (Quota, error) {
+	q := Quota{UserID: userID}
+
+	override, err := b.storer.FindOverride(ctx, userID)
+	switch {
+	case err == nil:
+		q.Limits = override.Limits
+		q.Custom = true
+	case errors.Is(err, ErrOverrideNotFound):
+		q.Limits, err = b.storer.FindDefault(ctx)
+		if err != nil {
+			return Quota{}, fmt.Errorf("get: %w", err)
+		}
+	default:
+		return Quota{}, fmt.Errorf("get: %w", err)
+	}
+
+	q.Usage, err = b.storer.Usage(ctx, userID)
+	if err != nil {
+		return Quota{}, fmt.Errorf("get: usage: %w", err)
+	}
+
+	return q, nil
+}
+
+// Lock holds off the quota checks of other requests of the user until the
+// transaction in ctx ends, so a check and the usage it admits are atomic.
+func (b *Business) Lock(ctx context.Context, userID uuid.UUID) error {
+	if err := b.storer.Lock(ctx, userID); err != nil {
+		return fmt.Errorf("lock: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Business) Default(ctx context.Context) (Limits, error) {
+	limits, err := b.storer.FindDefault(ctx)
+	if err != nil {
+		return Limits{}, fmt.Errorf("default: %w", err)
+	}
+
+	return limits, nil
+}
+
+// SetDefault changes the limits of every user without an override.
+func (b *Business) SetDefault(ctx context.Context, limits Limits) (Limits, error) {
+	if err := limits.validate(); err != nil {
+		return Limits{}, err
+	}
+
+	if err := b.storer.SaveDefault(ctx, limits); err != nil {
+		return Limits{}, fmt.Errorf("setdefault: %w", err)
+	}
+
+	return limits, nil
+}
+
+func (b *Business) SearchOverrides(ctx context.Context, pg page.Page) ([]Override, int, error) {
+	overrides, total, err := b.storer.FindAllOverrides(ctx, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searchoverrides: %w", err)
+	}
+
+	return overrides, total, nil
+}
+
+// SetOverride gives the user their own limits instead of the default.
+// Lowering a limit below the current usage is allowed; the user just cannot
+// grow until they are back under it.
+func (b *Business) SetOverride(ctx context.Context, userID uuid.UUID, limits Limits) (Override, error) {
+	if userID == uuid.Nil {
+		return Override{}, fmt.Errorf("%w: userID cannot be nil", ErrValidation)
+	}
+
+	if err := limits.validate(); err != nil {
+		return Override{}, err
+	}
+
+	o := Override{
+		UserID:    userID,
+		Limits:    limits,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if err := b.storer.SaveOverride(ctx, o); err != nil {
+		return Override{}, fmt.Errorf("setoverride: %w", err)
+	}
+
+	return o, nil
+}
+
+// DeleteOverride puts the user back on the default limits.
+func (b *Business) DeleteOverride(ctx context.Context, userID uuid.UUID) error {
+	if err := b.storer.DeleteOverride(ctx, userID); err != nil {
+		return fmt.Errorf("deleteoverride: %w", err)
+	}
+
+	return nil
+}
+
+func (l Limits) validate() error {
+	if l.Servers < 0 {
+		return fmt.Errorf("%w: server limit cannot be negative", ErrValidation)
+	}
+	if l.CPUCores < 0 {
+		return fmt.Errorf("%w: CPU core limit cannot be negative", ErrValidation)
+	}
+	if l.RAMMB < 0 {
+		return fmt.Errorf("%w: RAM limit cannot be negative", ErrValidation)
+	}
+	if l.DiskGB < 0 {
+		return fmt.Errorf("%w: disk limit cannot be negative", ErrValidation)
+	}
+	if l.IPCount < 0 {
+		return fmt.Errorf("%w: IP limit cannot be negative", ErrValidation)
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package quota_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"hosting-kit/page"
+	"hosting-service/internal/quota"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	FindDefaultFunc      func(ctx context.Context) (quota.Limits, error)
+	SaveDefaultFunc      func(ctx context.Context, limits quota.Limits) error
+	FindOverrideFunc     func(ctx context.Context, userID uuid.UUID) (quota.Override, error)
+	FindAllOverridesFunc func(ctx context.Context, pg page.Page) ([]quota.Override, int, error)
+	SaveOverrideFunc     func(ctx context.Context, o quota.Override) error
+	DeleteOverrideFunc   func(ctx context.Context, userID uuid.UUID) error
+	UsageFunc            func(ctx context.Context, userID uuid.UUID) (quota.Usage, error)
+	LockFunc             func(ctx context.Context, userID uuid.UUID) error
+}
+
+func (m *mockStorer) FindDefault(ctx context.Context) (quota.Limits, error) {
+	if m.FindDefaultFunc != nil {
+		return m.FindDefaultFunc(ctx)
+	}
+	return quota.Limits{}, nil
+}
+
+func (m *mockStorer) SaveDefault(ctx context.Context, limits quota.Limits) error {
+	if m.SaveDefaultFunc != nil {
+		return m.SaveDefaultFunc(ctx, limits)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindOverride(ctx context.Context, userID uuid.UUID) (quota.Override, error) {
+	if m.FindOverrideFunc != nil {
+		return m.FindOverrideFunc(ctx, userID)
+	}
+	return quota.Override{}, quota.ErrOverrideNotFound
+}
+
+func (m *mockStorer) FindAllOverrides(ctx context.Context, pg page.Page) ([]quota.Override, int, error) {
+	if m.FindAllOverridesFunc != nil {
+		return m.FindAllOverridesFunc(ctx, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) SaveOverride(ctx context.Context, o quota.Override) error {
+	if m.SaveOverrideFunc != nil {
+		return m.SaveOverrideFunc(ctx, o)
+	}
+	return nil
+}
+
+func (m *mockStorer) DeleteOverride(ctx context.Context, userID uuid.UUID) error {
+	if m.DeleteOverrideFunc != nil {
+		return m.DeleteOverrideFunc(ctx, userID)
+	}
+	return nil
+}
+
+func (m *mockStorer) Usage(ctx context.Context, userID uuid.UUID) (quota.Usage, error) {
+	if m.UsageFunc != nil {
+		return m.UsageFunc(ctx, userID)
+	}
+	return quota.Usage{}, nil
+}
+
+func (m *mockStorer) Lock(ctx context.Context, userID uuid.UUID) error {
+	if m.LockFunc != nil {
+		return m.LockFunc(ctx, userID)
+	}
+	return nil
+}
+
+func Test_Get(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	errBoom := errors.New("boom")
+
+	defaults := quota.Limits{Servers: 5, CPUCores: 16, RAMMB: 32768, DiskGB: 500, IPCount: 5}
+	custom := quota.Limits{Servers: 10, CPUCores: 64, RAMMB: 131072, DiskGB: 2000, IPCount: 10}
+	usage := quota.Usage{Servers: 1, CPUCores: 2, RAMMB: 2048, DiskGB: 20, IPCount: 1}
+
+	type testCase struct {
+		name        string
+		overrideErr error
+		defaultErr  error
+		usageErr    error
+
+		wantErr    error
+		wantLimits quota.Limits
+		wantCustom bool
+	}
+
+	table := []testCase{
+		{name: "default", overrideErr: quota.ErrOverrideNotFound, wantLimits: defaults},
+		{name: "override", wantLimits: custom, wantCustom: true},
+		{name: "fail_override", overrideErr: errBoom, wantErr: errBoom},
+		{name: "fail_default", overrideErr: quota.ErrOverrideNotFound, defaultErr: errBoom, wantErr: errBoom},
+		{name: "fail_usage", usageErr: errBoom, wantErr: errBoom},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindOverrideFunc: func(ctx context.Context, ID uuid.UUID) (quota.Override, error) {
+					if tt.overrideErr != nil {
+						return quota.Override{}, tt.overrideErr
+					}
+					return quota.Override{UserID: ID, Limits: custom}, nil
+				},
+				FindDefaultFunc: func(ctx context.Context) (quota.Limits, error) {
+					return defaults, tt.defaultErr
+				},
+				UsageFunc: func(ctx context.Context, ID uuid.UUID) (quota.Usage, error) {
+					if ID != userID {
+						t.Errorf("usage of %s, want %s", ID, userID)
+					}
+					return usage, tt.usageErr
+				},
+			}
+
+			bus := quota.NewBusiness(st)
+
+			got, err := bus.Get(ctx, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.UserID != userID {
+				t.Errorf("user: got %s, want %s", got.UserID, userID)
+			}
+			if got.Limits != tt.wantLimits {
+				t.Errorf("limits: got %+v, want %+v", got.Limits, tt.wantLimits)
+			}
+			if got.Custom != tt.wantCustom {
+				t.Errorf("custom: got %v, want %v", got.Custom, tt.wantCustom)
+			}
+			if got.Usage != usage {
+				t.Errorf("usage: got %+v, want %+v", got.Usage, usage)
+			}
+		})
+	}
+}
+
+func Test_Exceeded(t *testing.T) {
+	limits := quota.Limits{Servers: 2, CPUCores: 8, RAMMB: 8192, DiskGB: 100, IPCount: 2}
+
+	type testCase struct {
+		name  string
+		usage quota.Usage
+		extra quota.Usage
+
+		want []string
+	}
+
+	table := []testCase{
+		{name: "empty", extra: quota.Usage{Servers: 1, CPUCores: 2, RAMMB: 2048, DiskGB: 20, IPCount: 1}},
+		{name: "up_to_limit", usage: quota.Usage{Servers: 1, CPUCores: 6, RAMMB: 6144, DiskGB: 80, IPCount: 1}, extra: quota.Usage{Servers: 1, CPUCores: 2, RAMMB: 2048, DiskGB: 20, IPCount: 1}},
+		{name: "one_over", usage: quota.Usage{Servers: 1, CPUCores: 7}, extra: quota.Usage{Servers: 1, CPUCores: 2}, want: []string{"cpu cores"}},
+		{name: "all_over", usage: quota.Usage{Servers: 2, CPUCores: 8, RAMMB: 8192, DiskGB: 100, IPCount: 2}, extra: quota.Usage{Servers: 1, CPUCores: 1, RAMMB: 1, DiskGB: 1, IPCount: 1}, want: []string{"servers", "cpu cores", "ram", "disk", "ip addresses"}},
+		{name: "shrink_above_limit", usage: quota.Usage{Servers: 3, CPUCores: 16, RAMMB: 16384}, extra: quota.Usage{CPUCores: -4, RAMMB: -4096}},
+		{name: "no_growth_above_limit", usage: quota.Usage{Servers: 3}},
+		{name: "ip_over", extra: quota.Usage{IPCount: 3}, want: []string{"ip addresses"}},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			q := quota.Quota{Limits: limits, Usage: tt.usage}
+
+			got := q.Exceeded(tt.extra)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_SetOverride(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	errBoom := errors.New("boom")
+
+	valid := quota.Limits{Servers: 10, CPUCores: 32, RAMMB: 65536, DiskGB: 1000, IPCount: 10}
+
+	type testCase struct {
+		name    string
+		userID  uuid.UUID
+		limits  quota.Limits
+		saveErr error
+
+		wantErr   error
+		wantSaved bool
+	}
+
+	table := []testCase{
+		{name: "success", userID: userID, limits: valid, wantSaved: true},
+		{name: "success_zero_limits", userID: userID, limits: quota.Limits{}, wantSaved: true},
+		{name: "fail_nil_user", userID: uuid.Nil, limits: valid, wantErr: quota.ErrValidation},
+		{name: "fail_negative_servers", userID: userID, limits: quota.Limits{Servers: -1}, wantErr: quota.ErrValidation},
+		{name: "fail_negative_cpu", userID: userID, limits: quota.Limits{CPUCores: -1}, wantErr: quota.ErrValidation},
+		{name: "fail_negative_ram", userID: userID, limits: quota.Limits{RAMMB: -1}, wantErr: quota.ErrValidation},
+		{name: "fail_negative_disk", userID: userID, limits: quota.Limits{DiskGB: -1}, wantErr: quota.ErrValidation},
+		{name: "fail_negative_ip", userID: userID, limits: quota.Limits{IPCount: -1}, wantErr: quota.ErrValidation},
+		{name: "fail_store", userID: userID, limits: valid, saveErr: errBoom, wantErr: errBoom, wantSaved: true},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved bool
+
+			st := &mockStorer{
+				SaveOverrideFunc: func(ctx context.Context, o quota.Override) error {
+					saved = true
+					if o.UserID != tt.userID || o.Limits != tt.limits {
+						t.Errorf("saved %+v, want limits %+v of %s", o, tt.limits, tt.userID)
+					}
+					if o.UpdatedAt.IsZero() {
+						t.Error("expected updated at to be set")
+					}
+					return tt.saveErr
+				},
+			}
+
+			bus := quota.NewBusiness(st)
+
+			got, err := bus.SetOverride(ctx, tt.userID, tt.limits)
+
+			if saved != tt.wantSaved {
+				t.Errorf("saved: got %v, want %v", saved, tt.wantSaved)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.UserID != tt.userID || got.Limits != tt.limits {
+				t.Errorf("got %+v, want limits %+v of %s", got, tt.limits, tt.userID)
+			}
+		})
+	}
+}
+
+func Test_SetDefault(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		name   string
+		limits quota.Limits
+
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success", limits: quota.Limits{Servers: 5, CPUCores: 16, RAMMB: 32768, DiskGB: 500, IPCount: 5}},
+		{name: "fail_negative", limits: quota.Limits{Servers: 5, DiskGB: -10}, wantErr: quota.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved bool
+
+			st := &mockStorer{
+				SaveDefaultFunc: func(ctx context.Context, limits quota.Limits) error {
+					saved = true
+					return nil
+				},
+			}
+
+			bus := quota.NewBusiness(st)
+
+			_, err := bus.SetDefault(ctx, tt.limits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if saved != (tt.wantErr == nil) {
+				t.Errorf("saved: got %v, want %v", saved, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func Test_Lock(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name    string
+		lockErr error
+	}
+
+	table := []testCase{
+		{name: "success"},
+		{name: "fail_store", lockErr: errBoom},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var locked uuid.UUID
+
+			st := &mockStorer{
+				LockFunc: func(ctx context.Context, ID uuid.UUID) error {
+					locked = ID
+					return tt.lockErr
+				},
+			}
+
+			bus := quota.NewBusiness(st)
+
+			err := bus.Lock(ctx, userID)
+			if !errors.Is(err, tt.lockErr) {
+				t.Errorf("got error %v, want %v", err, tt.lockErr)
+			}
+			if locked != userID {
+				t.Errorf("locked %s, want %s", locked, userID)
+			}
+		})
+	}
+}
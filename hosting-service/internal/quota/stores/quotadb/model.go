@@ -0,0 +1,88 @@
+package quotadb
+
+import (
+	"hosting-service/internal/quota"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type limitsDB struct {
+	MaxServers  int `db:"max_servers"`
+	MaxCPUCores int `db:"max_cpu_cores"`
+	MaxRAMMB    int `db:"max_ram_mb"`
+	MaxDiskGB   int `db:"max_disk_gb"`
+	MaxIPCount  int `db:"max_ip_count"`
+}
+
+type overrideDB struct {
+	UserID      uuid.UUID `db:"user_id"`
+	MaxServers  int       `db:"max_servers"`
+	MaxCPUCores int       `db:"max_cpu_cores"`
+	MaxRAMMB    int       `db:"max_ram_mb"`
+	MaxDiskGB   int       `db:"max_disk_gb"`
+	MaxIPCount  int       `db:"max_ip_count"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type usageDB struct {
+	Servers  int `db:"servers"`
+	CPUCores int `db:"cpu_cores"`
+	RAMMB    int `db:"ram_mb"`
+	DiskGB   int `db:"disk_gb"`
+	IPCount  int `db:"ip_count"`
+}
+
+func toBusLimits(db limitsDB) quota.Limits {
+	return quota.Limits{
+		Servers:  db.MaxServers,
+		CPUCores: db.MaxCPUCores,
+		RAMMB:    db.MaxRAMMB,
+		DiskGB:   db.MaxDiskGB,
+		IPCount:  db.MaxIPCount,
+	}
+}
+
+func toDBOverride(o quota.Override) overrideDB {
+	return overrideDB{
+		UserID:      o.UserID,
+		MaxServers:  o.Limits.Servers,
+		MaxCPUCores: o.Limits.CPUCores,
+		MaxRAMMB:    o.Limits.RAMMB,
+		MaxDiskGB:   o.Limits.DiskGB,
+		MaxIPCount:  o.Limits.IPCount,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+func toBusOverride(db overrideDB) quota.Override {
+	return quota.Override{
+		UserID: db.UserID,
+		Limits: quota.Limits{
+			Servers:  db.MaxServers,
+			CPUCores: db.MaxCPUCores,
+			RAMMB:    db.MaxRAMMB,
+			DiskGB:   db.MaxDiskGB,
+			IPCount:  db.MaxIPCount,
+		},
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+func toBusOverrides(dbs []overrideDB) []quota.Override {
+	overrides := make([]quota.Override, len(dbs))
+	for i, db := range dbs {
+		overrides[i] = toBusOverride(db)
+	}
+	return overrides
+}
+
+func toBusUsage(db usageDB) quota.Usage {
+	return quota.Usage{
+		Servers:  db.Servers,
+		CPUCores: db.CPUCores,
+		RAMMB:    db.RAMMB,
+		DiskGB:   db.DiskGB,
+		IPCount:  db.IPCount,
+	}
+}
@@ -0,0 +1,271 @@
+package quotadb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/quota"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) FindDefault(ctx context.Context) (quota.Limits, error) {
+	const q = `
+	SELECT
+		max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count
+	FROM
+		quota_defaults`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q)
+	if err != nil {
+		return quota.Limits{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbLimits, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[limitsDB])
+	if err != nil {
+		return quota.Limits{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusLimits(dbLimits), nil
+}
+
+func (s *Store) SaveDefault(ctx context.Context, limits quota.Limits) error {
+	const q = `
+	INSERT INTO quota_defaults
+		(id, max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count)
+	VALUES
+		(TRUE, @max_servers, @max_cpu_cores, @max_ram_mb, @max_disk_gb, @max_ip_count)
+	ON CONFLICT (id) DO UPDATE SET
+		max_servers = EXCLUDED.max_servers,
+		max_cpu_cores = EXCLUDED.max_cpu_cores,
+		max_ram_mb = EXCLUDED.max_ram_mb,
+		max_disk_gb = EXCLUDED.max_disk_gb,
+		max_ip_count = EXCLUDED.max_ip_count`
+
+	args := pgx.NamedArgs{
+		"max_servers":   limits.Servers,
+		"max_cpu_cores": limits.CPUCores,
+		"max_ram_mb":    limits.RAMMB,
+		"max_disk_gb":   limits.DiskGB,
+		"max_ip_count":  limits.IPCount,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindOverride(ctx context.Context, userID uuid.UUID) (quota.Override, error) {
+	const q = `
+	SELECT
+		user_id, max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count, updated_at
+	FROM
+		user_quotas
+	WHERE
+		user_id = @user_id`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return quota.Override{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbOverride, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[overrideDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return quota.Override{}, quota.ErrOverrideNotFound
+		}
+		return quota.Override{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusOverride(dbOverride), nil
+}
+
+func (s *Store) FindAllOverrides(ctx context.Context, pg page.Page) ([]quota.Override, int, error) {
+	const qCount = `SELECT count(*) FROM user_quotas`
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	if total == 0 {
+		return []quota.Override{}, 0, nil
+	}
+
+	const qSelect = `
+	SELECT
+		user_id, max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count, updated_at
+	FROM
+		user_quotas
+	ORDER BY
+		user_id ASC
+	LIMIT @limit OFFSET @offset`
+
+	args := pgx.NamedArgs{
+		"limit":  pg.Size(),
+		"offset": pg.Offset(),
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, qSelect, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbOverrides, err := pgx.CollectRows(rows, pgx.RowToStructByName[overrideDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusOverrides(dbOverrides), total, nil
+}
+
+func (s *Store) SaveOverride(ctx context.Context, o quota.Override) error {
+	const q = `
+	INSERT INTO user_quotas
+		(user_id, max_servers, max_cpu_cores, max_ram_mb, max_disk_gb, max_ip_count, updated_at)
+	VALUES
+		(@user_id, @max_servers, @max_cpu_cores, @max_ram_mb, @max_disk_gb, @max_ip_count, @updated_at)
+	ON CONFLICT (user_id) DO UPDATE SET
+		max_servers = EXCLUDED.max_servers,
+		max_cpu_cores = EXCLUDED.max_cpu_cores,
+		max_ram_mb = EXCLUDED.max_ram_mb,
+		max_disk_gb = EXCLUDED.max_disk_gb,
+		max_ip_count = EXCLUDED.max_ip_count,
+		updated_at = EXCLUDED.updated_at`
+
+	dbOverride := toDBOverride(o)
+
+	args := pgx.NamedArgs{
+		"user_id":       dbOverride.UserID,
+		"max_servers":   dbOverride.MaxServers,
+		"max_cpu_cores": dbOverride.MaxCPUCores,
+		"max_ram_mb":    dbOverride.MaxRAMMB,
+		"max_disk_gb":   dbOverride.MaxDiskGB,
+		"max_ip_count":  dbOverride.MaxIPCount,
+		"updated_at":    dbOverride.UpdatedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteOverride(ctx context.Context, userID uuid.UUID) error {
+	const q = `DELETE FROM user_quotas WHERE user_id = @user_id`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return quota.ErrOverrideNotFound
+	}
+
+	return nil
+}
+
+// Usage sums the plans of every server the user holds, whatever its status,
+// since each of them keeps its pool reservation until it is removed, and the
+// resources of orders that have no server yet.
+func (s *Store) Usage(ctx context.Context, userID uuid.UUID) (quota.Usage, error) {
+	const q = `
+	SELECT
+		count(*)::INT AS servers,
+		COALESCE(SUM(h.cpu_cores), 0)::INT AS cpu_cores,
+		COALESCE(SUM(h.ram_mb), 0)::INT AS ram_mb,
+		COALESCE(SUM(h.disk_gb), 0)::INT AS disk_gb,
+		COALESCE(SUM(h.ip_count), 0)::INT AS ip_count
+	FROM (
+		SELECT
+			p.cpu_cores, p.ram_mb, p.disk_gb, p.ip_count
+		FROM
+			servers s
+		JOIN
+			plans p ON p.id = s.plan_id
+		WHERE
+			s.owner_id = @user_id
+		UNION ALL
+		SELECT
+			g.cpu_cores, g.ram_mb, g.disk_gb, g.ip_count
+		FROM
+			server_sagas g
+		WHERE
+			g.user_id = @user_id AND g.kind = 'ORDER' AND g.state IN ('STARTED', 'RESERVED')
+	) h`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return quota.Usage{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbUsage, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[usageDB])
+	if err != nil {
+		return quota.Usage{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusUsage(dbUsage), nil
+}
+
+// Lock takes the quota lock row of the user until the end of the transaction
+// in ctx, creating the row on first use.
+func (s *Store) Lock(ctx context.Context, userID uuid.UUID) error {
+	const qInsert = `
+	INSERT INTO quota_locks
+		(user_id)
+	VALUES
+		(@user_id)
+	ON CONFLICT (user_id) DO NOTHING`
+
+	const qLock = `
+	SELECT
+		user_id
+	FROM
+		quota_locks
+	WHERE
+		user_id = @user_id
+	FOR UPDATE`
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, qInsert, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	var locked uuid.UUID
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qLock, args).Scan(&locked); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package regionotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-service/internal/region"
+)
+
+type Extension struct {
+	bus region.ExtBusiness
+}
+
+func NewExtension() region.Extension {
+	return func(bus region.ExtBusiness) region.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Search(ctx context.Context) ([]region.Region, error) {
+	ctx, span := otel.AddSpan(ctx, "region.search")
+	defer span.End()
+
+	return e.bus.Search(ctx)
+}
@@ -0,0 +1,17 @@
+package region
+
+import "hosting-service/internal/server"
+
+// Pool is a pool as reported by the resources service.
+type Pool struct {
+	Region    string
+	Available server.Resources
+}
+
+// Region is a location servers can be ordered in. Available sums what its
+// pools have left; a server still has to fit into a single pool.
+type Region struct {
+	Name      string
+	Pools     int
+	Available server.Resources
+}
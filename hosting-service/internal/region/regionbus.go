@@ -0,0 +1,75 @@
+package region
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type PoolLister interface {
+	ListPools(ctx context.Context) ([]Pool, error)
+}
+
+type ExtBusiness interface {
+	Search(ctx context.Context) ([]Region, error)
+}
+
+type Business struct {
+	pools      PoolLister
+	extensions []Extension
+}
+
+func NewBusiness(pools PoolLister, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		pools:      pools,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Search lists the regions of all pools by name, with the resources their
+// pools have left.
+func (b *Business) Search(ctx context.Context) ([]Region, error) {
+	pools, err := b.pools.ListPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listpools: %w", err)
+	}
+
+	byName := make(map[string]*Region)
+	for _, p := range pools {
+		r, ok := byName[p.Region]
+		if !ok {
+			r = &Region{Name: p.Region}
+			byName[p.Region] = r
+		}
+
+		r.Pools++
+		r.Available.CPUCores += p.Available.CPUCores
+		r.Available.RAMMB += p.Available.RAMMB
+		r.Available.DiskGB += p.Available.DiskGB
+		r.Available.IPCount += p.Available.IPCount
+	}
+
+	regions := make([]Region, 0, len(byName))
+	for _, r := range byName {
+		regions = append(regions, *r)
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Name < regions[j].Name
+	})
+
+	return regions, nil
+}
@@ -0,0 +1,88 @@
+package region_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"hosting-service/internal/region"
+	"hosting-service/internal/server"
+)
+
+type mockPoolLister struct {
+	ListPoolsFunc func(ctx context.Context) ([]region.Pool, error)
+}
+
+func (m *mockPoolLister) ListPools(ctx context.Context) ([]region.Pool, error) {
+	if m.ListPoolsFunc != nil {
+		return m.ListPoolsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func res(cpu, ram, disk, ip int) server.Resources {
+	return server.Resources{CPUCores: cpu, RAMMB: ram, DiskGB: disk, IPCount: ip}
+}
+
+func Test_Search(t *testing.T) {
+	ctx := context.Background()
+	errList := errors.New("resources service unavailable")
+
+	type testCase struct {
+		name    string
+		pools   []region.Pool
+		listErr error
+		want    []region.Region
+		wantErr error
+	}
+
+	table := []testCase{
+		{
+			name: "success",
+			pools: []region.Pool{
+				{Region: "eu-west", Available: res(8, 16384, 200, 4)},
+				{Region: "eu-central", Available: res(4, 8192, 100, 2)},
+				{Region: "eu-west", Available: res(0, 0, 0, 0)},
+				{Region: "eu-central", Available: res(2, 4096, 50, 1)},
+			},
+			want: []region.Region{
+				{Name: "eu-central", Pools: 2, Available: res(6, 12288, 150, 3)},
+				{Name: "eu-west", Pools: 2, Available: res(8, 16384, 200, 4)},
+			},
+		},
+		{name: "success_no_pools", want: []region.Region{}},
+		{name: "fail_list", listErr: errList, wantErr: errList},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := &mockPoolLister{
+				ListPoolsFunc: func(ctx context.Context) ([]region.Pool, error) {
+					return tt.pools, tt.listErr
+				},
+			}
+
+			bus := region.NewBusiness(pl)
+
+			got, err := bus.Search(ctx)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d regions, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("region %d: got %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
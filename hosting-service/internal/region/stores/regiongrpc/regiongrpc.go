@@ -0,0 +1,48 @@
+package regiongrpc
+
+import (
+	"context"
+	"fmt"
+	"hosting-service/internal/region"
+	"hosting-service/internal/server"
+	"hosting-service/internal/server/stores/servergrpc/gen"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type PoolLister struct {
+	client  gen.ResourcesClient
+	timeOut time.Duration
+}
+
+func NewGrpc(client *grpc.ClientConn, timeOut time.Duration) *PoolLister {
+	return &PoolLister{client: gen.NewResourcesClient(client), timeOut: timeOut}
+}
+
+func (r *PoolLister) ListPools(ctx context.Context) ([]region.Pool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
+	defer cancel()
+
+	resp, err := r.client.ListPools(ctx, &gen.ListPoolsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("grpc: %w", err)
+	}
+
+	pools := make([]region.Pool, len(resp.GetPools()))
+	for i, state := range resp.GetPools() {
+		available := state.GetAvailable()
+
+		pools[i] = region.Pool{
+			Region: state.GetRegion(),
+			Available: server.Resources{
+				CPUCores: int(available.GetCpuCores()),
+				RAMMB:    int(available.GetRamMb()),
+				DiskGB:   int(available.GetDiskGb()),
+				IPCount:  int(available.GetIpCount()),
+			},
+		}
+	}
+
+	return pools, nil
+}
@@ -0,0 +1,71 @@
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hosting-service/internal/schedule"
+)
+
+func Test_ParseCron(t *testing.T) {
+	table := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "every_minute", expr: "* * * * *"},
+		{name: "lists_ranges_steps", expr: "0,30 8-18/2 1-15 */3 1-5"},
+		{name: "sunday_as_7", expr: "0 0 * * 7"},
+		{name: "too_few_fields", expr: "* * * *", wantErr: true},
+		{name: "out_of_range", expr: "60 * * * *", wantErr: true},
+		{name: "reversed_range", expr: "* 18-8 * * *", wantErr: true},
+		{name: "bad_step", expr: "*/0 * * * *", wantErr: true},
+		{name: "not_a_number", expr: "* * * jan *", wantErr: true},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schedule.ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, schedule.ErrValidation) {
+				t.Errorf("expected %v, got %v", schedule.ErrValidation, err)
+			}
+		})
+	}
+}
+
+func Test_CronNext(t *testing.T) {
+	// 2026-03-04 is a Wednesday.
+	from := time.Date(2026, 3, 4, 10, 17, 30, 0, time.UTC)
+
+	table := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{name: "every_minute", expr: "* * * * *", want: time.Date(2026, 3, 4, 10, 18, 0, 0, time.UTC)},
+		{name: "quarter_hours", expr: "*/15 * * * *", want: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)},
+		{name: "tomorrow_morning", expr: "0 8 * * *", want: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)},
+		{name: "next_sunday", expr: "0 0 * * 7", want: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{name: "next_month", expr: "0 0 1 * *", want: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day_of_month_or_week", expr: "0 0 13 * 5", want: time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "leap_day", expr: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "never", expr: "0 0 30 2 *"},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := schedule.ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := c.Next(from); !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
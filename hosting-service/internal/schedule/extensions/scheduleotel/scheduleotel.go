@@ -0,0 +1,64 @@
+package scheduleotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/schedule"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus schedule.ExtBusiness
+}
+
+func NewExtension() schedule.Extension {
+	return func(bus schedule.ExtBusiness) schedule.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Create(ctx context.Context, serverID uuid.UUID, ns schedule.NewSchedule, userID uuid.UUID) (schedule.Schedule, error) {
+	ctx, span := otel.AddSpan(ctx, "schedule.create")
+	defer span.End()
+
+	return e.bus.Create(ctx, serverID, ns, userID)
+}
+
+func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (schedule.Schedule, error) {
+	ctx, span := otel.AddSpan(ctx, "schedule.findbyid")
+	defer span.End()
+
+	return e.bus.FindByID(ctx, ID, userID)
+}
+
+func (e *Extension) Search(ctx context.Context, filter schedule.QueryFilter, pg page.Page, userID uuid.UUID) ([]schedule.Schedule, int, error) {
+	ctx, span := otel.AddSpan(ctx, "schedule.search")
+	defer span.End()
+
+	return e.bus.Search(ctx, filter, pg, userID)
+}
+
+func (e *Extension) Update(ctx context.Context, ID uuid.UUID, us schedule.UpdateSchedule, userID uuid.UUID) (schedule.Schedule, error) {
+	ctx, span := otel.AddSpan(ctx, "schedule.update")
+	defer span.End()
+
+	return e.bus.Update(ctx, ID, us, userID)
+}
+
+func (e *Extension) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "schedule.delete")
+	defer span.End()
+
+	return e.bus.Delete(ctx, ID, userID)
+}
+
+func (e *Extension) RunDue(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "schedule.rundue")
+	defer span.End()
+
+	return e.bus.RunDue(ctx, limit)
+}
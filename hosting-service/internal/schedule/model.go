@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPerServer limits the schedules of a single server.
+const MaxPerServer = 20
+
+// Schedule runs a power action on a server, once at RunAt or recurring on
+// Cron. Exactly one of RunAt and Cron is set.
+type Schedule struct {
+	ID       uuid.UUID
+	ServerID uuid.UUID
+	OwnerID  uuid.UUID
+	Action   server.ActionType
+
+	RunAt *time.Time
+	Cron  *string
+
+	// Timezone is the IANA name the cron expression is evaluated in.
+	Timezone string
+
+	Enabled bool
+
+	// NextRunAt is nil once a one-shot schedule ran or while the schedule is
+	// disabled.
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+
+	// LastError tells why the last run did not change the server, e.g. a
+	// STOP for a server that was already stopped.
+	LastError *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NewSchedule struct {
+	Action   server.ActionType
+	RunAt    *time.Time
+	Cron     *string
+	Timezone string
+}
+
+// UpdateSchedule changes the fields that are set. Setting RunAt turns the
+// schedule into a one-shot one, setting Cron into a recurring one.
+type UpdateSchedule struct {
+	Action   *server.ActionType
+	RunAt    *time.Time
+	Cron     *string
+	Timezone *string
+	Enabled  *bool
+}
+
+// QueryFilter narrows a schedule listing. Nil fields are not applied.
+type QueryFilter struct {
+	OwnerID  *uuid.UUID
+	ServerID *uuid.UUID
+}
@@ -0,0 +1,361 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrScheduleNotFound = errors.New("schedule not found")
+	ErrValidation       = errors.New("validation error")
+	ErrAccessDenied     = errors.New("access denied")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	FindByID(ctx context.Context, ID uuid.UUID) (Schedule, error)
+	Create(ctx context.Context, sched Schedule) error
+	Update(ctx context.Context, sched Schedule) error
+	Delete(ctx context.Context, ID uuid.UUID) error
+	FindAll(ctx context.Context, filter QueryFilter, pg page.Page) ([]Schedule, int, error)
+	CountByServerID(ctx context.Context, serverID uuid.UUID) (int, error)
+	// FindNextDue locks the enabled schedule that is due the longest, or
+	// returns ErrScheduleNotFound. Rows locked by other workers are skipped.
+	FindNextDue(ctx context.Context, now time.Time) (Schedule, error)
+}
+
+// ServerManager is the part of the server business schedules work with.
+type ServerManager interface {
+	Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error)
+	Start(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+	Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+	Reboot(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ExtBusiness interface {
+	Create(ctx context.Context, serverID uuid.UUID, ns NewSchedule, userID uuid.UUID) (Schedule, error)
+	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Schedule, error)
+	Search(ctx context.Context, filter QueryFilter, pg page.Page, userID uuid.UUID) ([]Schedule, int, error)
+	Update(ctx context.Context, ID uuid.UUID, us UpdateSchedule, userID uuid.UUID) (Schedule, error)
+	Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error
+	RunDue(ctx context.Context, limit int) (int, error)
+}
+
+type Config struct {
+	// MaxDelay is how late a run may start, e.g. after an outage. Older runs
+	// are skipped instead of changing the server at an unexpected time.
+	MaxDelay time.Duration
+}
+
+type Business struct {
+	cfg        Config
+	storer     Storer
+	servers    ServerManager
+	tx         Transactor
+	extensions []Extension
+}
+
+func NewBusiness(cfg Config, storer Storer, servers ServerManager, tx Transactor, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		cfg:        cfg,
+		storer:     storer,
+		servers:    servers,
+		tx:         tx,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Create adds a schedule to a server the user may operate. The schedule
+// belongs to the user and runs with their role in the project of the server,
+// so it stops working once they lose it.
+func (b *Business) Create(ctx context.Context, serverID uuid.UUID, ns NewSchedule, userID uuid.UUID) (Schedule, error) {
+	srv, err := b.servers.Authorize(ctx, serverID, userID, project.PermOperate)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("create: %w", err)
+	}
+
+	count, err := b.storer.CountByServerID(ctx, srv.ID)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("countbyserverid: %w", err)
+	}
+	if count >= MaxPerServer {
+		return Schedule{}, fmt.Errorf("%w: a server can have at most %d schedules", ErrValidation, MaxPerServer)
+	}
+
+	now := time.Now().UTC()
+
+	sched := Schedule{
+		ID:        uuid.New(),
+		ServerID:  srv.ID,
+		OwnerID:   userID,
+		Action:    ns.Action,
+		RunAt:     ns.RunAt,
+		Cron:      ns.Cron,
+		Timezone:  ns.Timezone,
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if sched.Timezone == "" {
+		sched.Timezone = "UTC"
+	}
+
+	if err := b.arm(&sched, now); err != nil {
+		return Schedule{}, err
+	}
+
+	if err := b.storer.Create(ctx, sched); err != nil {
+		return Schedule{}, fmt.Errorf("create: %w", err)
+	}
+
+	return sched, nil
+}
+
+func (b *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Schedule, error) {
+	sched, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	if sched.OwnerID != userID {
+		return Schedule{}, ErrAccessDenied
+	}
+
+	return sched, nil
+}
+
+func (b *Business) Search(ctx context.Context, filter QueryFilter, pg page.Page, userID uuid.UUID) ([]Schedule, int, error) {
+	filter.OwnerID = &userID
+
+	schedules, count, err := b.storer.FindAll(ctx, filter, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	return schedules, count, nil
+}
+
+// Update changes a schedule and works out its next run again. A one-shot
+// schedule can only be enabled again with a run time in the future.
+func (b *Business) Update(ctx context.Context, ID uuid.UUID, us UpdateSchedule, userID uuid.UUID) (Schedule, error) {
+	sched, err := b.FindByID(ctx, ID, userID)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	if us.RunAt != nil && us.Cron != nil {
+		return Schedule{}, fmt.Errorf("%w: set either a run time or a cron expression", ErrValidation)
+	}
+
+	if us.Action != nil {
+		sched.Action = *us.Action
+	}
+	if us.RunAt != nil {
+		sched.RunAt = us.RunAt
+		sched.Cron = nil
+	}
+	if us.Cron != nil {
+		sched.Cron = us.Cron
+		sched.RunAt = nil
+	}
+	if us.Timezone != nil {
+		sched.Timezone = *us.Timezone
+	}
+	if us.Enabled != nil {
+		sched.Enabled = *us.Enabled
+	}
+
+	now := time.Now().UTC()
+	sched.UpdatedAt = now
+
+	if err := b.arm(&sched, now); err != nil {
+		return Schedule{}, err
+	}
+
+	if err := b.storer.Update(ctx, sched); err != nil {
+		return Schedule{}, fmt.Errorf("update: %w", err)
+	}
+
+	return sched, nil
+}
+
+func (b *Business) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	if _, err := b.FindByID(ctx, ID, userID); err != nil {
+		return err
+	}
+
+	if err := b.storer.Delete(ctx, ID); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	return nil
+}
+
+// RunDue runs up to limit due schedules and returns how many ran. Each run
+// locks its schedule, acts on the server as its owner and moves the schedule
+// on in one transaction, so the provisioning command and the new next run
+// are committed together and a restart never repeats an action.
+func (b *Business) RunDue(ctx context.Context, limit int) (int, error) {
+	ran := 0
+
+	for ran < limit {
+		found := false
+
+		err := b.tx.WithinTran(ctx, func(ctx context.Context) error {
+			now := time.Now().UTC()
+
+			sched, err := b.storer.FindNextDue(ctx, now)
+			if err != nil {
+				if errors.Is(err, ErrScheduleNotFound) {
+					return nil
+				}
+				return fmt.Errorf("findnextdue: %w", err)
+			}
+			found = true
+
+			if err := b.run(ctx, &sched, now); err != nil {
+				return fmt.Errorf("run[%s]: %w", sched.ID, err)
+			}
+
+			if err := b.storer.Update(ctx, sched); err != nil {
+				return fmt.Errorf("update[%s]: %w", sched.ID, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return ran, fmt.Errorf("rundue: %w", err)
+		}
+
+		if !found {
+			break
+		}
+		ran++
+	}
+
+	return ran, nil
+}
+
+// run performs the action of a due schedule and moves it to its next run.
+// Refusals of the server business are kept in LastError; any other error
+// is returned, so the transaction rolls back and the run is tried again.
+func (b *Business) run(ctx context.Context, sched *Schedule, now time.Time) error {
+	due := *sched.NextRunAt
+
+	sched.LastRunAt = &now
+	sched.LastError = nil
+	sched.UpdatedAt = now
+
+	if b.cfg.MaxDelay > 0 && now.Sub(due) > b.cfg.MaxDelay {
+		reason := fmt.Sprintf("run due at %s was skipped, it is more than %s late", due.Format(time.RFC3339), b.cfg.MaxDelay)
+		sched.LastError = &reason
+	} else if err := b.act(ctx, *sched); err != nil {
+		if !errors.Is(err, server.ErrValidation) && !errors.Is(err, server.ErrAccessDenied) && !errors.Is(err, server.ErrServerNotFound) {
+			return err
+		}
+		reason := err.Error()
+		sched.LastError = &reason
+	}
+
+	if sched.Cron == nil {
+		sched.Enabled = false
+		sched.NextRunAt = nil
+		return nil
+	}
+
+	return b.arm(sched, now)
+}
+
+func (b *Business) act(ctx context.Context, sched Schedule) error {
+	var err error
+
+	switch sched.Action {
+	case server.ActionStart:
+		_, err = b.servers.Start(ctx, sched.ServerID, sched.OwnerID)
+	case server.ActionStop:
+		_, err = b.servers.Stop(ctx, sched.ServerID, sched.OwnerID)
+	case server.ActionReboot:
+		_, err = b.servers.Reboot(ctx, sched.ServerID, sched.OwnerID)
+	default:
+		err = fmt.Errorf("%w: action '%s' cannot be scheduled", server.ErrValidation, sched.Action)
+	}
+
+	return err
+}
+
+// arm validates the schedule and sets its next run after now. Disabled
+// schedules have no next run.
+func (b *Business) arm(sched *Schedule, now time.Time) error {
+	switch sched.Action {
+	case server.ActionStart, server.ActionStop, server.ActionReboot:
+	default:
+		return fmt.Errorf("%w: action '%s' cannot be scheduled, expected START, STOP or REBOOT", ErrValidation, sched.Action)
+	}
+
+	loc, err := time.LoadLocation(sched.Timezone)
+	if err != nil {
+		return fmt.Errorf("%w: unknown timezone '%s'", ErrValidation, sched.Timezone)
+	}
+
+	var next time.Time
+
+	switch {
+	case sched.RunAt != nil && sched.Cron != nil:
+		return fmt.Errorf("%w: set either a run time or a cron expression", ErrValidation)
+
+	case sched.RunAt != nil:
+		runAt := sched.RunAt.UTC()
+		sched.RunAt = &runAt
+		if sched.Enabled && !runAt.After(now) {
+			return fmt.Errorf("%w: run time must be in the future", ErrValidation)
+		}
+		next = runAt
+
+	case sched.Cron != nil:
+		cron, err := ParseCron(*sched.Cron)
+		if err != nil {
+			return err
+		}
+		next = cron.Next(now.In(loc))
+		if next.IsZero() {
+			return fmt.Errorf("%w: cron expression '%s' never matches", ErrValidation, *sched.Cron)
+		}
+		next = next.UTC()
+
+	default:
+		return fmt.Errorf("%w: set either a run time or a cron expression", ErrValidation)
+	}
+
+	if !sched.Enabled {
+		sched.NextRunAt = nil
+		return nil
+	}
+
+	sched.NextRunAt = &next
+
+	return nil
+}
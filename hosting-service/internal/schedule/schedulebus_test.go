@@ -0,0 +1,462 @@
+package schedule_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+	"hosting-service/internal/schedule"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+)
+
+type mockStorer struct {
+	FindByIDFunc        func(ctx context.Context, ID uuid.UUID) (schedule.Schedule, error)
+	CreateFunc          func(ctx context.Context, sched schedule.Schedule) error
+	UpdateFunc          func(ctx context.Context, sched schedule.Schedule) error
+	DeleteFunc          func(ctx context.Context, ID uuid.UUID) error
+	FindAllFunc         func(ctx context.Context, filter schedule.QueryFilter, pg page.Page) ([]schedule.Schedule, int, error)
+	CountByServerIDFunc func(ctx context.Context, serverID uuid.UUID) (int, error)
+	FindNextDueFunc     func(ctx context.Context, now time.Time) (schedule.Schedule, error)
+}
+
+func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (schedule.Schedule, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return schedule.Schedule{}, schedule.ErrScheduleNotFound
+}
+
+func (m *mockStorer) Create(ctx context.Context, sched schedule.Schedule) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, sched)
+	}
+	return nil
+}
+
+func (m *mockStorer) Update(ctx context.Context, sched schedule.Schedule) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, sched)
+	}
+	return nil
+}
+
+func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, ID)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindAll(ctx context.Context, filter schedule.QueryFilter, pg page.Page) ([]schedule.Schedule, int, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx, filter, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) CountByServerID(ctx context.Context, serverID uuid.UUID) (int, error) {
+	if m.CountByServerIDFunc != nil {
+		return m.CountByServerIDFunc(ctx, serverID)
+	}
+	return 0, nil
+}
+
+func (m *mockStorer) FindNextDue(ctx context.Context, now time.Time) (schedule.Schedule, error) {
+	if m.FindNextDueFunc != nil {
+		return m.FindNextDueFunc(ctx, now)
+	}
+	return schedule.Schedule{}, schedule.ErrScheduleNotFound
+}
+
+type mockServerManager struct {
+	AuthorizeFunc func(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error)
+	ActionFunc    func(ctx context.Context, action server.ActionType, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+}
+
+func (m *mockServerManager) Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error) {
+	if m.AuthorizeFunc != nil {
+		return m.AuthorizeFunc(ctx, serverID, userID, perm)
+	}
+	return server.Server{ID: serverID, OwnerID: userID}, nil
+}
+
+func (m *mockServerManager) Start(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	return m.action(ctx, server.ActionStart, serverID, userID)
+}
+
+func (m *mockServerManager) Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	return m.action(ctx, server.ActionStop, serverID, userID)
+}
+
+func (m *mockServerManager) Reboot(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	return m.action(ctx, server.ActionReboot, serverID, userID)
+}
+
+func (m *mockServerManager) action(ctx context.Context, action server.ActionType, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	if m.ActionFunc != nil {
+		return m.ActionFunc(ctx, action, serverID, userID)
+	}
+	return server.Server{ID: serverID, OwnerID: userID}, nil
+}
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTran(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func Test_Create(t *testing.T) {
+	ctx := context.Background()
+	serverID := uuid.New()
+	userID := uuid.New()
+
+	type testCase struct {
+		name      string
+		ns        schedule.NewSchedule
+		count     int
+		findErr   error
+		wantErr   error
+		wantCheck func(t *testing.T, sched schedule.Schedule)
+	}
+
+	table := []testCase{
+		{
+			name: "one_shot",
+			ns:   schedule.NewSchedule{Action: server.ActionStop, RunAt: ptr(time.Now().Add(time.Hour))},
+			wantCheck: func(t *testing.T, sched schedule.Schedule) {
+				if sched.NextRunAt == nil || !sched.NextRunAt.Equal(*sched.RunAt) {
+					t.Errorf("next run: got %v, want %v", sched.NextRunAt, sched.RunAt)
+				}
+				if sched.Timezone != "UTC" {
+					t.Errorf("timezone: got %q, want UTC", sched.Timezone)
+				}
+			},
+		},
+		{
+			name: "cron_in_timezone",
+			ns:   schedule.NewSchedule{Action: server.ActionStart, Cron: ptr("0 8 * * *"), Timezone: "Europe/Berlin"},
+			wantCheck: func(t *testing.T, sched schedule.Schedule) {
+				loc, _ := time.LoadLocation("Europe/Berlin")
+				next := sched.NextRunAt.In(loc)
+				if next.Hour() != 8 || next.Minute() != 0 || !next.After(time.Now()) {
+					t.Errorf("next run: got %s, want 08:00 Berlin time in the future", next)
+				}
+				if sched.NextRunAt.Location() != time.UTC {
+					t.Errorf("next run must be stored in UTC")
+				}
+			},
+		},
+		{
+			name:    "run_at_in_past",
+			ns:      schedule.NewSchedule{Action: server.ActionStop, RunAt: ptr(time.Now().Add(-time.Minute))},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "both_run_at_and_cron",
+			ns:      schedule.NewSchedule{Action: server.ActionStop, RunAt: ptr(time.Now().Add(time.Hour)), Cron: ptr("* * * * *")},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "neither_run_at_nor_cron",
+			ns:      schedule.NewSchedule{Action: server.ActionStop},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "action_not_schedulable",
+			ns:      schedule.NewSchedule{Action: server.ActionType("DELETE"), Cron: ptr("* * * * *")},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "unknown_timezone",
+			ns:      schedule.NewSchedule{Action: server.ActionStop, Cron: ptr("* * * * *"), Timezone: "Mars/Olympus"},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "too_many",
+			ns:      schedule.NewSchedule{Action: server.ActionStop, Cron: ptr("* * * * *")},
+			count:   schedule.MaxPerServer,
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "foreign_server",
+			ns:      schedule.NewSchedule{Action: server.ActionStop, Cron: ptr("* * * * *")},
+			findErr: server.ErrAccessDenied,
+			wantErr: server.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			created := 0
+
+			st := &mockStorer{
+				CountByServerIDFunc: func(ctx context.Context, id uuid.UUID) (int, error) {
+					return tt.count, nil
+				},
+				CreateFunc: func(ctx context.Context, sched schedule.Schedule) error {
+					created++
+					return nil
+				},
+			}
+			sm := &mockServerManager{
+				AuthorizeFunc: func(ctx context.Context, ID uuid.UUID, uID uuid.UUID, perm project.Permission) (server.Server, error) {
+					if perm != project.PermOperate {
+						t.Errorf("authorized for %s, want %s", perm, project.PermOperate)
+					}
+					if tt.findErr != nil {
+						return server.Server{}, tt.findErr
+					}
+					return server.Server{ID: ID, OwnerID: uuid.New()}, nil
+				},
+			}
+
+			bus := schedule.NewBusiness(schedule.Config{}, st, sm, &mockTransactor{})
+
+			sched, err := bus.Create(ctx, serverID, tt.ns, userID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if created != 0 {
+					t.Errorf("schedule stored despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if sched.ServerID != serverID || sched.OwnerID != userID || !sched.Enabled {
+				t.Errorf("unexpected schedule: %+v", sched)
+			}
+			if created != 1 {
+				t.Errorf("expected the schedule to be stored once, got %d", created)
+			}
+			tt.wantCheck(t, sched)
+		})
+	}
+}
+
+func Test_RunDue(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name        string
+		sched       func() schedule.Schedule
+		maxDelay    time.Duration
+		actionErr   error
+		wantErr     error
+		wantRan     int
+		wantActions int
+		wantEnabled bool
+		wantNext    bool
+		wantLastErr bool
+	}
+
+	oneShot := func() schedule.Schedule {
+		due := time.Now().UTC().Add(-time.Second)
+		return schedule.Schedule{ID: uuid.New(), ServerID: uuid.New(), OwnerID: uuid.New(), Action: server.ActionStop, RunAt: &due, Timezone: "UTC", Enabled: true, NextRunAt: &due}
+	}
+	recurring := func() schedule.Schedule {
+		due := time.Now().UTC().Add(-time.Second)
+		return schedule.Schedule{ID: uuid.New(), ServerID: uuid.New(), OwnerID: uuid.New(), Action: server.ActionReboot, Cron: ptr("*/5 * * * *"), Timezone: "UTC", Enabled: true, NextRunAt: &due}
+	}
+	late := func() schedule.Schedule {
+		sched := recurring()
+		due := time.Now().UTC().Add(-2 * time.Hour)
+		sched.NextRunAt = &due
+		return sched
+	}
+
+	table := []testCase{
+		{
+			name:        "one_shot_disabled_after_run",
+			sched:       oneShot,
+			wantRan:     1,
+			wantActions: 1,
+		},
+		{
+			name:        "cron_rearmed",
+			sched:       recurring,
+			wantRan:     1,
+			wantActions: 1,
+			wantEnabled: true,
+			wantNext:    true,
+		},
+		{
+			name:        "refusal_recorded",
+			sched:       recurring,
+			actionErr:   server.ErrValidation,
+			wantRan:     1,
+			wantActions: 1,
+			wantEnabled: true,
+			wantNext:    true,
+			wantLastErr: true,
+		},
+		{
+			name:        "late_run_skipped",
+			sched:       late,
+			maxDelay:    time.Hour,
+			wantRan:     1,
+			wantEnabled: true,
+			wantNext:    true,
+			wantLastErr: true,
+		},
+		{
+			name:        "failure_rolls_back",
+			sched:       oneShot,
+			actionErr:   errBoom,
+			wantErr:     errBoom,
+			wantActions: 1,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			pending := []schedule.Schedule{tt.sched()}
+			var updated []schedule.Schedule
+			actions := 0
+
+			st := &mockStorer{
+				FindNextDueFunc: func(ctx context.Context, now time.Time) (schedule.Schedule, error) {
+					if len(pending) == 0 {
+						return schedule.Schedule{}, schedule.ErrScheduleNotFound
+					}
+					return pending[0], nil
+				},
+				UpdateFunc: func(ctx context.Context, sched schedule.Schedule) error {
+					pending = pending[1:]
+					updated = append(updated, sched)
+					return nil
+				},
+			}
+			sm := &mockServerManager{
+				ActionFunc: func(ctx context.Context, action server.ActionType, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+					actions++
+					want := pending[0]
+					if action != want.Action || serverID != want.ServerID || userID != want.OwnerID {
+						t.Errorf("unexpected action %s on %s as %s", action, serverID, userID)
+					}
+					return server.Server{}, tt.actionErr
+				},
+			}
+
+			bus := schedule.NewBusiness(schedule.Config{MaxDelay: tt.maxDelay}, st, sm, &mockTransactor{})
+
+			ran, err := bus.RunDue(ctx, 10)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if ran != tt.wantRan {
+				t.Errorf("ran: got %d, want %d", ran, tt.wantRan)
+			}
+			if actions != tt.wantActions {
+				t.Errorf("actions: got %d, want %d", actions, tt.wantActions)
+			}
+
+			if tt.wantErr != nil {
+				if len(updated) != 0 {
+					t.Errorf("failed run must not move the schedule")
+				}
+				return
+			}
+
+			if len(updated) != 1 {
+				t.Fatalf("expected 1 update, got %d", len(updated))
+			}
+
+			sched := updated[0]
+			if sched.Enabled != tt.wantEnabled {
+				t.Errorf("enabled: got %v, want %v", sched.Enabled, tt.wantEnabled)
+			}
+			if (sched.NextRunAt != nil) != tt.wantNext {
+				t.Errorf("next run: got %v, want set %v", sched.NextRunAt, tt.wantNext)
+			}
+			if sched.NextRunAt != nil && !sched.NextRunAt.After(time.Now()) {
+				t.Errorf("next run must be in the future: %s", sched.NextRunAt)
+			}
+			if (sched.LastError != nil) != tt.wantLastErr {
+				t.Errorf("last error: got %v, want set %v", sched.LastError, tt.wantLastErr)
+			}
+			if sched.LastRunAt == nil {
+				t.Errorf("last run not recorded")
+			}
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	past := time.Now().UTC().Add(-time.Hour)
+
+	ran := schedule.Schedule{ID: uuid.New(), ServerID: uuid.New(), OwnerID: ownerID, Action: server.ActionStop, RunAt: &past, Timezone: "UTC"}
+
+	type testCase struct {
+		name    string
+		us      schedule.UpdateSchedule
+		userID  uuid.UUID
+		wantErr error
+	}
+
+	table := []testCase{
+		{
+			name: "switch_to_cron",
+			us:   schedule.UpdateSchedule{Cron: ptr("0 22 * * *"), Enabled: ptr(true)},
+		},
+		{
+			name: "rearm_one_shot",
+			us:   schedule.UpdateSchedule{RunAt: ptr(time.Now().Add(time.Hour)), Enabled: ptr(true)},
+		},
+		{
+			name:    "enable_past_one_shot",
+			us:      schedule.UpdateSchedule{Enabled: ptr(true)},
+			wantErr: schedule.ErrValidation,
+		},
+		{
+			name:    "foreign_schedule",
+			us:      schedule.UpdateSchedule{Enabled: ptr(false)},
+			userID:  uuid.New(),
+			wantErr: schedule.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (schedule.Schedule, error) {
+					return ran, nil
+				},
+			}
+
+			userID := ownerID
+			if tt.userID != uuid.Nil {
+				userID = tt.userID
+			}
+
+			bus := schedule.NewBusiness(schedule.Config{}, st, &mockServerManager{}, &mockTransactor{})
+
+			sched, err := bus.Update(ctx, ran.ID, tt.us, userID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !sched.Enabled || sched.NextRunAt == nil || !sched.NextRunAt.After(time.Now()) {
+				t.Errorf("schedule not armed: %+v", sched)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package scheduledb
+
+import (
+	"hosting-service/internal/schedule"
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type scheduleDB struct {
+	ID        uuid.UUID  `db:"id"`
+	ServerID  uuid.UUID  `db:"server_id"`
+	OwnerID   uuid.UUID  `db:"owner_id"`
+	Action    string     `db:"action"`
+	RunAt     *time.Time `db:"run_at"`
+	Cron      *string    `db:"cron"`
+	Timezone  string     `db:"timezone"`
+	Enabled   bool       `db:"enabled"`
+	NextRunAt *time.Time `db:"next_run_at"`
+	LastRunAt *time.Time `db:"last_run_at"`
+	LastError *string    `db:"last_error"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
+
+func toDBSchedule(s schedule.Schedule) scheduleDB {
+	return scheduleDB{
+		ID:        s.ID,
+		ServerID:  s.ServerID,
+		OwnerID:   s.OwnerID,
+		Action:    string(s.Action),
+		RunAt:     s.RunAt,
+		Cron:      s.Cron,
+		Timezone:  s.Timezone,
+		Enabled:   s.Enabled,
+		NextRunAt: s.NextRunAt,
+		LastRunAt: s.LastRunAt,
+		LastError: s.LastError,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func toBusSchedule(db scheduleDB) schedule.Schedule {
+	return schedule.Schedule{
+		ID:        db.ID,
+		ServerID:  db.ServerID,
+		OwnerID:   db.OwnerID,
+		Action:    server.ActionType(db.Action),
+		RunAt:     db.RunAt,
+		Cron:      db.Cron,
+		Timezone:  db.Timezone,
+		Enabled:   db.Enabled,
+		NextRunAt: db.NextRunAt,
+		LastRunAt: db.LastRunAt,
+		LastError: db.LastError,
+		CreatedAt: db.CreatedAt,
+		UpdatedAt: db.UpdatedAt,
+	}
+}
+
+func toBusSchedules(dbs []scheduleDB) []schedule.Schedule {
+	schedules := make([]schedule.Schedule, len(dbs))
+	for i, db := range dbs {
+		schedules[i] = toBusSchedule(db)
+	}
+	return schedules
+}
@@ -0,0 +1,223 @@
+package scheduledb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/schedule"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const scheduleColumns = `id, server_id, owner_id, action, run_at, cron, timezone, enabled,
+		next_run_at, last_run_at, last_error, created_at, updated_at`
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (schedule.Schedule, error) {
+	const q = `
+	SELECT
+		` + scheduleColumns + `
+	FROM
+		server_schedules
+	WHERE
+		id = @id`
+
+	return s.queryOne(ctx, q, pgx.NamedArgs{"id": ID})
+}
+
+func (s *Store) Create(ctx context.Context, sched schedule.Schedule) error {
+	const q = `
+	INSERT INTO server_schedules
+		(id, server_id, owner_id, action, run_at, cron, timezone, enabled,
+		next_run_at, last_run_at, last_error, created_at, updated_at)
+	VALUES
+		(@id, @server_id, @owner_id, @action, @run_at, @cron, @timezone, @enabled,
+		@next_run_at, @last_run_at, @last_error, @created_at, @updated_at)`
+
+	dbSched := toDBSchedule(sched)
+
+	args := pgx.NamedArgs{
+		"id":          dbSched.ID,
+		"server_id":   dbSched.ServerID,
+		"owner_id":    dbSched.OwnerID,
+		"action":      dbSched.Action,
+		"run_at":      dbSched.RunAt,
+		"cron":        dbSched.Cron,
+		"timezone":    dbSched.Timezone,
+		"enabled":     dbSched.Enabled,
+		"next_run_at": dbSched.NextRunAt,
+		"last_run_at": dbSched.LastRunAt,
+		"last_error":  dbSched.LastError,
+		"created_at":  dbSched.CreatedAt,
+		"updated_at":  dbSched.UpdatedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Update(ctx context.Context, sched schedule.Schedule) error {
+	const q = `
+	UPDATE server_schedules
+	SET
+		action = @action,
+		run_at = @run_at,
+		cron = @cron,
+		timezone = @timezone,
+		enabled = @enabled,
+		next_run_at = @next_run_at,
+		last_run_at = @last_run_at,
+		last_error = @last_error,
+		updated_at = @updated_at
+	WHERE
+		id = @id`
+
+	dbSched := toDBSchedule(sched)
+
+	args := pgx.NamedArgs{
+		"id":          dbSched.ID,
+		"action":      dbSched.Action,
+		"run_at":      dbSched.RunAt,
+		"cron":        dbSched.Cron,
+		"timezone":    dbSched.Timezone,
+		"enabled":     dbSched.Enabled,
+		"next_run_at": dbSched.NextRunAt,
+		"last_run_at": dbSched.LastRunAt,
+		"last_error":  dbSched.LastError,
+		"updated_at":  dbSched.UpdatedAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return schedule.ErrScheduleNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
+	const q = `
+	DELETE FROM server_schedules
+	WHERE id = @id`
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, pgx.NamedArgs{"id": ID}); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindAll(ctx context.Context, filter schedule.QueryFilter, pg page.Page) ([]schedule.Schedule, int, error) {
+	args := pgx.NamedArgs{}
+
+	var where strings.Builder
+	where.WriteString(" WHERE TRUE")
+
+	if filter.OwnerID != nil {
+		where.WriteString(" AND owner_id = @owner_id")
+		args["owner_id"] = *filter.OwnerID
+	}
+	if filter.ServerID != nil {
+		where.WriteString(" AND server_id = @server_id")
+		args["server_id"] = *filter.ServerID
+	}
+
+	qCount := `SELECT count(*) FROM server_schedules` + where.String()
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	q := `
+	SELECT
+		` + scheduleColumns + `
+	FROM
+		server_schedules` + where.String() + `
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbScheds, err := pgx.CollectRows(rows, pgx.RowToStructByName[scheduleDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusSchedules(dbScheds), total, nil
+}
+
+func (s *Store) CountByServerID(ctx context.Context, serverID uuid.UUID) (int, error) {
+	const q = `
+	SELECT count(*) FROM server_schedules WHERE server_id = @server_id`
+
+	var count int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, q, pgx.NamedArgs{"server_id": serverID}).Scan(&count); err != nil {
+		return 0, fmt.Errorf("db: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s *Store) FindNextDue(ctx context.Context, now time.Time) (schedule.Schedule, error) {
+	const q = `
+	SELECT
+		` + scheduleColumns + `
+	FROM
+		server_schedules
+	WHERE
+		enabled AND next_run_at <= @now
+	ORDER BY
+		next_run_at ASC
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED`
+
+	return s.queryOne(ctx, q, pgx.NamedArgs{"now": now})
+}
+
+func (s *Store) queryOne(ctx context.Context, q string, args pgx.NamedArgs) (schedule.Schedule, error) {
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbSched, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[scheduleDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return schedule.Schedule{}, schedule.ErrScheduleNotFound
+		}
+		return schedule.Schedule{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusSchedule(dbSched), nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// maxReverseDNSLength is the longest domain name DNS allows.
+const maxReverseDNSLength = 253
+
+// normalizeAddresses checks the addresses reported by provisioning and returns
+// them in canonical form. An empty family is derived from the address, and a
+// family without a primary address gets its first address as primary.
+func normalizeAddresses(addrs []Address) ([]Address, error) {
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("%w: at least one ip address is required", ErrValidation)
+	}
+
+	normalized := make([]Address, len(addrs))
+	seen := make(map[string]bool, len(addrs))
+	hasPrimary := make(map[AddressFamily]bool, 2)
+
+	for i, addr := range addrs {
+		ip := net.ParseIP(addr.Address)
+		if ip == nil {
+			return nil, fmt.Errorf("%w: invalid ip address format: %s", ErrValidation, addr.Address)
+		}
+
+		family := FamilyIPv6
+		if ip.To4() != nil {
+			family = FamilyIPv4
+		}
+
+		if addr.Family != "" && addr.Family != family {
+			return nil, fmt.Errorf("%w: ip address %s is not of family %s", ErrValidation, addr.Address, addr.Family)
+		}
+
+		canonical := ip.String()
+		if seen[canonical] {
+			return nil, fmt.Errorf("%w: duplicate ip address %s", ErrValidation, canonical)
+		}
+		seen[canonical] = true
+
+		if addr.Primary {
+			if hasPrimary[family] {
+				return nil, fmt.Errorf("%w: more than one primary %s address", ErrValidation, family)
+			}
+			hasPrimary[family] = true
+		}
+
+		var reverseDNS *string
+		if addr.ReverseDNS != nil {
+			name := strings.TrimSuffix(strings.TrimSpace(*addr.ReverseDNS), ".")
+			if len(name) > maxReverseDNSLength {
+				return nil, fmt.Errorf("%w: reverse dns name of %s is longer than %d characters", ErrValidation, canonical, maxReverseDNSLength)
+			}
+			if name != "" {
+				reverseDNS = &name
+			}
+		}
+
+		normalized[i] = Address{
+			Address:    canonical,
+			Family:     family,
+			Primary:    addr.Primary,
+			ReverseDNS: reverseDNS,
+		}
+	}
+
+	for i := range normalized {
+		if !hasPrimary[normalized[i].Family] {
+			normalized[i].Primary = true
+			hasPrimary[normalized[i].Family] = true
+		}
+	}
+
+	return normalized, nil
+}
+
+// primaryIPv4 returns the primary IPv4 address, or nil for a server with
+// IPv6 addresses only.
+func primaryIPv4(addrs []Address) *string {
+	for _, addr := range addrs {
+		if addr.Family == FamilyIPv4 && addr.Primary {
+			ip := addr.Address
+			return &ip
+		}
+	}
+	return nil
+}
+
+func sameAddresses(a, b []Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Address != b[i].Address || a[i].Family != b[i].Family || a[i].Primary != b[i].Primary {
+			return false
+		}
+		if (a[i].ReverseDNS == nil) != (b[i].ReverseDNS == nil) {
+			return false
+		}
+		if a[i].ReverseDNS != nil && *a[i].ReverseDNS != *b[i].ReverseDNS {
+			return false
+		}
+	}
+
+	return true
+}
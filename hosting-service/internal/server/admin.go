@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/auth"
+	"hosting-service/internal/project"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type adminKey struct{}
+
+// WithAdmin lets the following calls act on the servers of any user when the
+// claims belong to an administrator, and records the administrator as the
+// actor of the changes. Other claims leave ctx unchanged.
+func WithAdmin(ctx context.Context, claims auth.Claims) context.Context {
+	if !claims.IsAdmin {
+		return ctx
+	}
+
+	return context.WithValue(ctx, adminKey{}, claims.UserID)
+}
+
+func adminFrom(ctx context.Context) (uuid.UUID, bool) {
+	adminID, ok := ctx.Value(adminKey{}).(uuid.UUID)
+	return adminID, ok
+}
+
+// ForceStop stops a server that is running or stuck starting or rebooting,
+// and resends the command if it is already stopping.
+func (s *Business) ForceStop(ctx context.Context, serverID uuid.UUID) (Server, error) {
+	return s.force(ctx, serverID, "force stop", func(ctx context.Context, server *Server) error {
+		switch server.Status {
+		case StatusRunning, StatusStarting, StatusRebooting, StatusStopping:
+		default:
+			return fmt.Errorf("%w: cannot force stop server with status '%s'", ErrValidation, server.Status)
+		}
+
+		server.Status = StatusStopping
+
+		return s.provisioner.RequestPower(ctx, *server, ActionStop)
+	})
+}
+
+// ForceDelete deprovisions a server in any status but DELETING, including
+// servers stuck in a transitional one. A DELETED_PENDING server is purged
+// without waiting for the end of its grace period.
+func (s *Business) ForceDelete(ctx context.Context, serverID uuid.UUID) (Server, error) {
+	return s.force(ctx, serverID, "force delete", func(ctx context.Context, server *Server) error {
+		if server.Status == StatusDeleting {
+			return fmt.Errorf("%w: server is already being deleted", ErrValidation)
+		}
+
+		server.Status = StatusDeleting
+		server.RestoreStatus = nil
+		server.PurgeAt = nil
+
+		return s.provisioner.RequestDeprovision(ctx, *server)
+	})
+}
+
+// ResetProvision resends the provisioning command of a server stuck in
+// PENDING. It does not count as a provisioning attempt of the user, and the
+// pending timeout starts over.
+func (s *Business) ResetProvision(ctx context.Context, serverID uuid.UUID) (Server, error) {
+	return s.force(ctx, serverID, "provisioning reset", func(ctx context.Context, server *Server) error {
+		if server.Status != StatusPending {
+			return fmt.Errorf("%w: cannot reset provisioning of server with status '%s', expected PENDING", ErrValidation, server.Status)
+		}
+
+		now := time.Now().UTC()
+		server.ProvisionRequestedAt = &now
+
+		return s.requestProvision(ctx, *server)
+	})
+}
+
+// force runs an administrator action: apply checks and changes the server
+// and queues the command inside the transaction that stores the change. The history
+// records the administrator even when the status stays the same.
+func (s *Business) force(ctx context.Context, serverID uuid.UUID, reason string, apply func(ctx context.Context, server *Server) error) (Server, error) {
+	adminID, ok := adminFrom(ctx)
+	if !ok {
+		return Server{}, ErrAccessDenied
+	}
+
+	ctx = withChange(ctx, adminActor(adminID), reason+" by admin")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("%s: %w", reason, err)
+	}
+
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := apply(ctx, &server); err != nil {
+			return err
+		}
+
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("%s: %w", reason, err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("%s: notifier.serverupdated: %w", reason, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+func adminActor(adminID uuid.UUID) string {
+	return "admin:" + adminID.String()
+}
+
+// authorize lets administrators and the members whose role in the project of
+// the server grants perm act on it.
+func (s *Business) authorize(ctx context.Context, srv Server, userID uuid.UUID, perm project.Permission) error {
+	if _, ok := adminFrom(ctx); ok {
+		return nil
+	}
+
+	return s.authorizeProject(ctx, srv.ProjectID, userID, perm)
+}
+
+func (s *Business) authorizeProject(ctx context.Context, projectID uuid.UUID, userID uuid.UUID, perm project.Permission) error {
+	role, err := s.projects.Role(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, project.ErrNotMember) {
+			return ErrAccessDenied
+		}
+		return fmt.Errorf("project.role: %w", err)
+	}
+
+	if !role.Can(perm) {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// orderProject returns the project a new server goes to: the given one, where
+// the user needs PermManage, or the personal project of the user when none is
+// given. Administrators order like everyone else.
+func (s *Business) orderProject(ctx context.Context, projectID *uuid.UUID, userID uuid.UUID) (uuid.UUID, error) {
+	if projectID != nil {
+		if err := s.authorizeProject(ctx, *projectID, userID, project.PermManage); err != nil {
+			return uuid.Nil, err
+		}
+		return *projectID, nil
+	}
+
+	p, err := s.projects.Personal(ctx, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("project.personal: %w", err)
+	}
+
+	return p.ID, nil
+}
+
+// scopeToUser limits a listing to the servers of the projects the user is a
+// member of unless an administrator asks for it.
+func (s *Business) scopeToUser(ctx context.Context, filter QueryFilter, userID uuid.UUID) (QueryFilter, error) {
+	if _, ok := adminFrom(ctx); ok {
+		return filter, nil
+	}
+
+	projectIDs, err := s.projects.ProjectIDs(ctx, userID)
+	if err != nil {
+		return QueryFilter{}, fmt.Errorf("project.projectids: %w", err)
+	}
+	if projectIDs == nil {
+		projectIDs = []uuid.UUID{}
+	}
+
+	filter.ProjectIDs = projectIDs
+	return filter, nil
+}
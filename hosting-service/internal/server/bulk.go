@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/page"
+
+	"github.com/google/uuid"
+)
+
+// MaxBulkServers limits the servers a single bulk action runs on.
+const MaxBulkServers = 100
+
+// BulkTarget selects the servers of a bulk action: either the listed servers
+// or the servers of the user matching the filter.
+type BulkTarget struct {
+	ServerIDs []uuid.UUID
+	Filter    *QueryFilter
+}
+
+// BulkResult is the outcome of a bulk action on a single server. Server is
+// the updated server when Err is nil.
+type BulkResult struct {
+	ServerID uuid.UUID
+	Server   Server
+	Err      error
+}
+
+// BulkAction runs the action on every target server on its own, with the
+// same permission and status rules as the single server actions. A server
+// that fails does not stop the others; its error is in its result. planID
+// is only used by ActionResize.
+func (s *Business) BulkAction(ctx context.Context, target BulkTarget, action ActionType, planID *uuid.UUID, userID uuid.UUID) ([]BulkResult, error) {
+	switch action {
+	case ActionStart, ActionStop, ActionReboot, ActionDelete, ActionRestore, ActionRetryProvision:
+	case ActionResize:
+		if planID == nil {
+			return nil, fmt.Errorf("%w: planId is required for %s", ErrValidation, action)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown action '%s'", ErrValidation, action)
+	}
+
+	serverIDs, err := s.bulkTargets(ctx, target, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, len(serverIDs))
+	for i, serverID := range serverIDs {
+		server, err := s.runAction(ctx, serverID, action, planID, userID)
+		results[i] = BulkResult{ServerID: serverID, Server: server, Err: err}
+	}
+
+	return results, nil
+}
+
+// bulkTargets resolves the target into server IDs without duplicates, in the
+// order they were listed or, for a filter, the newest server first.
+func (s *Business) bulkTargets(ctx context.Context, target BulkTarget, userID uuid.UUID) ([]uuid.UUID, error) {
+	if (len(target.ServerIDs) == 0) == (target.Filter == nil) {
+		return nil, fmt.Errorf("%w: either server IDs or a filter must be given", ErrValidation)
+	}
+
+	if target.Filter == nil {
+		if len(target.ServerIDs) > MaxBulkServers {
+			return nil, fmt.Errorf("%w: at most %d servers can be changed at once", ErrValidation, MaxBulkServers)
+		}
+
+		seen := make(map[uuid.UUID]bool, len(target.ServerIDs))
+		serverIDs := make([]uuid.UUID, 0, len(target.ServerIDs))
+		for _, id := range target.ServerIDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			serverIDs = append(serverIDs, id)
+		}
+
+		return serverIDs, nil
+	}
+
+	if err := target.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter, err := s.scopeToUser(ctx, *target.Filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, count, err := s.storer.FindAll(ctx, filter, DefaultOrderBy, page.Parse(1, MaxBulkServers))
+	if err != nil {
+		return nil, fmt.Errorf("bulkaction: %w", err)
+	}
+
+	if count > MaxBulkServers {
+		return nil, fmt.Errorf("%w: the filter matches %d servers, at most %d can be changed at once", ErrValidation, count, MaxBulkServers)
+	}
+
+	serverIDs := make([]uuid.UUID, len(servers))
+	for i, server := range servers {
+		serverIDs[i] = server.ID
+	}
+
+	return serverIDs, nil
+}
+
+func (s *Business) runAction(ctx context.Context, serverID uuid.UUID, action ActionType, planID *uuid.UUID, userID uuid.UUID) (Server, error) {
+	switch action {
+	case ActionStart:
+		return s.Start(ctx, serverID, userID)
+	case ActionStop:
+		return s.Stop(ctx, serverID, userID)
+	case ActionReboot:
+		return s.Reboot(ctx, serverID, userID)
+	case ActionDelete:
+		return s.Delete(ctx, serverID, userID)
+	case ActionRestore:
+		return s.Restore(ctx, serverID, userID)
+	case ActionRetryProvision:
+		return s.RetryProvision(ctx, serverID, userID)
+	default:
+		return s.Resize(ctx, serverID, *planID, userID)
+	}
+}
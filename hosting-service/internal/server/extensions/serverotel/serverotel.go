@@ -4,6 +4,7 @@ import (
 	"context"
 	"hosting-kit/otel"
 	"hosting-kit/page"
+	"hosting-service/internal/project"
 	"hosting-service/internal/server"
 
 	"github.com/google/uuid"
@@ -21,11 +22,18 @@ func NewExtension() server.Extension {
 	}
 }
 
-func (e *Extension) Create(ctx context.Context, name string, planID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+func (e *Extension) Create(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
 	ctx, span := otel.AddSpan(ctx, "server.create")
 	defer span.End()
 
-	return e.bus.Create(ctx, name, planID, userID)
+	return e.bus.Create(ctx, name, planID, projectID, placement, sshKeyIDs, userID)
+}
+
+func (e *Extension) Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.authorize")
+	defer span.End()
+
+	return e.bus.Authorize(ctx, serverID, userID, perm)
 }
 
 func (e *Extension) Delete(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
@@ -35,6 +43,13 @@ func (e *Extension) Delete(ctx context.Context, serverID uuid.UUID, userID uuid.
 	return e.bus.Delete(ctx, serverID, userID)
 }
 
+func (e *Extension) Restore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.restore")
+	defer span.End()
+
+	return e.bus.Restore(ctx, serverID, userID)
+}
+
 func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (server.Server, error) {
 	ctx, span := otel.AddSpan(ctx, "server.findbyid")
 	defer span.End()
@@ -42,25 +57,32 @@ func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID
 	return e.bus.FindByID(ctx, ID, userID)
 }
 
-func (e *Extension) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]server.Server, int, error) {
+func (e *Extension) Search(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page, userID uuid.UUID) ([]server.Server, int, error) {
 	ctx, span := otel.AddSpan(ctx, "server.search")
 	defer span.End()
 
-	return e.bus.Search(ctx, pg, userID)
+	return e.bus.Search(ctx, filter, orderBy, pg, userID)
+}
+
+func (e *Extension) SearchByCursor(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, cur page.Cursor, userID uuid.UUID) ([]page.Edge[server.Server], page.CursorDocument, error) {
+	ctx, span := otel.AddSpan(ctx, "server.searchbycursor")
+	defer span.End()
+
+	return e.bus.SearchByCursor(ctx, filter, orderBy, cur, userID)
 }
 
-func (e *Extension) SetIPAddress(ctx context.Context, serverID uuid.UUID, ip string) error {
-	ctx, span := otel.AddSpan(ctx, "server.setipaddress")
+func (e *Extension) SetAddresses(ctx context.Context, serverID uuid.UUID, addrs []server.Address) error {
+	ctx, span := otel.AddSpan(ctx, "server.setaddresses")
 	defer span.End()
 
-	return e.bus.SetIPAddress(ctx, serverID, ip)
+	return e.bus.SetAddresses(ctx, serverID, addrs)
 }
 
-func (e *Extension) SetProvisioningFailed(ctx context.Context, serverID uuid.UUID) error {
+func (e *Extension) SetProvisioningFailed(ctx context.Context, serverID uuid.UUID, reason string) error {
 	ctx, span := otel.AddSpan(ctx, "server.setprovisioningfailed")
 	defer span.End()
 
-	return e.bus.SetProvisioningFailed(ctx, serverID)
+	return e.bus.SetProvisioningFailed(ctx, serverID, reason)
 }
 
 func (e *Extension) Start(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
@@ -76,3 +98,122 @@ func (e *Extension) Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UU
 
 	return e.bus.Stop(ctx, serverID, userID)
 }
+
+func (e *Extension) ResumeSagas(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "server.resumesagas")
+	defer span.End()
+
+	return e.bus.ResumeSagas(ctx, limit)
+}
+
+func (e *Extension) PurgeDeleted(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "server.purgedeleted")
+	defer span.End()
+
+	return e.bus.PurgeDeleted(ctx, limit)
+}
+
+func (e *Extension) ReapPending(ctx context.Context, limit int) (int, error) {
+	ctx, span := otel.AddSpan(ctx, "server.reappending")
+	defer span.End()
+
+	return e.bus.ReapPending(ctx, limit)
+}
+
+func (e *Extension) Resize(ctx context.Context, serverID uuid.UUID, planID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.resize")
+	defer span.End()
+
+	return e.bus.Resize(ctx, serverID, planID, userID)
+}
+
+func (e *Extension) BulkAction(ctx context.Context, target server.BulkTarget, action server.ActionType, planID *uuid.UUID, userID uuid.UUID) ([]server.BulkResult, error) {
+	ctx, span := otel.AddSpan(ctx, "server.bulkaction")
+	defer span.End()
+
+	return e.bus.BulkAction(ctx, target, action, planID, userID)
+}
+
+func (e *Extension) Reboot(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.reboot")
+	defer span.End()
+
+	return e.bus.Reboot(ctx, serverID, userID)
+}
+
+func (e *Extension) CompletePowerAction(ctx context.Context, serverID uuid.UUID, action server.ActionType) error {
+	ctx, span := otel.AddSpan(ctx, "server.completepoweraction")
+	defer span.End()
+
+	return e.bus.CompletePowerAction(ctx, serverID, action)
+}
+
+func (e *Extension) FailPowerAction(ctx context.Context, serverID uuid.UUID, action server.ActionType) error {
+	ctx, span := otel.AddSpan(ctx, "server.failpoweraction")
+	defer span.End()
+
+	return e.bus.FailPowerAction(ctx, serverID, action)
+}
+
+func (e *Extension) CompleteDeprovision(ctx context.Context, serverID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "server.completedeprovision")
+	defer span.End()
+
+	return e.bus.CompleteDeprovision(ctx, serverID)
+}
+
+func (e *Extension) RetryProvision(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.retryprovision")
+	defer span.End()
+
+	return e.bus.RetryProvision(ctx, serverID, userID)
+}
+
+func (e *Extension) History(ctx context.Context, serverID uuid.UUID, pg page.Page, userID uuid.UUID) ([]server.Event, int, error) {
+	ctx, span := otel.AddSpan(ctx, "server.history")
+	defer span.End()
+
+	return e.bus.History(ctx, serverID, pg, userID)
+}
+
+func (e *Extension) ForceStop(ctx context.Context, serverID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.forcestop")
+	defer span.End()
+
+	return e.bus.ForceStop(ctx, serverID)
+}
+
+func (e *Extension) ForceDelete(ctx context.Context, serverID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.forcedelete")
+	defer span.End()
+
+	return e.bus.ForceDelete(ctx, serverID)
+}
+
+func (e *Extension) ResetProvision(ctx context.Context, serverID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.resetprovision")
+	defer span.End()
+
+	return e.bus.ResetProvision(ctx, serverID)
+}
+
+func (e *Extension) CreateFromSnapshot(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, source server.Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.createfromsnapshot")
+	defer span.End()
+
+	return e.bus.CreateFromSnapshot(ctx, name, planID, projectID, placement, source, sshKeyIDs, userID)
+}
+
+func (e *Extension) BeginSnapshotRestore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "server.beginsnapshotrestore")
+	defer span.End()
+
+	return e.bus.BeginSnapshotRestore(ctx, serverID, userID)
+}
+
+func (e *Extension) EndSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error {
+	ctx, span := otel.AddSpan(ctx, "server.endsnapshotrestore")
+	defer span.End()
+
+	return e.bus.EndSnapshotRestore(ctx, serverID, reason)
+}
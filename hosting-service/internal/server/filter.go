@@ -0,0 +1,51 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QueryFilter narrows a server listing. Nil fields are not applied.
+// IPAddress matches any IPv4 or IPv6 address of the server. ProjectIDs is
+// set by the business to the projects the user may see; an empty slice
+// matches nothing.
+type QueryFilter struct {
+	OwnerID        *uuid.UUID
+	ProjectID      *uuid.UUID
+	ProjectIDs     []uuid.UUID
+	PoolID         *uuid.UUID
+	Status         *ServerStatus
+	PlanID         *uuid.UUID
+	Name           *string
+	IPAddress      *string
+	StartCreatedAt *time.Time
+	EndCreatedAt   *time.Time
+}
+
+var searchableStatuses = map[ServerStatus]bool{
+	StatusPending:         true,
+	StatusRunning:         true,
+	StatusStopped:         true,
+	StatusProvisionFailed: true,
+	StatusStarting:        true,
+	StatusStopping:        true,
+	StatusRebooting:       true,
+	StatusDeleting:        true,
+	StatusDeletedPending:  true,
+	StatusRestoring:       true,
+}
+
+// Validate checks that the filter values can be applied.
+func (f QueryFilter) Validate() error {
+	if f.Status != nil && !searchableStatuses[*f.Status] {
+		return fmt.Errorf("%w: unknown status '%s'", ErrValidation, *f.Status)
+	}
+
+	if f.StartCreatedAt != nil && f.EndCreatedAt != nil && f.StartCreatedAt.After(*f.EndCreatedAt) {
+		return fmt.Errorf("%w: created-at range start is after its end", ErrValidation)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/internal/project"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActorSystem marks changes made by the service itself, e.g. in response to
+// provisioning events.
+const ActorSystem = "system"
+
+type HistoryStorer interface {
+	Create(ctx context.Context, event Event) error
+	FindByServerID(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]Event, int, error)
+	FindProjectID(ctx context.Context, serverID uuid.UUID) (uuid.UUID, error)
+}
+
+// History returns the recorded state changes of a server, newest first. The
+// history outlives the server: once the row is deleted, access is checked
+// against the project recorded with its events.
+func (s *Business) History(ctx context.Context, serverID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Event, int, error) {
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		if !errors.Is(err, ErrServerNotFound) {
+			return nil, 0, fmt.Errorf("history: %w", err)
+		}
+
+		projectID, err := s.history.FindProjectID(ctx, serverID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("history: %w", err)
+		}
+		server = Server{ID: serverID, ProjectID: projectID, Status: StatusDeleted}
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermView); err != nil {
+		return nil, 0, err
+	}
+
+	events, count, err := s.history.FindByServerID(ctx, serverID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("history: %w", err)
+	}
+
+	return events, count, nil
+}
+
+type changeKey struct{}
+
+type change struct {
+	actor  string
+	reason string
+}
+
+// withChange describes who makes the following store writes and why. The
+// audited storer reads it to fill in the history events.
+func withChange(ctx context.Context, actor string, reason string) context.Context {
+	return context.WithValue(ctx, changeKey{}, change{actor: actor, reason: reason})
+}
+
+// userActor names the user behind a change, or the administrator acting on
+// their behalf.
+func userActor(ctx context.Context, userID uuid.UUID) string {
+	if adminID, ok := adminFrom(ctx); ok {
+		return adminActor(adminID)
+	}
+	return userID.String()
+}
+
+// UsageMeter is told about every server write so it can track how long
+// servers run and hold resources. A nil before means the server was just
+// created, a nil after that it is gone.
+type UsageMeter interface {
+	Record(ctx context.Context, before *Server, after *Server) error
+}
+
+// auditedStorer records every status change written through the business
+// layer in the server history and the usage meter, in the same transaction
+// as the write.
+type auditedStorer struct {
+	Storer
+	history HistoryStorer
+	meter   UsageMeter
+}
+
+func (a *auditedStorer) Create(ctx context.Context, server Server) error {
+	if err := a.Storer.Create(ctx, server); err != nil {
+		return err
+	}
+
+	if err := a.meter.Record(ctx, nil, &server); err != nil {
+		return fmt.Errorf("meter: %w", err)
+	}
+
+	return a.record(ctx, server, nil, server.Status)
+}
+
+func (a *auditedStorer) Update(ctx context.Context, server Server) error {
+	old, err := a.Storer.FindByID(ctx, server.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.Storer.Update(ctx, server); err != nil {
+		return err
+	}
+
+	if err := a.meter.Record(ctx, &old, &server); err != nil {
+		return fmt.Errorf("meter: %w", err)
+	}
+
+	c, _ := ctx.Value(changeKey{}).(change)
+	if old.Status == server.Status && c.reason == "" {
+		return nil
+	}
+
+	return a.record(ctx, server, &old.Status, server.Status)
+}
+
+func (a *auditedStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	old, err := a.Storer.FindByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.Storer.Delete(ctx, ID); err != nil {
+		return err
+	}
+
+	if err := a.meter.Record(ctx, &old, nil); err != nil {
+		return fmt.Errorf("meter: %w", err)
+	}
+
+	return a.record(ctx, old, &old.Status, StatusDeleted)
+}
+
+func (a *auditedStorer) record(ctx context.Context, server Server, oldStatus *ServerStatus, newStatus ServerStatus) error {
+	c, ok := ctx.Value(changeKey{}).(change)
+	if !ok {
+		c.actor = ActorSystem
+	}
+
+	event := Event{
+		ID:        uuid.New(),
+		ServerID:  server.ID,
+		ProjectID: server.ProjectID,
+		Actor:     c.actor,
+		OldStatus: oldStatus,
+		NewStatus: newStatus,
+		Reason:    c.reason,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := a.history.Create(ctx, event); err != nil {
+		return fmt.Errorf("history.create: %w", err)
+	}
+
+	return nil
+}
@@ -15,23 +15,109 @@ const (
 	StatusRunning         ServerStatus = "RUNNING"
 	StatusStopped         ServerStatus = "STOPPED"
 	StatusProvisionFailed ServerStatus = "PROVISION_FAILED"
+	StatusStarting        ServerStatus = "STARTING"
+	StatusStopping        ServerStatus = "STOPPING"
+	StatusRebooting       ServerStatus = "REBOOTING"
+	StatusDeleting        ServerStatus = "DELETING"
+	StatusDeletedPending  ServerStatus = "DELETED_PENDING"
+	StatusRestoring       ServerStatus = "RESTORING"
+
+	// StatusDeleted only appears in the server history.
+	StatusDeleted ServerStatus = "DELETED"
 )
 
 const (
-	ActionStart  ActionType = "START"
-	ActionStop   ActionType = "STOP"
-	ActionDelete ActionType = "DELETE"
+	ActionStart          ActionType = "START"
+	ActionStop           ActionType = "STOP"
+	ActionDelete         ActionType = "DELETE"
+	ActionResize         ActionType = "RESIZE"
+	ActionReboot         ActionType = "REBOOT"
+	ActionRetryProvision ActionType = "RETRY_PROVISION"
+	ActionRestore        ActionType = "RESTORE"
+)
+
+// AddressFamily is the IP version of a server address.
+type AddressFamily string
+
+const (
+	FamilyIPv4 AddressFamily = "IPV4"
+	FamilyIPv6 AddressFamily = "IPV6"
 )
 
+// Address is an IP address assigned to a server at provisioning. Each family
+// has one primary address.
+type Address struct {
+	Address    string
+	Family     AddressFamily
+	Primary    bool
+	ReverseDNS *string
+}
+
+// MaxSSHKeys limits the keys installed on a single server.
+const MaxSSHKeys = 10
+
+// Server is a virtual machine in a project. OwnerID is the user who ordered
+// it; the server counts against their quota and bill, while access follows
+// the roles of the project members.
 type Server struct {
-	ID          uuid.UUID
-	OwnerID     uuid.UUID
-	IPv4Address *string
-	PoolID      uuid.UUID
-	PlanID      uuid.UUID
-	Name        string
-	Status      ServerStatus
-	CreatedAt   time.Time
+	ID                uuid.UUID
+	ProjectID         uuid.UUID
+	OwnerID           uuid.UUID
+	IPv4Address       *string
+	PoolID            uuid.UUID
+	Region            string
+	PlanID            uuid.UUID
+	Name              string
+	Status            ServerStatus
+	ProvisionAttempts int
+	FailureReason     *string
+	CreatedAt         time.Time
+
+	// SnapshotID is the snapshot the server was created from, if any.
+	SnapshotID *uuid.UUID
+
+	// SSHKeys are the public keys installed at provisioning. They are copied
+	// at order time so a retry installs the same keys.
+	SSHKeys []string
+
+	// Addresses are the IPv4 and IPv6 addresses assigned at provisioning.
+	// IPv4Address is the primary IPv4 address among them, kept on its own
+	// for sorting and for consumers that only know a single address.
+	Addresses []Address
+
+	// PurgeAt and RestoreStatus are only set while the server is
+	// DELETED_PENDING: the moment it is deprovisioned and the status a
+	// restore returns it to.
+	PurgeAt       *time.Time
+	RestoreStatus *ServerStatus
+
+	// ProvisionRequestedAt is when the last provisioning command was sent.
+	// ProvisionResends counts the commands the reaper resent for a server
+	// stuck in PENDING since the last order or retry.
+	ProvisionRequestedAt *time.Time
+	ProvisionResends     int
+
+	// Version grows with every update and guards against lost writes.
+	Version int
+}
+
+// Event is a single entry of the server history.
+type Event struct {
+	ID        uuid.UUID
+	ServerID  uuid.UUID
+	ProjectID uuid.UUID
+	Actor     string
+	OldStatus *ServerStatus
+	NewStatus ServerStatus
+	Reason    string
+	CreatedAt time.Time
+}
+
+// Source is the snapshot a new server is created from. The plan of the
+// server must have at least SizeGB of disk.
+type Source struct {
+	SnapshotID uuid.UUID
+	SizeGB     int
 }
 
 type Resources struct {
@@ -40,3 +126,79 @@ type Resources struct {
 	DiskGB   int
 	IPCount  int
 }
+
+// MaxRegionLength limits the name of a region.
+const MaxRegionLength = 50
+
+// Placement constrains where a server is ordered. An empty region lets the
+// resources service pick any pool.
+type Placement struct {
+	Region string
+}
+
+// Reservation is where the resources of a server were taken from.
+type Reservation struct {
+	PoolID uuid.UUID
+	Region string
+}
+
+type SagaKind string
+
+type SagaState string
+
+const (
+	SagaOrder  SagaKind = "ORDER"
+	SagaDelete SagaKind = "DELETE"
+	SagaResize SagaKind = "RESIZE"
+)
+
+const (
+	SagaStarted     SagaState = "STARTED"
+	SagaReserved    SagaState = "RESERVED"
+	SagaReturning   SagaState = "RETURNING"
+	SagaCompleted   SagaState = "COMPLETED"
+	SagaCompensated SagaState = "COMPENSATED"
+	SagaAborted     SagaState = "ABORTED"
+)
+
+// Saga tracks the pool reservation of a server across the steps of an order
+// or a delete, so it can be released or retried after a failure or a crash.
+// A resize saga covers a plan change from Resources to ResizeTo in the pool
+// PoolID and undoes it if the server row never recorded the new plan.
+type Saga struct {
+	ID            uuid.UUID
+	Kind          SagaKind
+	State         SagaState
+	ServerID      *uuid.UUID
+	UserID        *uuid.UUID
+	PoolID        *uuid.UUID
+	Resources     Resources
+	ResizeTo      *Resources
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type Config struct {
+	SagaTimeout       time.Duration
+	SagaRetryDelay    time.Duration
+	SagaMaxRetryDelay time.Duration
+
+	MaxProvisionAttempts int
+
+	// PendingTimeout is how long a server waits in PENDING for the result of
+	// its provisioning command before the command is resent, up to
+	// MaxPendingResends times. After that the server fails to provision.
+	PendingTimeout    time.Duration
+	MaxPendingResends int
+
+	// ConflictRetries is how many times an update made by the service itself
+	// is rerun after a concurrent write changed the server.
+	ConflictRetries int
+
+	// DeleteGracePeriod is how long a deleted server can be restored before
+	// it is deprovisioned and its resources go back to the pool.
+	DeleteGracePeriod time.Duration
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	OrderByName        = "name"
+	OrderByStatus      = "status"
+	OrderByIPv4Address = "ipv4Address"
+	OrderByCreatedAt   = "createdAt"
+)
+
+const (
+	ASC  = "ASC"
+	DESC = "DESC"
+)
+
+// DefaultOrderBy lists the newest servers first.
+var DefaultOrderBy = OrderBy{Field: OrderByCreatedAt, Direction: DESC}
+
+var orderFields = map[string]bool{
+	OrderByName:        true,
+	OrderByStatus:      true,
+	OrderByIPv4Address: true,
+	OrderByCreatedAt:   true,
+}
+
+// OrderBy is the sort field and direction of a server listing.
+type OrderBy struct {
+	Field     string
+	Direction string
+}
+
+// NewOrderBy validates the field and direction. Empty values fall back to
+// DefaultOrderBy.
+func NewOrderBy(field string, direction string) (OrderBy, error) {
+	if field == "" {
+		field = DefaultOrderBy.Field
+	}
+
+	if direction == "" {
+		direction = DefaultOrderBy.Direction
+	}
+	direction = strings.ToUpper(direction)
+
+	if !orderFields[field] {
+		return OrderBy{}, fmt.Errorf("%w: unknown order field '%s'", ErrValidation, field)
+	}
+
+	if direction != ASC && direction != DESC {
+		return OrderBy{}, fmt.Errorf("%w: unknown order direction '%s'", ErrValidation, direction)
+	}
+
+	return OrderBy{Field: field, Direction: direction}, nil
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SagaStorer interface {
+	Create(ctx context.Context, saga Saga) error
+	// Update stores the saga if it is still in the from state and fails
+	// with ErrSagaConflict otherwise.
+	Update(ctx context.Context, saga Saga, from SagaState) error
+	FindResumable(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]Saga, error)
+}
+
+func newSaga(kind SagaKind, state SagaState, r Resources) Saga {
+	now := time.Now().UTC()
+
+	return Saga{
+		ID:            uuid.New(),
+		Kind:          kind,
+		State:         state,
+		Resources:     r,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// ResumeSagas finishes sagas left behind by failed returns or crashed
+// requests and returns the number of sagas that reached a final state. Every
+// saga is locked and handled in its own transaction, so a slow or failing
+// return holds back neither the other sagas nor their state writes.
+func (s *Business) ResumeSagas(ctx context.Context, limit int) (int, error) {
+	var finished int
+
+	for range limit {
+		var found bool
+
+		err := s.tx.WithinTran(ctx, func(ctx context.Context) error {
+			now := time.Now().UTC()
+
+			sagas, err := s.sagas.FindResumable(ctx, now, now.Add(-s.cfg.SagaTimeout), 1)
+			if err != nil {
+				return fmt.Errorf("findresumable: %w", err)
+			}
+			if len(sagas) == 0 {
+				return nil
+			}
+			found = true
+
+			saga := sagas[0]
+			if err := s.returnResources(ctx, &saga); err != nil {
+				return err
+			}
+			if saga.State != SagaReturning {
+				finished++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return finished, fmt.Errorf("resumesagas: %w", err)
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	return finished, nil
+}
+
+// compensate releases the reservation of a failed order and returns the
+// original error. A failed release is retried by the saga worker; only a
+// saga that could not be recorded is added to the error. A saga lost to a
+// concurrent transition is left to whoever moved it on.
+func (s *Business) compensate(ctx context.Context, saga *Saga, cause error) error {
+	if errors.Is(cause, ErrSagaConflict) {
+		return cause
+	}
+
+	if err := s.returnResources(ctx, saga); err != nil {
+		return errors.Join(cause, fmt.Errorf("compensate: %w", err))
+	}
+
+	return cause
+}
+
+// returnResources gives the reserved capacity back to the pool, or for a
+// resize saga gives back the difference of the resize. The saga id
+// is the reservation id, so the resources service applies a repeated return
+// once, and a return for an order whose consume never arrived keeps that
+// consume from taking anything later. A failed return is stored with a
+// backoff for the saga worker; only a failed saga write is returned.
+func (s *Business) returnResources(ctx context.Context, saga *Saga) error {
+	var poolID uuid.UUID
+	if saga.PoolID != nil {
+		poolID = *saga.PoolID
+	}
+
+	if err := s.release(ctx, saga, poolID); err != nil {
+		from := saga.State
+		saga.State = SagaReturning
+		saga.Attempts++
+		reason := err.Error()
+		saga.LastError = &reason
+		saga.UpdatedAt = time.Now().UTC()
+		saga.NextAttemptAt = saga.UpdatedAt.Add(s.sagaRetryDelay(saga.Attempts))
+
+		if err := s.sagas.Update(ctx, *saga, from); err != nil {
+			return fmt.Errorf("saga.update: %w", err)
+		}
+
+		return nil
+	}
+
+	final := SagaCompleted
+	if saga.Kind == SagaOrder || saga.Kind == SagaResize {
+		final = SagaCompensated
+	}
+
+	return s.setSagaState(ctx, saga, final)
+}
+
+// release undoes the pool change of a saga: a resize saga resizes the
+// reservation back, the other sagas return it.
+func (s *Business) release(ctx context.Context, saga *Saga, poolID uuid.UUID) error {
+	if saga.Kind == SagaResize && saga.ResizeTo != nil {
+		return s.revertResize(ctx, saga, poolID)
+	}
+
+	if err := s.resources.Return(ctx, saga.ID, saga.Resources, poolID); err != nil {
+		return fmt.Errorf("resources.return: %w", err)
+	}
+
+	return nil
+}
+
+// revertResize gives a server whose new plan was never stored its old plan
+// back in the pools. The resize is sent again under the saga id first: the
+// resources service replays it if it was applied and reports the pool that
+// holds the server, and a resize that never arrived is applied and undone.
+// The way back runs under its own reservation id and may land in yet
+// another pool, which the server row then follows with its old plan.
+func (s *Business) revertResize(ctx context.Context, saga *Saga, poolID uuid.UUID) error {
+	resizedIn, err := s.resources.Resize(ctx, saga.ID, saga.Resources, *saga.ResizeTo, poolID)
+	if err != nil {
+		if errors.Is(err, ErrNoResources) {
+			return nil
+		}
+		return fmt.Errorf("resources.resize: %w", err)
+	}
+
+	heldIn, err := s.resources.Resize(ctx, revertID(saga.ID), *saga.ResizeTo, saga.Resources, resizedIn)
+	if err != nil {
+		return fmt.Errorf("resources.resize: revert: %w", err)
+	}
+
+	if heldIn == poolID || saga.ServerID == nil {
+		return nil
+	}
+
+	ctx = withChange(ctx, ActorSystem, "resize rolled back")
+
+	server, err := s.storer.FindByID(ctx, *saga.ServerID)
+	if err != nil {
+		if errors.Is(err, ErrServerNotFound) {
+			return nil
+		}
+		return fmt.Errorf("findbyid: %w", err)
+	}
+
+	if server.PoolID != poolID {
+		return nil
+	}
+
+	server.PoolID = heldIn
+
+	return s.updateAndNotify(ctx, &server, "revertresize")
+}
+
+// revertID is the reservation id of the way back of a resize saga. It is
+// derived from the saga id, so a repeated revert is applied once.
+func revertID(sagaID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(sagaID, []byte("revert"))
+}
+
+// setSagaState moves the saga on from the state it was read in. A saga the
+// worker or another request moved on in the meantime fails with
+// ErrSagaConflict and keeps its state.
+func (s *Business) setSagaState(ctx context.Context, saga *Saga, state SagaState) error {
+	from := saga.State
+	saga.State = state
+	saga.UpdatedAt = time.Now().UTC()
+
+	if err := s.sagas.Update(ctx, *saga, from); err != nil {
+		saga.State = from
+		return fmt.Errorf("saga.update: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Business) sagaRetryDelay(attempts int) time.Duration {
+	d := s.cfg.SagaRetryDelay
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= s.cfg.SagaMaxRetryDelay {
+			return s.cfg.SagaMaxRetryDelay
+		}
+	}
+
+	return d
+}
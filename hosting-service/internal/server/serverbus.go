@@ -6,7 +6,10 @@ import (
 	"fmt"
 	"hosting-kit/page"
 	"hosting-service/internal/plan"
-	"net"
+	"hosting-service/internal/project"
+	"hosting-service/internal/quota"
+	"hosting-service/internal/sshkey"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,60 +22,126 @@ var (
 	ErrInvalidPlan    = errors.New("invalid plan provided")
 	ErrNoResources    = errors.New("not enough resources available")
 	ErrAccessDenied   = errors.New("access denied")
+	ErrConflict       = errors.New("server was modified concurrently")
+	ErrQuotaExceeded  = errors.New("quota exceeded")
+	ErrSagaConflict   = errors.New("saga was moved on concurrently")
 )
 
+var regionPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 type Extension func(ExtBusiness) ExtBusiness
 
 type Notifier interface {
-	ServerUpdated(ctx context.Context, server Server)
+	ServerUpdated(ctx context.Context, server Server) error
 }
 
 type ResourcesManager interface {
-	Consume(ctx context.Context, r Resources) (uuid.UUID, error)
-	Return(ctx context.Context, r Resources, poolID uuid.UUID) error
+	Consume(ctx context.Context, reservationID uuid.UUID, r Resources, placement Placement) (Reservation, error)
+	Return(ctx context.Context, reservationID uuid.UUID, r Resources, poolID uuid.UUID) error
+	Resize(ctx context.Context, reservationID uuid.UUID, current Resources, target Resources, poolID uuid.UUID) (uuid.UUID, error)
 }
 
 type PlanFinder interface {
 	FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
 }
 
+type QuotaFinder interface {
+	Get(ctx context.Context, userID uuid.UUID) (quota.Quota, error)
+	Lock(ctx context.Context, userID uuid.UUID) error
+}
+
+type KeyFinder interface {
+	FindByIDs(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]sshkey.SSHKey, error)
+}
+
+// ProjectFinder resolves the roles users have in the projects servers
+// belong to.
+type ProjectFinder interface {
+	Role(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Role, error)
+	ProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	Personal(ctx context.Context, userID uuid.UUID) (project.Project, error)
+}
+
 type Storer interface {
 	FindByID(ctx context.Context, ID uuid.UUID) (Server, error)
 	Create(ctx context.Context, server Server) error
 	Update(ctx context.Context, server Server) error
 	Delete(ctx context.Context, ID uuid.UUID) error
-	FindAll(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Server, int, error)
+	FindAll(ctx context.Context, filter QueryFilter, orderBy OrderBy, pg page.Page) ([]Server, int, error)
+	FindAllByCursor(ctx context.Context, filter QueryFilter, orderBy OrderBy, cur page.Cursor) ([]page.Edge[Server], page.CursorDocument, error)
+	FindPurgeable(ctx context.Context, now time.Time, limit int) ([]Server, error)
+	FindStuckPending(ctx context.Context, before time.Time, limit int) ([]Server, error)
 }
 
 type ExtBusiness interface {
 	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Server, error)
-	Create(ctx context.Context, name string, planID uuid.UUID, userID uuid.UUID) (Server, error)
-	Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Server, int, error)
+	Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (Server, error)
+	Create(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (Server, error)
+	CreateFromSnapshot(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement Placement, source Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (Server, error)
+	Search(ctx context.Context, filter QueryFilter, orderBy OrderBy, pg page.Page, userID uuid.UUID) ([]Server, int, error)
+	SearchByCursor(ctx context.Context, filter QueryFilter, orderBy OrderBy, cur page.Cursor, userID uuid.UUID) ([]page.Edge[Server], page.CursorDocument, error)
+	History(ctx context.Context, serverID uuid.UUID, pg page.Page, userID uuid.UUID) ([]Event, int, error)
 	Start(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
 	Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
+	Reboot(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
 	Delete(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
-	SetIPAddress(ctx context.Context, serverID uuid.UUID, ip string) error
-	SetProvisioningFailed(ctx context.Context, serverID uuid.UUID) error
+	Restore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
+	Resize(ctx context.Context, serverID uuid.UUID, planID uuid.UUID, userID uuid.UUID) (Server, error)
+	BulkAction(ctx context.Context, target BulkTarget, action ActionType, planID *uuid.UUID, userID uuid.UUID) ([]BulkResult, error)
+	SetAddresses(ctx context.Context, serverID uuid.UUID, addrs []Address) error
+	SetProvisioningFailed(ctx context.Context, serverID uuid.UUID, reason string) error
+	RetryProvision(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
+	CompleteDeprovision(ctx context.Context, serverID uuid.UUID) error
+	CompletePowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error
+	FailPowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error
+	ResumeSagas(ctx context.Context, limit int) (int, error)
+	PurgeDeleted(ctx context.Context, limit int) (int, error)
+	ReapPending(ctx context.Context, limit int) (int, error)
+	ForceStop(ctx context.Context, serverID uuid.UUID) (Server, error)
+	ForceDelete(ctx context.Context, serverID uuid.UUID) (Server, error)
+	ResetProvision(ctx context.Context, serverID uuid.UUID) (Server, error)
+	BeginSnapshotRestore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error)
+	EndSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error
 }
 
 type Provisioner interface {
-	RequestIP(ctx context.Context, server Server) error
+	RequestIP(ctx context.Context, server Server, ipCount int) error
+	RequestPower(ctx context.Context, server Server, action ActionType) error
+	RequestDeprovision(ctx context.Context, server Server) error
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Business struct {
+	cfg         Config
 	storer      Storer
+	sagas       SagaStorer
+	history     HistoryStorer
+	tx          Transactor
 	planBus     PlanFinder
+	quotas      QuotaFinder
+	keys        KeyFinder
+	projects    ProjectFinder
 	provisioner Provisioner
 	resources   ResourcesManager
 	notifier    Notifier
 	extensions  []Extension
 }
 
-func NewBusiness(storer Storer, planBus PlanFinder, provisioner Provisioner,
-	resources ResourcesManager, notifier Notifier, extensions ...Extension) ExtBusiness {
+func NewBusiness(cfg Config, storer Storer, sagas SagaStorer, history HistoryStorer, tx Transactor, planBus PlanFinder, quotas QuotaFinder, keys KeyFinder, projects ProjectFinder, meter UsageMeter,
+	provisioner Provisioner, resources ResourcesManager, notifier Notifier, extensions ...Extension) ExtBusiness {
 	b := &Business{
-		storer:      storer,
+		cfg:         cfg,
+		storer:      &auditedStorer{Storer: storer, history: history, meter: meter},
+		sagas:       sagas,
+		history:     history,
+		tx:          tx,
 		planBus:     planBus,
+		quotas:      quotas,
+		keys:        keys,
+		projects:    projects,
 		provisioner: provisioner,
 		resources:   resources,
 		notifier:    notifier,
@@ -91,7 +160,7 @@ func NewBusiness(storer Storer, planBus PlanFinder, provisioner Provisioner,
 	return extBus
 }
 
-func NewServer(planID uuid.UUID, poolID uuid.UUID, userID uuid.UUID, name string) (Server, error) {
+func NewServer(planID uuid.UUID, poolID uuid.UUID, projectID uuid.UUID, userID uuid.UUID, name string) (Server, error) {
 	trimmedName := strings.TrimSpace(name)
 	if trimmedName == "" {
 		return Server{}, fmt.Errorf("%w: server name cannot be empty", ErrValidation)
@@ -102,18 +171,27 @@ func NewServer(planID uuid.UUID, poolID uuid.UUID, userID uuid.UUID, name string
 	if poolID == uuid.Nil {
 		return Server{}, fmt.Errorf("%w: poolID cannot be nil", ErrValidation)
 	}
+	if projectID == uuid.Nil {
+		return Server{}, fmt.Errorf("%w: projectID cannot be nil", ErrValidation)
+	}
 	if userID == uuid.Nil {
 		return Server{}, fmt.Errorf("%w: ownerID cannot be nil", ErrValidation)
 	}
 
+	now := time.Now().UTC()
+
 	return Server{
-		ID:        uuid.New(),
-		OwnerID:   userID,
-		PlanID:    planID,
-		PoolID:    poolID,
-		Name:      trimmedName,
-		Status:    StatusPending,
-		CreatedAt: time.Now().UTC(),
+		ID:                   uuid.New(),
+		ProjectID:            projectID,
+		OwnerID:              userID,
+		PlanID:               planID,
+		PoolID:               poolID,
+		Name:                 trimmedName,
+		Status:               StatusPending,
+		ProvisionAttempts:    1,
+		CreatedAt:            now,
+		Version:              1,
+		ProvisionRequestedAt: &now,
 	}, nil
 }
 
@@ -123,14 +201,61 @@ func (s *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID)
 		return Server{}, fmt.Errorf("findbyid: %w", err)
 	}
 
-	if err := checkOwnership(server, userID); err != nil {
+	if err := s.authorize(ctx, server, userID, project.PermView); err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+// Authorize returns the server if the role of the user in its project grants
+// perm. Other packages check access to servers through it.
+func (s *Business) Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (Server, error) {
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("authorize: %w", err)
+	}
+
+	if err := s.authorize(ctx, server, userID, perm); err != nil {
 		return Server{}, err
 	}
 
 	return server, nil
 }
 
-func (s *Business) Create(ctx context.Context, name string, planID uuid.UUID, userID uuid.UUID) (Server, error) {
+// Create orders a server into the project, or into the personal project of
+// the user when projectID is nil. The server is placed in a pool of the
+// region of placement, or in any pool when it has none. The public keys of
+// sshKeyIDs are installed at provisioning; every key must belong to the
+// user. The user stays the owner of the server, whose quota and bill it
+// counts against.
+func (s *Business) Create(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (Server, error) {
+	return s.create(ctx, name, planID, projectID, placement, nil, sshKeyIDs, userID)
+}
+
+// CreateFromSnapshot orders a server whose disk is provisioned from the
+// snapshot in source instead of a blank image.
+func (s *Business) CreateFromSnapshot(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement Placement, source Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (Server, error) {
+	return s.create(ctx, name, planID, projectID, placement, &source, sshKeyIDs, userID)
+}
+
+func (s *Business) create(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement Placement, source *Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), "server ordered")
+
+	if err := validatePlacement(placement); err != nil {
+		return Server{}, err
+	}
+
+	targetProjectID, err := s.orderProject(ctx, projectID, userID)
+	if err != nil {
+		return Server{}, err
+	}
+
+	publicKeys, err := s.findKeys(ctx, sshKeyIDs, userID)
+	if err != nil {
+		return Server{}, err
+	}
+
 	planFound, err := s.planBus.FindByID(ctx, planID)
 	if err != nil {
 		if errors.Is(err, plan.ErrPlanNotFound) {
@@ -139,37 +264,140 @@ func (s *Business) Create(ctx context.Context, name string, planID uuid.UUID, us
 		return Server{}, fmt.Errorf("plan.findbyid: %w", err)
 	}
 
-	resorce := Resources{
-		CPUCores: planFound.CPUCores,
-		RAMMB:    planFound.RAMMB,
-		DiskGB:   planFound.DiskGB,
-		IPCount:  planFound.IpCount,
+	if source != nil && planFound.DiskGB < source.SizeGB {
+		return Server{}, fmt.Errorf("%w: the plan has %d GB of disk, the snapshot needs %d GB", ErrValidation, planFound.DiskGB, source.SizeGB)
 	}
 
-	poolID, err := s.resources.Consume(ctx, resorce)
+	resorce := toResources(planFound)
+
+	saga := newSaga(SagaOrder, SagaStarted, resorce)
+	saga.UserID = &userID
+
+	// The open saga counts against the quota until the server row replaces
+	// it, so checking and storing it under the quota lock keeps concurrent
+	// orders of the user from overshooting.
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.checkQuota(ctx, userID, 1, resorce); err != nil {
+			return err
+		}
+
+		if err := s.sagas.Create(ctx, saga); err != nil {
+			return fmt.Errorf("saga.create: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return Server{}, fmt.Errorf("resources.consume: %w", err)
+		return Server{}, err
 	}
 
-	server, err := NewServer(planID, poolID, userID, name)
+	// The saga is stored before the consume and its id is the reservation id,
+	// so a consume that was taken but never recorded can still be released.
+	reservation, err := s.resources.Consume(ctx, saga.ID, resorce, placement)
 	if err != nil {
-		return Server{}, err
+		err = fmt.Errorf("resources.consume: %w", err)
+		if errors.Is(err, ErrNoResources) || errors.Is(err, ErrValidation) {
+			reason := err.Error()
+			saga.LastError = &reason
+			if err := s.setSagaState(ctx, &saga, SagaAborted); err != nil {
+				return Server{}, err
+			}
+			return Server{}, err
+		}
+		return Server{}, s.compensate(ctx, &saga, err)
 	}
 
-	err = s.storer.Create(ctx, server)
+	saga.PoolID = &reservation.PoolID
+	if err := s.setSagaState(ctx, &saga, SagaReserved); err != nil {
+		return Server{}, s.compensate(ctx, &saga, err)
+	}
+
+	server, err := NewServer(planID, reservation.PoolID, targetProjectID, userID, name)
 	if err != nil {
-		return Server{}, fmt.Errorf("create: %w", err)
+		return Server{}, s.compensate(ctx, &saga, err)
+	}
+	server.Region = reservation.Region
+	if source != nil {
+		server.SnapshotID = &source.SnapshotID
 	}
+	server.SSHKeys = publicKeys
+
+	saga.ServerID = &server.ID
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Create(ctx, server); err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+
+		if err := s.provisioner.RequestIP(ctx, server, planFound.IpCount); err != nil {
+			return fmt.Errorf("provisioner.requestip: %w", err)
+		}
 
-	if err := s.provisioner.RequestIP(ctx, server); err != nil {
-		return Server{}, fmt.Errorf("provisioner.requestip: %w", err)
+		return s.setSagaState(ctx, &saga, SagaCompleted)
+	})
+	if err != nil {
+		return Server{}, s.compensate(ctx, &saga, err)
 	}
 
 	return server, nil
 }
 
-func (s *Business) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]Server, int, error) {
-	servers, count, err := s.storer.FindAll(ctx, pg, userID)
+// validatePlacement checks the region name before it is sent to the
+// resources service, which reports regions without pools.
+func validatePlacement(placement Placement) error {
+	if placement.Region == "" {
+		return nil
+	}
+	if len(placement.Region) > MaxRegionLength {
+		return fmt.Errorf("%w: region cannot be longer than %d characters", ErrValidation, MaxRegionLength)
+	}
+	if !regionPattern.MatchString(placement.Region) {
+		return fmt.Errorf("%w: unknown region '%s'", ErrValidation, placement.Region)
+	}
+
+	return nil
+}
+
+// findKeys resolves the keys to install on a new server. Unknown keys and
+// keys of other users are reported as a validation error.
+func (s *Business) findKeys(ctx context.Context, sshKeyIDs []uuid.UUID, userID uuid.UUID) ([]string, error) {
+	if len(sshKeyIDs) == 0 {
+		return nil, nil
+	}
+	if len(sshKeyIDs) > MaxSSHKeys {
+		return nil, fmt.Errorf("%w: at most %d ssh keys can be installed", ErrValidation, MaxSSHKeys)
+	}
+
+	keys, err := s.keys.FindByIDs(ctx, sshKeyIDs, userID)
+	if err != nil {
+		if errors.Is(err, sshkey.ErrKeyNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+		}
+		return nil, fmt.Errorf("sshkey.findbyids: %w", err)
+	}
+
+	publicKeys := make([]string, len(keys))
+	for i, k := range keys {
+		publicKeys[i] = k.PublicKey
+	}
+
+	return publicKeys, nil
+}
+
+// Search lists the servers of the projects the user is a member of.
+// Administrators list the servers of every project, narrowed by the owner
+// and project filters if set.
+func (s *Business) Search(ctx context.Context, filter QueryFilter, orderBy OrderBy, pg page.Page, userID uuid.UUID) ([]Server, int, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	filter, err := s.scopeToUser(ctx, filter, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	servers, count, err := s.storer.FindAll(ctx, filter, orderBy, pg)
 	if err != nil {
 		return nil, 0, fmt.Errorf("search: %w", err)
 	}
@@ -177,100 +405,533 @@ func (s *Business) Search(ctx context.Context, pg page.Page, userID uuid.UUID) (
 	return servers, count, nil
 }
 
+// SearchByCursor lists servers a keyset page at a time. Unlike Search it does
+// not count the listing, and rows created meanwhile do not shift the pages.
+func (s *Business) SearchByCursor(ctx context.Context, filter QueryFilter, orderBy OrderBy, cur page.Cursor, userID uuid.UUID) ([]page.Edge[Server], page.CursorDocument, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, page.CursorDocument{}, err
+	}
+
+	filter, err := s.scopeToUser(ctx, filter, userID)
+	if err != nil {
+		return nil, page.CursorDocument{}, err
+	}
+
+	edges, doc, err := s.storer.FindAllByCursor(ctx, filter, orderBy, cur)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("searchbycursor: %w", err)
+	}
+
+	return edges, doc, nil
+}
+
+// Start asks the provisioning service to boot a stopped server. The server
+// stays STARTING until the result event arrives.
 func (s *Business) Start(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	return s.requestPower(ctx, serverID, userID, ActionStart)
+}
+
+// Stop asks the provisioning service to shut a running server down. The
+// server stays STOPPING until the result event arrives.
+func (s *Business) Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	return s.requestPower(ctx, serverID, userID, ActionStop)
+}
+
+// Reboot asks the provisioning service to restart a running server. The
+// server stays REBOOTING until the result event arrives.
+func (s *Business) Reboot(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	return s.requestPower(ctx, serverID, userID, ActionReboot)
+}
+
+// Delete moves the server to DELETED_PENDING for the grace period. The
+// machine and its pool reservation are kept, so the owner can restore the
+// server until PurgeDeleted hands it over to deprovisioning.
+func (s *Business) Delete(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), "delete requested")
+
 	server, err := s.storer.FindByID(ctx, serverID)
 	if err != nil {
-		return Server{}, fmt.Errorf("start: %w", err)
+		return Server{}, fmt.Errorf("delete: %w", err)
 	}
 
-	if err := checkOwnership(server, userID); err != nil {
+	if err := s.authorize(ctx, server, userID, project.PermManage); err != nil {
 		return Server{}, err
 	}
 
-	if server.Status != StatusStopped {
-		return Server{}, fmt.Errorf("%w: cannot start server with status '%s', expected STOPPED", ErrValidation, server.Status)
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	if server.Status != StatusStopped && server.Status != StatusRunning && server.Status != StatusProvisionFailed {
+		return Server{}, fmt.Errorf("%w: cannot delete server with status '%s', expected RUNNING or STOPPED", ErrValidation, server.Status)
 	}
 
-	server.Status = StatusRunning
+	restoreStatus := server.Status
+	purgeAt := time.Now().UTC().Add(s.cfg.DeleteGracePeriod)
+
+	server.Status = StatusDeletedPending
+	server.RestoreStatus = &restoreStatus
+	server.PurgeAt = &purgeAt
 
-	if err := s.storer.Update(ctx, server); err != nil {
-		return Server{}, fmt.Errorf("start: %w", err)
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("delete: %w", err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("delete: notifier.serverupdated: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
 	}
 
 	return server, nil
 }
 
-func (s *Business) Stop(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+// Restore returns a DELETED_PENDING server to the status it had before the
+// delete. The resources were never released, so no reservation is needed.
+func (s *Business) Restore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), "restore requested")
+
 	server, err := s.storer.FindByID(ctx, serverID)
 	if err != nil {
-		return Server{}, fmt.Errorf("stop: %w", err)
+		return Server{}, fmt.Errorf("restore: %w", err)
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermManage); err != nil {
+		return Server{}, err
 	}
 
-	if err := checkOwnership(server, userID); err != nil {
+	if err := checkVersion(ctx, server); err != nil {
 		return Server{}, err
 	}
 
-	if server.Status != StatusRunning {
-		return Server{}, fmt.Errorf("%w: cannot stop server with status '%s', expected RUNNING", ErrValidation, server.Status)
+	if server.Status != StatusDeletedPending {
+		return Server{}, fmt.Errorf("%w: cannot restore server with status '%s', expected DELETED_PENDING", ErrValidation, server.Status)
+	}
+
+	if server.PurgeAt != nil && !server.PurgeAt.After(time.Now().UTC()) {
+		return Server{}, fmt.Errorf("%w: the restore period of the server is over", ErrValidation)
 	}
 
 	server.Status = StatusStopped
+	if server.RestoreStatus != nil {
+		server.Status = *server.RestoreStatus
+	}
+	server.RestoreStatus = nil
+	server.PurgeAt = nil
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("restore: %w", err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("restore: notifier.serverupdated: %w", err)
+		}
 
-	if err := s.storer.Update(ctx, server); err != nil {
-		return Server{}, fmt.Errorf("stop: %w", err)
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
 	}
 
 	return server, nil
 }
 
-func (s *Business) Delete(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+// PurgeDeleted starts deprovisioning of up to limit servers whose grace
+// period is over. Their resources go back to the pool in
+// CompleteDeprovision. A server that fails is left for the next run.
+func (s *Business) PurgeDeleted(ctx context.Context, limit int) (int, error) {
+	servers, err := s.storer.FindPurgeable(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("purgedeleted: %w", err)
+	}
+
+	var purged int
+	for _, server := range servers {
+		var done bool
+		err := s.retryOnConflict(func() error {
+			var err error
+			done, err = s.purge(ctx, server.ID)
+			return err
+		})
+		if err != nil || !done {
+			continue
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purge reports false when the server no longer waits for purging, for
+// example because it was restored after it was selected.
+func (s *Business) purge(ctx context.Context, serverID uuid.UUID) (bool, error) {
+	ctx = withChange(ctx, ActorSystem, "grace period expired")
+
 	server, err := s.storer.FindByID(ctx, serverID)
 	if err != nil {
-		return Server{}, fmt.Errorf("delete: %w", err)
+		return false, fmt.Errorf("purge: %w", err)
 	}
 
-	if err := checkOwnership(server, userID); err != nil {
-		return Server{}, err
+	if server.Status != StatusDeletedPending || server.PurgeAt == nil || server.PurgeAt.After(time.Now().UTC()) {
+		return false, nil
 	}
 
-	if server.Status != StatusStopped && server.Status != StatusRunning && server.Status != StatusProvisionFailed {
-		return Server{}, fmt.Errorf("%w: cannot delete server with status '%s', expected RUNNING or STOPPED", ErrValidation, server.Status)
+	server.Status = StatusDeleting
+	server.RestoreStatus = nil
+	server.PurgeAt = nil
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("purge: %w", err)
+		}
+		server.Version++
+
+		if err := s.provisioner.RequestDeprovision(ctx, server); err != nil {
+			return fmt.Errorf("purge: provisioner.requestdeprovision: %w", err)
+		}
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("purge: notifier.serverupdated: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReapPending handles up to limit servers that waited in PENDING longer than
+// the configured timeout, e.g. because the provisioning command was lost. The
+// command is resent up to MaxPendingResends times, then the server is marked
+// PROVISION_FAILED. A server that fails is left for the next run.
+func (s *Business) ReapPending(ctx context.Context, limit int) (int, error) {
+	servers, err := s.storer.FindStuckPending(ctx, time.Now().UTC().Add(-s.cfg.PendingTimeout), limit)
+	if err != nil {
+		return 0, fmt.Errorf("reappending: %w", err)
+	}
+
+	var reaped int
+	for _, server := range servers {
+		var done bool
+		err := s.retryOnConflict(func() error {
+			var err error
+			done, err = s.reap(ctx, server.ID)
+			return err
+		})
+		if err != nil || !done {
+			continue
+		}
+		reaped++
+	}
+
+	return reaped, nil
+}
+
+// reap reports false when the server no longer waits for provisioning, for
+// example because its IP address arrived after it was selected.
+func (s *Business) reap(ctx context.Context, serverID uuid.UUID) (bool, error) {
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return false, fmt.Errorf("reap: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	if server.Status != StatusPending || server.ProvisionRequestedAt == nil || server.ProvisionRequestedAt.After(now.Add(-s.cfg.PendingTimeout)) {
+		return false, nil
+	}
+
+	if server.ProvisionResends >= s.cfg.MaxPendingResends {
+		reason := fmt.Sprintf("provisioning timed out after %d resends", server.ProvisionResends)
+		ctx = withChange(ctx, ActorSystem, "provisioning failed: "+reason)
+
+		server.Status = StatusProvisionFailed
+		server.FailureReason = &reason
+
+		if err := s.updateAndNotify(ctx, &server, "reap"); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	server.ProvisionResends++
+	server.ProvisionRequestedAt = &now
+
+	ctx = withChange(ctx, ActorSystem, fmt.Sprintf("provisioning command resent (%d of %d)", server.ProvisionResends, s.cfg.MaxPendingResends))
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("reap: %w", err)
+		}
+		server.Version++
+
+		if err := s.requestProvision(ctx, server); err != nil {
+			return fmt.Errorf("reap: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CompleteDeprovision removes a DELETING server after the provisioning
+// service released it and gives its resources back to the pool.
+func (s *Business) CompleteDeprovision(ctx context.Context, serverID uuid.UUID) error {
+	ctx = withChange(ctx, ActorSystem, "deprovisioning completed")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return fmt.Errorf("completedeprovision: %w", err)
+	}
+
+	if server.Status != StatusDeleting {
+		return fmt.Errorf("%w: cannot remove server with status '%s', expected DELETING", ErrValidation, server.Status)
 	}
 
 	plan, err := s.planBus.FindByID(ctx, server.PlanID)
 	if err != nil {
-		return Server{}, fmt.Errorf("delete: %w", err)
+		return fmt.Errorf("completedeprovision: %w", err)
+	}
+
+	saga := newSaga(SagaDelete, SagaReturning, toResources(plan))
+	saga.ServerID = &server.ID
+	saga.PoolID = &server.PoolID
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Delete(ctx, serverID); err != nil {
+			return fmt.Errorf("completedeprovision: %w", err)
+		}
+
+		if err := s.sagas.Create(ctx, saga); err != nil {
+			return fmt.Errorf("saga.create: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	resource := Resources{
-		CPUCores: plan.CPUCores,
-		RAMMB:    plan.RAMMB,
-		DiskGB:   plan.DiskGB,
-		IPCount:  plan.IpCount,
+	// The server is gone at this point and the saga is already stored as
+	// RETURNING, so the saga worker finishes the return if this write fails.
+	if err := s.returnResources(ctx, &saga); err != nil {
+		return fmt.Errorf("completedeprovision: %w", err)
 	}
 
-	if err := s.storer.Delete(ctx, serverID); err != nil {
-		return Server{}, fmt.Errorf("delete: %w", err)
+	return nil
+}
+
+// Resize moves a stopped server to another plan. The resources service takes
+// the difference from the current pool or moves the reservation to another
+// pool, and the server is updated with the plan and pool it ends up in.
+func (s *Business) Resize(ctx context.Context, serverID uuid.UUID, planID uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), fmt.Sprintf("plan changed to %s", planID))
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("resize: %w", err)
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermManage); err != nil {
+		return Server{}, err
 	}
 
-	if err := s.resources.Return(ctx, resource, server.PoolID); err != nil {
-		return Server{}, fmt.Errorf("resources.return: %w", err)
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	if server.Status != StatusStopped {
+		return Server{}, fmt.Errorf("%w: cannot resize server with status '%s', expected STOPPED", ErrValidation, server.Status)
+	}
+
+	if server.PlanID == planID {
+		return Server{}, fmt.Errorf("%w: server already uses this plan", ErrValidation)
+	}
+
+	currentPlan, err := s.planBus.FindByID(ctx, server.PlanID)
+	if err != nil {
+		return Server{}, fmt.Errorf("resize: %w", err)
+	}
+
+	targetPlan, err := s.planBus.FindByID(ctx, planID)
+	if err != nil {
+		if errors.Is(err, plan.ErrPlanNotFound) {
+			return Server{}, ErrInvalidPlan
+		}
+		return Server{}, fmt.Errorf("plan.findbyid: %w", err)
+	}
+
+	current := toResources(currentPlan)
+	target := toResources(targetPlan)
+
+	grow := Resources{
+		CPUCores: target.CPUCores - current.CPUCores,
+		RAMMB:    target.RAMMB - current.RAMMB,
+		DiskGB:   target.DiskGB - current.DiskGB,
+		IPCount:  target.IPCount - current.IPCount,
+	}
+
+	// The saga is stored before the resize and its id is the reservation id,
+	// so a resize that was taken but never recorded on the server can still
+	// be undone by the saga worker.
+	serverID, poolID := server.ID, server.PoolID
+
+	saga := newSaga(SagaResize, SagaStarted, current)
+	saga.ServerID = &serverID
+	saga.PoolID = &poolID
+	saga.ResizeTo = &target
+
+	if err := s.sagas.Create(ctx, saga); err != nil {
+		return Server{}, fmt.Errorf("saga.create: %w", err)
+	}
+
+	// The server row holds the usage of the resize, so the quota lock is kept
+	// until the new plan is written. The saga completes in the same
+	// transaction, so it is left open exactly when the new plan is not stored.
+	var resized bool
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.checkQuota(ctx, server.OwnerID, 0, grow); err != nil {
+			return err
+		}
+
+		poolID, err := s.resources.Resize(ctx, saga.ID, current, target, server.PoolID)
+		if err != nil {
+			return fmt.Errorf("resources.resize: %w", err)
+		}
+		resized = true
+
+		server.PlanID = planID
+		server.PoolID = poolID
+
+		if err := s.updateAndNotify(ctx, &server, "resize"); err != nil {
+			return err
+		}
+
+		return s.setSagaState(ctx, &saga, SagaCompleted)
+	})
+	if err != nil {
+		if !resized && (errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrNoResources) || errors.Is(err, ErrValidation)) {
+			reason := err.Error()
+			saga.LastError = &reason
+			if err := s.setSagaState(ctx, &saga, SagaAborted); err != nil {
+				return Server{}, err
+			}
+			return Server{}, err
+		}
+
+		return Server{}, s.compensate(ctx, &saga, err)
 	}
 
 	return server, nil
 }
 
-func (s *Business) SetIPAddress(ctx context.Context, serverID uuid.UUID, ip string) error {
+// CompletePowerAction moves the server out of its transitional state after
+// the provisioning service confirmed the action.
+func (s *Business) CompletePowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error {
+	return s.retryOnConflict(func() error {
+		return s.completePowerAction(ctx, serverID, action)
+	})
+}
+
+func (s *Business) completePowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error {
+	ctx = withChange(ctx, ActorSystem, strings.ToLower(string(action))+" completed")
+
+	t, ok := powerTransitions[action]
+	if !ok {
+		return fmt.Errorf("%w: unknown power action '%s'", ErrValidation, action)
+	}
+
 	server, err := s.storer.FindByID(ctx, serverID)
 	if err != nil {
-		return fmt.Errorf("setipaddress: %w", err)
+		return fmt.Errorf("completepoweraction: %w", err)
+	}
+
+	if server.Status == t.done {
+		return nil
 	}
 
-	if net.ParseIP(ip) == nil {
-		return fmt.Errorf("%w: invalid ip address format: %s", ErrValidation, ip)
+	if server.Status != t.pending {
+		return fmt.Errorf("%w: cannot complete %s for server with status '%s', expected %s", ErrValidation, action, server.Status, t.pending)
 	}
 
-	if server.IPv4Address != nil && *server.IPv4Address == ip {
+	server.Status = t.done
+
+	return s.updateAndNotify(ctx, &server, "completepoweraction")
+}
+
+// FailPowerAction returns the server to the status it had before the action
+// after the provisioning service reported a failure.
+func (s *Business) FailPowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error {
+	return s.retryOnConflict(func() error {
+		return s.failPowerAction(ctx, serverID, action)
+	})
+}
+
+func (s *Business) failPowerAction(ctx context.Context, serverID uuid.UUID, action ActionType) error {
+	ctx = withChange(ctx, ActorSystem, strings.ToLower(string(action))+" failed")
+
+	t, ok := powerTransitions[action]
+	if !ok {
+		return fmt.Errorf("%w: unknown power action '%s'", ErrValidation, action)
+	}
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return fmt.Errorf("failpoweraction: %w", err)
+	}
+
+	if server.Status == t.from {
+		return nil
+	}
+
+	if server.Status != t.pending {
+		return fmt.Errorf("%w: cannot fail %s for server with status '%s', expected %s", ErrValidation, action, server.Status, t.pending)
+	}
+
+	server.Status = t.from
+
+	return s.updateAndNotify(ctx, &server, "failpoweraction")
+}
+
+// SetAddresses records the addresses assigned at provisioning and moves the
+// PENDING server to STOPPED.
+func (s *Business) SetAddresses(ctx context.Context, serverID uuid.UUID, addrs []Address) error {
+	return s.retryOnConflict(func() error {
+		return s.setAddresses(ctx, serverID, addrs)
+	})
+}
+
+func (s *Business) setAddresses(ctx context.Context, serverID uuid.UUID, addrs []Address) error {
+	ctx = withChange(ctx, ActorSystem, "ip address assigned")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return fmt.Errorf("setaddresses: %w", err)
+	}
+
+	addrs, err = normalizeAddresses(addrs)
+	if err != nil {
+		return err
+	}
+
+	if sameAddresses(server.Addresses, addrs) {
 		return nil
 	}
 
@@ -279,41 +940,222 @@ func (s *Business) SetIPAddress(ctx context.Context, serverID uuid.UUID, ip stri
 	}
 
 	server.Status = StatusStopped
-	server.IPv4Address = &ip
+	server.Addresses = addrs
+	server.IPv4Address = primaryIPv4(addrs)
 
-	if err := s.storer.Update(ctx, server); err != nil {
-		return fmt.Errorf("setipaddress: %w", err)
-	}
-
-	s.notifier.ServerUpdated(ctx, server)
+	return s.updateAndNotify(ctx, &server, "setaddresses")
+}
 
-	return nil
+func (s *Business) SetProvisioningFailed(ctx context.Context, serverID uuid.UUID, reason string) error {
+	return s.retryOnConflict(func() error {
+		return s.setProvisioningFailed(ctx, serverID, reason)
+	})
 }
 
-func (s *Business) SetProvisioningFailed(ctx context.Context, serverID uuid.UUID) error {
+func (s *Business) setProvisioningFailed(ctx context.Context, serverID uuid.UUID, reason string) error {
+	ctx = withChange(ctx, ActorSystem, "provisioning failed: "+reason)
+
 	server, err := s.storer.FindByID(ctx, serverID)
 	if err != nil {
 		return fmt.Errorf("setprovisioningfailed: %w", err)
 	}
 
-	if server.Status == StatusProvisionFailed {
+	// Provisioning commands are resent and delivered at least once, so a
+	// failure may arrive for an attempt that another one already finished.
+	// Only a server still waiting for its addresses can fail to provision.
+	if server.Status != StatusPending {
 		return nil
 	}
 
 	server.Status = StatusProvisionFailed
+	server.FailureReason = &reason
 
-	if err := s.storer.Update(ctx, server); err != nil {
-		return fmt.Errorf("setprovisioningfailed: %w", err)
+	return s.updateAndNotify(ctx, &server, "setprovisioningfailed")
+}
+
+// RetryProvision sends a server that failed to provision back to PENDING and
+// queues a new provisioning command, up to the configured number of attempts.
+func (s *Business) RetryProvision(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), "provisioning retried")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("retryprovision: %w", err)
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermManage); err != nil {
+		return Server{}, err
+	}
+
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	if server.Status != StatusProvisionFailed {
+		return Server{}, fmt.Errorf("%w: cannot retry provisioning of server with status '%s', expected PROVISION_FAILED", ErrValidation, server.Status)
+	}
+
+	if server.ProvisionAttempts >= s.cfg.MaxProvisionAttempts {
+		return Server{}, fmt.Errorf("%w: provisioning already attempted %d times, the limit is %d", ErrValidation, server.ProvisionAttempts, s.cfg.MaxProvisionAttempts)
+	}
+
+	now := time.Now().UTC()
+
+	server.Status = StatusPending
+	server.ProvisionAttempts++
+	server.FailureReason = nil
+	server.ProvisionRequestedAt = &now
+	server.ProvisionResends = 0
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("retryprovision: %w", err)
+		}
+		server.Version++
+
+		if err := s.requestProvision(ctx, server); err != nil {
+			return fmt.Errorf("retryprovision: %w", err)
+		}
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("retryprovision: notifier.serverupdated: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+type powerTransition struct {
+	from    ServerStatus
+	pending ServerStatus
+	done    ServerStatus
+}
+
+var powerTransitions = map[ActionType]powerTransition{
+	ActionStart:  {from: StatusStopped, pending: StatusStarting, done: StatusRunning},
+	ActionStop:   {from: StatusRunning, pending: StatusStopping, done: StatusStopped},
+	ActionReboot: {from: StatusRunning, pending: StatusRebooting, done: StatusRunning},
+}
+
+// requestPower puts the server into the transitional state of the action and
+// queues the command for the provisioning service in the same transaction.
+func (s *Business) requestPower(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, action ActionType) (Server, error) {
+	op := strings.ToLower(string(action))
+	t := powerTransitions[action]
+
+	ctx = withChange(ctx, userActor(ctx, userID), op+" requested")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermOperate); err != nil {
+		return Server{}, err
+	}
+
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	if server.Status != t.from {
+		return Server{}, fmt.Errorf("%w: cannot %s server with status '%s', expected %s", ErrValidation, op, server.Status, t.from)
 	}
 
-	s.notifier.ServerUpdated(ctx, server)
+	server.Status = t.pending
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		server.Version++
+
+		if err := s.provisioner.RequestPower(ctx, server, action); err != nil {
+			return fmt.Errorf("%s: provisioner.requestpower: %w", op, err)
+		}
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("%s: notifier.serverupdated: %w", op, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+// updateAndNotify stores the server and queues its status event in one
+// transaction, so the event is never lost or sent for a rolled back change.
+func (s *Business) updateAndNotify(ctx context.Context, server *Server, op string) error {
+	return s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, *server); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, *server); err != nil {
+			return fmt.Errorf("%s: notifier.serverupdated: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+// requestProvision queues the provisioning command of a server with the
+// number of addresses of its plan.
+func (s *Business) requestProvision(ctx context.Context, server Server) error {
+	p, err := s.planBus.FindByID(ctx, server.PlanID)
+	if err != nil {
+		return fmt.Errorf("plan.findbyid: %w", err)
+	}
+
+	if err := s.provisioner.RequestIP(ctx, server, p.IpCount); err != nil {
+		return fmt.Errorf("provisioner.requestip: %w", err)
+	}
 
 	return nil
 }
 
-func checkOwnership(srv Server, userID uuid.UUID) error {
-	if srv.OwnerID != userID {
-		return ErrAccessDenied
+func toResources(p plan.Plan) Resources {
+	return Resources{
+		CPUCores: p.CPUCores,
+		RAMMB:    p.RAMMB,
+		DiskGB:   p.DiskGB,
+		IPCount:  p.IpCount,
+	}
+}
+
+// checkQuota fails with ErrQuotaExceeded when adding servers and r to what
+// the user already holds would go over their quota. It takes the quota lock
+// of the user, so it must run in the transaction that records the usage.
+func (s *Business) checkQuota(ctx context.Context, userID uuid.UUID, servers int, r Resources) error {
+	if err := s.quotas.Lock(ctx, userID); err != nil {
+		return fmt.Errorf("quota.lock: %w", err)
 	}
+
+	q, err := s.quotas.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("quota.get: %w", err)
+	}
+
+	over := q.Exceeded(quota.Usage{
+		Servers:  servers,
+		CPUCores: r.CPUCores,
+		RAMMB:    r.RAMMB,
+		DiskGB:   r.DiskGB,
+		IPCount:  r.IPCount,
+	})
+	if len(over) > 0 {
+		return fmt.Errorf("%w: %s", ErrQuotaExceeded, strings.Join(over, ", "))
+	}
+
 	return nil
 }
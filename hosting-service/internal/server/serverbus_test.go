@@ -4,44 +4,120 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"hosting-kit/auth"
 	"hosting-kit/page"
 	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/quota"
 	"hosting-service/internal/server"
+	"hosting-service/internal/sshkey"
 
 	"github.com/google/uuid"
 )
 
 type mockNotifier struct {
-	ServerUpdatedFunc func(ctx context.Context, s server.Server)
+	ServerUpdatedFunc func(ctx context.Context, s server.Server) error
 }
 
-func (m *mockNotifier) ServerUpdated(ctx context.Context, s server.Server) {
+func (m *mockNotifier) ServerUpdated(ctx context.Context, s server.Server) error {
 	if m.ServerUpdatedFunc != nil {
-		m.ServerUpdatedFunc(ctx, s)
+		return m.ServerUpdatedFunc(ctx, s)
 	}
+	return nil
+}
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTran(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type mockSagaStorer struct {
+	CreateFunc        func(ctx context.Context, saga server.Saga) error
+	UpdateFunc        func(ctx context.Context, saga server.Saga, from server.SagaState) error
+	FindResumableFunc func(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]server.Saga, error)
+}
+
+func (m *mockSagaStorer) Create(ctx context.Context, saga server.Saga) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, saga)
+	}
+	return nil
+}
+
+func (m *mockSagaStorer) Update(ctx context.Context, saga server.Saga, from server.SagaState) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, saga, from)
+	}
+	return nil
+}
+
+func (m *mockSagaStorer) FindResumable(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]server.Saga, error) {
+	if m.FindResumableFunc != nil {
+		return m.FindResumableFunc(ctx, now, staleBefore, limit)
+	}
+	return nil, nil
+}
+
+type mockHistoryStorer struct {
+	CreateFunc         func(ctx context.Context, event server.Event) error
+	FindByServerIDFunc func(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]server.Event, int, error)
+	FindProjectIDFunc  func(ctx context.Context, serverID uuid.UUID) (uuid.UUID, error)
+}
+
+func (m *mockHistoryStorer) Create(ctx context.Context, event server.Event) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, event)
+	}
+	return nil
+}
+
+func (m *mockHistoryStorer) FindByServerID(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]server.Event, int, error) {
+	if m.FindByServerIDFunc != nil {
+		return m.FindByServerIDFunc(ctx, serverID, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockHistoryStorer) FindProjectID(ctx context.Context, serverID uuid.UUID) (uuid.UUID, error) {
+	if m.FindProjectIDFunc != nil {
+		return m.FindProjectIDFunc(ctx, serverID)
+	}
+	return uuid.Nil, server.ErrServerNotFound
 }
 
 type mockResourcesManager struct {
-	ConsumeFunc func(ctx context.Context, r server.Resources) (uuid.UUID, error)
-	ReturnFunc  func(ctx context.Context, r server.Resources, poolID uuid.UUID) error
+	ConsumeFunc func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error)
+	ReturnFunc  func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error
+	ResizeFunc  func(ctx context.Context, reservationID uuid.UUID, current server.Resources, target server.Resources, poolID uuid.UUID) (uuid.UUID, error)
 }
 
-func (m *mockResourcesManager) Consume(ctx context.Context, r server.Resources) (uuid.UUID, error) {
+func (m *mockResourcesManager) Consume(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
 	if m.ConsumeFunc != nil {
-		return m.ConsumeFunc(ctx, r)
+		return m.ConsumeFunc(ctx, reservationID, r, placement)
 	}
-	return uuid.New(), nil
+	return server.Reservation{PoolID: uuid.New(), Region: "eu-central"}, nil
 }
 
-func (m *mockResourcesManager) Return(ctx context.Context, r server.Resources, poolID uuid.UUID) error {
+func (m *mockResourcesManager) Return(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
 	if m.ReturnFunc != nil {
-		return m.ReturnFunc(ctx, r, poolID)
+		return m.ReturnFunc(ctx, reservationID, r, poolID)
 	}
 	return nil
 }
 
+func (m *mockResourcesManager) Resize(ctx context.Context, reservationID uuid.UUID, current server.Resources, target server.Resources, poolID uuid.UUID) (uuid.UUID, error) {
+	if m.ResizeFunc != nil {
+		return m.ResizeFunc(ctx, reservationID, current, target, poolID)
+	}
+	return poolID, nil
+}
+
 type mockPlanFinder struct {
 	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
 }
@@ -53,12 +129,93 @@ func (m *mockPlanFinder) FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan,
 	return plan.Plan{}, nil
 }
 
+type mockQuotaFinder struct {
+	GetFunc  func(ctx context.Context, userID uuid.UUID) (quota.Quota, error)
+	LockFunc func(ctx context.Context, userID uuid.UUID) error
+}
+
+func (m *mockQuotaFinder) Get(ctx context.Context, userID uuid.UUID) (quota.Quota, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, userID)
+	}
+	return quota.Quota{UserID: userID, Limits: quota.Limits{Servers: 100, CPUCores: 100, RAMMB: 1 << 20, DiskGB: 1 << 20, IPCount: 100}}, nil
+}
+
+func (m *mockQuotaFinder) Lock(ctx context.Context, userID uuid.UUID) error {
+	if m.LockFunc != nil {
+		return m.LockFunc(ctx, userID)
+	}
+	return nil
+}
+
+type mockKeyFinder struct {
+	FindByIDsFunc func(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]sshkey.SSHKey, error)
+}
+
+func (m *mockKeyFinder) FindByIDs(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]sshkey.SSHKey, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, IDs, userID)
+	}
+	return nil, nil
+}
+
+// foreignProjectID is a project no test user is a member of.
+var foreignProjectID = uuid.New()
+
+// mockProjectFinder makes every user the OWNER of every project but
+// foreignProjectID unless RoleFunc says otherwise.
+type mockProjectFinder struct {
+	RoleFunc       func(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Role, error)
+	ProjectIDsFunc func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	PersonalFunc   func(ctx context.Context, userID uuid.UUID) (project.Project, error)
+}
+
+func (m *mockProjectFinder) Role(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (project.Role, error) {
+	if m.RoleFunc != nil {
+		return m.RoleFunc(ctx, projectID, userID)
+	}
+	if projectID == foreignProjectID {
+		return "", project.ErrNotMember
+	}
+	return project.RoleOwner, nil
+}
+
+func (m *mockProjectFinder) ProjectIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if m.ProjectIDsFunc != nil {
+		return m.ProjectIDsFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockProjectFinder) Personal(ctx context.Context, userID uuid.UUID) (project.Project, error) {
+	if m.PersonalFunc != nil {
+		return m.PersonalFunc(ctx, userID)
+	}
+	return project.Project{ID: uuid.New(), Name: project.PersonalName, Personal: true, CreatedBy: userID}, nil
+}
+
+type mockUsageMeter struct {
+	RecordFunc func(ctx context.Context, before *server.Server, after *server.Server) error
+}
+
+func (m *mockUsageMeter) Record(ctx context.Context, before *server.Server, after *server.Server) error {
+	if m.RecordFunc != nil {
+		return m.RecordFunc(ctx, before, after)
+	}
+	return nil
+}
+
 type mockStorer struct {
 	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (server.Server, error)
 	CreateFunc   func(ctx context.Context, s server.Server) error
 	UpdateFunc   func(ctx context.Context, s server.Server) error
 	DeleteFunc   func(ctx context.Context, ID uuid.UUID) error
-	FindAllFunc  func(ctx context.Context, pg page.Page, userID uuid.UUID) ([]server.Server, int, error)
+	FindAllFunc  func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error)
+
+	FindAllByCursorFunc func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, cur page.Cursor) ([]page.Edge[server.Server], page.CursorDocument, error)
+	FindPurgeableFunc   func(ctx context.Context, now time.Time, limit int) ([]server.Server, error)
+
+	FindStuckPendingFunc func(ctx context.Context, before time.Time, limit int) ([]server.Server, error)
 }
 
 func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (server.Server, error) {
@@ -86,20 +243,57 @@ func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
 	return nil
 }
 
-func (m *mockStorer) FindAll(ctx context.Context, pg page.Page, userID uuid.UUID) ([]server.Server, int, error) {
+func (m *mockStorer) FindAll(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error) {
 	if m.FindAllFunc != nil {
-		return m.FindAllFunc(ctx, pg, userID)
+		return m.FindAllFunc(ctx, filter, orderBy, pg)
 	}
 	return nil, 0, nil
 }
 
+func (m *mockStorer) FindAllByCursor(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, cur page.Cursor) ([]page.Edge[server.Server], page.CursorDocument, error) {
+	if m.FindAllByCursorFunc != nil {
+		return m.FindAllByCursorFunc(ctx, filter, orderBy, cur)
+	}
+	return nil, page.CursorDocument{}, nil
+}
+
+func (m *mockStorer) FindPurgeable(ctx context.Context, now time.Time, limit int) ([]server.Server, error) {
+	if m.FindPurgeableFunc != nil {
+		return m.FindPurgeableFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) FindStuckPending(ctx context.Context, before time.Time, limit int) ([]server.Server, error) {
+	if m.FindStuckPendingFunc != nil {
+		return m.FindStuckPendingFunc(ctx, before, limit)
+	}
+	return nil, nil
+}
+
 type mockProvisioner struct {
-	RequestIPFunc func(ctx context.Context, s server.Server) error
+	RequestIPFunc          func(ctx context.Context, s server.Server, ipCount int) error
+	RequestPowerFunc       func(ctx context.Context, s server.Server, action server.ActionType) error
+	RequestDeprovisionFunc func(ctx context.Context, s server.Server) error
 }
 
-func (m *mockProvisioner) RequestIP(ctx context.Context, s server.Server) error {
+func (m *mockProvisioner) RequestIP(ctx context.Context, s server.Server, ipCount int) error {
 	if m.RequestIPFunc != nil {
-		return m.RequestIPFunc(ctx, s)
+		return m.RequestIPFunc(ctx, s, ipCount)
+	}
+	return nil
+}
+
+func (m *mockProvisioner) RequestPower(ctx context.Context, s server.Server, action server.ActionType) error {
+	if m.RequestPowerFunc != nil {
+		return m.RequestPowerFunc(ctx, s, action)
+	}
+	return nil
+}
+
+func (m *mockProvisioner) RequestDeprovision(ctx context.Context, s server.Server) error {
+	if m.RequestDeprovisionFunc != nil {
+		return m.RequestDeprovisionFunc(ctx, s)
 	}
 	return nil
 }
@@ -131,7 +325,7 @@ func Test_Create(t *testing.T) {
 			pf: func() *mockPlanFinder {
 				return &mockPlanFinder{
 					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
-						return plan.Plan{ID: ID, Name: "Basic"}, nil
+						return plan.Plan{ID: ID, Name: "Basic", IpCount: 2}, nil
 					},
 				}
 			},
@@ -148,7 +342,16 @@ func Test_Create(t *testing.T) {
 					},
 				}
 			},
-			prov:    func() *mockProvisioner { return &mockProvisioner{} },
+			prov: func() *mockProvisioner {
+				return &mockProvisioner{
+					RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
+						if ipCount != 2 {
+							return fmt.Errorf("expected 2 addresses, got %d", ipCount)
+						}
+						return nil
+					},
+				}
+			},
 			rm:      func() *mockResourcesManager { return &mockResourcesManager{} },
 			wantErr: nil,
 		},
@@ -198,7 +401,7 @@ func Test_Create(t *testing.T) {
 			st: func() *mockStorer { return &mockStorer{} },
 			prov: func() *mockProvisioner {
 				return &mockProvisioner{
-					RequestIPFunc: func(ctx context.Context, s server.Server) error {
+					RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
 						return errBoom
 					},
 				}
@@ -210,9 +413,9 @@ func Test_Create(t *testing.T) {
 
 	for _, tt := range table {
 		t.Run(tt.name, func(t *testing.T) {
-			bus := server.NewBusiness(tt.st(), tt.pf(), tt.prov(), tt.rm(), &mockNotifier{})
+			bus := server.NewBusiness(server.Config{}, tt.st(), &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, tt.pf(), &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, tt.prov(), tt.rm(), &mockNotifier{})
 
-			got, err := bus.Create(ctx, tt.serverName, tt.planID, userID)
+			got, err := bus.Create(ctx, tt.serverName, tt.planID, nil, server.Placement{}, nil, userID)
 
 			if tt.wantErr != nil {
 				if !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
@@ -231,226 +434,2833 @@ func Test_Create(t *testing.T) {
 	}
 }
 
-func Test_Start(t *testing.T) {
+func Test_CreateWithSSHKeys(t *testing.T) {
 	ctx := context.Background()
-	srvID := uuid.New()
 	userID := uuid.New()
+	keyID := uuid.New()
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl alice@laptop"
 
 	type testCase struct {
-		name       string
-		initStatus server.ServerStatus
-		st         func() *mockStorer
-		wantErr    error
+		name     string
+		keyIDs   []uuid.UUID
+		findErr  error
+		wantKeys []string
+		wantErr  error
 	}
 
 	table := []testCase{
-		{
-			name:       "success_from_stopped",
-			initStatus: server.StatusStopped,
-			st: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: userID}, nil
-					},
-					UpdateFunc: func(ctx context.Context, s server.Server) error {
-						if s.Status != server.StatusRunning {
-							return fmt.Errorf("expected RUNNING, got %s", s.Status)
-						}
-						return nil
-					},
-				}
-			},
-			wantErr: nil,
-		},
-		{
-			name:       "fail_wrong_status_pending",
-			initStatus: server.StatusPending,
-			st: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusPending, OwnerID: userID}, nil
-					},
-				}
-			},
-			wantErr: server.ErrValidation,
-		},
-		{
-			name:       "fail_not_found",
-			initStatus: "",
-			st: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{}, server.ErrServerNotFound
-					},
-				}
-			},
-			wantErr: server.ErrServerNotFound,
-		},
-		{
-			name:       "fail_access_denied",
-			initStatus: server.StatusStopped,
-			st: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: uuid.New()}, nil
-					},
-				}
-			},
-			wantErr: server.ErrAccessDenied,
-		},
+		{name: "success", keyIDs: []uuid.UUID{keyID}, wantKeys: []string{publicKey}},
+		{name: "success_without_keys"},
+		{name: "fail_unknown_key", keyIDs: []uuid.UUID{keyID}, findErr: sshkey.ErrKeyNotFound, wantErr: server.ErrValidation},
+		{name: "fail_too_many_keys", keyIDs: make([]uuid.UUID, server.MaxSSHKeys+1), wantErr: server.ErrValidation},
 	}
 
 	for _, tt := range table {
 		t.Run(tt.name, func(t *testing.T) {
-			bus := server.NewBusiness(tt.st(), nil, nil, nil, &mockNotifier{})
+			var created, requested server.Server
+			consumed := false
 
-			_, err := bus.Start(ctx, srvID, userID)
+			kf := &mockKeyFinder{
+				FindByIDsFunc: func(ctx context.Context, IDs []uuid.UUID, uID uuid.UUID) ([]sshkey.SSHKey, error) {
+					if uID != userID {
+						t.Errorf("keys looked up for %s, want %s", uID, userID)
+					}
+					if tt.findErr != nil {
+						return nil, tt.findErr
+					}
+					return []sshkey.SSHKey{{ID: keyID, OwnerID: userID, PublicKey: publicKey}}, nil
+				},
+			}
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, s server.Server) error {
+					created = s
+					return nil
+				},
+			}
+			prov := &mockProvisioner{
+				RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
+					requested = s
+					return nil
+				},
+			}
+			rm := &mockResourcesManager{
+				ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+					consumed = true
+					return server.Reservation{PoolID: uuid.New()}, nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, kf, &mockProjectFinder{}, &mockUsageMeter{}, prov, rm, &mockNotifier{})
+
+			_, err := bus.Create(ctx, "Web01", uuid.New(), nil, server.Placement{}, tt.keyIDs, userID)
 
 			if tt.wantErr != nil {
 				if !errors.Is(err, tt.wantErr) {
 					t.Errorf("got error %v, want %v", err, tt.wantErr)
 				}
+				if consumed {
+					t.Error("resources must not be consumed when the keys are invalid")
+				}
 				return
 			}
 			if err != nil {
-				t.Errorf("unexpected error: %v", err)
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(created.SSHKeys) != fmt.Sprint(tt.wantKeys) || fmt.Sprint(requested.SSHKeys) != fmt.Sprint(tt.wantKeys) {
+				t.Errorf("keys: stored %v, sent %v, want %v", created.SSHKeys, requested.SSHKeys, tt.wantKeys)
 			}
 		})
 	}
 }
 
-func Test_SetIPAddress(t *testing.T) {
+func Test_CreateWithPlacement(t *testing.T) {
 	ctx := context.Background()
-	srvID := uuid.New()
-	validIP := "10.0.0.1"
+	userID := uuid.New()
+	poolID := uuid.New()
 
 	type testCase struct {
-		name        string
-		ip          string
-		setupStorer func() *mockStorer
-		wantErr     error
+		name       string
+		region     string
+		reserved   string
+		consume    error
+		wantRegion string
+		wantErr    error
 	}
 
 	table := []testCase{
-		{
-			name: "success",
-			ip:   validIP,
-			setupStorer: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusPending}, nil
-					},
-					UpdateFunc: func(ctx context.Context, s server.Server) error {
-						if s.Status != server.StatusStopped {
-							return fmt.Errorf("expected STOPPED, got %s", s.Status)
-						}
-						if s.IPv4Address == nil || *s.IPv4Address != validIP {
-							return errors.New("ip address mismatch")
-						}
-						return nil
-					},
-				}
-			},
-			wantErr: nil,
-		},
-		{
-			name: "fail_invalid_ip",
-			ip:   "not-an-ip",
-			setupStorer: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusPending}, nil
-					},
-				}
-			},
-			wantErr: server.ErrValidation,
-		},
-		{
-			name: "fail_wrong_status_running",
-			ip:   validIP,
-			setupStorer: func() *mockStorer {
-				return &mockStorer{
-					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-						return server.Server{ID: ID, Status: server.StatusRunning}, nil
-					},
-				}
-			},
-			wantErr: server.ErrValidation,
-		},
+		{name: "success", region: "eu-west", reserved: "eu-west", wantRegion: "eu-west"},
+		{name: "success_any_region", reserved: "eu-central", wantRegion: "eu-central"},
+		{name: "fail_malformed_region", region: "EU West", wantErr: server.ErrValidation},
+		{name: "fail_region_too_long", region: strings.Repeat("a", server.MaxRegionLength+1), wantErr: server.ErrValidation},
+		{name: "fail_region_full", region: "eu-west", consume: server.ErrNoResources, wantErr: server.ErrNoResources},
 	}
 
 	for _, tt := range table {
 		t.Run(tt.name, func(t *testing.T) {
-			bus := server.NewBusiness(tt.setupStorer(), nil, nil, nil, &mockNotifier{})
-			err := bus.SetIPAddress(ctx, srvID, tt.ip)
+			var created server.Server
+			var placed *server.Placement
+
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, s server.Server) error {
+					created = s
+					return nil
+				},
+			}
+			rm := &mockResourcesManager{
+				ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+					placed = &placement
+					if tt.consume != nil {
+						return server.Reservation{}, tt.consume
+					}
+					return server.Reservation{PoolID: poolID, Region: tt.reserved}, nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, rm, &mockNotifier{})
+
+			got, err := bus.Create(ctx, "Web01", uuid.New(), nil, server.Placement{Region: tt.region}, nil, userID)
 
 			if tt.wantErr != nil {
 				if !errors.Is(err, tt.wantErr) {
 					t.Errorf("got error %v, want %v", err, tt.wantErr)
 				}
+				if errors.Is(tt.wantErr, server.ErrValidation) && placed != nil {
+					t.Error("resources must not be consumed when the region is invalid")
+				}
 				return
 			}
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
+			if placed == nil || placed.Region != tt.region {
+				t.Errorf("consumed with placement %v, want region %q", placed, tt.region)
+			}
+			if got.Region != tt.wantRegion || created.Region != tt.wantRegion {
+				t.Errorf("region: returned %q, stored %q, want %q", got.Region, created.Region, tt.wantRegion)
+			}
+			if got.PoolID != poolID {
+				t.Errorf("got pool %s, want %s", got.PoolID, poolID)
+			}
 		})
 	}
 }
 
-func Test_Delete(t *testing.T) {
+func Test_CreateInProject(t *testing.T) {
 	ctx := context.Background()
-	srvID := uuid.New()
-	planID := uuid.New()
 	userID := uuid.New()
+	projectID := uuid.New()
+	personalID := uuid.New()
 
 	type testCase struct {
-		name    string
-		status  server.ServerStatus
-		wantErr error
+		name        string
+		projectID   *uuid.UUID
+		role        project.Role
+		wantProject uuid.UUID
+		wantErr     error
 	}
 
 	table := []testCase{
-		{
-			name:    "success_stopped",
-			status:  server.StatusStopped,
-			wantErr: nil,
-		},
-		{
-			name:    "success_running",
-			status:  server.StatusRunning,
-			wantErr: nil,
-		},
-		{
-			name:    "fail_pending",
-			status:  server.StatusPending,
-			wantErr: server.ErrValidation,
-		},
+		{name: "success_personal", wantProject: personalID},
+		{name: "success_admin", projectID: &projectID, role: project.RoleAdmin, wantProject: projectID},
+		{name: "fail_operator", projectID: &projectID, role: project.RoleOperator, wantErr: server.ErrAccessDenied},
+		{name: "fail_not_member", projectID: &foreignProjectID, wantErr: server.ErrAccessDenied},
 	}
 
 	for _, tt := range table {
 		t.Run(tt.name, func(t *testing.T) {
+			consumed := false
+
+			projects := &mockProjectFinder{
+				RoleFunc: func(ctx context.Context, pID uuid.UUID, uID uuid.UUID) (project.Role, error) {
+					if pID != projectID {
+						return "", project.ErrNotMember
+					}
+					return tt.role, nil
+				},
+				PersonalFunc: func(ctx context.Context, uID uuid.UUID) (project.Project, error) {
+					return project.Project{ID: personalID, Personal: true, CreatedBy: uID}, nil
+				},
+			}
+			rm := &mockResourcesManager{
+				ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+					consumed = true
+					return server.Reservation{PoolID: uuid.New()}, nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, &mockStorer{}, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, projects, &mockUsageMeter{}, &mockProvisioner{}, rm, &mockNotifier{})
+
+			got, err := bus.Create(ctx, "Web01", uuid.New(), tt.projectID, server.Placement{}, nil, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if consumed {
+					t.Error("resources must not be consumed when the project is denied")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ProjectID != tt.wantProject {
+				t.Errorf("project: got %s, want %s", got.ProjectID, tt.wantProject)
+			}
+			if got.OwnerID != userID {
+				t.Errorf("owner: got %s, want the ordering user %s", got.OwnerID, userID)
+			}
+		})
+	}
+}
+
+func Test_CreateFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	snapID := uuid.New()
+
+	type testCase struct {
+		name    string
+		diskGB  int
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success", diskGB: 40},
+		{name: "fail_plan_disk_too_small", diskGB: 10, wantErr: server.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var created server.Server
+
+			pf := &mockPlanFinder{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+					return plan.Plan{ID: ID, DiskGB: tt.diskGB}, nil
+				},
+			}
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, s server.Server) error {
+					created = s
+					return nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, pf, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, &mockResourcesManager{}, &mockNotifier{})
+
+			_, err := bus.CreateFromSnapshot(ctx, "Web01", uuid.New(), nil, server.Placement{}, server.Source{SnapshotID: snapID, SizeGB: 20}, nil, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.SnapshotID == nil || *created.SnapshotID != snapID {
+				t.Errorf("expected the server to record snapshot %s", snapID)
+			}
+		})
+	}
+}
+
+func Test_Start(t *testing.T) {
+	ctx := context.Background()
+	srvID := uuid.New()
+	userID := uuid.New()
+
+	type testCase struct {
+		name       string
+		initStatus server.ServerStatus
+		st         func() *mockStorer
+		wantErr    error
+	}
+
+	table := []testCase{
+		{
+			name:       "success_from_stopped",
+			initStatus: server.StatusStopped,
+			st: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: userID}, nil
+					},
+					UpdateFunc: func(ctx context.Context, s server.Server) error {
+						if s.Status != server.StatusStarting {
+							return fmt.Errorf("expected STARTING, got %s", s.Status)
+						}
+						return nil
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:       "fail_wrong_status_pending",
+			initStatus: server.StatusPending,
+			st: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusPending, OwnerID: userID}, nil
+					},
+				}
+			},
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:       "fail_not_found",
+			initStatus: "",
+			st: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{}, server.ErrServerNotFound
+					},
+				}
+			},
+			wantErr: server.ErrServerNotFound,
+		},
+		{
+			name:       "fail_access_denied",
+			initStatus: server.StatusStopped,
+			st: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: uuid.New(), ProjectID: foreignProjectID}, nil
+					},
+				}
+			},
+			wantErr: server.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := server.NewBusiness(server.Config{}, tt.st(), &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, nil, &mockNotifier{})
+
+			_, err := bus.Start(ctx, srvID, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_SetAddresses(t *testing.T) {
+	ctx := context.Background()
+	srvID := uuid.New()
+	validIP := "10.0.0.1"
+	rdns := "web-1.example.com."
+
+	pending := func() *mockStorer {
+		return &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusPending}, nil
+			},
+		}
+	}
+
+	type testCase struct {
+		name        string
+		addrs       []server.Address
+		setupStorer func() *mockStorer
+		wantErr     error
+	}
+
+	table := []testCase{
+		{
+			name:  "success",
+			addrs: []server.Address{{Address: validIP, Family: server.FamilyIPv4, Primary: true}},
+			setupStorer: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusPending}, nil
+					},
+					UpdateFunc: func(ctx context.Context, s server.Server) error {
+						if s.Status != server.StatusStopped {
+							return fmt.Errorf("expected STOPPED, got %s", s.Status)
+						}
+						if s.IPv4Address == nil || *s.IPv4Address != validIP {
+							return errors.New("ip address mismatch")
+						}
+						return nil
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success_dual_stack",
+			addrs: []server.Address{
+				{Address: "2001:DB8:0:0::10", Family: server.FamilyIPv6, ReverseDNS: &rdns},
+				{Address: "10.0.0.2", Family: server.FamilyIPv4},
+				{Address: validIP, Family: server.FamilyIPv4, Primary: true},
+			},
+			setupStorer: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusPending}, nil
+					},
+					UpdateFunc: func(ctx context.Context, s server.Server) error {
+						if s.IPv4Address == nil || *s.IPv4Address != validIP {
+							return errors.New("primary ipv4 address mismatch")
+						}
+						if len(s.Addresses) != 3 {
+							return fmt.Errorf("expected 3 addresses, got %d", len(s.Addresses))
+						}
+						v6 := s.Addresses[0]
+						if v6.Address != "2001:db8::10" || !v6.Primary {
+							return fmt.Errorf("expected canonical primary ipv6, got %+v", v6)
+						}
+						if v6.ReverseDNS == nil || *v6.ReverseDNS != "web-1.example.com" {
+							return errors.New("reverse dns mismatch")
+						}
+						if s.Addresses[1].Primary {
+							return errors.New("expected only one primary ipv4 address")
+						}
+						return nil
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "success_ipv6_only",
+			addrs: []server.Address{{Address: "2001:db8::1"}},
+			setupStorer: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusPending}, nil
+					},
+					UpdateFunc: func(ctx context.Context, s server.Server) error {
+						if s.IPv4Address != nil {
+							return errors.New("expected no ipv4 address")
+						}
+						if s.Addresses[0].Family != server.FamilyIPv6 || !s.Addresses[0].Primary {
+							return fmt.Errorf("expected primary ipv6, got %+v", s.Addresses[0])
+						}
+						return nil
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "success_already_set",
+			addrs: []server.Address{{Address: validIP}},
+			setupStorer: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{
+							ID:          ID,
+							Status:      server.StatusRunning,
+							IPv4Address: &validIP,
+							Addresses:   []server.Address{{Address: validIP, Family: server.FamilyIPv4, Primary: true}},
+						}, nil
+					},
+					UpdateFunc: func(ctx context.Context, s server.Server) error {
+						return errors.New("unexpected update")
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:        "fail_invalid_ip",
+			addrs:       []server.Address{{Address: "not-an-ip"}},
+			setupStorer: pending,
+			wantErr:     server.ErrValidation,
+		},
+		{
+			name:        "fail_no_addresses",
+			addrs:       nil,
+			setupStorer: pending,
+			wantErr:     server.ErrValidation,
+		},
+		{
+			name:        "fail_family_mismatch",
+			addrs:       []server.Address{{Address: "2001:db8::1", Family: server.FamilyIPv4}},
+			setupStorer: pending,
+			wantErr:     server.ErrValidation,
+		},
+		{
+			name:        "fail_duplicate",
+			addrs:       []server.Address{{Address: "2001:db8::1"}, {Address: "2001:DB8::1"}},
+			setupStorer: pending,
+			wantErr:     server.ErrValidation,
+		},
+		{
+			name:        "fail_two_primaries",
+			addrs:       []server.Address{{Address: validIP, Primary: true}, {Address: "10.0.0.2", Primary: true}},
+			setupStorer: pending,
+			wantErr:     server.ErrValidation,
+		},
+		{
+			name:  "fail_wrong_status_running",
+			addrs: []server.Address{{Address: validIP}},
+			setupStorer: func() *mockStorer {
+				return &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, Status: server.StatusRunning}, nil
+					},
+				}
+			},
+			wantErr: server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := server.NewBusiness(server.Config{}, tt.setupStorer(), &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+			err := bus.SetAddresses(ctx, srvID, tt.addrs)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctx := context.Background()
+	srvID := uuid.New()
+	planID := uuid.New()
+	userID := uuid.New()
+	grace := 72 * time.Hour
+
+	type testCase struct {
+		name    string
+		status  server.ServerStatus
+		wantErr error
+	}
+
+	table := []testCase{
+		{
+			name:    "success_stopped",
+			status:  server.StatusStopped,
+			wantErr: nil,
+		},
+		{
+			name:    "success_running",
+			status:  server.StatusRunning,
+			wantErr: nil,
+		},
+		{
+			name:    "fail_pending",
+			status:  server.StatusPending,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_already_deleting",
+			status:  server.StatusDeleting,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_already_deleted",
+			status:  server.StatusDeletedPending,
+			wantErr: server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.status, PlanID: planID, OwnerID: userID}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					if s.Status != server.StatusDeletedPending {
+						return fmt.Errorf("expected DELETED_PENDING, got %s", s.Status)
+					}
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error {
+					t.Error("server row must be kept during the grace period")
+					return nil
+				},
+			}
+
+			prov := &mockProvisioner{
+				RequestDeprovisionFunc: func(ctx context.Context, s server.Server) error {
+					t.Error("server must not be deprovisioned during the grace period")
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
+					t.Error("resources must stay reserved during the grace period")
+					return nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{DeleteGracePeriod: grace}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, rm, &mockNotifier{})
+
+			before := time.Now().UTC()
+			got, err := bus.Delete(ctx, srvID, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != server.StatusDeletedPending {
+				t.Errorf("status: got %s, want %s", got.Status, server.StatusDeletedPending)
+			}
+			if got.RestoreStatus == nil || *got.RestoreStatus != tt.status {
+				t.Errorf("restore status: got %v, want %s", got.RestoreStatus, tt.status)
+			}
+			if got.PurgeAt == nil || got.PurgeAt.Before(before.Add(grace)) {
+				t.Errorf("purge at: got %v, want at least %v", got.PurgeAt, before.Add(grace))
+			}
+		})
+	}
+}
+
+func Test_Restore(t *testing.T) {
+	ctx := context.Background()
+	srvID := uuid.New()
+	userID := uuid.New()
+	running := server.StatusRunning
+	future := time.Now().UTC().Add(time.Hour)
+	past := time.Now().UTC().Add(-time.Minute)
+
+	type testCase struct {
+		name       string
+		srv        server.Server
+		userID     uuid.UUID
+		wantStatus server.ServerStatus
+		wantErr    error
+	}
+
+	table := []testCase{
+		{
+			name:       "success",
+			srv:        server.Server{Status: server.StatusDeletedPending, RestoreStatus: &running, PurgeAt: &future, OwnerID: userID},
+			userID:     userID,
+			wantStatus: server.StatusRunning,
+		},
+		{
+			name:       "success_without_restore_status",
+			srv:        server.Server{Status: server.StatusDeletedPending, PurgeAt: &future, OwnerID: userID},
+			userID:     userID,
+			wantStatus: server.StatusStopped,
+		},
+		{
+			name:    "fail_not_deleted",
+			srv:     server.Server{Status: server.StatusStopped, OwnerID: userID},
+			userID:  userID,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_grace_period_over",
+			srv:     server.Server{Status: server.StatusDeletedPending, RestoreStatus: &running, PurgeAt: &past, OwnerID: userID},
+			userID:  userID,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_not_member",
+			srv:     server.Server{Status: server.StatusDeletedPending, RestoreStatus: &running, PurgeAt: &future, OwnerID: userID, ProjectID: foreignProjectID},
+			userID:  uuid.New(),
+			wantErr: server.ErrAccessDenied,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated server.Server
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					srv := tt.srv
+					srv.ID = ID
+					return srv, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					updated = s
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+					t.Error("resources are still reserved and must not be consumed again")
+					return server.Reservation{}, nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, rm, &mockNotifier{})
+
+			got, err := bus.Restore(ctx, srvID, tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != tt.wantStatus || updated.Status != tt.wantStatus {
+				t.Errorf("status: got %s (stored %s), want %s", got.Status, updated.Status, tt.wantStatus)
+			}
+			if updated.PurgeAt != nil || updated.RestoreStatus != nil {
+				t.Error("expected the deletion fields to be cleared")
+			}
+		})
+	}
+}
+
+func Test_PurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().UTC().Add(-time.Minute)
+	future := time.Now().UTC().Add(time.Hour)
+	stopped := server.StatusStopped
+
+	expired := server.Server{ID: uuid.New(), Status: server.StatusDeletedPending, RestoreStatus: &stopped, PurgeAt: &past}
+	failing := server.Server{ID: uuid.New(), Status: server.StatusDeletedPending, RestoreStatus: &stopped, PurgeAt: &past}
+	restored := server.Server{ID: uuid.New(), Status: server.StatusStopped}
+	extended := server.Server{ID: uuid.New(), Status: server.StatusDeletedPending, RestoreStatus: &stopped, PurgeAt: &future}
+
+	current := map[uuid.UUID]server.Server{
+		expired.ID:  expired,
+		failing.ID:  failing,
+		restored.ID: restored,
+		extended.ID: extended,
+	}
+
+	var deprovisioned []uuid.UUID
+
+	st := &mockStorer{
+		FindPurgeableFunc: func(ctx context.Context, now time.Time, limit int) ([]server.Server, error) {
+			if limit != 10 {
+				t.Errorf("limit: got %d, want 10", limit)
+			}
+			return []server.Server{expired, failing, restored, extended}, nil
+		},
+		FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+			return current[ID], nil
+		},
+		UpdateFunc: func(ctx context.Context, s server.Server) error {
+			if s.Status != server.StatusDeleting {
+				return fmt.Errorf("expected DELETING, got %s", s.Status)
+			}
+			if s.PurgeAt != nil || s.RestoreStatus != nil {
+				return errors.New("expected the deletion fields to be cleared")
+			}
+			return nil
+		},
+	}
+
+	prov := &mockProvisioner{
+		RequestDeprovisionFunc: func(ctx context.Context, s server.Server) error {
+			if s.ID == failing.ID {
+				return errors.New("outbox unavailable")
+			}
+			deprovisioned = append(deprovisioned, s.ID)
+			return nil
+		},
+	}
+
+	bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, &mockResourcesManager{}, &mockNotifier{})
+
+	purged, err := bus.PurgeDeleted(ctx, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("purged: got %d, want 1", purged)
+	}
+
+	if len(deprovisioned) != 1 || deprovisioned[0] != expired.ID {
+		t.Errorf("deprovisioned: got %v, want only %s", deprovisioned, expired.ID)
+	}
+}
+
+func Test_ReapPending(t *testing.T) {
+	ctx := context.Background()
+	timeout := 10 * time.Minute
+	stale := time.Now().UTC().Add(-time.Hour)
+	fresh := time.Now().UTC()
+
+	resend := server.Server{ID: uuid.New(), Status: server.StatusPending, ProvisionRequestedAt: &stale, ProvisionResends: 1}
+	exhausted := server.Server{ID: uuid.New(), Status: server.StatusPending, ProvisionRequestedAt: &stale, ProvisionResends: 2}
+	provisioned := server.Server{ID: uuid.New(), Status: server.StatusStopped, ProvisionRequestedAt: &stale}
+	reset := server.Server{ID: uuid.New(), Status: server.StatusPending, ProvisionRequestedAt: &fresh}
+
+	current := map[uuid.UUID]server.Server{
+		resend.ID:      resend,
+		exhausted.ID:   exhausted,
+		provisioned.ID: provisioned,
+		reset.ID:       reset,
+	}
+
+	updated := make(map[uuid.UUID]server.Server)
+	var requested []uuid.UUID
+	var notified []uuid.UUID
+
+	st := &mockStorer{
+		FindStuckPendingFunc: func(ctx context.Context, before time.Time, limit int) ([]server.Server, error) {
+			if before.After(time.Now().UTC().Add(-timeout)) {
+				t.Errorf("before: got %s, want at least %s ago", before, timeout)
+			}
+			return []server.Server{resend, exhausted, provisioned, reset}, nil
+		},
+		FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+			return current[ID], nil
+		},
+		UpdateFunc: func(ctx context.Context, s server.Server) error {
+			updated[s.ID] = s
+			return nil
+		},
+	}
+	prov := &mockProvisioner{
+		RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
+			requested = append(requested, s.ID)
+			return nil
+		},
+	}
+	notifier := &mockNotifier{
+		ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+			notified = append(notified, s.ID)
+			return nil
+		},
+	}
+
+	cfg := server.Config{PendingTimeout: timeout, MaxPendingResends: 2}
+	bus := server.NewBusiness(cfg, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, nil, notifier)
+
+	reaped, err := bus.ReapPending(ctx, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reaped != 2 {
+		t.Errorf("reaped: got %d, want 2", reaped)
+	}
+
+	got := updated[resend.ID]
+	if got.Status != server.StatusPending || got.ProvisionResends != 2 {
+		t.Errorf("resend: got status %s with %d resends, want PENDING with 2", got.Status, got.ProvisionResends)
+	}
+	if got.ProvisionRequestedAt == nil || !got.ProvisionRequestedAt.After(stale) {
+		t.Errorf("resend: expected the request time to move forward")
+	}
+	if len(requested) != 1 || requested[0] != resend.ID {
+		t.Errorf("requested: got %v, want only %s", requested, resend.ID)
+	}
+
+	got = updated[exhausted.ID]
+	if got.Status != server.StatusProvisionFailed || got.FailureReason == nil || !strings.Contains(*got.FailureReason, "timed out") {
+		t.Errorf("exhausted: got status %s with reason %v, want PROVISION_FAILED with a timeout reason", got.Status, got.FailureReason)
+	}
+	if len(notified) != 1 || notified[0] != exhausted.ID {
+		t.Errorf("notified: got %v, want only %s", notified, exhausted.ID)
+	}
+
+	if _, ok := updated[provisioned.ID]; ok {
+		t.Error("a provisioned server must be left alone")
+	}
+	if _, ok := updated[reset.ID]; ok {
+		t.Error("a server with a fresh request must be left alone")
+	}
+}
+
+func Test_SetProvisioningFailed(t *testing.T) {
+	ctx := context.Background()
+	srvID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name     string
+		status   server.ServerStatus
+		notifier func() *mockNotifier
+		wantErr  error
+	}
+
+	table := []testCase{
+		{
+			name:     "success",
+			status:   server.StatusPending,
+			notifier: func() *mockNotifier { return &mockNotifier{} },
+			wantErr:  nil,
+		},
+		{
+			name:   "already_failed",
+			status: server.StatusProvisionFailed,
+			notifier: func() *mockNotifier {
+				return &mockNotifier{
+					ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+						return errors.New("notifier must not be called")
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "late_for_running",
+			status: server.StatusRunning,
+			notifier: func() *mockNotifier {
+				return &mockNotifier{
+					ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+						return errors.New("notifier must not be called")
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "late_for_stopped",
+			status: server.StatusStopped,
+			notifier: func() *mockNotifier {
+				return &mockNotifier{
+					ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+						return errors.New("notifier must not be called")
+					},
+				}
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "fail_notifier",
+			status: server.StatusPending,
+			notifier: func() *mockNotifier {
+				return &mockNotifier{
+					ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+						return errBoom
+					},
+				}
+			},
+			wantErr: errBoom,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.status}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					if s.Status != server.StatusProvisionFailed {
+						return fmt.Errorf("expected PROVISION_FAILED, got %s", s.Status)
+					}
+					if s.FailureReason == nil || *s.FailureReason != "no IP left" {
+						return fmt.Errorf("expected failure reason to be stored, got %v", s.FailureReason)
+					}
+					return nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, tt.notifier())
+
+			err := bus.SetProvisioningFailed(ctx, srvID, "no IP left")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_CreateCompensation(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+	userID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name        string
+		createErr   error
+		returnErr   error
+		consume     error
+		completeErr error
+
+		wantReturned bool
+		wantState    server.SagaState
+	}
+
+	table := []testCase{
+		{
+			name:      "success",
+			wantState: server.SagaCompleted,
+		},
+		{
+			name:      "fail_consume_no_resources",
+			consume:   server.ErrNoResources,
+			wantState: server.SagaAborted,
+		},
+		{
+			name:         "fail_consume_released",
+			consume:      errBoom,
+			wantReturned: true,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "fail_create_returned",
+			createErr:    errBoom,
+			wantReturned: true,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "fail_create_return_failed",
+			createErr:    errBoom,
+			returnErr:    errBoom,
+			wantReturned: true,
+			wantState:    server.SagaReturning,
+		},
+		{
+			// The worker compensated the saga while the request ran, so
+			// the request must neither complete nor return it again.
+			name:        "fail_complete_taken_over",
+			completeErr: server.ErrSagaConflict,
+			wantState:   server.SagaReserved,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var returned bool
+			var consumedID uuid.UUID
+			last := server.Saga{State: server.SagaStarted}
+
+			sagas := &mockSagaStorer{
+				UpdateFunc: func(ctx context.Context, saga server.Saga, from server.SagaState) error {
+					if from != last.State {
+						t.Errorf("saga moved on from %s, stored state is %s", from, last.State)
+					}
+					if saga.State == server.SagaCompleted && tt.completeErr != nil {
+						return tt.completeErr
+					}
+					last = saga
+					return nil
+				},
+			}
+
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, s server.Server) error { return tt.createErr },
+			}
+
+			pf := &mockPlanFinder{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+					return plan.Plan{ID: ID, CPUCores: 2}, nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+					consumedID = reservationID
+					if tt.consume != nil {
+						return server.Reservation{}, tt.consume
+					}
+					return server.Reservation{PoolID: poolID}, nil
+				},
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, ID uuid.UUID) error {
+					returned = true
+					if reservationID != consumedID {
+						t.Errorf("returned reservation %s, want %s", reservationID, consumedID)
+					}
+					if tt.consume == nil && ID != poolID {
+						t.Errorf("returned to pool %s, want %s", ID, poolID)
+					}
+					return tt.returnErr
+				},
+			}
+
+			cfg := server.Config{SagaRetryDelay: time.Second, SagaMaxRetryDelay: time.Minute}
+			bus := server.NewBusiness(cfg, st, sagas, &mockHistoryStorer{}, &mockTransactor{}, pf, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, rm, &mockNotifier{})
+
+			_, err := bus.Create(ctx, "Web01", uuid.New(), nil, server.Placement{}, nil, userID)
+
+			wantErr := tt.createErr
+			if tt.consume != nil {
+				wantErr = tt.consume
+			}
+			if tt.completeErr != nil {
+				wantErr = tt.completeErr
+			}
+			if wantErr != nil && !errors.Is(err, wantErr) {
+				t.Errorf("got error %v, want %v", err, wantErr)
+			}
+			if wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if returned != tt.wantReturned {
+				t.Errorf("resources returned: got %v, want %v", returned, tt.wantReturned)
+			}
+			if last.State != tt.wantState {
+				t.Errorf("saga state: got %s, want %s", last.State, tt.wantState)
+			}
+		})
+	}
+}
+
+func Test_CompleteDeprovision(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name      string
+		status    server.ServerStatus
+		returnErr error
+
+		wantErr     error
+		wantDeleted bool
+		wantState   server.SagaState
+		wantAttempt int
+	}
+
+	table := []testCase{
+		{
+			name:        "success",
+			status:      server.StatusDeleting,
+			wantDeleted: true,
+			wantState:   server.SagaCompleted,
+		},
+		{
+			name:        "return_failed",
+			status:      server.StatusDeleting,
+			returnErr:   errBoom,
+			wantDeleted: true,
+			wantState:   server.SagaReturning,
+			wantAttempt: 1,
+		},
+		{
+			name:    "fail_not_deleting",
+			status:  server.StatusStopped,
+			wantErr: server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			var last server.Saga
+
+			sagas := &mockSagaStorer{
+				UpdateFunc: func(ctx context.Context, saga server.Saga, from server.SagaState) error {
+					last = saga
+					return nil
+				},
+			}
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.status, PoolID: poolID}, nil
+				},
+				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, ID uuid.UUID) error {
+					if ID != poolID {
+						t.Errorf("returned to pool %s, want %s", ID, poolID)
+					}
+					return tt.returnErr
+				},
+			}
+
+			cfg := server.Config{SagaRetryDelay: time.Second, SagaMaxRetryDelay: time.Minute}
+			bus := server.NewBusiness(cfg, st, sagas, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, rm, &mockNotifier{})
+
+			err := bus.CompleteDeprovision(ctx, uuid.New())
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if deleted != tt.wantDeleted {
+				t.Errorf("deleted: got %v, want %v", deleted, tt.wantDeleted)
+			}
+			if last.State != tt.wantState {
+				t.Errorf("saga state: got %s, want %s", last.State, tt.wantState)
+			}
+			if last.Attempts != tt.wantAttempt {
+				t.Errorf("saga attempts: got %d, want %d", last.Attempts, tt.wantAttempt)
+			}
+		})
+	}
+}
+
+func Test_ResumeSagas(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name      string
+		saga      server.Saga
+		returnErr error
+
+		wantFinished int
+		wantState    server.SagaState
+	}
+
+	table := []testCase{
+		{
+			name:         "started_released",
+			saga:         server.Saga{Kind: server.SagaOrder, State: server.SagaStarted},
+			wantFinished: 1,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "reserved_compensated",
+			saga:         server.Saga{Kind: server.SagaOrder, State: server.SagaReserved, PoolID: &poolID},
+			wantFinished: 1,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "returning_completed",
+			saga:         server.Saga{Kind: server.SagaDelete, State: server.SagaReturning, PoolID: &poolID},
+			wantFinished: 1,
+			wantState:    server.SagaCompleted,
+		},
+		{
+			name:         "resize_compensated",
+			saga:         server.Saga{Kind: server.SagaResize, State: server.SagaReturning, PoolID: &poolID, Resources: server.Resources{CPUCores: 1}, ResizeTo: &server.Resources{CPUCores: 4}},
+			wantFinished: 1,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "resize_never_applied",
+			saga:         server.Saga{Kind: server.SagaResize, State: server.SagaStarted, PoolID: &poolID, Resources: server.Resources{CPUCores: 1}, ResizeTo: &server.Resources{CPUCores: 4}},
+			returnErr:    server.ErrNoResources,
+			wantFinished: 1,
+			wantState:    server.SagaCompensated,
+		},
+		{
+			name:         "returning_failed_again",
+			saga:         server.Saga{Kind: server.SagaDelete, State: server.SagaReturning, PoolID: &poolID, Attempts: 1},
+			returnErr:    errBoom,
+			wantFinished: 0,
+			wantState:    server.SagaReturning,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var last server.Saga
+			var found bool
+
+			sagas := &mockSagaStorer{
+				FindResumableFunc: func(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]server.Saga, error) {
+					if found {
+						return nil, nil
+					}
+					found = true
+					return []server.Saga{tt.saga}, nil
+				},
+				UpdateFunc: func(ctx context.Context, saga server.Saga, from server.SagaState) error {
+					last = saga
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
+					if tt.saga.Kind == server.SagaResize {
+						t.Error("resize saga returned instead of resized back")
+					}
+					return tt.returnErr
+				},
+				ResizeFunc: func(ctx context.Context, reservationID uuid.UUID, current server.Resources, target server.Resources, pID uuid.UUID) (uuid.UUID, error) {
+					if pID != poolID {
+						t.Errorf("resized in pool %s, want %s", pID, poolID)
+					}
+					// The resize is replayed under the saga id, then undone
+					// under another one.
+					if reservationID == tt.saga.ID {
+						if current.CPUCores != 1 || target.CPUCores != 4 {
+							t.Errorf("replayed %d cores to %d, want 1 to 4", current.CPUCores, target.CPUCores)
+						}
+						return pID, tt.returnErr
+					}
+					if current.CPUCores != 4 || target.CPUCores != 1 {
+						t.Errorf("reverted %d cores to %d, want 4 to 1", current.CPUCores, target.CPUCores)
+					}
+					return pID, nil
+				},
+			}
+
+			cfg := server.Config{SagaTimeout: time.Minute, SagaRetryDelay: time.Second, SagaMaxRetryDelay: time.Minute}
+			bus := server.NewBusiness(cfg, &mockStorer{}, sagas, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, rm, &mockNotifier{})
+
+			finished, err := bus.ResumeSagas(ctx, 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if finished != tt.wantFinished {
+				t.Errorf("finished: got %d, want %d", finished, tt.wantFinished)
+			}
+			if last.State != tt.wantState {
+				t.Errorf("saga state: got %s, want %s", last.State, tt.wantState)
+			}
+		})
+	}
+}
+
+func Test_Resize(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	oldPlanID := uuid.New()
+	newPlanID := uuid.New()
+	oldPoolID := uuid.New()
+	newPoolID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name      string
+		status    server.ServerStatus
+		planID    uuid.UUID
+		samePool  bool
+		resizeErr error
+		updateErr error
+		revertErr error
+
+		wantErr       error
+		wantNotified  bool
+		wantReverted  bool
+		wantMoved     bool
+		wantSagaState server.SagaState
+	}
+
+	table := []testCase{
+		{
+			name:          "success",
+			status:        server.StatusStopped,
+			planID:        newPlanID,
+			wantNotified:  true,
+			wantSagaState: server.SagaCompleted,
+		},
+		{
+			name:    "fail_running",
+			status:  server.StatusRunning,
+			planID:  newPlanID,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_same_plan",
+			status:  server.StatusStopped,
+			planID:  oldPlanID,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_plan_not_found",
+			status:  server.StatusStopped,
+			planID:  uuid.New(),
+			wantErr: server.ErrInvalidPlan,
+		},
+		{
+			name:          "fail_no_resources",
+			status:        server.StatusStopped,
+			planID:        newPlanID,
+			resizeErr:     server.ErrNoResources,
+			wantErr:       server.ErrNoResources,
+			wantSagaState: server.SagaAborted,
+		},
+		{
+			name:          "fail_update_reverted_same_pool",
+			status:        server.StatusStopped,
+			planID:        newPlanID,
+			samePool:      true,
+			updateErr:     errBoom,
+			wantErr:       errBoom,
+			wantReverted:  true,
+			wantSagaState: server.SagaCompensated,
+		},
+		{
+			name:          "fail_update_reverted_moved",
+			status:        server.StatusStopped,
+			planID:        newPlanID,
+			updateErr:     errBoom,
+			wantErr:       errBoom,
+			wantNotified:  true,
+			wantReverted:  true,
+			wantMoved:     true,
+			wantSagaState: server.SagaCompensated,
+		},
+		{
+			name:          "fail_update_revert_retried",
+			status:        server.StatusStopped,
+			planID:        newPlanID,
+			updateErr:     errBoom,
+			revertErr:     errBoom,
+			wantErr:       errBoom,
+			wantReverted:  true,
+			wantSagaState: server.SagaReturning,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var notified, reverted, moved bool
+			var sagaID uuid.UUID
+			var last server.Saga
+
+			resizedIn := newPoolID
+			if tt.samePool {
+				resizedIn = oldPoolID
+			}
+
+			sagas := &mockSagaStorer{
+				CreateFunc: func(ctx context.Context, saga server.Saga) error {
+					if saga.Kind != server.SagaResize || saga.State != server.SagaStarted {
+						t.Errorf("expected a started resize saga, got %s %s", saga.Kind, saga.State)
+					}
+					if saga.PoolID == nil || *saga.PoolID != oldPoolID {
+						t.Errorf("saga pool: got %v, want %s", saga.PoolID, oldPoolID)
+					}
+					sagaID = saga.ID
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, saga server.Saga, from server.SagaState) error {
+					last = saga
+					return nil
+				},
+			}
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.status, PlanID: oldPlanID, PoolID: oldPoolID, OwnerID: userID}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					if s.PlanID == newPlanID {
+						if s.PoolID != resizedIn {
+							t.Errorf("resized server stored in pool %s, want %s", s.PoolID, resizedIn)
+						}
+						return tt.updateErr
+					}
+					if s.PoolID != newPoolID {
+						t.Errorf("reverted server moved to pool %s, want %s", s.PoolID, newPoolID)
+					}
+					moved = true
+					return nil
+				},
+			}
+
+			pf := &mockPlanFinder{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+					switch ID {
+					case oldPlanID:
+						return plan.Plan{ID: ID, CPUCores: 1}, nil
+					case newPlanID:
+						return plan.Plan{ID: ID, CPUCores: 4}, nil
+					}
+					return plan.Plan{}, plan.ErrPlanNotFound
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ResizeFunc: func(ctx context.Context, reservationID uuid.UUID, current server.Resources, target server.Resources, poolID uuid.UUID) (uuid.UUID, error) {
+					if reservationID == sagaID {
+						if current.CPUCores != 1 || target.CPUCores != 4 || poolID != oldPoolID {
+							t.Errorf("resized %d cores to %d in pool %s, want 1 to 4 in %s", current.CPUCores, target.CPUCores, poolID, oldPoolID)
+						}
+						return resizedIn, tt.resizeErr
+					}
+					if poolID != resizedIn {
+						t.Errorf("reverted in pool %s, want %s", poolID, resizedIn)
+					}
+					reverted = current.CPUCores == 4 && target.CPUCores == 1
+					return poolID, tt.revertErr
+				},
+			}
+
+			notifier := &mockNotifier{
+				ServerUpdatedFunc: func(ctx context.Context, s server.Server) error {
+					notified = true
+					return nil
+				},
+			}
+
+			cfg := server.Config{SagaRetryDelay: time.Second, SagaMaxRetryDelay: time.Minute}
+			bus := server.NewBusiness(cfg, st, sagas, &mockHistoryStorer{}, &mockTransactor{}, pf, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, rm, notifier)
+
+			got, err := bus.Resize(ctx, uuid.New(), tt.planID, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.PlanID != newPlanID {
+					t.Errorf("plan: got %s, want %s", got.PlanID, newPlanID)
+				}
+			}
+
+			if notified != tt.wantNotified {
+				t.Errorf("notified: got %v, want %v", notified, tt.wantNotified)
+			}
+			if reverted != tt.wantReverted {
+				t.Errorf("reverted: got %v, want %v", reverted, tt.wantReverted)
+			}
+			if moved != tt.wantMoved {
+				t.Errorf("server row moved: got %v, want %v", moved, tt.wantMoved)
+			}
+			if last.State != tt.wantSagaState {
+				t.Errorf("saga state: got %s, want %s", last.State, tt.wantSagaState)
+			}
+		})
+	}
+}
+
+func Test_Reboot(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name       string
+		initStatus server.ServerStatus
+		cmdErr     error
+
+		wantErr    error
+		wantAction server.ActionType
+	}
+
+	table := []testCase{
+		{
+			name:       "success",
+			initStatus: server.StatusRunning,
+			wantAction: server.ActionReboot,
+		},
+		{
+			name:       "fail_stopped",
+			initStatus: server.StatusStopped,
+			wantErr:    server.ErrValidation,
+		},
+		{
+			name:       "fail_command",
+			initStatus: server.StatusRunning,
+			cmdErr:     errBoom,
+			wantErr:    errBoom,
+			wantAction: server.ActionReboot,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAction server.ActionType
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.initStatus, OwnerID: userID}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					if s.Status != server.StatusRebooting {
+						return fmt.Errorf("expected REBOOTING, got %s", s.Status)
+					}
+					return nil
+				},
+			}
+
+			prov := &mockProvisioner{
+				RequestPowerFunc: func(ctx context.Context, s server.Server, action server.ActionType) error {
+					gotAction = action
+					return tt.cmdErr
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, nil, &mockNotifier{})
+
+			got, err := bus.Reboot(ctx, uuid.New(), userID)
+
+			if gotAction != tt.wantAction {
+				t.Errorf("command action: got %q, want %q", gotAction, tt.wantAction)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != server.StatusRebooting {
+				t.Errorf("status: got %s, want %s", got.Status, server.StatusRebooting)
+			}
+		})
+	}
+}
+
+func Test_PowerResult(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		name       string
+		initStatus server.ServerStatus
+		action     server.ActionType
+		failed     bool
+
+		wantErr    error
+		wantStatus server.ServerStatus
+	}
+
+	table := []testCase{
+		{
+			name:       "start_completed",
+			initStatus: server.StatusStarting,
+			action:     server.ActionStart,
+			wantStatus: server.StatusRunning,
+		},
+		{
+			name:       "stop_completed",
+			initStatus: server.StatusStopping,
+			action:     server.ActionStop,
+			wantStatus: server.StatusStopped,
+		},
+		{
+			name:       "reboot_completed",
+			initStatus: server.StatusRebooting,
+			action:     server.ActionReboot,
+			wantStatus: server.StatusRunning,
+		},
+		{
+			name:       "start_failed",
+			initStatus: server.StatusStarting,
+			action:     server.ActionStart,
+			failed:     true,
+			wantStatus: server.StatusStopped,
+		},
+		{
+			name:       "stop_failed",
+			initStatus: server.StatusStopping,
+			action:     server.ActionStop,
+			failed:     true,
+			wantStatus: server.StatusRunning,
+		},
+		{
+			name:       "duplicate_completed",
+			initStatus: server.StatusRunning,
+			action:     server.ActionStart,
+		},
+		{
+			name:       "fail_unexpected_status",
+			initStatus: server.StatusPending,
+			action:     server.ActionStart,
+			wantErr:    server.ErrValidation,
+		},
+		{
+			name:       "fail_unknown_action",
+			initStatus: server.StatusStopped,
+			action:     server.ActionDelete,
+			wantErr:    server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotStatus server.ServerStatus
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.initStatus}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					gotStatus = s.Status
+					return nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+			var err error
+			if tt.failed {
+				err = bus.FailPowerAction(ctx, uuid.New(), tt.action)
+			} else {
+				err = bus.CompletePowerAction(ctx, uuid.New(), tt.action)
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotStatus != tt.wantStatus {
+				t.Errorf("status: got %q, want %q", gotStatus, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func Test_RetryProvision(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	reason := "no IP left"
+
+	type testCase struct {
+		name     string
+		status   server.ServerStatus
+		attempts int
+
+		wantErr      error
+		wantAttempts int
+	}
+
+	table := []testCase{
+		{
+			name:         "success",
+			status:       server.StatusProvisionFailed,
+			attempts:     1,
+			wantAttempts: 2,
+		},
+		{
+			name:     "fail_attempts_exhausted",
+			status:   server.StatusProvisionFailed,
+			attempts: 3,
+			wantErr:  server.ErrValidation,
+		},
+		{
+			name:     "fail_not_failed",
+			status:   server.StatusRunning,
+			attempts: 1,
+			wantErr:  server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested bool
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: tt.status, OwnerID: userID, ProvisionAttempts: tt.attempts, FailureReason: &reason}, nil
+				},
+			}
+
+			prov := &mockProvisioner{
+				RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
+					requested = true
+					return nil
+				},
+			}
+
+			cfg := server.Config{MaxProvisionAttempts: 3}
+			bus := server.NewBusiness(cfg, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, nil, &mockNotifier{})
+
+			got, err := bus.RetryProvision(ctx, uuid.New(), userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if requested {
+					t.Error("provisioning must not be requested")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.Status != server.StatusPending {
+				t.Errorf("status: got %s, want %s", got.Status, server.StatusPending)
+			}
+			if got.ProvisionAttempts != tt.wantAttempts {
+				t.Errorf("attempts: got %d, want %d", got.ProvisionAttempts, tt.wantAttempts)
+			}
+			if got.FailureReason != nil {
+				t.Errorf("expected failure reason to be cleared, got %q", *got.FailureReason)
+			}
+			if !requested {
+				t.Error("expected provisioning to be requested")
+			}
+		})
+	}
+}
+
+func Test_History(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	ip := "10.0.0.5"
+
+	t.Run("records_user_change", func(t *testing.T) {
+		var events []server.Event
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: userID}, nil
+			},
+		}
+		hist := &mockHistoryStorer{
+			CreateFunc: func(ctx context.Context, event server.Event) error {
+				events = append(events, event)
+				return nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, nil, &mockNotifier{})
+
+		if _, err := bus.Start(ctx, uuid.New(), userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events) != 1 {
+			t.Fatalf("events: got %d, want 1", len(events))
+		}
+		e := events[0]
+		if e.Actor != userID.String() {
+			t.Errorf("actor: got %q, want %q", e.Actor, userID.String())
+		}
+		if e.OldStatus == nil || *e.OldStatus != server.StatusStopped {
+			t.Errorf("old status: got %v, want %s", e.OldStatus, server.StatusStopped)
+		}
+		if e.NewStatus != server.StatusStarting {
+			t.Errorf("new status: got %s, want %s", e.NewStatus, server.StatusStarting)
+		}
+		if e.Reason == "" {
+			t.Error("expected a reason")
+		}
+	})
+
+	t.Run("records_system_change", func(t *testing.T) {
+		var events []server.Event
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusPending, OwnerID: userID}, nil
+			},
+		}
+		hist := &mockHistoryStorer{
+			CreateFunc: func(ctx context.Context, event server.Event) error {
+				events = append(events, event)
+				return nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		if err := bus.SetAddresses(ctx, uuid.New(), []server.Address{{Address: ip}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events) != 1 {
+			t.Fatalf("events: got %d, want 1", len(events))
+		}
+		if events[0].Actor != server.ActorSystem {
+			t.Errorf("actor: got %q, want %q", events[0].Actor, server.ActorSystem)
+		}
+		if events[0].NewStatus != server.StatusStopped {
+			t.Errorf("new status: got %s, want %s", events[0].NewStatus, server.StatusStopped)
+		}
+	})
+
+	t.Run("fail_not_owner", func(t *testing.T) {
+		var queried bool
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusRunning, OwnerID: uuid.New(), ProjectID: foreignProjectID}, nil
+			},
+		}
+		hist := &mockHistoryStorer{
+			FindByServerIDFunc: func(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]server.Event, int, error) {
+				queried = true
+				return nil, 0, nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		_, _, err := bus.History(ctx, uuid.New(), page.Parse(1, 10), userID)
+		if !errors.Is(err, server.ErrAccessDenied) {
+			t.Errorf("got error %v, want %v", err, server.ErrAccessDenied)
+		}
+		if queried {
+			t.Error("history must not be queried for a foreign server")
+		}
+	})
+
+	t.Run("deleted_server", func(t *testing.T) {
+		projectID := uuid.New()
+
+		tests := []struct {
+			name      string
+			projectID uuid.UUID
+			wantErr   error
+		}{
+			{name: "member_reads", projectID: projectID},
+			{name: "fail_foreign", projectID: foreignProjectID, wantErr: server.ErrAccessDenied},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				st := &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{}, server.ErrServerNotFound
+					},
+				}
+				hist := &mockHistoryStorer{
+					FindProjectIDFunc: func(ctx context.Context, serverID uuid.UUID) (uuid.UUID, error) {
+						return tt.projectID, nil
+					},
+					FindByServerIDFunc: func(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]server.Event, int, error) {
+						return []server.Event{{ServerID: serverID, NewStatus: server.StatusDeleted}}, 1, nil
+					},
+				}
+
+				bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+				events, _, err := bus.History(ctx, uuid.New(), page.Parse(1, 10), userID)
+				if tt.wantErr != nil {
+					if !errors.Is(err, tt.wantErr) {
+						t.Errorf("got error %v, want %v", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(events) != 1 {
+					t.Errorf("events: got %d, want 1", len(events))
+				}
+			})
+		}
+	})
+
+	t.Run("records_project", func(t *testing.T) {
+		projectID := uuid.New()
+		var events []server.Event
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusDeleting, ProjectID: projectID}, nil
+			},
+		}
+		hist := &mockHistoryStorer{
+			CreateFunc: func(ctx context.Context, event server.Event) error {
+				events = append(events, event)
+				return nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, &mockResourcesManager{}, &mockNotifier{})
+
+		if err := bus.CompleteDeprovision(ctx, uuid.New()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events) != 1 || events[0].ProjectID != projectID {
+			t.Errorf("events: got %+v, want one event in project %s", events, projectID)
+		}
+	})
+}
+
+func Test_UsageMeter(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	ip := "10.0.0.5"
+
+	t.Run("sees_transition", func(t *testing.T) {
+		var calls int
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusPending, OwnerID: userID}, nil
+			},
+		}
+		meter := &mockUsageMeter{
+			RecordFunc: func(ctx context.Context, before *server.Server, after *server.Server) error {
+				calls++
+				if before == nil || before.Status != server.StatusPending {
+					t.Errorf("before: got %v, want %s", before, server.StatusPending)
+				}
+				if after == nil || after.Status != server.StatusStopped {
+					t.Errorf("after: got %v, want %s", after, server.StatusStopped)
+				}
+				return nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, meter, nil, nil, &mockNotifier{})
+
+		if err := bus.SetAddresses(ctx, uuid.New(), []server.Address{{Address: ip}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("meter calls: got %d, want 1", calls)
+		}
+	})
+
+	t.Run("fail_meter", func(t *testing.T) {
+		meterErr := errors.New("meter down")
+		var recorded bool
+
+		st := &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: userID}, nil
+			},
+		}
+		hist := &mockHistoryStorer{
+			CreateFunc: func(ctx context.Context, event server.Event) error {
+				recorded = true
+				return nil
+			},
+		}
+		meter := &mockUsageMeter{
+			RecordFunc: func(ctx context.Context, before *server.Server, after *server.Server) error {
+				return meterErr
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, meter, &mockProvisioner{}, nil, &mockNotifier{})
+
+		if _, err := bus.Start(ctx, uuid.New(), userID); !errors.Is(err, meterErr) {
+			t.Errorf("got error %v, want %v", err, meterErr)
+		}
+		if recorded {
+			t.Error("history must not be recorded when the meter fails")
+		}
+	})
+}
+
+func Test_Search(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	projectID := uuid.New()
+
+	unknown := server.ServerStatus("UNKNOWN")
+	running := server.StatusRunning
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	type testCase struct {
+		name   string
+		filter server.QueryFilter
+
+		wantErr error
+	}
+
+	table := []testCase{
+		{
+			name:   "success",
+			filter: server.QueryFilter{Status: &running},
+		},
+		{
+			name:    "fail_unknown_status",
+			filter:  server.QueryFilter{Status: &unknown},
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_inverted_range",
+			filter:  server.QueryFilter{StartCreatedAt: &from, EndCreatedAt: &to},
+			wantErr: server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter server.QueryFilter
+			var queried bool
+
+			st := &mockStorer{
+				FindAllFunc: func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error) {
+					queried = true
+					gotFilter = filter
+					return nil, 0, nil
+				},
+			}
+
+			projects := &mockProjectFinder{
+				ProjectIDsFunc: func(ctx context.Context, uID uuid.UUID) ([]uuid.UUID, error) {
+					return []uuid.UUID{projectID}, nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, projects, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+			_, _, err := bus.Search(ctx, tt.filter, server.DefaultOrderBy, page.Parse(1, 10), userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if queried {
+					t.Error("store must not be queried with an invalid filter")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotFilter.Status == nil || *gotFilter.Status != running {
+				t.Errorf("filter status: got %v, want %s", gotFilter.Status, running)
+			}
+			if len(gotFilter.ProjectIDs) != 1 || gotFilter.ProjectIDs[0] != projectID {
+				t.Errorf("filter projects: got %v, want [%s]", gotFilter.ProjectIDs, projectID)
+			}
+		})
+	}
+}
+
+func Test_NewOrderBy(t *testing.T) {
+	type testCase struct {
+		name      string
+		field     string
+		direction string
+
+		want    server.OrderBy
+		wantErr error
+	}
+
+	table := []testCase{
+		{
+			name: "default",
+			want: server.DefaultOrderBy,
+		},
+		{
+			name:      "success",
+			field:     server.OrderByName,
+			direction: "asc",
+			want:      server.OrderBy{Field: server.OrderByName, Direction: server.ASC},
+		},
+		{
+			name:    "fail_unknown_field",
+			field:   "owner_id",
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:      "fail_unknown_direction",
+			direction: "SIDEWAYS",
+			wantErr:   server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := server.NewOrderBy(tt.field, tt.direction)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_SearchByCursor(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	unknown := server.ServerStatus("UNKNOWN")
+
+	cur, err := page.ParseCursor(10, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		want := page.CursorDocument{PageSize: 10, HasNext: true}
+
+		st := &mockStorer{
+			FindAllByCursorFunc: func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, c page.Cursor) ([]page.Edge[server.Server], page.CursorDocument, error) {
+				if filter.ProjectIDs == nil {
+					t.Error("listing not scoped to the projects of the user")
+				}
+				return []page.Edge[server.Server]{{Cursor: "c1", Node: server.Server{OwnerID: userID}}}, want, nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		edges, doc, err := bus.SearchByCursor(ctx, server.QueryFilter{}, server.DefaultOrderBy, cur, userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(edges) != 1 || edges[0].Cursor != "c1" {
+			t.Errorf("edges: got %+v", edges)
+		}
+		if doc != want {
+			t.Errorf("document: got %+v, want %+v", doc, want)
+		}
+	})
+
+	t.Run("fail_invalid_filter", func(t *testing.T) {
+		st := &mockStorer{
+			FindAllByCursorFunc: func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, c page.Cursor) ([]page.Edge[server.Server], page.CursorDocument, error) {
+				t.Error("store must not be queried with an invalid filter")
+				return nil, page.CursorDocument{}, nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		_, _, err := bus.SearchByCursor(ctx, server.QueryFilter{Status: &unknown}, server.DefaultOrderBy, cur, userID)
+		if !errors.Is(err, server.ErrValidation) {
+			t.Errorf("got error %v, want %v", err, server.ErrValidation)
+		}
+	})
+}
+
+func Test_ConflictRetry(t *testing.T) {
+	ctx := context.Background()
+	ip := "10.0.0.7"
+
+	type testCase struct {
+		name      string
+		conflicts int
+		retries   int
+
+		wantErr     error
+		wantUpdates int
+	}
+
+	table := []testCase{
+		{
+			name:        "success_after_retry",
+			conflicts:   2,
+			retries:     3,
+			wantUpdates: 3,
+		},
+		{
+			name:        "fail_retries_exhausted",
+			conflicts:   5,
+			retries:     2,
+			wantErr:     server.ErrConflict,
+			wantUpdates: 3,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates int
+
 			st := &mockStorer{
 				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
-					return server.Server{ID: ID, Status: tt.status, PlanID: planID, OwnerID: userID}, nil
+					return server.Server{ID: ID, Status: server.StatusPending, Version: 1 + updates}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					updates++
+					if updates <= tt.conflicts {
+						return server.ErrConflict
+					}
+					return nil
 				},
-				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error { return nil },
 			}
 
-			pf := &mockPlanFinder{
-				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
-					return plan.Plan{ID: ID}, nil
+			cfg := server.Config{ConflictRetries: tt.retries}
+			bus := server.NewBusiness(cfg, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+			err := bus.SetAddresses(ctx, uuid.New(), []server.Address{{Address: ip}})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if updates != tt.wantUpdates {
+				t.Errorf("updates: got %d, want %d", updates, tt.wantUpdates)
+			}
+		})
+	}
+}
+
+func Test_ExpectedVersion(t *testing.T) {
+	userID := uuid.New()
+
+	type testCase struct {
+		name     string
+		expected *int
+
+		wantErr     error
+		wantVersion int
+	}
+
+	match, stale := 4, 3
+
+	table := []testCase{
+		{
+			name:        "success_without_expectation",
+			wantVersion: 5,
+		},
+		{
+			name:        "success_matching_version",
+			expected:    &match,
+			wantVersion: 5,
+		},
+		{
+			name:     "fail_stale_version",
+			expected: &stale,
+			wantErr:  server.ErrConflict,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated bool
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, Status: server.StatusStopped, OwnerID: userID, Version: 4}, nil
+				},
+				UpdateFunc: func(ctx context.Context, s server.Server) error {
+					updated = true
+					return nil
 				},
 			}
 
-			rm := &mockResourcesManager{
-				ReturnFunc: func(ctx context.Context, r server.Resources, poolID uuid.UUID) error {
+			ctx := context.Background()
+			if tt.expected != nil {
+				ctx = server.WithExpectedVersion(ctx, *tt.expected)
+			}
+
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, nil, &mockNotifier{})
+
+			got, err := bus.Start(ctx, uuid.New(), userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if updated {
+					t.Error("server must not be updated on a version mismatch")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Version != tt.wantVersion {
+				t.Errorf("version: got %d, want %d", got.Version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func Test_Quota(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	small := plan.Plan{ID: uuid.New(), CPUCores: 2, RAMMB: 2048, DiskGB: 20, IpCount: 1}
+	large := plan.Plan{ID: uuid.New(), CPUCores: 8, RAMMB: 8192, DiskGB: 80, IpCount: 1}
+
+	pf := &mockPlanFinder{
+		FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+			if ID == large.ID {
+				return large, nil
+			}
+			return small, nil
+		},
+	}
+
+	quotas := func(limits quota.Limits, usage quota.Usage) *mockQuotaFinder {
+		return &mockQuotaFinder{
+			GetFunc: func(ctx context.Context, ID uuid.UUID) (quota.Quota, error) {
+				if ID != userID {
+					return quota.Quota{}, fmt.Errorf("expected quota of %s, got %s", userID, ID)
+				}
+				return quota.Quota{UserID: ID, Limits: limits, Usage: usage}, nil
+			},
+		}
+	}
+
+	limits := quota.Limits{Servers: 2, CPUCores: 8, RAMMB: 8192, DiskGB: 100, IPCount: 2}
+
+	t.Run("create", func(t *testing.T) {
+		type testCase struct {
+			name     string
+			usage    quota.Usage
+			wantErr  error
+			consumed bool
+		}
+
+		table := []testCase{
+			{name: "within_quota", usage: quota.Usage{Servers: 1, CPUCores: 2, RAMMB: 2048, DiskGB: 20, IPCount: 1}, consumed: true},
+			{name: "server_limit", usage: quota.Usage{Servers: 2, CPUCores: 4, RAMMB: 4096, DiskGB: 40, IPCount: 1}, wantErr: server.ErrQuotaExceeded},
+			{name: "cpu_limit", usage: quota.Usage{Servers: 1, CPUCores: 7}, wantErr: server.ErrQuotaExceeded},
+		}
+
+		for _, tt := range table {
+			t.Run(tt.name, func(t *testing.T) {
+				consumed := false
+				rm := &mockResourcesManager{
+					ConsumeFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, placement server.Placement) (server.Reservation, error) {
+						consumed = true
+						return server.Reservation{PoolID: uuid.New()}, nil
+					},
+				}
+
+				bus := server.NewBusiness(server.Config{}, &mockStorer{}, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, pf, quotas(limits, tt.usage), &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, rm, &mockNotifier{})
+
+				_, err := bus.Create(ctx, "web", small.ID, nil, server.Placement{}, nil, userID)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if consumed != tt.consumed {
+					t.Errorf("expected consumed %v, got %v", tt.consumed, consumed)
+				}
+			})
+		}
+	})
+
+	t.Run("locked_with_order", func(t *testing.T) {
+		var calls []string
+
+		q := quotas(limits, quota.Usage{})
+		get := q.GetFunc
+		q.GetFunc = func(ctx context.Context, ID uuid.UUID) (quota.Quota, error) {
+			calls = append(calls, "get")
+			return get(ctx, ID)
+		}
+		q.LockFunc = func(ctx context.Context, ID uuid.UUID) error {
+			calls = append(calls, "lock")
+			return nil
+		}
+
+		sagas := &mockSagaStorer{
+			CreateFunc: func(ctx context.Context, saga server.Saga) error {
+				calls = append(calls, "saga")
+				if saga.UserID == nil || *saga.UserID != userID {
+					t.Errorf("saga user: got %v, want %s", saga.UserID, userID)
+				}
+				return nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, &mockStorer{}, sagas, &mockHistoryStorer{}, &mockTransactor{}, pf, q, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, &mockResourcesManager{}, &mockNotifier{})
+
+		if _, err := bus.Create(ctx, "web", small.ID, nil, server.Placement{}, nil, userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []string{"lock", "get", "saga"}
+		if !slices.Equal(calls, want) {
+			t.Errorf("calls: got %v, want %v", calls, want)
+		}
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		type testCase struct {
+			name    string
+			from    plan.Plan
+			to      plan.Plan
+			usage   quota.Usage
+			wantErr error
+		}
+
+		table := []testCase{
+			{name: "grow_within_quota", from: small, to: large, usage: quota.Usage{Servers: 1, CPUCores: 2, RAMMB: 2048, DiskGB: 20, IPCount: 1}},
+			{name: "grow_over_quota", from: small, to: large, usage: quota.Usage{Servers: 2, CPUCores: 4, RAMMB: 4096, DiskGB: 40, IPCount: 2}, wantErr: server.ErrQuotaExceeded},
+			{name: "shrink_over_quota", from: large, to: small, usage: quota.Usage{Servers: 3, CPUCores: 16, RAMMB: 16384, DiskGB: 160, IPCount: 3}},
+		}
+
+		for _, tt := range table {
+			t.Run(tt.name, func(t *testing.T) {
+				st := &mockStorer{
+					FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+						return server.Server{ID: ID, OwnerID: userID, PlanID: tt.from.ID, PoolID: uuid.New(), Status: server.StatusStopped, Version: 1}, nil
+					},
+				}
+
+				bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, pf, quotas(limits, tt.usage), &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, &mockResourcesManager{}, &mockNotifier{})
+
+				_, err := bus.Resize(ctx, uuid.New(), tt.to.ID, userID)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+}
+
+func Test_Admin(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+
+	adminCtx := server.WithAdmin(ctx, auth.Claims{UserID: adminID, IsAdmin: true})
+	userCtx := server.WithAdmin(ctx, auth.Claims{UserID: adminID})
+
+	newStorer := func(status server.ServerStatus) *mockStorer {
+		return &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				return server.Server{ID: ID, OwnerID: ownerID, ProjectID: foreignProjectID, Status: status, Version: 1}, nil
+			},
+		}
+	}
+
+	t.Run("ownership", func(t *testing.T) {
+		bus := server.NewBusiness(server.Config{}, newStorer(server.StatusRunning), &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		if _, err := bus.FindByID(userCtx, uuid.New(), adminID); !errors.Is(err, server.ErrAccessDenied) {
+			t.Errorf("non-admin claims: got error %v, want %v", err, server.ErrAccessDenied)
+		}
+		if _, err := bus.FindByID(adminCtx, uuid.New(), adminID); err != nil {
+			t.Errorf("admin claims: unexpected error %v", err)
+		}
+	})
+
+	t.Run("search_all_owners", func(t *testing.T) {
+		var gotFilter server.QueryFilter
+		st := &mockStorer{
+			FindAllFunc: func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error) {
+				gotFilter = filter
+				return nil, 0, nil
+			},
+		}
+
+		bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, nil, nil, &mockNotifier{})
+
+		if _, _, err := bus.Search(adminCtx, server.QueryFilter{}, server.DefaultOrderBy, page.Parse(1, 10), adminID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotFilter.OwnerID != nil {
+			t.Errorf("owner filter: got %s, want none", gotFilter.OwnerID)
+		}
+
+		if _, _, err := bus.Search(adminCtx, server.QueryFilter{OwnerID: &ownerID}, server.DefaultOrderBy, page.Parse(1, 10), adminID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotFilter.OwnerID == nil || *gotFilter.OwnerID != ownerID {
+			t.Errorf("owner filter: got %v, want %s", gotFilter.OwnerID, ownerID)
+		}
+	})
+
+	type testCase struct {
+		name       string
+		ctx        context.Context
+		status     server.ServerStatus
+		run        func(bus server.ExtBusiness, ctx context.Context) (server.Server, error)
+		wantErr    error
+		wantStatus server.ServerStatus
+		wantCmd    string
+	}
+
+	forceStop := func(bus server.ExtBusiness, ctx context.Context) (server.Server, error) {
+		return bus.ForceStop(ctx, uuid.New())
+	}
+	forceDelete := func(bus server.ExtBusiness, ctx context.Context) (server.Server, error) {
+		return bus.ForceDelete(ctx, uuid.New())
+	}
+	resetProvision := func(bus server.ExtBusiness, ctx context.Context) (server.Server, error) {
+		return bus.ResetProvision(ctx, uuid.New())
+	}
+
+	table := []testCase{
+		{name: "force_stop_starting", ctx: adminCtx, status: server.StatusStarting, run: forceStop, wantStatus: server.StatusStopping, wantCmd: "power"},
+		{name: "force_stop_stopped", ctx: adminCtx, status: server.StatusStopped, run: forceStop, wantErr: server.ErrValidation},
+		{name: "force_stop_not_admin", ctx: userCtx, status: server.StatusRunning, run: forceStop, wantErr: server.ErrAccessDenied},
+		{name: "force_delete_pending", ctx: adminCtx, status: server.StatusPending, run: forceDelete, wantStatus: server.StatusDeleting, wantCmd: "deprovision"},
+		{name: "force_delete_deleting", ctx: adminCtx, status: server.StatusDeleting, run: forceDelete, wantErr: server.ErrValidation},
+		{name: "reset_pending", ctx: adminCtx, status: server.StatusPending, run: resetProvision, wantStatus: server.StatusPending, wantCmd: "ip"},
+		{name: "reset_running", ctx: adminCtx, status: server.StatusRunning, run: resetProvision, wantErr: server.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var cmd string
+			var actors []string
+
+			prov := &mockProvisioner{
+				RequestIPFunc: func(ctx context.Context, s server.Server, ipCount int) error {
+					cmd = "ip"
 					return nil
 				},
+				RequestPowerFunc: func(ctx context.Context, s server.Server, action server.ActionType) error {
+					cmd = "power"
+					return nil
+				},
+				RequestDeprovisionFunc: func(ctx context.Context, s server.Server) error {
+					cmd = "deprovision"
+					return nil
+				},
+			}
+			hist := &mockHistoryStorer{
+				CreateFunc: func(ctx context.Context, event server.Event) error {
+					actors = append(actors, event.Actor)
+					return nil
+				},
+			}
+
+			bus := server.NewBusiness(server.Config{}, newStorer(tt.status), &mockSagaStorer{}, hist, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, prov, nil, &mockNotifier{})
+
+			got, err := tt.run(bus, tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if cmd != "" {
+					t.Errorf("no command expected, got %s", cmd)
+				}
+				return
+			}
+
+			if got.Status != tt.wantStatus {
+				t.Errorf("status: got %s, want %s", got.Status, tt.wantStatus)
+			}
+			if cmd != tt.wantCmd {
+				t.Errorf("command: got %q, want %q", cmd, tt.wantCmd)
+			}
+			if len(actors) != 1 || actors[0] != "admin:"+adminID.String() {
+				t.Errorf("history actors: got %v, want admin:%s", actors, adminID)
+			}
+		})
+	}
+}
+
+func Test_BulkAction(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	stoppedID := uuid.New()
+	runningID := uuid.New()
+	foreignID := uuid.New()
+	missingID := uuid.New()
+
+	servers := map[uuid.UUID]server.Server{
+		stoppedID: {ID: stoppedID, Status: server.StatusStopped, OwnerID: userID},
+		runningID: {ID: runningID, Status: server.StatusRunning, OwnerID: userID},
+		foreignID: {ID: foreignID, Status: server.StatusStopped, OwnerID: uuid.New(), ProjectID: foreignProjectID},
+	}
+
+	newStorer := func(count int) *mockStorer {
+		return &mockStorer{
+			FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+				s, ok := servers[ID]
+				if !ok {
+					return server.Server{}, server.ErrServerNotFound
+				}
+				return s, nil
+			},
+			FindAllFunc: func(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error) {
+				if filter.ProjectIDs == nil {
+					return nil, 0, fmt.Errorf("filter not scoped to the user")
+				}
+				return []server.Server{servers[stoppedID], servers[runningID]}, count, nil
+			},
+		}
+	}
+
+	stopped := server.StatusStopped
+
+	type testCase struct {
+		name   string
+		target server.BulkTarget
+		action server.ActionType
+		planID *uuid.UUID
+		count  int
+
+		wantErr     error
+		wantResults []error
+	}
+
+	table := []testCase{
+		{
+			name:        "per_server_results",
+			target:      server.BulkTarget{ServerIDs: []uuid.UUID{stoppedID, runningID, foreignID, missingID, stoppedID}},
+			action:      server.ActionStart,
+			wantResults: []error{nil, server.ErrValidation, server.ErrAccessDenied, server.ErrServerNotFound},
+		},
+		{
+			name:        "filter",
+			target:      server.BulkTarget{Filter: &server.QueryFilter{Status: &stopped}},
+			action:      server.ActionStop,
+			count:       2,
+			wantResults: []error{server.ErrValidation, nil},
+		},
+		{
+			name:    "fail_filter_matches_too_many",
+			target:  server.BulkTarget{Filter: &server.QueryFilter{}},
+			action:  server.ActionStop,
+			count:   server.MaxBulkServers + 1,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_ids_and_filter",
+			target:  server.BulkTarget{ServerIDs: []uuid.UUID{stoppedID}, Filter: &server.QueryFilter{}},
+			action:  server.ActionStart,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_no_target",
+			action:  server.ActionStart,
+			wantErr: server.ErrValidation,
+		},
+		{
+			name:    "fail_resize_without_plan",
+			target:  server.BulkTarget{ServerIDs: []uuid.UUID{stoppedID}},
+			action:  server.ActionResize,
+			wantErr: server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := server.NewBusiness(server.Config{}, newStorer(tt.count), &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, &mockProjectFinder{}, &mockUsageMeter{}, &mockProvisioner{}, nil, &mockNotifier{})
+
+			results, err := bus.BulkAction(ctx, tt.target, tt.action, tt.planID, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(results) != len(tt.wantResults) {
+				t.Fatalf("got %d results, want %d", len(results), len(tt.wantResults))
+			}
+			for i, want := range tt.wantResults {
+				got := results[i].Err
+				if want == nil && got != nil {
+					t.Errorf("result %d: unexpected error: %v", i, got)
+				}
+				if want != nil && !errors.Is(got, want) {
+					t.Errorf("result %d: got error %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_Permissions(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	projectID := uuid.New()
+
+	findByID := func(bus server.ExtBusiness) (server.Server, error) {
+		return bus.FindByID(ctx, uuid.New(), userID)
+	}
+	start := func(bus server.ExtBusiness) (server.Server, error) {
+		return bus.Start(ctx, uuid.New(), userID)
+	}
+	del := func(bus server.ExtBusiness) (server.Server, error) {
+		return bus.Delete(ctx, uuid.New(), userID)
+	}
+
+	type testCase struct {
+		name    string
+		role    project.Role
+		run     func(bus server.ExtBusiness) (server.Server, error)
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "viewer_find", role: project.RoleViewer, run: findByID},
+		{name: "viewer_start", role: project.RoleViewer, run: start, wantErr: server.ErrAccessDenied},
+		{name: "operator_start", role: project.RoleOperator, run: start},
+		{name: "operator_delete", role: project.RoleOperator, run: del, wantErr: server.ErrAccessDenied},
+		{name: "admin_delete", role: project.RoleAdmin, run: del},
+		{name: "owner_delete", role: project.RoleOwner, run: del},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: ID, OwnerID: uuid.New(), ProjectID: projectID, PlanID: uuid.New(), Status: server.StatusStopped, Version: 1}, nil
+				},
+			}
+			projects := &mockProjectFinder{
+				RoleFunc: func(ctx context.Context, pID uuid.UUID, uID uuid.UUID) (project.Role, error) {
+					if pID != projectID || uID != userID {
+						t.Errorf("role asked for user %s in project %s", uID, pID)
+					}
+					return tt.role, nil
+				},
 			}
 
-			bus := server.NewBusiness(st, pf, nil, rm, &mockNotifier{})
+			bus := server.NewBusiness(server.Config{}, st, &mockSagaStorer{}, &mockHistoryStorer{}, &mockTransactor{}, &mockPlanFinder{}, &mockQuotaFinder{}, &mockKeyFinder{}, projects, &mockUsageMeter{}, &mockProvisioner{}, &mockResourcesManager{}, &mockNotifier{})
 
-			_, err := bus.Delete(ctx, srvID, userID)
+			_, err := tt.run(bus)
 
 			if tt.wantErr != nil {
 				if !errors.Is(err, tt.wantErr) {
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"hosting-service/internal/project"
+
+	"github.com/google/uuid"
+)
+
+// BeginSnapshotRestore moves a stopped server to RESTORING so it cannot be
+// started while a snapshot is written to its disk. The caller queues the
+// restore command in the same transaction.
+func (s *Business) BeginSnapshotRestore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (Server, error) {
+	ctx = withChange(ctx, userActor(ctx, userID), "snapshot restore requested")
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return Server{}, fmt.Errorf("beginsnapshotrestore: %w", err)
+	}
+
+	if err := s.authorize(ctx, server, userID, project.PermManage); err != nil {
+		return Server{}, err
+	}
+
+	if err := checkVersion(ctx, server); err != nil {
+		return Server{}, err
+	}
+
+	if server.Status != StatusStopped {
+		return Server{}, fmt.Errorf("%w: cannot restore a snapshot to server with status '%s', expected STOPPED", ErrValidation, server.Status)
+	}
+
+	server.Status = StatusRestoring
+
+	err = s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("beginsnapshotrestore: %w", err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("beginsnapshotrestore: notifier.serverupdated: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+// EndSnapshotRestore returns a RESTORING server to STOPPED once the restore
+// finished or failed. A server that is no longer restoring is left as is.
+func (s *Business) EndSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error {
+	return s.retryOnConflict(func() error {
+		return s.endSnapshotRestore(ctx, serverID, reason)
+	})
+}
+
+func (s *Business) endSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error {
+	ctx = withChange(ctx, ActorSystem, reason)
+
+	server, err := s.storer.FindByID(ctx, serverID)
+	if err != nil {
+		return fmt.Errorf("endsnapshotrestore: %w", err)
+	}
+
+	if server.Status != StatusRestoring {
+		return nil
+	}
+
+	server.Status = StatusStopped
+
+	return s.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := s.storer.Update(ctx, server); err != nil {
+			return fmt.Errorf("endsnapshotrestore: %w", err)
+		}
+		server.Version++
+
+		if err := s.notifier.ServerUpdated(ctx, server); err != nil {
+			return fmt.Errorf("endsnapshotrestore: notifier.serverupdated: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,119 @@
+package historydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/server"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, event server.Event) error {
+	const q = `
+	INSERT INTO server_events
+		(id, server_id, project_id, actor, old_status, new_status, reason, created_at)
+	VALUES
+		(@id, @server_id, @project_id, @actor, @old_status, @new_status, @reason, @created_at)`
+
+	dbEvent := toDBEvent(event)
+
+	args := pgx.NamedArgs{
+		"id":         dbEvent.ID,
+		"server_id":  dbEvent.ServerID,
+		"project_id": dbEvent.ProjectID,
+		"actor":      dbEvent.Actor,
+		"old_status": dbEvent.OldStatus,
+		"new_status": dbEvent.NewStatus,
+		"reason":     dbEvent.Reason,
+		"created_at": dbEvent.CreatedAt,
+	}
+
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindByServerID(ctx context.Context, serverID uuid.UUID, pg page.Page) ([]server.Event, int, error) {
+	const qCount = `SELECT count(*) FROM server_events WHERE server_id = @server_id`
+
+	var total int
+	argsCount := pgx.NamedArgs{"server_id": serverID}
+	err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, argsCount).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		id, server_id, project_id, actor, old_status, new_status, reason, created_at
+	FROM
+		server_events
+	WHERE
+		server_id = @server_id
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args := pgx.NamedArgs{
+		"limit":     pg.Size(),
+		"offset":    pg.Offset(),
+		"server_id": serverID,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbEvents, err := pgx.CollectRows(rows, pgx.RowToStructByName[eventDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusEvents(dbEvents), total, nil
+}
+
+// FindProjectID returns the project of the latest event of a server, which
+// outlives the server row. A server without events is not found.
+func (s *Store) FindProjectID(ctx context.Context, serverID uuid.UUID) (uuid.UUID, error) {
+	const q = `
+	SELECT
+		project_id
+	FROM
+		server_events
+	WHERE
+		server_id = @server_id AND project_id IS NOT NULL
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT 1`
+
+	var projectID uuid.UUID
+	err := database.Conn(ctx, s.db).QueryRow(ctx, q, pgx.NamedArgs{"server_id": serverID}).Scan(&projectID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, server.ErrServerNotFound
+		}
+		return uuid.Nil, fmt.Errorf("db: %w", err)
+	}
+
+	return projectID, nil
+}
@@ -0,0 +1,70 @@
+package historydb
+
+import (
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type eventDB struct {
+	ID        uuid.UUID  `db:"id"`
+	ServerID  uuid.UUID  `db:"server_id"`
+	ProjectID *uuid.UUID `db:"project_id"`
+	Actor     string     `db:"actor"`
+	OldStatus *string    `db:"old_status"`
+	NewStatus string     `db:"new_status"`
+	Reason    string     `db:"reason"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func toDBEvent(e server.Event) eventDB {
+	var oldStatus *string
+	if e.OldStatus != nil {
+		s := string(*e.OldStatus)
+		oldStatus = &s
+	}
+
+	return eventDB{
+		ID:        e.ID,
+		ServerID:  e.ServerID,
+		ProjectID: &e.ProjectID,
+		Actor:     e.Actor,
+		OldStatus: oldStatus,
+		NewStatus: string(e.NewStatus),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toBusEvent(db eventDB) server.Event {
+	var oldStatus *server.ServerStatus
+	if db.OldStatus != nil {
+		s := server.ServerStatus(*db.OldStatus)
+		oldStatus = &s
+	}
+
+	var projectID uuid.UUID
+	if db.ProjectID != nil {
+		projectID = *db.ProjectID
+	}
+
+	return server.Event{
+		ID:        db.ID,
+		ServerID:  db.ServerID,
+		ProjectID: projectID,
+		Actor:     db.Actor,
+		OldStatus: oldStatus,
+		NewStatus: server.ServerStatus(db.NewStatus),
+		Reason:    db.Reason,
+		CreatedAt: db.CreatedAt,
+	}
+}
+
+func toBusEvents(dbs []eventDB) []server.Event {
+	events := make([]server.Event, len(dbs))
+	for i, db := range dbs {
+		events[i] = toBusEvent(db)
+	}
+	return events
+}
@@ -0,0 +1,100 @@
+package sagadb
+
+import (
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type sagaDB struct {
+	ID            uuid.UUID  `db:"id"`
+	Kind          string     `db:"kind"`
+	State         string     `db:"state"`
+	ServerID      *uuid.UUID `db:"server_id"`
+	UserID        *uuid.UUID `db:"user_id"`
+	PoolID        *uuid.UUID `db:"pool_id"`
+	CPUCores      int        `db:"cpu_cores"`
+	RAMMB         int        `db:"ram_mb"`
+	DiskGB        int        `db:"disk_gb"`
+	IPCount       int        `db:"ip_count"`
+	ResizeCPU     *int       `db:"resize_cpu_cores"`
+	ResizeRAMMB   *int       `db:"resize_ram_mb"`
+	ResizeDiskGB  *int       `db:"resize_disk_gb"`
+	ResizeIPCount *int       `db:"resize_ip_count"`
+	Attempts      int        `db:"attempts"`
+	LastError     *string    `db:"last_error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+func toDBSaga(s server.Saga) sagaDB {
+	db := sagaDB{
+		ID:            s.ID,
+		Kind:          string(s.Kind),
+		State:         string(s.State),
+		ServerID:      s.ServerID,
+		UserID:        s.UserID,
+		PoolID:        s.PoolID,
+		CPUCores:      s.Resources.CPUCores,
+		RAMMB:         s.Resources.RAMMB,
+		DiskGB:        s.Resources.DiskGB,
+		IPCount:       s.Resources.IPCount,
+		Attempts:      s.Attempts,
+		LastError:     s.LastError,
+		NextAttemptAt: s.NextAttemptAt,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+
+	if s.ResizeTo != nil {
+		db.ResizeCPU = &s.ResizeTo.CPUCores
+		db.ResizeRAMMB = &s.ResizeTo.RAMMB
+		db.ResizeDiskGB = &s.ResizeTo.DiskGB
+		db.ResizeIPCount = &s.ResizeTo.IPCount
+	}
+
+	return db
+}
+
+func toBusSaga(db sagaDB) server.Saga {
+	saga := server.Saga{
+		ID:       db.ID,
+		Kind:     server.SagaKind(db.Kind),
+		State:    server.SagaState(db.State),
+		ServerID: db.ServerID,
+		UserID:   db.UserID,
+		PoolID:   db.PoolID,
+		Resources: server.Resources{
+			CPUCores: db.CPUCores,
+			RAMMB:    db.RAMMB,
+			DiskGB:   db.DiskGB,
+			IPCount:  db.IPCount,
+		},
+		Attempts:      db.Attempts,
+		LastError:     db.LastError,
+		NextAttemptAt: db.NextAttemptAt,
+		CreatedAt:     db.CreatedAt,
+		UpdatedAt:     db.UpdatedAt,
+	}
+
+	if db.ResizeCPU != nil {
+		saga.ResizeTo = &server.Resources{
+			CPUCores: *db.ResizeCPU,
+			RAMMB:    *db.ResizeRAMMB,
+			DiskGB:   *db.ResizeDiskGB,
+			IPCount:  *db.ResizeIPCount,
+		}
+	}
+
+	return saga
+}
+
+func toBusSagas(dbs []sagaDB) []server.Saga {
+	sagas := make([]server.Saga, len(dbs))
+	for i, db := range dbs {
+		sagas[i] = toBusSaga(db)
+	}
+	return sagas
+}
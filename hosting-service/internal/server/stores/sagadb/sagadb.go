@@ -0,0 +1,139 @@
+package sagadb
+
+import (
+	"context"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, saga server.Saga) error {
+	const q = `
+	INSERT INTO server_sagas
+		(id, kind, state, server_id, user_id, pool_id, cpu_cores, ram_mb, disk_gb, ip_count,
+		 resize_cpu_cores, resize_ram_mb, resize_disk_gb, resize_ip_count,
+		 attempts, last_error, next_attempt_at, created_at, updated_at)
+	VALUES
+		(@id, @kind, @state, @server_id, @user_id, @pool_id, @cpu_cores, @ram_mb, @disk_gb, @ip_count,
+		 @resize_cpu_cores, @resize_ram_mb, @resize_disk_gb, @resize_ip_count,
+		 @attempts, @last_error, @next_attempt_at, @created_at, @updated_at)`
+
+	dbSaga := toDBSaga(saga)
+
+	args := pgx.NamedArgs{
+		"id":               dbSaga.ID,
+		"kind":             dbSaga.Kind,
+		"state":            dbSaga.State,
+		"server_id":        dbSaga.ServerID,
+		"user_id":          dbSaga.UserID,
+		"pool_id":          dbSaga.PoolID,
+		"cpu_cores":        dbSaga.CPUCores,
+		"ram_mb":           dbSaga.RAMMB,
+		"disk_gb":          dbSaga.DiskGB,
+		"ip_count":         dbSaga.IPCount,
+		"resize_cpu_cores": dbSaga.ResizeCPU,
+		"resize_ram_mb":    dbSaga.ResizeRAMMB,
+		"resize_disk_gb":   dbSaga.ResizeDiskGB,
+		"resize_ip_count":  dbSaga.ResizeIPCount,
+		"attempts":         dbSaga.Attempts,
+		"last_error":       dbSaga.LastError,
+		"next_attempt_at":  dbSaga.NextAttemptAt,
+		"created_at":       dbSaga.CreatedAt,
+		"updated_at":       dbSaga.UpdatedAt,
+	}
+
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Update(ctx context.Context, saga server.Saga, from server.SagaState) error {
+	const q = `
+	UPDATE server_sagas
+	SET
+		state = @state,
+		server_id = @server_id,
+		pool_id = @pool_id,
+		attempts = @attempts,
+		last_error = @last_error,
+		next_attempt_at = @next_attempt_at,
+		updated_at = @updated_at
+	WHERE
+		id = @id AND state = @from`
+
+	dbSaga := toDBSaga(saga)
+
+	args := pgx.NamedArgs{
+		"id":              dbSaga.ID,
+		"state":           dbSaga.State,
+		"server_id":       dbSaga.ServerID,
+		"pool_id":         dbSaga.PoolID,
+		"attempts":        dbSaga.Attempts,
+		"last_error":      dbSaga.LastError,
+		"next_attempt_at": dbSaga.NextAttemptAt,
+		"updated_at":      dbSaga.UpdatedAt,
+		"from":            string(from),
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: saga %s is no longer %s", server.ErrSagaConflict, saga.ID, from)
+	}
+
+	return nil
+}
+
+func (s *Store) FindResumable(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]server.Saga, error) {
+	const q = `
+	SELECT
+		id, kind, state, server_id, user_id, pool_id, cpu_cores, ram_mb, disk_gb, ip_count,
+		resize_cpu_cores, resize_ram_mb, resize_disk_gb, resize_ip_count,
+		attempts, last_error, next_attempt_at, created_at, updated_at
+	FROM
+		server_sagas
+	WHERE
+		(state IN ('STARTED', 'RESERVED') AND updated_at <= @stale_before) OR
+		(state = 'RETURNING' AND next_attempt_at <= @now)
+	ORDER BY
+		next_attempt_at ASC
+	LIMIT
+		@limit
+	FOR UPDATE SKIP LOCKED`
+
+	args := pgx.NamedArgs{
+		"now":          now,
+		"stale_before": staleBefore,
+		"limit":        limit,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbSagas, err := pgx.CollectRows(rows, pgx.RowToStructByName[sagaDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusSagas(dbSagas), nil
+}
@@ -0,0 +1,73 @@
+package serverdb
+
+import (
+	"hosting-service/internal/server"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func applyFilter(filter server.QueryFilter, args pgx.NamedArgs, buf *strings.Builder) {
+	if filter.OwnerID != nil {
+		args["owner_id"] = *filter.OwnerID
+		buf.WriteString(" AND owner_id = @owner_id")
+	}
+
+	if filter.ProjectID != nil {
+		args["project_id"] = *filter.ProjectID
+		buf.WriteString(" AND project_id = @project_id")
+	}
+
+	if filter.ProjectIDs != nil {
+		args["project_ids"] = filter.ProjectIDs
+		buf.WriteString(" AND project_id = ANY(@project_ids)")
+	}
+
+	if filter.PoolID != nil {
+		args["pool_id"] = *filter.PoolID
+		buf.WriteString(" AND pool_id = @pool_id")
+	}
+
+	if filter.Status != nil {
+		args["status"] = string(*filter.Status)
+		buf.WriteString(" AND status = @status")
+	}
+
+	if filter.PlanID != nil {
+		args["plan_id"] = *filter.PlanID
+		buf.WriteString(" AND plan_id = @plan_id")
+	}
+
+	if filter.Name != nil {
+		args["name"] = "%" + escapeLike(*filter.Name) + "%"
+		buf.WriteString(" AND name ILIKE @name")
+	}
+
+	if filter.IPAddress != nil {
+		// Addresses are stored in canonical form, e.g. IPv6 in lower case
+		// with zeros compressed.
+		ip := *filter.IPAddress
+		if parsed := net.ParseIP(ip); parsed != nil {
+			ip = parsed.String()
+		}
+		args["ip_address"] = ip
+		buf.WriteString(" AND addresses @> jsonb_build_array(jsonb_build_object('address', @ip_address::text))")
+	}
+
+	if filter.StartCreatedAt != nil {
+		args["start_created_at"] = filter.StartCreatedAt.UTC()
+		buf.WriteString(" AND created_at >= @start_created_at")
+	}
+
+	if filter.EndCreatedAt != nil {
+		args["end_created_at"] = filter.EndCreatedAt.UTC()
+		buf.WriteString(" AND created_at <= @end_created_at")
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -7,40 +7,110 @@ import (
 	"github.com/google/uuid"
 )
 
+// addressDB is an element of the addresses JSONB column.
+type addressDB struct {
+	Address    string  `json:"address"`
+	Family     string  `json:"family"`
+	Primary    bool    `json:"primary"`
+	ReverseDNS *string `json:"reverseDns,omitempty"`
+}
+
 type serverDB struct {
-	ID          uuid.UUID `db:"id"`
-	IPv4Address *string   `db:"ipv4_address"`
-	OwnerID     uuid.UUID `db:"owner_id"`
-	PoolID      uuid.UUID `db:"pool_id"`
-	PlanID      uuid.UUID `db:"plan_id"`
-	Name        string    `db:"name"`
-	Status      string    `db:"status"`
-	CreatedAt   time.Time `db:"created_at"`
+	ID                   uuid.UUID   `db:"id"`
+	IPv4Address          *string     `db:"ipv4_address"`
+	OwnerID              uuid.UUID   `db:"owner_id"`
+	ProjectID            uuid.UUID   `db:"project_id"`
+	PoolID               uuid.UUID   `db:"pool_id"`
+	Region               string      `db:"region"`
+	PlanID               uuid.UUID   `db:"plan_id"`
+	Name                 string      `db:"name"`
+	Status               string      `db:"status"`
+	ProvisionAttempts    int         `db:"provision_attempts"`
+	FailureReason        *string     `db:"failure_reason"`
+	CreatedAt            time.Time   `db:"created_at"`
+	Version              int         `db:"version"`
+	PurgeAt              *time.Time  `db:"purge_at"`
+	RestoreStatus        *string     `db:"restore_status"`
+	SnapshotID           *uuid.UUID  `db:"snapshot_id"`
+	SSHKeys              []string    `db:"ssh_keys"`
+	Addresses            []addressDB `db:"addresses"`
+	ProvisionRequestedAt *time.Time  `db:"provision_requested_at"`
+	ProvisionResends     int         `db:"provision_resends"`
 }
 
 func toDBServer(s server.Server) serverDB {
+	// The column is NOT NULL; a nil slice would be written as NULL.
+	sshKeys := s.SSHKeys
+	if sshKeys == nil {
+		sshKeys = []string{}
+	}
+
+	addresses := make([]addressDB, len(s.Addresses))
+	for i, a := range s.Addresses {
+		addresses[i] = addressDB{
+			Address:    a.Address,
+			Family:     string(a.Family),
+			Primary:    a.Primary,
+			ReverseDNS: a.ReverseDNS,
+		}
+	}
+
 	return serverDB{
-		ID:          s.ID,
-		IPv4Address: s.IPv4Address,
-		OwnerID:     s.OwnerID,
-		PoolID:      s.PoolID,
-		PlanID:      s.PlanID,
-		Name:        s.Name,
-		Status:      string(s.Status),
-		CreatedAt:   s.CreatedAt,
+		ID:                   s.ID,
+		IPv4Address:          s.IPv4Address,
+		OwnerID:              s.OwnerID,
+		ProjectID:            s.ProjectID,
+		PoolID:               s.PoolID,
+		Region:               s.Region,
+		PlanID:               s.PlanID,
+		Name:                 s.Name,
+		Status:               string(s.Status),
+		ProvisionAttempts:    s.ProvisionAttempts,
+		FailureReason:        s.FailureReason,
+		CreatedAt:            s.CreatedAt,
+		Version:              s.Version,
+		PurgeAt:              s.PurgeAt,
+		RestoreStatus:        (*string)(s.RestoreStatus),
+		SnapshotID:           s.SnapshotID,
+		SSHKeys:              sshKeys,
+		Addresses:            addresses,
+		ProvisionRequestedAt: s.ProvisionRequestedAt,
+		ProvisionResends:     s.ProvisionResends,
 	}
 }
 
 func toBusServer(db serverDB) server.Server {
+	var addresses []server.Address
+	for _, a := range db.Addresses {
+		addresses = append(addresses, server.Address{
+			Address:    a.Address,
+			Family:     server.AddressFamily(a.Family),
+			Primary:    a.Primary,
+			ReverseDNS: a.ReverseDNS,
+		})
+	}
+
 	return server.Server{
-		ID:          db.ID,
-		IPv4Address: db.IPv4Address,
-		OwnerID:     db.OwnerID,
-		PoolID:      db.PoolID,
-		PlanID:      db.PlanID,
-		Name:        db.Name,
-		Status:      server.ServerStatus(db.Status),
-		CreatedAt:   db.CreatedAt,
+		ID:                   db.ID,
+		IPv4Address:          db.IPv4Address,
+		OwnerID:              db.OwnerID,
+		ProjectID:            db.ProjectID,
+		PoolID:               db.PoolID,
+		Region:               db.Region,
+		PlanID:               db.PlanID,
+		Name:                 db.Name,
+		Status:               server.ServerStatus(db.Status),
+		ProvisionAttempts:    db.ProvisionAttempts,
+		FailureReason:        db.FailureReason,
+		CreatedAt:            db.CreatedAt,
+		Version:              db.Version,
+		PurgeAt:              db.PurgeAt,
+		RestoreStatus:        (*server.ServerStatus)(db.RestoreStatus),
+		SnapshotID:           db.SnapshotID,
+		SSHKeys:              db.SSHKeys,
+		Addresses:            addresses,
+		ProvisionRequestedAt: db.ProvisionRequestedAt,
+		ProvisionResends:     db.ProvisionResends,
 	}
 }
 
@@ -0,0 +1,140 @@
+package serverdb
+
+import (
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/internal/server"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// orderField describes how a sort field maps to SQL and how its value is
+// kept in a cursor key.
+type orderField struct {
+	column string
+	key    func(s server.Server) string
+	parse  func(v string) (any, error)
+}
+
+var orderByFields = map[string]orderField{
+	server.OrderByName: {
+		column: "name",
+		key:    func(s server.Server) string { return s.Name },
+		parse:  parseText,
+	},
+	server.OrderByStatus: {
+		column: "status",
+		key:    func(s server.Server) string { return string(s.Status) },
+		parse:  parseText,
+	},
+	server.OrderByIPv4Address: {
+		// Servers without an address yet sort as an empty string, so the
+		// keyset comparison never meets a NULL.
+		column: "COALESCE(ipv4_address, '')",
+		key: func(s server.Server) string {
+			if s.IPv4Address == nil {
+				return ""
+			}
+			return *s.IPv4Address
+		},
+		parse: parseText,
+	},
+	server.OrderByCreatedAt: {
+		column: "created_at",
+		key:    func(s server.Server) string { return s.CreatedAt.UTC().Format(time.RFC3339Nano) },
+		parse: func(v string) (any, error) {
+			return time.Parse(time.RFC3339Nano, v)
+		},
+	},
+}
+
+func parseText(v string) (any, error) {
+	return v, nil
+}
+
+func lookupOrderField(orderBy server.OrderBy) (orderField, error) {
+	field, ok := orderByFields[orderBy.Field]
+	if !ok {
+		return orderField{}, fmt.Errorf("field %q does not exist", orderBy.Field)
+	}
+
+	if orderBy.Direction != server.ASC && orderBy.Direction != server.DESC {
+		return orderField{}, fmt.Errorf("direction %q does not exist", orderBy.Direction)
+	}
+
+	return field, nil
+}
+
+// orderByClause adds the id as a tie breaker so pages stay stable when the
+// sort column has duplicates.
+func orderByClause(orderBy server.OrderBy) (string, error) {
+	field, err := lookupOrderField(orderBy)
+	if err != nil {
+		return "", err
+	}
+
+	return field.column + " " + orderBy.Direction + ", id " + orderBy.Direction, nil
+}
+
+// keysetClause builds the condition and ordering that read the page of cur.
+// A backward page is read in reverse and flipped back by page.Collect.
+func keysetClause(orderBy server.OrderBy, cur page.Cursor, args map[string]any) (where string, order string, err error) {
+	field, err := lookupOrderField(orderBy)
+	if err != nil {
+		return "", "", err
+	}
+
+	direction := orderBy.Direction
+	if cur.Backward() {
+		direction = reverse(direction)
+	}
+
+	order = field.column + " " + direction + ", id " + direction
+
+	key, ok := cur.Key()
+	if !ok {
+		return "", order, nil
+	}
+
+	if key.Field != orderBy.Field {
+		return "", "", fmt.Errorf("%w: cursor was issued for another sort order", page.ErrInvalidCursor)
+	}
+
+	value, err := field.parse(key.Value)
+	if err != nil {
+		return "", "", page.ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(key.ID)
+	if err != nil {
+		return "", "", page.ErrInvalidCursor
+	}
+
+	args["cursor_value"] = value
+	args["cursor_id"] = id
+
+	op := ">"
+	if direction == server.DESC {
+		op = "<"
+	}
+
+	where = " AND (" + field.column + ", id) " + op + " (@cursor_value, @cursor_id)"
+
+	return where, order, nil
+}
+
+func cursorKey(orderBy server.OrderBy) func(s server.Server) page.Key {
+	field := orderByFields[orderBy.Field]
+
+	return func(s server.Server) page.Key {
+		return page.Key{Field: orderBy.Field, Value: field.key(s), ID: s.ID.String()}
+	}
+}
+
+func reverse(direction string) string {
+	if direction == server.ASC {
+		return server.DESC
+	}
+	return server.ASC
+}
@@ -3,8 +3,11 @@ package serverdb
 import (
 	"context"
 	"fmt"
+	"hosting-kit/database"
 	"hosting-kit/page"
 	"hosting-service/internal/server"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -22,7 +25,7 @@ func NewStore(db *pgxpool.Pool) *Store {
 func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (server.Server, error) {
 	const q = `
 	SELECT 
-		id, plan_id, name, ipv4_address, pool_id, status, created_at, owner_id
+		id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends
 	FROM 
 		servers 
 	WHERE 
@@ -32,7 +35,7 @@ func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (server.Server, erro
 		"id": ID,
 	}
 
-	rows, err := s.db.Query(ctx, q, args)
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
 	if err != nil {
 		return server.Server{}, fmt.Errorf("db: %w", err)
 	}
@@ -51,24 +54,36 @@ func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (server.Server, erro
 func (s *Store) Create(ctx context.Context, srv server.Server) error {
 	const q = `
 	INSERT INTO servers 
-		(id, plan_id, name, ipv4_address, pool_id, status, created_at, owner_id)
+		(id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends)
 	VALUES 
-		(@id, @plan_id, @name, @ipv4_address, @pool_id, @status, @created_at, @owner_id)`
+		(@id, @plan_id, @name, @ipv4_address, @pool_id, @region, @status, @provision_attempts, @failure_reason, @created_at, @owner_id, @project_id, @version, @purge_at, @restore_status, @snapshot_id, @ssh_keys, @addresses, @provision_requested_at, @provision_resends)`
 
 	dbServer := toDBServer(srv)
 
 	args := pgx.NamedArgs{
-		"id":           dbServer.ID,
-		"plan_id":      dbServer.PlanID,
-		"name":         dbServer.Name,
-		"ipv4_address": dbServer.IPv4Address,
-		"pool_id":      dbServer.PoolID,
-		"status":       dbServer.Status,
-		"created_at":   dbServer.CreatedAt,
-		"owner_id":     dbServer.OwnerID,
+		"id":                     dbServer.ID,
+		"plan_id":                dbServer.PlanID,
+		"name":                   dbServer.Name,
+		"ipv4_address":           dbServer.IPv4Address,
+		"pool_id":                dbServer.PoolID,
+		"region":                 dbServer.Region,
+		"status":                 dbServer.Status,
+		"provision_attempts":     dbServer.ProvisionAttempts,
+		"failure_reason":         dbServer.FailureReason,
+		"created_at":             dbServer.CreatedAt,
+		"owner_id":               dbServer.OwnerID,
+		"project_id":             dbServer.ProjectID,
+		"version":                dbServer.Version,
+		"purge_at":               dbServer.PurgeAt,
+		"restore_status":         dbServer.RestoreStatus,
+		"snapshot_id":            dbServer.SnapshotID,
+		"ssh_keys":               dbServer.SSHKeys,
+		"addresses":              dbServer.Addresses,
+		"provision_requested_at": dbServer.ProvisionRequestedAt,
+		"provision_resends":      dbServer.ProvisionResends,
 	}
 
-	_, err := s.db.Exec(ctx, q, args)
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
 	if err != nil {
 		return fmt.Errorf("db: %w", err)
 	}
@@ -76,37 +91,41 @@ func (s *Store) Create(ctx context.Context, srv server.Server) error {
 	return nil
 }
 
-func (s *Store) FindAll(ctx context.Context, pg page.Page, userID uuid.UUID) ([]server.Server, int, error) {
-	const qCount = `SELECT count(*) FROM servers WHERE owner_id = @owner_id`
+func (s *Store) FindAll(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, pg page.Page) ([]server.Server, int, error) {
+	args := pgx.NamedArgs{}
+
+	var where strings.Builder
+	where.WriteString(" WHERE TRUE")
+	applyFilter(filter, args, &where)
+
+	order, err := orderByClause(orderBy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	qCount := `SELECT count(*) FROM servers` + where.String()
 
 	var total int
-	argsCount := pgx.NamedArgs{"owner_id": userID}
-	err := s.db.QueryRow(ctx, qCount, argsCount).Scan(&total)
+	err = database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("db: %w", err)
 	}
 
-	const q = `
+	q := `
 	SELECT 
-		id, plan_id, name, ipv4_address, pool_id, status, created_at, owner_id
+		id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends
 	FROM 
-		servers
-	WHERE
-		owner_id = @owner_id
-	ORDER BY 
-		created_at DESC
+		servers` + where.String() + `
+	ORDER BY ` + order + `
 	LIMIT 
 		@limit 
 	OFFSET 
 		@offset`
 
-	args := pgx.NamedArgs{
-		"limit":    pg.Size(),
-		"offset":   pg.Offset(),
-		"owner_id": userID,
-	}
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
 
-	rows, err := s.db.Query(ctx, q, args)
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
 	if err != nil {
 		return nil, 0, fmt.Errorf("db: %w", err)
 	}
@@ -119,6 +138,50 @@ func (s *Store) FindAll(ctx context.Context, pg page.Page, userID uuid.UUID) ([]
 	return toBusServers(dbServers), total, nil
 }
 
+// FindAllByCursor reads a keyset page of the servers without counting the
+// whole listing.
+func (s *Store) FindAllByCursor(ctx context.Context, filter server.QueryFilter, orderBy server.OrderBy, cur page.Cursor) ([]page.Edge[server.Server], page.CursorDocument, error) {
+	args := pgx.NamedArgs{
+		"limit": cur.Limit(),
+	}
+
+	var where strings.Builder
+	where.WriteString(" WHERE TRUE")
+	applyFilter(filter, args, &where)
+
+	keyset, order, err := keysetClause(orderBy, cur, args)
+	if err != nil {
+		return nil, page.CursorDocument{}, err
+	}
+	where.WriteString(keyset)
+
+	q := `
+	SELECT 
+		id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends
+	FROM 
+		servers` + where.String() + `
+	ORDER BY ` + order + `
+	LIMIT 
+		@limit`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbServers, err := pgx.CollectRows(rows, pgx.RowToStructByName[serverDB])
+	if err != nil {
+		return nil, page.CursorDocument{}, fmt.Errorf("db: %w", err)
+	}
+
+	edges, doc := page.Collect(cur, toBusServers(dbServers), cursorKey(orderBy))
+
+	return edges, doc, nil
+}
+
+// Update writes the server only if it still has the version it was read
+// with, and bumps the version. A concurrent write makes it fail with
+// server.ErrConflict.
 func (s *Store) Update(ctx context.Context, srv server.Server) error {
 	const q = `
 	UPDATE servers
@@ -126,32 +189,123 @@ func (s *Store) Update(ctx context.Context, srv server.Server) error {
 		plan_id = @plan_id,
 		name = @name,
 		ipv4_address = @ipv4_address,
+		addresses = @addresses,
 		pool_id = @pool_id,
 		status = @status,
-		owner_id = @owner_id
+		provision_attempts = @provision_attempts,
+		failure_reason = @failure_reason,
+		owner_id = @owner_id,
+		purge_at = @purge_at,
+		restore_status = @restore_status,
+		provision_requested_at = @provision_requested_at,
+		provision_resends = @provision_resends,
+		version = version + 1
 	WHERE 
-		id = @id`
+		id = @id AND version = @version`
 
 	dbServer := toDBServer(srv)
 
 	args := pgx.NamedArgs{
-		"id":           dbServer.ID,
-		"plan_id":      dbServer.PlanID,
-		"pool_id":      dbServer.PoolID,
-		"name":         dbServer.Name,
-		"ipv4_address": dbServer.IPv4Address,
-		"status":       dbServer.Status,
-		"owner_id":     dbServer.OwnerID,
+		"id":                     dbServer.ID,
+		"plan_id":                dbServer.PlanID,
+		"pool_id":                dbServer.PoolID,
+		"name":                   dbServer.Name,
+		"ipv4_address":           dbServer.IPv4Address,
+		"addresses":              dbServer.Addresses,
+		"status":                 dbServer.Status,
+		"provision_attempts":     dbServer.ProvisionAttempts,
+		"failure_reason":         dbServer.FailureReason,
+		"owner_id":               dbServer.OwnerID,
+		"version":                dbServer.Version,
+		"purge_at":               dbServer.PurgeAt,
+		"restore_status":         dbServer.RestoreStatus,
+		"provision_requested_at": dbServer.ProvisionRequestedAt,
+		"provision_resends":      dbServer.ProvisionResends,
 	}
 
-	_, err := s.db.Exec(ctx, q, args)
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
 	if err != nil {
 		return fmt.Errorf("db: %w", err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		if _, err := s.FindByID(ctx, srv.ID); err != nil {
+			return err
+		}
+		return server.ErrConflict
+	}
+
 	return nil
 }
 
+// FindPurgeable returns DELETED_PENDING servers whose grace period ended by
+// now, oldest first.
+func (s *Store) FindPurgeable(ctx context.Context, now time.Time, limit int) ([]server.Server, error) {
+	const q = `
+	SELECT 
+		id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends
+	FROM 
+		servers 
+	WHERE 
+		status = @status AND purge_at <= @now
+	ORDER BY 
+		purge_at
+	LIMIT 
+		@limit`
+
+	args := pgx.NamedArgs{
+		"status": string(server.StatusDeletedPending),
+		"now":    now,
+		"limit":  limit,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbServers, err := pgx.CollectRows(rows, pgx.RowToStructByName[serverDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusServers(dbServers), nil
+}
+
+// FindStuckPending returns PENDING servers whose provisioning command was
+// sent before the given time, the longest waiting first.
+func (s *Store) FindStuckPending(ctx context.Context, before time.Time, limit int) ([]server.Server, error) {
+	const q = `
+	SELECT 
+		id, plan_id, name, ipv4_address, pool_id, region, status, provision_attempts, failure_reason, created_at, owner_id, project_id, version, purge_at, restore_status, snapshot_id, ssh_keys, addresses, provision_requested_at, provision_resends
+	FROM 
+		servers 
+	WHERE 
+		status = @status AND provision_requested_at < @before
+	ORDER BY 
+		provision_requested_at
+	LIMIT 
+		@limit`
+
+	args := pgx.NamedArgs{
+		"status": string(server.StatusPending),
+		"before": before,
+		"limit":  limit,
+	}
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbServers, err := pgx.CollectRows(rows, pgx.RowToStructByName[serverDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusServers(dbServers), nil
+}
+
 func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
 	const q = `
 	DELETE FROM servers
@@ -161,7 +315,7 @@ func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
 		"id": ID,
 	}
 
-	_, err := s.db.Exec(ctx, q, args)
+	_, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
 	if err != nil {
 		return fmt.Errorf("db: %w", err)
 	}
@@ -89,16 +89,62 @@ func (x *Resource) GetIpCount() int32 {
 	return 0
 }
 
+type Placement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Placement) Reset() {
+	*x = Placement{}
+	mi := &file_resources_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Placement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Placement) ProtoMessage() {}
+
+func (x *Placement) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Placement.ProtoReflect.Descriptor instead.
+func (*Placement) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Placement) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type ConsumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Placement     *Placement             `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConsumeRequest) Reset() {
 	*x = ConsumeRequest{}
-	mi := &file_resources_proto_msgTypes[1]
+	mi := &file_resources_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +156,7 @@ func (x *ConsumeRequest) String() string {
 func (*ConsumeRequest) ProtoMessage() {}
 
 func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_proto_msgTypes[1]
+	mi := &file_resources_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +169,7 @@ func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
 func (*ConsumeRequest) Descriptor() ([]byte, []int) {
-	return file_resources_proto_rawDescGZIP(), []int{1}
+	return file_resources_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ConsumeRequest) GetResource() *Resource {
@@ -133,16 +179,31 @@ func (x *ConsumeRequest) GetResource() *Resource {
 	return nil
 }
 
+func (x *ConsumeRequest) GetPlacement() *Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
 type ConsumeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PoolId        string                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConsumeReply) Reset() {
 	*x = ConsumeReply{}
-	mi := &file_resources_proto_msgTypes[2]
+	mi := &file_resources_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +215,7 @@ func (x *ConsumeReply) String() string {
 func (*ConsumeReply) ProtoMessage() {}
 
 func (x *ConsumeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_proto_msgTypes[2]
+	mi := &file_resources_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +228,7 @@ func (x *ConsumeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsumeReply.ProtoReflect.Descriptor instead.
 func (*ConsumeReply) Descriptor() ([]byte, []int) {
-	return file_resources_proto_rawDescGZIP(), []int{2}
+	return file_resources_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConsumeReply) GetPoolId() string {
@@ -177,17 +238,25 @@ func (x *ConsumeReply) GetPoolId() string {
 	return ""
 }
 
+func (x *ConsumeReply) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type ReturnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	PoolId        string                 `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReturnRequest) Reset() {
 	*x = ReturnRequest{}
-	mi := &file_resources_proto_msgTypes[3]
+	mi := &file_resources_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +268,7 @@ func (x *ReturnRequest) String() string {
 func (*ReturnRequest) ProtoMessage() {}
 
 func (x *ReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_proto_msgTypes[3]
+	mi := &file_resources_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +281,7 @@ func (x *ReturnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnRequest.ProtoReflect.Descriptor instead.
 func (*ReturnRequest) Descriptor() ([]byte, []int) {
-	return file_resources_proto_rawDescGZIP(), []int{3}
+	return file_resources_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReturnRequest) GetResource() *Resource {
@@ -229,6 +298,13 @@ func (x *ReturnRequest) GetPoolId() string {
 	return ""
 }
 
+func (x *ReturnRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
 type ReturnReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -237,7 +313,7 @@ type ReturnReply struct {
 
 func (x *ReturnReply) Reset() {
 	*x = ReturnReply{}
-	mi := &file_resources_proto_msgTypes[4]
+	mi := &file_resources_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -249,7 +325,7 @@ func (x *ReturnReply) String() string {
 func (*ReturnReply) ProtoMessage() {}
 
 func (x *ReturnReply) ProtoReflect() protoreflect.Message {
-	mi := &file_resources_proto_msgTypes[4]
+	mi := &file_resources_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +338,475 @@ func (x *ReturnReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnReply.ProtoReflect.Descriptor instead.
 func (*ReturnReply) Descriptor() ([]byte, []int) {
-	return file_resources_proto_rawDescGZIP(), []int{4}
+	return file_resources_proto_rawDescGZIP(), []int{5}
+}
+
+type ResizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       *Resource              `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Target        *Resource              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	PoolId        string                 `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,4,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeRequest) Reset() {
+	*x = ResizeRequest{}
+	mi := &file_resources_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeRequest) ProtoMessage() {}
+
+func (x *ResizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeRequest) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResizeRequest) GetCurrent() *Resource {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *ResizeRequest) GetTarget() *Resource {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ResizeRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *ResizeRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ResizeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        string                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeReply) Reset() {
+	*x = ResizeReply{}
+	mi := &file_resources_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeReply) ProtoMessage() {}
+
+func (x *ResizeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeReply.ProtoReflect.Descriptor instead.
+func (*ResizeReply) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResizeReply) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+type ReserveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	PoolId        string                 `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveRequest) Reset() {
+	*x = ReserveRequest{}
+	mi := &file_resources_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveRequest) ProtoMessage() {}
+
+func (x *ReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveRequest.ProtoReflect.Descriptor instead.
+func (*ReserveRequest) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReserveRequest) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ReserveRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *ReserveRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReserveReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveReply) Reset() {
+	*x = ReserveReply{}
+	mi := &file_resources_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveReply) ProtoMessage() {}
+
+func (x *ReserveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveReply.ProtoReflect.Descriptor instead.
+func (*ReserveReply) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{9}
+}
+
+type PoolState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        string                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Available     *Resource              `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+	Capacity      *Resource              `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolState) Reset() {
+	*x = PoolState{}
+	mi := &file_resources_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolState) ProtoMessage() {}
+
+func (x *PoolState) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolState.ProtoReflect.Descriptor instead.
+func (*PoolState) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PoolState) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *PoolState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PoolState) GetAvailable() *Resource {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *PoolState) GetCapacity() *Resource {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *PoolState) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ListPoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsRequest) Reset() {
+	*x = ListPoolsRequest{}
+	mi := &file_resources_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsRequest) ProtoMessage() {}
+
+func (x *ListPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{11}
+}
+
+type ListPoolsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*PoolState           `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsReply) Reset() {
+	*x = ListPoolsReply{}
+	mi := &file_resources_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsReply) ProtoMessage() {}
+
+func (x *ListPoolsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsReply.ProtoReflect.Descriptor instead.
+func (*ListPoolsReply) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPoolsReply) GetPools() []*PoolState {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type RepairPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        string                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Observed      *Resource              `protobuf:"bytes,2,opt,name=observed,proto3" json:"observed,omitempty"`
+	Allocated     *Resource              `protobuf:"bytes,3,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairPoolRequest) Reset() {
+	*x = RepairPoolRequest{}
+	mi := &file_resources_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairPoolRequest) ProtoMessage() {}
+
+func (x *RepairPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairPoolRequest.ProtoReflect.Descriptor instead.
+func (*RepairPoolRequest) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RepairPoolRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *RepairPoolRequest) GetObserved() *Resource {
+	if x != nil {
+		return x.Observed
+	}
+	return nil
+}
+
+func (x *RepairPoolRequest) GetAllocated() *Resource {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+type RepairPoolReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pool          *PoolState             `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepairPoolReply) Reset() {
+	*x = RepairPoolReply{}
+	mi := &file_resources_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepairPoolReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairPoolReply) ProtoMessage() {}
+
+func (x *RepairPoolReply) ProtoReflect() protoreflect.Message {
+	mi := &file_resources_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairPoolReply.ProtoReflect.Descriptor instead.
+func (*RepairPoolReply) Descriptor() ([]byte, []int) {
+	return file_resources_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RepairPoolReply) GetPool() *PoolState {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
 }
 
 var File_resources_proto protoreflect.FileDescriptor
@@ -274,18 +818,56 @@ const file_resources_proto_rawDesc = "" +
 	"\tcpu_cores\x18\x01 \x01(\x05R\bcpuCores\x12\x15\n" +
 	"\x06ram_mb\x18\x02 \x01(\x05R\x05ramMb\x12\x17\n" +
 	"\adisk_gb\x18\x03 \x01(\x05R\x06diskGb\x12\x19\n" +
-	"\bip_count\x18\x04 \x01(\x05R\aipCount\";\n" +
+	"\bip_count\x18\x04 \x01(\x05R\aipCount\"#\n" +
+	"\tPlacement\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\"\x90\x01\n" +
 	"\x0eConsumeRequest\x12)\n" +
-	"\bresource\x18\x01 \x01(\v2\r.gen.ResourceR\bresource\"'\n" +
+	"\bresource\x18\x01 \x01(\v2\r.gen.ResourceR\bresource\x12,\n" +
+	"\tplacement\x18\x02 \x01(\v2\x0e.gen.PlacementR\tplacement\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\"?\n" +
 	"\fConsumeReply\x12\x17\n" +
-	"\apool_id\x18\x01 \x01(\tR\x06poolId\"S\n" +
+	"\apool_id\x18\x01 \x01(\tR\x06poolId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"z\n" +
 	"\rReturnRequest\x12)\n" +
 	"\bresource\x18\x01 \x01(\v2\r.gen.ResourceR\bresource\x12\x17\n" +
-	"\apool_id\x18\x02 \x01(\tR\x06poolId\"\r\n" +
-	"\vReturnReply2\x82\x01\n" +
+	"\apool_id\x18\x02 \x01(\tR\x06poolId\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\"\r\n" +
+	"\vReturnReply\"\x9f\x01\n" +
+	"\rResizeRequest\x12'\n" +
+	"\acurrent\x18\x01 \x01(\v2\r.gen.ResourceR\acurrent\x12%\n" +
+	"\x06target\x18\x02 \x01(\v2\r.gen.ResourceR\x06target\x12\x17\n" +
+	"\apool_id\x18\x03 \x01(\tR\x06poolId\x12%\n" +
+	"\x0ereservation_id\x18\x04 \x01(\tR\rreservationId\"&\n" +
+	"\vResizeReply\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\tR\x06poolId\"{\n" +
+	"\x0eReserveRequest\x12)\n" +
+	"\bresource\x18\x01 \x01(\v2\r.gen.ResourceR\bresource\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\tR\x06poolId\x12%\n" +
+	"\x0ereservation_id\x18\x03 \x01(\tR\rreservationId\"\x0e\n" +
+	"\fReserveReply\"\xa8\x01\n" +
+	"\tPoolState\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\tR\x06poolId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\tavailable\x18\x03 \x01(\v2\r.gen.ResourceR\tavailable\x12)\n" +
+	"\bcapacity\x18\x04 \x01(\v2\r.gen.ResourceR\bcapacity\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\"\x12\n" +
+	"\x10ListPoolsRequest\"6\n" +
+	"\x0eListPoolsReply\x12$\n" +
+	"\x05pools\x18\x01 \x03(\v2\x0e.gen.PoolStateR\x05pools\"\x84\x01\n" +
+	"\x11RepairPoolRequest\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\tR\x06poolId\x12)\n" +
+	"\bobserved\x18\x02 \x01(\v2\r.gen.ResourceR\bobserved\x12+\n" +
+	"\tallocated\x18\x03 \x01(\v2\r.gen.ResourceR\tallocated\"5\n" +
+	"\x0fRepairPoolReply\x12\"\n" +
+	"\x04pool\x18\x01 \x01(\v2\x0e.gen.PoolStateR\x04pool2\xf2\x02\n" +
 	"\tResources\x12;\n" +
 	"\x0fConsumeResource\x12\x13.gen.ConsumeRequest\x1a\x11.gen.ConsumeReply\"\x00\x128\n" +
-	"\x0eReturnResource\x12\x12.gen.ReturnRequest\x1a\x10.gen.ReturnReply\"\x00B\bZ\x06./;genb\x06proto3"
+	"\x0eReturnResource\x12\x12.gen.ReturnRequest\x1a\x10.gen.ReturnReply\"\x00\x128\n" +
+	"\x0eResizeResource\x12\x12.gen.ResizeRequest\x1a\x10.gen.ResizeReply\"\x00\x12;\n" +
+	"\x0fReserveResource\x12\x13.gen.ReserveRequest\x1a\x11.gen.ReserveReply\"\x00\x129\n" +
+	"\tListPools\x12\x15.gen.ListPoolsRequest\x1a\x13.gen.ListPoolsReply\"\x00\x12<\n" +
+	"\n" +
+	"RepairPool\x12\x16.gen.RepairPoolRequest\x1a\x14.gen.RepairPoolReply\"\x00B\bZ\x06./;genb\x06proto3"
 
 var (
 	file_resources_proto_rawDescOnce sync.Once
@@ -299,26 +881,54 @@ func file_resources_proto_rawDescGZIP() []byte {
 	return file_resources_proto_rawDescData
 }
 
-var file_resources_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_resources_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_resources_proto_goTypes = []any{
-	(*Resource)(nil),       // 0: gen.Resource
-	(*ConsumeRequest)(nil), // 1: gen.ConsumeRequest
-	(*ConsumeReply)(nil),   // 2: gen.ConsumeReply
-	(*ReturnRequest)(nil),  // 3: gen.ReturnRequest
-	(*ReturnReply)(nil),    // 4: gen.ReturnReply
+	(*Resource)(nil),          // 0: gen.Resource
+	(*Placement)(nil),         // 1: gen.Placement
+	(*ConsumeRequest)(nil),    // 2: gen.ConsumeRequest
+	(*ConsumeReply)(nil),      // 3: gen.ConsumeReply
+	(*ReturnRequest)(nil),     // 4: gen.ReturnRequest
+	(*ReturnReply)(nil),       // 5: gen.ReturnReply
+	(*ResizeRequest)(nil),     // 6: gen.ResizeRequest
+	(*ResizeReply)(nil),       // 7: gen.ResizeReply
+	(*ReserveRequest)(nil),    // 8: gen.ReserveRequest
+	(*ReserveReply)(nil),      // 9: gen.ReserveReply
+	(*PoolState)(nil),         // 10: gen.PoolState
+	(*ListPoolsRequest)(nil),  // 11: gen.ListPoolsRequest
+	(*ListPoolsReply)(nil),    // 12: gen.ListPoolsReply
+	(*RepairPoolRequest)(nil), // 13: gen.RepairPoolRequest
+	(*RepairPoolReply)(nil),   // 14: gen.RepairPoolReply
 }
 var file_resources_proto_depIdxs = []int32{
-	0, // 0: gen.ConsumeRequest.resource:type_name -> gen.Resource
-	0, // 1: gen.ReturnRequest.resource:type_name -> gen.Resource
-	1, // 2: gen.Resources.ConsumeResource:input_type -> gen.ConsumeRequest
-	3, // 3: gen.Resources.ReturnResource:input_type -> gen.ReturnRequest
-	2, // 4: gen.Resources.ConsumeResource:output_type -> gen.ConsumeReply
-	4, // 5: gen.Resources.ReturnResource:output_type -> gen.ReturnReply
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: gen.ConsumeRequest.resource:type_name -> gen.Resource
+	1,  // 1: gen.ConsumeRequest.placement:type_name -> gen.Placement
+	0,  // 2: gen.ReturnRequest.resource:type_name -> gen.Resource
+	0,  // 3: gen.ResizeRequest.current:type_name -> gen.Resource
+	0,  // 4: gen.ResizeRequest.target:type_name -> gen.Resource
+	0,  // 5: gen.ReserveRequest.resource:type_name -> gen.Resource
+	0,  // 6: gen.PoolState.available:type_name -> gen.Resource
+	0,  // 7: gen.PoolState.capacity:type_name -> gen.Resource
+	10, // 8: gen.ListPoolsReply.pools:type_name -> gen.PoolState
+	0,  // 9: gen.RepairPoolRequest.observed:type_name -> gen.Resource
+	0,  // 10: gen.RepairPoolRequest.allocated:type_name -> gen.Resource
+	10, // 11: gen.RepairPoolReply.pool:type_name -> gen.PoolState
+	2,  // 12: gen.Resources.ConsumeResource:input_type -> gen.ConsumeRequest
+	4,  // 13: gen.Resources.ReturnResource:input_type -> gen.ReturnRequest
+	6,  // 14: gen.Resources.ResizeResource:input_type -> gen.ResizeRequest
+	8,  // 15: gen.Resources.ReserveResource:input_type -> gen.ReserveRequest
+	11, // 16: gen.Resources.ListPools:input_type -> gen.ListPoolsRequest
+	13, // 17: gen.Resources.RepairPool:input_type -> gen.RepairPoolRequest
+	3,  // 18: gen.Resources.ConsumeResource:output_type -> gen.ConsumeReply
+	5,  // 19: gen.Resources.ReturnResource:output_type -> gen.ReturnReply
+	7,  // 20: gen.Resources.ResizeResource:output_type -> gen.ResizeReply
+	9,  // 21: gen.Resources.ReserveResource:output_type -> gen.ReserveReply
+	12, // 22: gen.Resources.ListPools:output_type -> gen.ListPoolsReply
+	14, // 23: gen.Resources.RepairPool:output_type -> gen.RepairPoolReply
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_resources_proto_init() }
@@ -332,7 +942,7 @@ func file_resources_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resources_proto_rawDesc), len(file_resources_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,7 +22,9 @@ func NewGrpc(client *grpc.ClientConn, timeOut time.Duration) *ResourcesManager {
 	return &ResourcesManager{client: gen.NewResourcesClient(client), timeOut: timeOut}
 }
 
-func (r *ResourcesManager) Consume(ctx context.Context, resources server.Resources) (uuid.UUID, error) {
+// Consume takes the resources from a pool that fits them and the placement.
+// Repeating it with the same reservation id answers with the first pool.
+func (r *ResourcesManager) Consume(ctx context.Context, reservationID uuid.UUID, resources server.Resources, placement server.Placement) (server.Reservation, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
 	defer cancel()
 
@@ -33,32 +35,48 @@ func (r *ResourcesManager) Consume(ctx context.Context, resources server.Resourc
 			DiskGb:   int32(resources.DiskGB),
 			IpCount:  int32(resources.IPCount),
 		},
+		Placement: &gen.Placement{
+			Region: placement.Region,
+		},
+		ReservationId: reservationID.String(),
 	})
 
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			switch st.Code() {
 			case codes.FailedPrecondition:
-				return uuid.Nil, server.ErrNoResources
+				return server.Reservation{}, server.ErrNoResources
 			case codes.InvalidArgument:
-				return uuid.Nil, server.ErrValidation
+				return server.Reservation{}, server.ErrValidation
+			case codes.NotFound:
+				return server.Reservation{}, fmt.Errorf("%w: unknown region '%s'", server.ErrValidation, placement.Region)
 			}
 		}
-		return uuid.Nil, fmt.Errorf("grpc: %w", err)
+		return server.Reservation{}, fmt.Errorf("grpc: %w", err)
 	}
 
 	poolID, err := uuid.Parse(resp.GetPoolId())
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("grpc: %w", err)
+		return server.Reservation{}, fmt.Errorf("grpc: %w", err)
 	}
 
-	return poolID, nil
+	return server.Reservation{
+		PoolID: poolID,
+		Region: resp.GetRegion(),
+	}, nil
 }
 
-func (r *ResourcesManager) Return(ctx context.Context, resources server.Resources, poolID uuid.UUID) error {
+// Return gives the resources of a reservation back. A nil pool lets the
+// resources service release the reservation wherever it was consumed.
+func (r *ResourcesManager) Return(ctx context.Context, reservationID uuid.UUID, resources server.Resources, poolID uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
 	defer cancel()
 
+	var pool string
+	if poolID != uuid.Nil {
+		pool = poolID.String()
+	}
+
 	_, err := r.client.ReturnResource(ctx, &gen.ReturnRequest{
 		Resource: &gen.Resource{
 			CpuCores: int32(resources.CPUCores),
@@ -66,7 +84,8 @@ func (r *ResourcesManager) Return(ctx context.Context, resources server.Resource
 			DiskGb:   int32(resources.DiskGB),
 			IpCount:  int32(resources.IPCount),
 		},
-		PoolId: poolID.String(),
+		PoolId:        pool,
+		ReservationId: reservationID.String(),
 	})
 
 	if err != nil {
@@ -82,3 +101,78 @@ func (r *ResourcesManager) Return(ctx context.Context, resources server.Resource
 	}
 	return nil
 }
+
+// Resize changes the resources held by a server. A resize with a reservation
+// id that was already applied answers with its pool and changes nothing.
+func (r *ResourcesManager) Resize(ctx context.Context, reservationID uuid.UUID, current server.Resources, target server.Resources, poolID uuid.UUID) (uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
+	defer cancel()
+
+	resp, err := r.client.ResizeResource(ctx, &gen.ResizeRequest{
+		Current: &gen.Resource{
+			CpuCores: int32(current.CPUCores),
+			RamMb:    int32(current.RAMMB),
+			DiskGb:   int32(current.DiskGB),
+			IpCount:  int32(current.IPCount),
+		},
+		Target: &gen.Resource{
+			CpuCores: int32(target.CPUCores),
+			RamMb:    int32(target.RAMMB),
+			DiskGb:   int32(target.DiskGB),
+			IpCount:  int32(target.IPCount),
+		},
+		PoolId:        poolID.String(),
+		ReservationId: reservationID.String(),
+	})
+
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.FailedPrecondition:
+				return uuid.Nil, server.ErrNoResources
+			case codes.InvalidArgument:
+				return uuid.Nil, server.ErrValidation
+			}
+		}
+		return uuid.Nil, fmt.Errorf("grpc: %w", err)
+	}
+
+	newPoolID, err := uuid.Parse(resp.GetPoolId())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("grpc: %w", err)
+	}
+
+	return newPoolID, nil
+}
+
+// Reserve takes resources from the given pool only. It is used for the disk
+// of snapshots, which stay in the pool of their server. Repeating it with the
+// same reservation id takes nothing more.
+func (r *ResourcesManager) Reserve(ctx context.Context, reservationID uuid.UUID, resources server.Resources, poolID uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeOut)
+	defer cancel()
+
+	_, err := r.client.ReserveResource(ctx, &gen.ReserveRequest{
+		Resource: &gen.Resource{
+			CpuCores: int32(resources.CPUCores),
+			RamMb:    int32(resources.RAMMB),
+			DiskGb:   int32(resources.DiskGB),
+			IpCount:  int32(resources.IPCount),
+		},
+		PoolId:        poolID.String(),
+		ReservationId: reservationID.String(),
+	})
+
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.FailedPrecondition:
+				return server.ErrNoResources
+			case codes.InvalidArgument, codes.NotFound:
+				return server.ErrValidation
+			}
+		}
+		return fmt.Errorf("grpc: %w", err)
+	}
+	return nil
+}
@@ -2,29 +2,34 @@ package servermsg
 
 import (
 	"context"
+	"fmt"
 	"hosting-contracts/hosting-service/queue/events"
 	"hosting-contracts/topology"
-	"hosting-kit/messaging"
 	"hosting-service/internal/server"
 )
 
 type Notifier struct {
-	publisher *messaging.MessageManager
+	publisher Publisher
 }
 
-func NewNotifier(publisher *messaging.MessageManager) *Notifier {
+func NewNotifier(publisher Publisher) *Notifier {
 	return &Notifier{
 		publisher: publisher,
 	}
 }
 
-func (p *Notifier) ServerUpdated(ctx context.Context, server server.Server) {
+func (p *Notifier) ServerUpdated(ctx context.Context, server server.Server) error {
 	event := events.ServerStatusChangedEvent{
 		ServerID:    server.ID,
 		OwnerID:     server.OwnerID,
+		PlanID:      server.PlanID,
 		Status:      string(server.Status),
 		IPv4Address: server.IPv4Address,
 	}
 
-	p.publisher.Publish(ctx, topology.EventsExchange, events.ServerStatusUpdated, event)
+	if err := p.publisher.PublishOrdered(ctx, server.ID, topology.EventsExchange, events.ServerStatusUpdated, event); err != nil {
+		return fmt.Errorf("msg: failed to queue server status event: %w", err)
+	}
+
+	return nil
 }
@@ -4,30 +4,80 @@ import (
 	"context"
 	"fmt"
 	"hosting-contracts/hosting-service/queue/commands"
+	"hosting-contracts/provisioning-service/queue/events"
 	"hosting-contracts/topology"
-	"hosting-kit/messaging"
 	"hosting-service/internal/server"
 )
 
 type Provisioner struct {
-	publisher *messaging.MessageManager
+	publisher Publisher
 }
 
-func NewProvisioner(publisher *messaging.MessageManager) *Provisioner {
+func NewProvisioner(publisher Publisher) *Provisioner {
 	return &Provisioner{
 		publisher: publisher,
 	}
 }
 
-func (p *Provisioner) RequestIP(ctx context.Context, server server.Server) error {
+// RequestIP queues the provisioning of a server with ipCount addresses in
+// each family. Every server is dual-stack.
+func (p *Provisioner) RequestIP(ctx context.Context, server server.Server, ipCount int) error {
 	command := commands.ProvisionServerCommand{
-		ServerID: server.ID,
-		Hostname: server.Name,
+		ServerID:        server.ID,
+		Hostname:        server.Name,
+		SnapshotID:      server.SnapshotID,
+		SSHKeys:         server.SSHKeys,
+		IPCount:         ipCount,
+		AddressFamilies: []string{events.AddressFamilyIPv4, events.AddressFamilyIPv6},
 	}
 
-	if err := p.publisher.Publish(ctx, topology.CommandsExchange, commands.ProvisionRequestKey, command); err != nil {
+	if err := p.publisher.PublishOrdered(ctx, server.ID, topology.CommandsExchange, commands.ProvisionRequestKey, command); err != nil {
 		return fmt.Errorf("msg: failed to queue server for provisioning: %w", err)
 	}
 
 	return nil
 }
+
+func (p *Provisioner) RequestDeprovision(ctx context.Context, server server.Server) error {
+	command := commands.DeprovisionServerCommand{
+		ServerID:    server.ID,
+		IPv4Address: server.IPv4Address,
+		Addresses:   make([]string, len(server.Addresses)),
+	}
+
+	for i, addr := range server.Addresses {
+		command.Addresses[i] = addr.Address
+	}
+
+	if err := p.publisher.PublishOrdered(ctx, server.ID, topology.CommandsExchange, commands.DeprovisionRequestKey, command); err != nil {
+		return fmt.Errorf("msg: failed to queue server for deprovisioning: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Provisioner) RequestPower(ctx context.Context, srv server.Server, action server.ActionType) error {
+	var cmdAction string
+
+	switch action {
+	case server.ActionStart:
+		cmdAction = commands.PowerActionStart
+	case server.ActionStop:
+		cmdAction = commands.PowerActionStop
+	case server.ActionReboot:
+		cmdAction = commands.PowerActionReboot
+	default:
+		return fmt.Errorf("msg: unsupported power action: %s", action)
+	}
+
+	command := commands.PowerServerCommand{
+		ServerID: srv.ID,
+		Action:   cmdAction,
+	}
+
+	if err := p.publisher.PublishOrdered(ctx, srv.ID, topology.CommandsExchange, commands.PowerRequestKey, command); err != nil {
+		return fmt.Errorf("msg: failed to queue server power command: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package servermsg
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Publisher is satisfied by the outbox, so messages are stored in the same
+// transaction as the server change and relayed to the broker later. The
+// messages of a server are relayed in the order they were published.
+type Publisher interface {
+	PublishOrdered(ctx context.Context, aggregateID uuid.UUID, exchangeName, routingKey string, data interface{}) error
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type versionKey struct{}
+
+// WithExpectedVersion makes the following user action fail with ErrConflict
+// unless the server still has the given version, e.g. the one a client read
+// and sent back in an If-Match header.
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, versionKey{}, version)
+}
+
+func checkVersion(ctx context.Context, srv Server) error {
+	expected, ok := ctx.Value(versionKey{}).(int)
+	if !ok || expected == srv.Version {
+		return nil
+	}
+
+	return fmt.Errorf("%w: server has version %d, expected %d", ErrConflict, srv.Version, expected)
+}
+
+// retryOnConflict reruns a read-modify-write made by the service itself when
+// a concurrent write changed the server in between. User actions are not
+// retried: the user decided on the state they saw and gets ErrConflict.
+func (s *Business) retryOnConflict(fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || !errors.Is(err, ErrConflict) || attempt >= s.cfg.ConflictRetries {
+			return err
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package snapshototel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus snapshot.ExtBusiness
+}
+
+func NewExtension() snapshot.Extension {
+	return func(bus snapshot.ExtBusiness) snapshot.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Create(ctx context.Context, serverID uuid.UUID, name string, userID uuid.UUID) (snapshot.Snapshot, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.create")
+	defer span.End()
+
+	return e.bus.Create(ctx, serverID, name, userID)
+}
+
+func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (snapshot.Snapshot, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.findbyid")
+	defer span.End()
+
+	return e.bus.FindByID(ctx, ID, userID)
+}
+
+func (e *Extension) Search(ctx context.Context, filter snapshot.QueryFilter, pg page.Page, userID uuid.UUID) ([]snapshot.Snapshot, int, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.search")
+	defer span.End()
+
+	return e.bus.Search(ctx, filter, pg, userID)
+}
+
+func (e *Extension) Restore(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (snapshot.Snapshot, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.restore")
+	defer span.End()
+
+	return e.bus.Restore(ctx, snapshotID, userID)
+}
+
+func (e *Extension) CreateServer(ctx context.Context, snapshotID uuid.UUID, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.createserver")
+	defer span.End()
+
+	return e.bus.CreateServer(ctx, snapshotID, name, planID, projectID, placement, sshKeyIDs, userID)
+}
+
+func (e *Extension) Delete(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (snapshot.Snapshot, error) {
+	ctx, span := otel.AddSpan(ctx, "snapshot.delete")
+	defer span.End()
+
+	return e.bus.Delete(ctx, snapshotID, userID)
+}
+
+func (e *Extension) CompleteCreate(ctx context.Context, snapshotID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "snapshot.completecreate")
+	defer span.End()
+
+	return e.bus.CompleteCreate(ctx, snapshotID)
+}
+
+func (e *Extension) FailCreate(ctx context.Context, snapshotID uuid.UUID, reason string) error {
+	ctx, span := otel.AddSpan(ctx, "snapshot.failcreate")
+	defer span.End()
+
+	return e.bus.FailCreate(ctx, snapshotID, reason)
+}
+
+func (e *Extension) CompleteRestore(ctx context.Context, snapshotID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "snapshot.completerestore")
+	defer span.End()
+
+	return e.bus.CompleteRestore(ctx, snapshotID)
+}
+
+func (e *Extension) FailRestore(ctx context.Context, snapshotID uuid.UUID, reason string) error {
+	ctx, span := otel.AddSpan(ctx, "snapshot.failrestore")
+	defer span.End()
+
+	return e.bus.FailRestore(ctx, snapshotID, reason)
+}
+
+func (e *Extension) CompleteDelete(ctx context.Context, snapshotID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "snapshot.completedelete")
+	defer span.End()
+
+	return e.bus.CompleteDelete(ctx, snapshotID)
+}
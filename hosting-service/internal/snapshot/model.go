@@ -0,0 +1,47 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	StatusCreating  Status = "CREATING"
+	StatusAvailable Status = "AVAILABLE"
+	StatusFailed    Status = "FAILED"
+	StatusRestoring Status = "RESTORING"
+	StatusDeleting  Status = "DELETING"
+)
+
+// MaxNameLength limits the name users give a snapshot.
+const MaxNameLength = 100
+
+type Snapshot struct {
+	ID       uuid.UUID
+	ServerID uuid.UUID
+	OwnerID  uuid.UUID
+
+	// PoolID is the pool of the server at the time of the snapshot. The disk
+	// of the snapshot is reserved there.
+	PoolID uuid.UUID
+
+	Name          string
+	SizeGB        int
+	Status        Status
+	FailureReason *string
+
+	// Reserved is true while the pool may hold the disk of the snapshot. It is
+	// set before the disk is taken and cleared once it is returned.
+	Reserved bool
+
+	CreatedAt time.Time
+}
+
+// QueryFilter narrows a snapshot listing. Nil fields are not applied.
+type QueryFilter struct {
+	OwnerID  *uuid.UUID
+	ServerID *uuid.UUID
+}
@@ -0,0 +1,435 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/server"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	ErrValidation       = errors.New("validation error")
+	ErrAccessDenied     = errors.New("access denied")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	FindByID(ctx context.Context, ID uuid.UUID) (Snapshot, error)
+	Create(ctx context.Context, snap Snapshot) error
+	Update(ctx context.Context, snap Snapshot) error
+	Delete(ctx context.Context, ID uuid.UUID) error
+	FindAll(ctx context.Context, filter QueryFilter, pg page.Page) ([]Snapshot, int, error)
+}
+
+// ServerManager is the part of the server business snapshots work with.
+type ServerManager interface {
+	Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error)
+	CreateFromSnapshot(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, source server.Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error)
+	BeginSnapshotRestore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+	EndSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error
+}
+
+type PlanFinder interface {
+	FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
+}
+
+type ResourcesManager interface {
+	Reserve(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error
+	Return(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error
+}
+
+type Provisioner interface {
+	RequestSnapshot(ctx context.Context, snap Snapshot) error
+	RequestSnapshotRestore(ctx context.Context, snap Snapshot) error
+	RequestSnapshotDelete(ctx context.Context, snap Snapshot) error
+}
+
+type Transactor interface {
+	WithinTran(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ExtBusiness interface {
+	Create(ctx context.Context, serverID uuid.UUID, name string, userID uuid.UUID) (Snapshot, error)
+	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Snapshot, error)
+	Search(ctx context.Context, filter QueryFilter, pg page.Page, userID uuid.UUID) ([]Snapshot, int, error)
+	Restore(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (Snapshot, error)
+	CreateServer(ctx context.Context, snapshotID uuid.UUID, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error)
+	Delete(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (Snapshot, error)
+	CompleteCreate(ctx context.Context, snapshotID uuid.UUID) error
+	FailCreate(ctx context.Context, snapshotID uuid.UUID, reason string) error
+	CompleteRestore(ctx context.Context, snapshotID uuid.UUID) error
+	FailRestore(ctx context.Context, snapshotID uuid.UUID, reason string) error
+	CompleteDelete(ctx context.Context, snapshotID uuid.UUID) error
+}
+
+type Business struct {
+	storer      Storer
+	servers     ServerManager
+	plans       PlanFinder
+	resources   ResourcesManager
+	provisioner Provisioner
+	tx          Transactor
+	extensions  []Extension
+}
+
+func NewBusiness(storer Storer, servers ServerManager, plans PlanFinder, resources ResourcesManager, provisioner Provisioner, tx Transactor, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:      storer,
+		servers:     servers,
+		plans:       plans,
+		resources:   resources,
+		provisioner: provisioner,
+		tx:          tx,
+		extensions:  extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Create reserves the disk of the server in its pool under the snapshot id
+// and asks the provisioning service to copy it. Members who may manage the server can
+// snapshot it; the snapshot belongs to the user who took it. The snapshot
+// stays CREATING until the result event arrives.
+func (b *Business) Create(ctx context.Context, serverID uuid.UUID, name string, userID uuid.UUID) (Snapshot, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Snapshot{}, fmt.Errorf("%w: snapshot name cannot be empty", ErrValidation)
+	}
+	if len(name) > MaxNameLength {
+		return Snapshot{}, fmt.Errorf("%w: snapshot name is longer than %d characters", ErrValidation, MaxNameLength)
+	}
+
+	srv, err := b.servers.Authorize(ctx, serverID, userID, project.PermManage)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("create: %w", err)
+	}
+
+	if srv.Status != server.StatusStopped {
+		return Snapshot{}, fmt.Errorf("%w: cannot snapshot server with status '%s', expected STOPPED", ErrValidation, srv.Status)
+	}
+
+	planFound, err := b.plans.FindByID(ctx, srv.PlanID)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("plan.findbyid: %w", err)
+	}
+
+	snap := Snapshot{
+		ID:        uuid.New(),
+		ServerID:  srv.ID,
+		OwnerID:   userID,
+		PoolID:    srv.PoolID,
+		Name:      name,
+		SizeGB:    planFound.DiskGB,
+		Status:    StatusCreating,
+		Reserved:  true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// The row is written before the disk is taken, so a reservation whose
+	// answer is lost can still be returned under the snapshot id.
+	if err := b.storer.Create(ctx, snap); err != nil {
+		return Snapshot{}, fmt.Errorf("create: %w", err)
+	}
+
+	if err := b.resources.Reserve(ctx, snap.ID, disk(snap), snap.PoolID); err != nil {
+		return Snapshot{}, b.abandon(ctx, &snap, fmt.Errorf("resources.reserve: %w", err))
+	}
+
+	if err := b.provisioner.RequestSnapshot(ctx, snap); err != nil {
+		return Snapshot{}, b.abandon(ctx, &snap, fmt.Errorf("provisioner.requestsnapshot: %w", err))
+	}
+
+	return snap, nil
+}
+
+// abandon marks a snapshot whose creation could not be requested failed and
+// gives its disk back. A failed release is joined to cause; the snapshot is
+// then left FAILED and reserved, and deleting it returns the disk.
+func (b *Business) abandon(ctx context.Context, snap *Snapshot, cause error) error {
+	reason := cause.Error()
+	snap.Status = StatusFailed
+	snap.FailureReason = &reason
+
+	if err := b.storer.Update(ctx, *snap); err != nil {
+		return errors.Join(cause, fmt.Errorf("abandon: %w", err))
+	}
+
+	if err := b.release(ctx, snap); err != nil {
+		return errors.Join(cause, fmt.Errorf("abandon: %w", err))
+	}
+
+	return cause
+}
+
+func (b *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (Snapshot, error) {
+	snap, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	if snap.OwnerID != userID {
+		return Snapshot{}, ErrAccessDenied
+	}
+
+	return snap, nil
+}
+
+// Search lists the snapshots of the user, the newest first.
+func (b *Business) Search(ctx context.Context, filter QueryFilter, pg page.Page, userID uuid.UUID) ([]Snapshot, int, error) {
+	filter.OwnerID = &userID
+
+	snaps, count, err := b.storer.FindAll(ctx, filter, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	return snaps, count, nil
+}
+
+// Restore writes an available snapshot back to its server. The server must
+// be stopped and stays RESTORING until the result event arrives.
+func (b *Business) Restore(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (Snapshot, error) {
+	snap, err := b.FindByID(ctx, snapshotID, userID)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if snap.Status != StatusAvailable {
+		return Snapshot{}, fmt.Errorf("%w: cannot restore snapshot with status '%s', expected AVAILABLE", ErrValidation, snap.Status)
+	}
+
+	snap.Status = StatusRestoring
+	snap.FailureReason = nil
+
+	err = b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if _, err := b.servers.BeginSnapshotRestore(ctx, snap.ServerID, userID); err != nil {
+			if errors.Is(err, server.ErrServerNotFound) {
+				return fmt.Errorf("%w: the server of the snapshot no longer exists", ErrValidation)
+			}
+			return fmt.Errorf("restore: %w", err)
+		}
+
+		if err := b.storer.Update(ctx, snap); err != nil {
+			return fmt.Errorf("restore: %w", err)
+		}
+
+		if err := b.provisioner.RequestSnapshotRestore(ctx, snap); err != nil {
+			return fmt.Errorf("provisioner.requestsnapshotrestore: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return snap, nil
+}
+
+// CreateServer orders a new server from an available snapshot into the
+// project, or the personal project of the user when projectID is nil. The
+// plan must have room for the snapshot disk; the server is placed like an
+// ordered one, in the region of placement or in any pool.
+func (b *Business) CreateServer(ctx context.Context, snapshotID uuid.UUID, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	snap, err := b.FindByID(ctx, snapshotID, userID)
+	if err != nil {
+		return server.Server{}, err
+	}
+
+	if snap.Status != StatusAvailable {
+		return server.Server{}, fmt.Errorf("%w: cannot create a server from snapshot with status '%s', expected AVAILABLE", ErrValidation, snap.Status)
+	}
+
+	source := server.Source{SnapshotID: snap.ID, SizeGB: snap.SizeGB}
+
+	srv, err := b.servers.CreateFromSnapshot(ctx, name, planID, projectID, placement, source, sshKeyIDs, userID)
+	if err != nil {
+		return server.Server{}, fmt.Errorf("createserver: %w", err)
+	}
+
+	return srv, nil
+}
+
+// Delete asks the provisioning service to remove the snapshot image. The
+// disk goes back to the pool in CompleteDelete.
+func (b *Business) Delete(ctx context.Context, snapshotID uuid.UUID, userID uuid.UUID) (Snapshot, error) {
+	snap, err := b.FindByID(ctx, snapshotID, userID)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if snap.Status != StatusAvailable && snap.Status != StatusFailed {
+		return Snapshot{}, fmt.Errorf("%w: cannot delete snapshot with status '%s', expected AVAILABLE or FAILED", ErrValidation, snap.Status)
+	}
+
+	snap.Status = StatusDeleting
+
+	err = b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		if err := b.storer.Update(ctx, snap); err != nil {
+			return fmt.Errorf("delete: %w", err)
+		}
+
+		if err := b.provisioner.RequestSnapshotDelete(ctx, snap); err != nil {
+			return fmt.Errorf("provisioner.requestsnapshotdelete: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return snap, nil
+}
+
+// CompleteCreate marks a CREATING snapshot available. Repeated events are
+// ignored.
+func (b *Business) CompleteCreate(ctx context.Context, snapshotID uuid.UUID) error {
+	snap, err := b.storer.FindByID(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("completecreate: %w", err)
+	}
+
+	if snap.Status != StatusCreating {
+		return nil
+	}
+
+	snap.Status = StatusAvailable
+
+	if err := b.storer.Update(ctx, snap); err != nil {
+		return fmt.Errorf("completecreate: %w", err)
+	}
+
+	return nil
+}
+
+// FailCreate marks a CREATING snapshot failed and gives its disk back to
+// the pool. A failed release is returned so the event is redelivered.
+func (b *Business) FailCreate(ctx context.Context, snapshotID uuid.UUID, reason string) error {
+	snap, err := b.storer.FindByID(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("failcreate: %w", err)
+	}
+
+	if snap.Status == StatusCreating {
+		snap.Status = StatusFailed
+		snap.FailureReason = &reason
+
+		if err := b.storer.Update(ctx, snap); err != nil {
+			return fmt.Errorf("failcreate: %w", err)
+		}
+	}
+
+	if snap.Status != StatusFailed || !snap.Reserved {
+		return nil
+	}
+
+	return b.release(ctx, &snap)
+}
+
+// CompleteRestore makes the snapshot available again and lets the server
+// be started.
+func (b *Business) CompleteRestore(ctx context.Context, snapshotID uuid.UUID) error {
+	return b.endRestore(ctx, snapshotID, nil)
+}
+
+// FailRestore records why the restore failed. The server keeps its disk as
+// it was and returns to STOPPED.
+func (b *Business) FailRestore(ctx context.Context, snapshotID uuid.UUID, reason string) error {
+	return b.endRestore(ctx, snapshotID, &reason)
+}
+
+func (b *Business) endRestore(ctx context.Context, snapshotID uuid.UUID, failure *string) error {
+	snap, err := b.storer.FindByID(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("endrestore: %w", err)
+	}
+
+	if snap.Status != StatusRestoring {
+		return nil
+	}
+
+	reason := "snapshot restored"
+	if failure != nil {
+		reason = "snapshot restore failed: " + *failure
+	}
+
+	snap.Status = StatusAvailable
+	snap.FailureReason = failure
+
+	return b.tx.WithinTran(ctx, func(ctx context.Context) error {
+		// The server may have been force deleted in the meantime.
+		if err := b.servers.EndSnapshotRestore(ctx, snap.ServerID, reason); err != nil && !errors.Is(err, server.ErrServerNotFound) {
+			return fmt.Errorf("endrestore: %w", err)
+		}
+
+		if err := b.storer.Update(ctx, snap); err != nil {
+			return fmt.Errorf("endrestore: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// CompleteDelete removes a DELETING snapshot once its image is gone and
+// gives its disk back to the pool.
+func (b *Business) CompleteDelete(ctx context.Context, snapshotID uuid.UUID) error {
+	snap, err := b.storer.FindByID(ctx, snapshotID)
+	if err != nil {
+		return fmt.Errorf("completedelete: %w", err)
+	}
+
+	if snap.Status != StatusDeleting {
+		return fmt.Errorf("%w: cannot remove snapshot with status '%s', expected DELETING", ErrValidation, snap.Status)
+	}
+
+	if snap.Reserved {
+		if err := b.release(ctx, &snap); err != nil {
+			return err
+		}
+	}
+
+	if err := b.storer.Delete(ctx, snapshotID); err != nil {
+		return fmt.Errorf("completedelete: %w", err)
+	}
+
+	return nil
+}
+
+// release returns the disk of the snapshot and records it. The snapshot id is
+// the reservation id, so a return repeated after a crash is applied once.
+func (b *Business) release(ctx context.Context, snap *Snapshot) error {
+	if err := b.resources.Return(ctx, snap.ID, disk(*snap), snap.PoolID); err != nil {
+		return fmt.Errorf("resources.return: %w", err)
+	}
+
+	snap.Reserved = false
+
+	if err := b.storer.Update(ctx, *snap); err != nil {
+		return fmt.Errorf("release: %w", err)
+	}
+
+	return nil
+}
+
+func disk(snap Snapshot) server.Resources {
+	return server.Resources{DiskGB: snap.SizeGB}
+}
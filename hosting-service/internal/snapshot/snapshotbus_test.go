@@ -0,0 +1,763 @@
+package snapshot_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"hosting-kit/page"
+	"hosting-service/internal/plan"
+	"hosting-service/internal/project"
+	"hosting-service/internal/server"
+	"hosting-service/internal/snapshot"
+
+	"github.com/google/uuid"
+)
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTran(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type mockStorer struct {
+	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error)
+	CreateFunc   func(ctx context.Context, snap snapshot.Snapshot) error
+	UpdateFunc   func(ctx context.Context, snap snapshot.Snapshot) error
+	DeleteFunc   func(ctx context.Context, ID uuid.UUID) error
+	FindAllFunc  func(ctx context.Context, filter snapshot.QueryFilter, pg page.Page) ([]snapshot.Snapshot, int, error)
+}
+
+func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return snapshot.Snapshot{}, nil
+}
+
+func (m *mockStorer) Create(ctx context.Context, snap snapshot.Snapshot) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, snap)
+	}
+	return nil
+}
+
+func (m *mockStorer) Update(ctx context.Context, snap snapshot.Snapshot) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, snap)
+	}
+	return nil
+}
+
+func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, ID)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindAll(ctx context.Context, filter snapshot.QueryFilter, pg page.Page) ([]snapshot.Snapshot, int, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx, filter, pg)
+	}
+	return nil, 0, nil
+}
+
+type mockServerManager struct {
+	AuthorizeFunc            func(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error)
+	CreateFromSnapshotFunc   func(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, source server.Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error)
+	BeginSnapshotRestoreFunc func(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error)
+	EndSnapshotRestoreFunc   func(ctx context.Context, serverID uuid.UUID, reason string) error
+}
+
+func (m *mockServerManager) Authorize(ctx context.Context, serverID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error) {
+	if m.AuthorizeFunc != nil {
+		return m.AuthorizeFunc(ctx, serverID, userID, perm)
+	}
+	return server.Server{}, nil
+}
+
+func (m *mockServerManager) CreateFromSnapshot(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, source server.Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	if m.CreateFromSnapshotFunc != nil {
+		return m.CreateFromSnapshotFunc(ctx, name, planID, projectID, placement, source, sshKeyIDs, userID)
+	}
+	return server.Server{}, nil
+}
+
+func (m *mockServerManager) BeginSnapshotRestore(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+	if m.BeginSnapshotRestoreFunc != nil {
+		return m.BeginSnapshotRestoreFunc(ctx, serverID, userID)
+	}
+	return server.Server{}, nil
+}
+
+func (m *mockServerManager) EndSnapshotRestore(ctx context.Context, serverID uuid.UUID, reason string) error {
+	if m.EndSnapshotRestoreFunc != nil {
+		return m.EndSnapshotRestoreFunc(ctx, serverID, reason)
+	}
+	return nil
+}
+
+type mockPlanFinder struct {
+	FindByIDFunc func(ctx context.Context, ID uuid.UUID) (plan.Plan, error)
+}
+
+func (m *mockPlanFinder) FindByID(ctx context.Context, ID uuid.UUID) (plan.Plan, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return plan.Plan{ID: ID, DiskGB: 20}, nil
+}
+
+type mockResourcesManager struct {
+	ReserveFunc func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error
+	ReturnFunc  func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error
+}
+
+func (m *mockResourcesManager) Reserve(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
+	if m.ReserveFunc != nil {
+		return m.ReserveFunc(ctx, reservationID, r, poolID)
+	}
+	return nil
+}
+
+func (m *mockResourcesManager) Return(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
+	if m.ReturnFunc != nil {
+		return m.ReturnFunc(ctx, reservationID, r, poolID)
+	}
+	return nil
+}
+
+type mockProvisioner struct {
+	RequestSnapshotFunc        func(ctx context.Context, snap snapshot.Snapshot) error
+	RequestSnapshotRestoreFunc func(ctx context.Context, snap snapshot.Snapshot) error
+	RequestSnapshotDeleteFunc  func(ctx context.Context, snap snapshot.Snapshot) error
+}
+
+func (m *mockProvisioner) RequestSnapshot(ctx context.Context, snap snapshot.Snapshot) error {
+	if m.RequestSnapshotFunc != nil {
+		return m.RequestSnapshotFunc(ctx, snap)
+	}
+	return nil
+}
+
+func (m *mockProvisioner) RequestSnapshotRestore(ctx context.Context, snap snapshot.Snapshot) error {
+	if m.RequestSnapshotRestoreFunc != nil {
+		return m.RequestSnapshotRestoreFunc(ctx, snap)
+	}
+	return nil
+}
+
+func (m *mockProvisioner) RequestSnapshotDelete(ctx context.Context, snap snapshot.Snapshot) error {
+	if m.RequestSnapshotDeleteFunc != nil {
+		return m.RequestSnapshotDeleteFunc(ctx, snap)
+	}
+	return nil
+}
+
+func Test_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	poolID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type testCase struct {
+		name         string
+		snapName     string
+		status       server.ServerStatus
+		reserveErr   error
+		requestErr   error
+		returnErr    error
+		wantErr      error
+		wantReturned bool
+		wantStored   snapshot.Status
+		wantReserved bool
+	}
+
+	table := []testCase{
+		{
+			name:         "success",
+			snapName:     "before-upgrade",
+			status:       server.StatusStopped,
+			wantStored:   snapshot.StatusCreating,
+			wantReserved: true,
+		},
+		{
+			name:     "fail_empty_name",
+			snapName: "  ",
+			status:   server.StatusStopped,
+			wantErr:  snapshot.ErrValidation,
+		},
+		{
+			name:     "fail_server_running",
+			snapName: "before-upgrade",
+			status:   server.StatusRunning,
+			wantErr:  snapshot.ErrValidation,
+		},
+		{
+			name:         "fail_no_room_in_pool",
+			snapName:     "before-upgrade",
+			status:       server.StatusStopped,
+			reserveErr:   server.ErrNoResources,
+			wantErr:      server.ErrNoResources,
+			wantReturned: true,
+			wantStored:   snapshot.StatusFailed,
+		},
+		{
+			name:         "fail_reserve_unanswered",
+			snapName:     "before-upgrade",
+			status:       server.StatusStopped,
+			reserveErr:   errBoom,
+			wantErr:      errBoom,
+			wantReturned: true,
+			wantStored:   snapshot.StatusFailed,
+		},
+		{
+			name:         "fail_provisioner_returns_disk",
+			snapName:     "before-upgrade",
+			status:       server.StatusStopped,
+			requestErr:   errBoom,
+			wantErr:      errBoom,
+			wantReturned: true,
+			wantStored:   snapshot.StatusFailed,
+		},
+		{
+			name:         "fail_return_keeps_reservation",
+			snapName:     "before-upgrade",
+			status:       server.StatusStopped,
+			requestErr:   errBoom,
+			returnErr:    server.ErrNoResources,
+			wantErr:      server.ErrNoResources,
+			wantReturned: true,
+			wantStored:   snapshot.StatusFailed,
+			wantReserved: true,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var reserved, returned server.Resources
+			var stored *snapshot.Snapshot
+			var reservedAs, returnedAs uuid.UUID
+
+			store := &mockStorer{
+				CreateFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					if !snap.Reserved {
+						t.Errorf("snapshot stored before its disk is taken must be marked reserved")
+					}
+					if reserved.DiskGB != 0 {
+						t.Errorf("disk reserved before the snapshot was stored")
+					}
+					stored = &snap
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					stored = &snap
+					return nil
+				},
+			}
+
+			servers := &mockServerManager{
+				AuthorizeFunc: func(ctx context.Context, ID uuid.UUID, userID uuid.UUID, perm project.Permission) (server.Server, error) {
+					if perm != project.PermManage {
+						t.Errorf("authorized for %s, want %s", perm, project.PermManage)
+					}
+					return server.Server{ID: ID, OwnerID: uuid.New(), PoolID: poolID, PlanID: uuid.New(), Status: tt.status}, nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReserveFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, pID uuid.UUID) error {
+					if pID != poolID {
+						t.Errorf("reserved in pool %s, want %s", pID, poolID)
+					}
+					reservedAs = reservationID
+					reserved = r
+					return tt.reserveErr
+				},
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, pID uuid.UUID) error {
+					returnedAs = reservationID
+					returned = r
+					return tt.returnErr
+				},
+			}
+
+			prov := &mockProvisioner{
+				RequestSnapshotFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					return tt.requestErr
+				},
+			}
+
+			bus := snapshot.NewBusiness(store, servers, &mockPlanFinder{}, rm, prov, &mockTransactor{})
+
+			got, err := bus.Create(ctx, uuid.New(), tt.snapName, userID)
+
+			if tt.wantStored != "" {
+				if stored == nil {
+					t.Fatalf("snapshot was not stored")
+				}
+				if reservedAs != stored.ID {
+					t.Errorf("reserved under %s, want the snapshot id %s", reservedAs, stored.ID)
+				}
+				if stored.Status != tt.wantStored || stored.Reserved != tt.wantReserved {
+					t.Errorf("stored status %s reserved %v, want %s reserved %v", stored.Status, stored.Reserved, tt.wantStored, tt.wantReserved)
+				}
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if tt.wantReturned {
+					if returned.DiskGB != 20 {
+						t.Errorf("returned disk: got %d, want 20", returned.DiskGB)
+					}
+					if returnedAs != stored.ID {
+						t.Errorf("returned under %s, want the snapshot id %s", returnedAs, stored.ID)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != snapshot.StatusCreating || !got.Reserved {
+				t.Errorf("got status %s reserved %v, want CREATING and reserved", got.Status, got.Reserved)
+			}
+			if reserved.DiskGB != 20 || got.SizeGB != 20 {
+				t.Errorf("reserved %d GB for a %d GB snapshot, want 20", reserved.DiskGB, got.SizeGB)
+			}
+			if got.OwnerID != userID {
+				t.Errorf("got owner %s, want the user who took the snapshot", got.OwnerID)
+			}
+		})
+	}
+}
+
+func Test_Restore(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	type testCase struct {
+		name     string
+		snap     snapshot.Snapshot
+		userID   uuid.UUID
+		beginErr error
+		wantErr  error
+	}
+
+	table := []testCase{
+		{
+			name:   "success",
+			snap:   snapshot.Snapshot{OwnerID: userID, Status: snapshot.StatusAvailable},
+			userID: userID,
+		},
+		{
+			name:    "fail_not_available",
+			snap:    snapshot.Snapshot{OwnerID: userID, Status: snapshot.StatusCreating},
+			userID:  userID,
+			wantErr: snapshot.ErrValidation,
+		},
+		{
+			name:    "fail_not_owner",
+			snap:    snapshot.Snapshot{OwnerID: userID, Status: snapshot.StatusAvailable},
+			userID:  uuid.New(),
+			wantErr: snapshot.ErrAccessDenied,
+		},
+		{
+			name:     "fail_server_gone",
+			snap:     snapshot.Snapshot{OwnerID: userID, Status: snapshot.StatusAvailable},
+			userID:   userID,
+			beginErr: server.ErrServerNotFound,
+			wantErr:  snapshot.ErrValidation,
+		},
+		{
+			name:     "fail_server_running",
+			snap:     snapshot.Snapshot{OwnerID: userID, Status: snapshot.StatusAvailable},
+			userID:   userID,
+			beginErr: server.ErrValidation,
+			wantErr:  server.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated snapshot.Snapshot
+			requested := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+					snap := tt.snap
+					snap.ID = ID
+					return snap, nil
+				},
+				UpdateFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					updated = snap
+					return nil
+				},
+			}
+
+			servers := &mockServerManager{
+				BeginSnapshotRestoreFunc: func(ctx context.Context, serverID uuid.UUID, userID uuid.UUID) (server.Server, error) {
+					return server.Server{ID: serverID, Status: server.StatusRestoring}, tt.beginErr
+				},
+			}
+
+			prov := &mockProvisioner{
+				RequestSnapshotRestoreFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					requested = true
+					return nil
+				},
+			}
+
+			bus := snapshot.NewBusiness(st, servers, &mockPlanFinder{}, &mockResourcesManager{}, prov, &mockTransactor{})
+
+			got, err := bus.Restore(ctx, uuid.New(), tt.userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if requested {
+					t.Error("restore must not be requested on failure")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != snapshot.StatusRestoring || updated.Status != snapshot.StatusRestoring {
+				t.Errorf("status: got %s (stored %s), want RESTORING", got.Status, updated.Status)
+			}
+			if !requested {
+				t.Error("expected the restore to be requested")
+			}
+		})
+	}
+}
+
+func Test_RestoreResult(t *testing.T) {
+	ctx := context.Background()
+	reason := "disk checksum mismatch"
+
+	type testCase struct {
+		name       string
+		status     snapshot.Status
+		failure    *string
+		endErr     error
+		wantErr    bool
+		wantEnded  bool
+		wantReason string
+	}
+
+	table := []testCase{
+		{
+			name:       "success",
+			status:     snapshot.StatusRestoring,
+			wantEnded:  true,
+			wantReason: "snapshot restored",
+		},
+		{
+			name:       "failure_reported",
+			status:     snapshot.StatusRestoring,
+			failure:    &reason,
+			wantEnded:  true,
+			wantReason: "snapshot restore failed: " + reason,
+		},
+		{
+			name:       "server_gone",
+			status:     snapshot.StatusRestoring,
+			endErr:     server.ErrServerNotFound,
+			wantEnded:  true,
+			wantReason: "snapshot restored",
+		},
+		{
+			name:    "server_update_failed",
+			status:  snapshot.StatusRestoring,
+			endErr:  errors.New("boom"),
+			wantErr: true,
+		},
+		{
+			name:   "duplicate_event",
+			status: snapshot.StatusAvailable,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *snapshot.Snapshot
+			var endedReason string
+			ended := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+					return snapshot.Snapshot{ID: ID, Status: tt.status}, nil
+				},
+				UpdateFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					updated = &snap
+					return nil
+				},
+			}
+
+			servers := &mockServerManager{
+				EndSnapshotRestoreFunc: func(ctx context.Context, serverID uuid.UUID, reason string) error {
+					ended = true
+					endedReason = reason
+					return tt.endErr
+				},
+			}
+
+			bus := snapshot.NewBusiness(st, servers, &mockPlanFinder{}, &mockResourcesManager{}, &mockProvisioner{}, &mockTransactor{})
+
+			var err error
+			if tt.failure != nil {
+				err = bus.FailRestore(ctx, uuid.New(), *tt.failure)
+			} else {
+				err = bus.CompleteRestore(ctx, uuid.New())
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ended != tt.wantEnded {
+				t.Fatalf("server restore ended: got %v, want %v", ended, tt.wantEnded)
+			}
+			if !tt.wantEnded {
+				if updated != nil {
+					t.Error("a duplicate event must not update the snapshot")
+				}
+				return
+			}
+			if endedReason != tt.wantReason {
+				t.Errorf("reason: got %q, want %q", endedReason, tt.wantReason)
+			}
+			if updated == nil || updated.Status != snapshot.StatusAvailable {
+				t.Error("expected the snapshot to be AVAILABLE again")
+			}
+		})
+	}
+}
+
+func Test_FailCreate(t *testing.T) {
+	ctx := context.Background()
+	poolID := uuid.New()
+
+	type testCase struct {
+		name         string
+		snap         snapshot.Snapshot
+		returnErr    error
+		wantErr      bool
+		wantReturned bool
+	}
+
+	table := []testCase{
+		{
+			name:         "success_returns_disk",
+			snap:         snapshot.Snapshot{Status: snapshot.StatusCreating, Reserved: true, SizeGB: 40, PoolID: poolID},
+			wantReturned: true,
+		},
+		{
+			name:         "redelivered_after_failed_return",
+			snap:         snapshot.Snapshot{Status: snapshot.StatusFailed, Reserved: true, SizeGB: 40, PoolID: poolID},
+			wantReturned: true,
+		},
+		{
+			name: "already_released",
+			snap: snapshot.Snapshot{Status: snapshot.StatusFailed, Reserved: false, SizeGB: 40, PoolID: poolID},
+		},
+		{
+			name:      "fail_return",
+			snap:      snapshot.Snapshot{Status: snapshot.StatusCreating, Reserved: true, SizeGB: 40, PoolID: poolID},
+			returnErr: errors.New("boom"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated snapshot.Snapshot
+			returned := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+					snap := tt.snap
+					snap.ID = ID
+					return snap, nil
+				},
+				UpdateFunc: func(ctx context.Context, snap snapshot.Snapshot) error {
+					updated = snap
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, pID uuid.UUID) error {
+					if r.DiskGB != 40 || pID != poolID {
+						t.Errorf("returned %d GB to %s, want 40 GB to %s", r.DiskGB, pID, poolID)
+					}
+					returned = true
+					return tt.returnErr
+				},
+			}
+
+			bus := snapshot.NewBusiness(st, &mockServerManager{}, &mockPlanFinder{}, rm, &mockProvisioner{}, &mockTransactor{})
+
+			err := bus.FailCreate(ctx, uuid.New(), "out of space")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if updated.Status != snapshot.StatusFailed || !updated.Reserved {
+					t.Error("expected the snapshot to be FAILED and still reserved")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if returned != tt.wantReturned {
+				t.Errorf("disk returned: got %v, want %v", returned, tt.wantReturned)
+			}
+			if tt.wantReturned && (updated.Status != snapshot.StatusFailed || updated.Reserved) {
+				t.Errorf("got status %s reserved %v, want FAILED and released", updated.Status, updated.Reserved)
+			}
+		})
+	}
+}
+
+func Test_CompleteDelete(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		name         string
+		snap         snapshot.Snapshot
+		wantErr      error
+		wantReturned bool
+	}
+
+	table := []testCase{
+		{
+			name:         "success",
+			snap:         snapshot.Snapshot{Status: snapshot.StatusDeleting, Reserved: true, SizeGB: 20},
+			wantReturned: true,
+		},
+		{
+			name: "success_already_released",
+			snap: snapshot.Snapshot{Status: snapshot.StatusDeleting, SizeGB: 20},
+		},
+		{
+			name:    "fail_not_deleting",
+			snap:    snapshot.Snapshot{Status: snapshot.StatusAvailable, Reserved: true, SizeGB: 20},
+			wantErr: snapshot.ErrValidation,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			returned := false
+			deleted := false
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+					snap := tt.snap
+					snap.ID = ID
+					return snap, nil
+				},
+				DeleteFunc: func(ctx context.Context, ID uuid.UUID) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			rm := &mockResourcesManager{
+				ReturnFunc: func(ctx context.Context, reservationID uuid.UUID, r server.Resources, poolID uuid.UUID) error {
+					returned = true
+					return nil
+				},
+			}
+
+			bus := snapshot.NewBusiness(st, &mockServerManager{}, &mockPlanFinder{}, rm, &mockProvisioner{}, &mockTransactor{})
+
+			err := bus.CompleteDelete(ctx, uuid.New())
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if deleted || returned {
+					t.Error("nothing must change on failure")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deleted {
+				t.Error("expected the snapshot to be removed")
+			}
+			if returned != tt.wantReturned {
+				t.Errorf("disk returned: got %v, want %v", returned, tt.wantReturned)
+			}
+		})
+	}
+}
+
+func Test_CreateServer(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	type testCase struct {
+		name    string
+		status  snapshot.Status
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success", status: snapshot.StatusAvailable},
+		{name: "fail_not_available", status: snapshot.StatusFailed, wantErr: snapshot.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotSource server.Source
+
+			st := &mockStorer{
+				FindByIDFunc: func(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+					return snapshot.Snapshot{ID: ID, OwnerID: userID, SizeGB: 30, Status: tt.status}, nil
+				},
+			}
+
+			servers := &mockServerManager{
+				CreateFromSnapshotFunc: func(ctx context.Context, name string, planID uuid.UUID, projectID *uuid.UUID, placement server.Placement, source server.Source, sshKeyIDs []uuid.UUID, userID uuid.UUID) (server.Server, error) {
+					gotSource = source
+					return server.Server{ID: uuid.New(), SnapshotID: &source.SnapshotID}, nil
+				},
+			}
+
+			bus := snapshot.NewBusiness(st, servers, &mockPlanFinder{}, &mockResourcesManager{}, &mockProvisioner{}, &mockTransactor{})
+
+			snapID := uuid.New()
+			_, err := bus.CreateServer(ctx, snapID, "Web01", uuid.New(), nil, server.Placement{}, nil, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotSource.SnapshotID != snapID || gotSource.SizeGB != 30 {
+				t.Errorf("got source %+v, want snapshot %s of 30 GB", gotSource, snapID)
+			}
+		})
+	}
+}
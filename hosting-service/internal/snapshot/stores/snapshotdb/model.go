@@ -0,0 +1,59 @@
+package snapshotdb
+
+import (
+	"hosting-service/internal/snapshot"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type snapshotDB struct {
+	ID            uuid.UUID `db:"id"`
+	ServerID      uuid.UUID `db:"server_id"`
+	OwnerID       uuid.UUID `db:"owner_id"`
+	PoolID        uuid.UUID `db:"pool_id"`
+	Name          string    `db:"name"`
+	SizeGB        int       `db:"size_gb"`
+	Status        string    `db:"status"`
+	FailureReason *string   `db:"failure_reason"`
+	Reserved      bool      `db:"reserved"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func toDBSnapshot(s snapshot.Snapshot) snapshotDB {
+	return snapshotDB{
+		ID:            s.ID,
+		ServerID:      s.ServerID,
+		OwnerID:       s.OwnerID,
+		PoolID:        s.PoolID,
+		Name:          s.Name,
+		SizeGB:        s.SizeGB,
+		Status:        string(s.Status),
+		FailureReason: s.FailureReason,
+		Reserved:      s.Reserved,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func toBusSnapshot(db snapshotDB) snapshot.Snapshot {
+	return snapshot.Snapshot{
+		ID:            db.ID,
+		ServerID:      db.ServerID,
+		OwnerID:       db.OwnerID,
+		PoolID:        db.PoolID,
+		Name:          db.Name,
+		SizeGB:        db.SizeGB,
+		Status:        snapshot.Status(db.Status),
+		FailureReason: db.FailureReason,
+		Reserved:      db.Reserved,
+		CreatedAt:     db.CreatedAt,
+	}
+}
+
+func toBusSnapshots(dbs []snapshotDB) []snapshot.Snapshot {
+	snaps := make([]snapshot.Snapshot, len(dbs))
+	for i, db := range dbs {
+		snaps[i] = toBusSnapshot(db)
+	}
+	return snaps
+}
@@ -0,0 +1,172 @@
+package snapshotdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/snapshot"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (snapshot.Snapshot, error) {
+	const q = `
+	SELECT
+		id, server_id, owner_id, pool_id, name, size_gb, status, failure_reason, reserved, created_at
+	FROM
+		snapshots
+	WHERE
+		id = @id`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"id": ID})
+	if err != nil {
+		return snapshot.Snapshot{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbSnap, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[snapshotDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return snapshot.Snapshot{}, snapshot.ErrSnapshotNotFound
+		}
+		return snapshot.Snapshot{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusSnapshot(dbSnap), nil
+}
+
+func (s *Store) Create(ctx context.Context, snap snapshot.Snapshot) error {
+	const q = `
+	INSERT INTO snapshots
+		(id, server_id, owner_id, pool_id, name, size_gb, status, failure_reason, reserved, created_at)
+	VALUES
+		(@id, @server_id, @owner_id, @pool_id, @name, @size_gb, @status, @failure_reason, @reserved, @created_at)`
+
+	dbSnap := toDBSnapshot(snap)
+
+	args := pgx.NamedArgs{
+		"id":             dbSnap.ID,
+		"server_id":      dbSnap.ServerID,
+		"owner_id":       dbSnap.OwnerID,
+		"pool_id":        dbSnap.PoolID,
+		"name":           dbSnap.Name,
+		"size_gb":        dbSnap.SizeGB,
+		"status":         dbSnap.Status,
+		"failure_reason": dbSnap.FailureReason,
+		"reserved":       dbSnap.Reserved,
+		"created_at":     dbSnap.CreatedAt,
+	}
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, args); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Update(ctx context.Context, snap snapshot.Snapshot) error {
+	const q = `
+	UPDATE snapshots
+	SET
+		name = @name,
+		status = @status,
+		failure_reason = @failure_reason,
+		reserved = @reserved
+	WHERE
+		id = @id`
+
+	dbSnap := toDBSnapshot(snap)
+
+	args := pgx.NamedArgs{
+		"id":             dbSnap.ID,
+		"name":           dbSnap.Name,
+		"status":         dbSnap.Status,
+		"failure_reason": dbSnap.FailureReason,
+		"reserved":       dbSnap.Reserved,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return snapshot.ErrSnapshotNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
+	const q = `
+	DELETE FROM snapshots
+	WHERE id = @id`
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, pgx.NamedArgs{"id": ID}); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) FindAll(ctx context.Context, filter snapshot.QueryFilter, pg page.Page) ([]snapshot.Snapshot, int, error) {
+	args := pgx.NamedArgs{}
+
+	var where strings.Builder
+	where.WriteString(" WHERE TRUE")
+
+	if filter.OwnerID != nil {
+		where.WriteString(" AND owner_id = @owner_id")
+		args["owner_id"] = *filter.OwnerID
+	}
+	if filter.ServerID != nil {
+		where.WriteString(" AND server_id = @server_id")
+		args["server_id"] = *filter.ServerID
+	}
+
+	qCount := `SELECT count(*) FROM snapshots` + where.String()
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	q := `
+	SELECT
+		id, server_id, owner_id, pool_id, name, size_gb, status, failure_reason, reserved, created_at
+	FROM
+		snapshots` + where.String() + `
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbSnaps, err := pgx.CollectRows(rows, pgx.RowToStructByName[snapshotDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusSnapshots(dbSnaps), total, nil
+}
@@ -0,0 +1,66 @@
+package snapshotmsg
+
+import (
+	"context"
+	"fmt"
+	"hosting-contracts/hosting-service/queue/commands"
+	"hosting-contracts/topology"
+	"hosting-service/internal/snapshot"
+
+	"github.com/google/uuid"
+)
+
+// Publisher is satisfied by the outbox, so commands are stored in the same
+// transaction as the snapshot change. Snapshot commands are ordered with the
+// other commands of their server.
+type Publisher interface {
+	PublishOrdered(ctx context.Context, aggregateID uuid.UUID, exchangeName, routingKey string, data interface{}) error
+}
+
+type Provisioner struct {
+	publisher Publisher
+}
+
+func NewProvisioner(publisher Publisher) *Provisioner {
+	return &Provisioner{
+		publisher: publisher,
+	}
+}
+
+func (p *Provisioner) RequestSnapshot(ctx context.Context, snap snapshot.Snapshot) error {
+	command := commands.CreateSnapshotCommand{
+		SnapshotID: snap.ID,
+		ServerID:   snap.ServerID,
+	}
+
+	if err := p.publisher.PublishOrdered(ctx, snap.ServerID, topology.CommandsExchange, commands.SnapshotCreateRequestKey, command); err != nil {
+		return fmt.Errorf("msg: failed to queue snapshot creation: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Provisioner) RequestSnapshotRestore(ctx context.Context, snap snapshot.Snapshot) error {
+	command := commands.RestoreSnapshotCommand{
+		SnapshotID: snap.ID,
+		ServerID:   snap.ServerID,
+	}
+
+	if err := p.publisher.PublishOrdered(ctx, snap.ServerID, topology.CommandsExchange, commands.SnapshotRestoreRequestKey, command); err != nil {
+		return fmt.Errorf("msg: failed to queue snapshot restore: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Provisioner) RequestSnapshotDelete(ctx context.Context, snap snapshot.Snapshot) error {
+	command := commands.DeleteSnapshotCommand{
+		SnapshotID: snap.ID,
+	}
+
+	if err := p.publisher.PublishOrdered(ctx, snap.ServerID, topology.CommandsExchange, commands.SnapshotDeleteRequestKey, command); err != nil {
+		return fmt.Errorf("msg: failed to queue snapshot deletion: %w", err)
+	}
+
+	return nil
+}
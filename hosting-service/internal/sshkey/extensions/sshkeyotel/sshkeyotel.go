@@ -0,0 +1,57 @@
+package sshkeyotel
+
+import (
+	"context"
+	"hosting-kit/otel"
+	"hosting-kit/page"
+	"hosting-service/internal/sshkey"
+
+	"github.com/google/uuid"
+)
+
+type Extension struct {
+	bus sshkey.ExtBusiness
+}
+
+func NewExtension() sshkey.Extension {
+	return func(bus sshkey.ExtBusiness) sshkey.ExtBusiness {
+		return &Extension{
+			bus: bus,
+		}
+	}
+}
+
+func (e *Extension) Create(ctx context.Context, name string, publicKey string, userID uuid.UUID) (sshkey.SSHKey, error) {
+	ctx, span := otel.AddSpan(ctx, "sshkey.create")
+	defer span.End()
+
+	return e.bus.Create(ctx, name, publicKey, userID)
+}
+
+func (e *Extension) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (sshkey.SSHKey, error) {
+	ctx, span := otel.AddSpan(ctx, "sshkey.findbyid")
+	defer span.End()
+
+	return e.bus.FindByID(ctx, ID, userID)
+}
+
+func (e *Extension) FindByIDs(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]sshkey.SSHKey, error) {
+	ctx, span := otel.AddSpan(ctx, "sshkey.findbyids")
+	defer span.End()
+
+	return e.bus.FindByIDs(ctx, IDs, userID)
+}
+
+func (e *Extension) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]sshkey.SSHKey, int, error) {
+	ctx, span := otel.AddSpan(ctx, "sshkey.search")
+	defer span.End()
+
+	return e.bus.Search(ctx, pg, userID)
+}
+
+func (e *Extension) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	ctx, span := otel.AddSpan(ctx, "sshkey.delete")
+	defer span.End()
+
+	return e.bus.Delete(ctx, ID, userID)
+}
@@ -0,0 +1,25 @@
+package sshkey
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxNameLength limits the name users give a key.
+const MaxNameLength = 100
+
+type SSHKey struct {
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+	Name    string
+
+	// PublicKey is the key in the OpenSSH authorized_keys format, without
+	// options.
+	PublicKey string
+
+	// Fingerprint is the SHA256 fingerprint as printed by ssh-keygen -l.
+	Fingerprint string
+
+	CreatedAt time.Time
+}
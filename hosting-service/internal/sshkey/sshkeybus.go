@@ -0,0 +1,204 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/page"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	ErrKeyNotFound  = errors.New("ssh key not found")
+	ErrValidation   = errors.New("validation error")
+	ErrAccessDenied = errors.New("access denied")
+	ErrDuplicate    = errors.New("ssh key already added")
+)
+
+type Extension func(ExtBusiness) ExtBusiness
+
+type Storer interface {
+	// Create returns ErrDuplicate when the owner already has a key with the
+	// same fingerprint.
+	Create(ctx context.Context, key SSHKey) error
+	FindByID(ctx context.Context, ID uuid.UUID) (SSHKey, error)
+	FindByIDs(ctx context.Context, IDs []uuid.UUID) ([]SSHKey, error)
+	FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]SSHKey, int, error)
+	Delete(ctx context.Context, ID uuid.UUID) error
+}
+
+type ExtBusiness interface {
+	Create(ctx context.Context, name string, publicKey string, userID uuid.UUID) (SSHKey, error)
+	FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (SSHKey, error)
+	FindByIDs(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]SSHKey, error)
+	Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]SSHKey, int, error)
+	Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error
+}
+
+type Business struct {
+	storer     Storer
+	extensions []Extension
+}
+
+func NewBusiness(storer Storer, extensions ...Extension) ExtBusiness {
+	b := &Business{
+		storer:     storer,
+		extensions: extensions,
+	}
+
+	extBus := ExtBusiness(b)
+
+	for i := len(extensions) - 1; i >= 0; i-- {
+		ext := extensions[i]
+		if ext != nil {
+			extBus = ext(extBus)
+		}
+	}
+
+	return extBus
+}
+
+// Create stores an OpenSSH public key of the user. The key is normalised to
+// "type base64 comment" and fingerprinted the way ssh-keygen does it.
+func (b *Business) Create(ctx context.Context, name string, publicKey string, userID uuid.UUID) (SSHKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return SSHKey{}, fmt.Errorf("%w: key name cannot be empty", ErrValidation)
+	}
+	if len(name) > MaxNameLength {
+		return SSHKey{}, fmt.Errorf("%w: key name is longer than %d characters", ErrValidation, MaxNameLength)
+	}
+
+	normalized, fingerprint, err := Parse(publicKey)
+	if err != nil {
+		return SSHKey{}, err
+	}
+
+	key := SSHKey{
+		ID:          uuid.New(),
+		OwnerID:     userID,
+		Name:        name,
+		PublicKey:   normalized,
+		Fingerprint: fingerprint,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if err := b.storer.Create(ctx, key); err != nil {
+		return SSHKey{}, fmt.Errorf("create: %w", err)
+	}
+
+	return key, nil
+}
+
+func (b *Business) FindByID(ctx context.Context, ID uuid.UUID, userID uuid.UUID) (SSHKey, error) {
+	key, err := b.storer.FindByID(ctx, ID)
+	if err != nil {
+		return SSHKey{}, fmt.Errorf("findbyid: %w", err)
+	}
+
+	if key.OwnerID != userID {
+		return SSHKey{}, ErrAccessDenied
+	}
+
+	return key, nil
+}
+
+// FindByIDs returns the keys in the order of IDs. Every key must exist and
+// belong to the user; duplicates are dropped.
+func (b *Business) FindByIDs(ctx context.Context, IDs []uuid.UUID, userID uuid.UUID) ([]SSHKey, error) {
+	if len(IDs) == 0 {
+		return nil, nil
+	}
+
+	unique := make([]uuid.UUID, 0, len(IDs))
+	seen := make(map[uuid.UUID]bool, len(IDs))
+	for _, id := range IDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	keys, err := b.storer.FindByIDs(ctx, unique)
+	if err != nil {
+		return nil, fmt.Errorf("findbyids: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]SSHKey, len(keys))
+	for _, k := range keys {
+		if k.OwnerID == userID {
+			byID[k.ID] = k
+		}
+	}
+
+	result := make([]SSHKey, 0, len(unique))
+	for _, id := range unique {
+		k, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s'", ErrKeyNotFound, id)
+		}
+		result = append(result, k)
+	}
+
+	return result, nil
+}
+
+func (b *Business) Search(ctx context.Context, pg page.Page, userID uuid.UUID) ([]SSHKey, int, error) {
+	keys, count, err := b.storer.FindAll(ctx, userID, pg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search: %w", err)
+	}
+
+	return keys, count, nil
+}
+
+// Delete removes the key. Servers ordered with it keep it installed.
+func (b *Business) Delete(ctx context.Context, ID uuid.UUID, userID uuid.UUID) error {
+	if _, err := b.FindByID(ctx, ID, userID); err != nil {
+		return err
+	}
+
+	if err := b.storer.Delete(ctx, ID); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	return nil
+}
+
+// Parse validates a public key in the OpenSSH authorized_keys format and
+// returns it normalised together with its SHA256 fingerprint. Keys with
+// options or more than one line are rejected.
+func Parse(publicKey string) (string, string, error) {
+	publicKey = strings.TrimSpace(publicKey)
+	if publicKey == "" {
+		return "", "", fmt.Errorf("%w: public key cannot be empty", ErrValidation)
+	}
+	if strings.ContainsAny(publicKey, "\r\n") {
+		return "", "", fmt.Errorf("%w: public key must be a single line", ErrValidation)
+	}
+
+	parsed, comment, options, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: not an OpenSSH public key: %v", ErrValidation, err)
+	}
+	if len(options) > 0 {
+		return "", "", fmt.Errorf("%w: public key options are not supported", ErrValidation)
+	}
+
+	// The parser trusts the type inside the key blob and skips the one
+	// written in front of it.
+	if keyType := strings.Fields(publicKey)[0]; keyType != parsed.Type() {
+		return "", "", fmt.Errorf("%w: key type '%s' does not match the key data '%s'", ErrValidation, keyType, parsed.Type())
+	}
+
+	normalized := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(parsed)))
+	if comment != "" {
+		normalized += " " + comment
+	}
+
+	return normalized, ssh.FingerprintSHA256(parsed), nil
+}
@@ -0,0 +1,208 @@
+package sshkey_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"hosting-kit/page"
+	"hosting-service/internal/sshkey"
+
+	"github.com/google/uuid"
+)
+
+const (
+	testKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID8ySZQrpKbRwMf2VifrKt+i/M9o6xo5pJwPB7nRefaQ alice@laptop"
+	testFingerprint = "SHA256:Kq1x0NsQMk24BnIQo8pDXHPDkNJtHxWjF6IOZpM6tJo"
+)
+
+type mockStorer struct {
+	CreateFunc    func(ctx context.Context, key sshkey.SSHKey) error
+	FindByIDFunc  func(ctx context.Context, ID uuid.UUID) (sshkey.SSHKey, error)
+	FindByIDsFunc func(ctx context.Context, IDs []uuid.UUID) ([]sshkey.SSHKey, error)
+	FindAllFunc   func(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]sshkey.SSHKey, int, error)
+	DeleteFunc    func(ctx context.Context, ID uuid.UUID) error
+}
+
+func (m *mockStorer) Create(ctx context.Context, key sshkey.SSHKey) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, key)
+	}
+	return nil
+}
+
+func (m *mockStorer) FindByID(ctx context.Context, ID uuid.UUID) (sshkey.SSHKey, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, ID)
+	}
+	return sshkey.SSHKey{}, nil
+}
+
+func (m *mockStorer) FindByIDs(ctx context.Context, IDs []uuid.UUID) ([]sshkey.SSHKey, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, IDs)
+	}
+	return nil, nil
+}
+
+func (m *mockStorer) FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]sshkey.SSHKey, int, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx, ownerID, pg)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStorer) Delete(ctx context.Context, ID uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, ID)
+	}
+	return nil
+}
+
+func Test_Parse(t *testing.T) {
+	type testCase struct {
+		name    string
+		key     string
+		wantKey string
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success", key: testKey, wantKey: testKey},
+		{name: "success_trims_spaces", key: "  " + testKey + "\n", wantKey: testKey},
+		{name: "success_without_comment", key: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID8ySZQrpKbRwMf2VifrKt+i/M9o6xo5pJwPB7nRefaQ", wantKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID8ySZQrpKbRwMf2VifrKt+i/M9o6xo5pJwPB7nRefaQ"},
+		{name: "fail_empty", key: " ", wantErr: sshkey.ErrValidation},
+		{name: "fail_garbage", key: "ssh-ed25519 not-base64", wantErr: sshkey.ErrValidation},
+		{name: "fail_type_mismatch", key: "ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAID8ySZQrpKbRwMf2VifrKt+i/M9o6xo5pJwPB7nRefaQ", wantErr: sshkey.ErrValidation},
+		{name: "fail_options", key: `command="ls" ` + testKey, wantErr: sshkey.ErrValidation},
+		{name: "fail_two_keys", key: testKey + "\n" + testKey, wantErr: sshkey.ErrValidation},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			key, fingerprint, err := sshkey.Parse(tt.key)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != tt.wantKey {
+				t.Errorf("key: got %q, want %q", key, tt.wantKey)
+			}
+			if fingerprint != testFingerprint {
+				t.Errorf("fingerprint: got %q, want %q", fingerprint, testFingerprint)
+			}
+		})
+	}
+}
+
+func Test_Create(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	type testCase struct {
+		name     string
+		keyName  string
+		storeErr error
+		wantErr  error
+	}
+
+	table := []testCase{
+		{name: "success", keyName: "laptop"},
+		{name: "fail_empty_name", keyName: "", wantErr: sshkey.ErrValidation},
+		{name: "fail_duplicate", keyName: "laptop", storeErr: sshkey.ErrDuplicate, wantErr: sshkey.ErrDuplicate},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				CreateFunc: func(ctx context.Context, key sshkey.SSHKey) error {
+					return tt.storeErr
+				},
+			}
+
+			bus := sshkey.NewBusiness(st)
+
+			got, err := bus.Create(ctx, tt.keyName, testKey, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.OwnerID != userID || got.Fingerprint != testFingerprint {
+				t.Errorf("got owner %s fingerprint %s, want %s and %s", got.OwnerID, got.Fingerprint, userID, testFingerprint)
+			}
+		})
+	}
+}
+
+func Test_FindByIDs(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	first := sshkey.SSHKey{ID: uuid.New(), OwnerID: userID}
+	second := sshkey.SSHKey{ID: uuid.New(), OwnerID: userID}
+	foreign := sshkey.SSHKey{ID: uuid.New(), OwnerID: uuid.New()}
+
+	type testCase struct {
+		name    string
+		ids     []uuid.UUID
+		wantIDs []uuid.UUID
+		wantErr error
+	}
+
+	table := []testCase{
+		{name: "success_keeps_order", ids: []uuid.UUID{second.ID, first.ID}, wantIDs: []uuid.UUID{second.ID, first.ID}},
+		{name: "success_drops_duplicates", ids: []uuid.UUID{first.ID, first.ID}, wantIDs: []uuid.UUID{first.ID}},
+		{name: "fail_unknown", ids: []uuid.UUID{first.ID, uuid.New()}, wantErr: sshkey.ErrKeyNotFound},
+		{name: "fail_foreign", ids: []uuid.UUID{foreign.ID}, wantErr: sshkey.ErrKeyNotFound},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mockStorer{
+				FindByIDsFunc: func(ctx context.Context, IDs []uuid.UUID) ([]sshkey.SSHKey, error) {
+					all := map[uuid.UUID]sshkey.SSHKey{first.ID: first, second.ID: second, foreign.ID: foreign}
+					var found []sshkey.SSHKey
+					for _, id := range IDs {
+						if k, ok := all[id]; ok {
+							found = append(found, k)
+						}
+					}
+					return found, nil
+				},
+			}
+
+			bus := sshkey.NewBusiness(st)
+
+			got, err := bus.FindByIDs(ctx, tt.ids, userID)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("got %d keys, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].ID != id {
+					t.Errorf("key %d: got %s, want %s", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package sshkeydb
+
+import (
+	"hosting-service/internal/sshkey"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type sshKeyDB struct {
+	ID          uuid.UUID `db:"id"`
+	OwnerID     uuid.UUID `db:"owner_id"`
+	Name        string    `db:"name"`
+	PublicKey   string    `db:"public_key"`
+	Fingerprint string    `db:"fingerprint"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func toDBKey(k sshkey.SSHKey) sshKeyDB {
+	return sshKeyDB{
+		ID:          k.ID,
+		OwnerID:     k.OwnerID,
+		Name:        k.Name,
+		PublicKey:   k.PublicKey,
+		Fingerprint: k.Fingerprint,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+func toBusKey(db sshKeyDB) sshkey.SSHKey {
+	return sshkey.SSHKey{
+		ID:          db.ID,
+		OwnerID:     db.OwnerID,
+		Name:        db.Name,
+		PublicKey:   db.PublicKey,
+		Fingerprint: db.Fingerprint,
+		CreatedAt:   db.CreatedAt,
+	}
+}
+
+func toBusKeys(dbs []sshKeyDB) []sshkey.SSHKey {
+	keys := make([]sshkey.SSHKey, len(dbs))
+	for i, db := range dbs {
+		keys[i] = toBusKey(db)
+	}
+	return keys
+}
@@ -0,0 +1,152 @@
+package sshkeydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hosting-kit/database"
+	"hosting-kit/page"
+	"hosting-service/internal/sshkey"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) Create(ctx context.Context, key sshkey.SSHKey) error {
+	const q = `
+	INSERT INTO ssh_keys
+		(id, owner_id, name, public_key, fingerprint, created_at)
+	VALUES
+		(@id, @owner_id, @name, @public_key, @fingerprint, @created_at)
+	ON CONFLICT (owner_id, fingerprint) DO NOTHING`
+
+	dbKey := toDBKey(key)
+
+	args := pgx.NamedArgs{
+		"id":          dbKey.ID,
+		"owner_id":    dbKey.OwnerID,
+		"name":        dbKey.Name,
+		"public_key":  dbKey.PublicKey,
+		"fingerprint": dbKey.Fingerprint,
+		"created_at":  dbKey.CreatedAt,
+	}
+
+	tag, err := database.Conn(ctx, s.db).Exec(ctx, q, args)
+	if err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sshkey.ErrDuplicate
+	}
+
+	return nil
+}
+
+func (s *Store) FindByID(ctx context.Context, ID uuid.UUID) (sshkey.SSHKey, error) {
+	const q = `
+	SELECT
+		id, owner_id, name, public_key, fingerprint, created_at
+	FROM
+		ssh_keys
+	WHERE
+		id = @id`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"id": ID})
+	if err != nil {
+		return sshkey.SSHKey{}, fmt.Errorf("db: %w", err)
+	}
+
+	dbKey, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[sshKeyDB])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sshkey.SSHKey{}, sshkey.ErrKeyNotFound
+		}
+		return sshkey.SSHKey{}, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusKey(dbKey), nil
+}
+
+func (s *Store) FindByIDs(ctx context.Context, IDs []uuid.UUID) ([]sshkey.SSHKey, error) {
+	const q = `
+	SELECT
+		id, owner_id, name, public_key, fingerprint, created_at
+	FROM
+		ssh_keys
+	WHERE
+		id = ANY(@ids)`
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, pgx.NamedArgs{"ids": IDs})
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	dbKeys, err := pgx.CollectRows(rows, pgx.RowToStructByName[sshKeyDB])
+	if err != nil {
+		return nil, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusKeys(dbKeys), nil
+}
+
+func (s *Store) FindAll(ctx context.Context, ownerID uuid.UUID, pg page.Page) ([]sshkey.SSHKey, int, error) {
+	const qCount = `SELECT count(*) FROM ssh_keys WHERE owner_id = @owner_id`
+
+	args := pgx.NamedArgs{"owner_id": ownerID}
+
+	var total int
+	if err := database.Conn(ctx, s.db).QueryRow(ctx, qCount, args).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	const q = `
+	SELECT
+		id, owner_id, name, public_key, fingerprint, created_at
+	FROM
+		ssh_keys
+	WHERE
+		owner_id = @owner_id
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT
+		@limit
+	OFFSET
+		@offset`
+
+	args["limit"] = pg.Size()
+	args["offset"] = pg.Offset()
+
+	rows, err := database.Conn(ctx, s.db).Query(ctx, q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	dbKeys, err := pgx.CollectRows(rows, pgx.RowToStructByName[sshKeyDB])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: %w", err)
+	}
+
+	return toBusKeys(dbKeys), total, nil
+}
+
+func (s *Store) Delete(ctx context.Context, ID uuid.UUID) error {
+	const q = `
+	DELETE FROM ssh_keys
+	WHERE id = @id`
+
+	if _, err := database.Conn(ctx, s.db).Exec(ctx, q, pgx.NamedArgs{"id": ID}); err != nil {
+		return fmt.Errorf("db: %w", err)
+	}
+
+	return nil
+}